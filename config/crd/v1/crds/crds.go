@@ -29,17 +29,19 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o#9r\xef\xfe\x15\x05\xe7a\xee\x00K\xbeE\x1e\x12\xf8m\xd63\x8b\b\xbb\x993֎\xf3\x10\xe4\x81\xea.I<w\x93\x1d\x92-\x8f\x12\xe4\xbf\aU$\xfbK\xfdAy=w{\xc1\xf0e\xc6-\xb2X\xac*\xd6\x17\x8b\xbcZ\xadVW\xa2\x92\xcfh\xac\xd4\xea\x0eD%\xf1\xabCE\u007f\xd9\xf5\xcb?۵Է\xc7\x1f\xae^\xa4\xca\xefྶN\x97\xbf\xa2յ\xc9\xf0\x13\ue912NjuU\xa2\x13\xb9p\xe2\xee\n@(\xa5\x9d\xa0ϖ\xfe\x04ȴrF\x17\x05\x9a\xd5\x1e\xd5\xfa\xa5\xdeⶖE\x8e\x86\x81ǩ\x8f\u007fZ\xff\xd3\xfaOW\x00\x99A\x1e\xfe$K\xb4N\x94\xd5\x1d\xa8\xba(\xae\x00\x94(\xf1\x0e\xb6\"{\xa9+\xbb>b\x81F\xaf\xa5\xbe\xb2\x15f4\xd7\xde躺\x83\xf6\a?$\xe0\xe1\xd7\xf0#\x8f\xe6\x0f\x85\xb4\xee\xe7\xce\xc7_\xa4u\xfcCU\xd4F\x14\xcdL\xfc\xcdJ\xb5\xaf\va\xe2\xd7+\x00\x9b\xe9\n\xef\xe0\vMQ\x89\f\xf3+\x80\xb0\x1c\x9er\x15\x10>\xfe\xe0!d\a,\x85\xc7\x05@W\xa8>>l\x9e\xff\xf1\xb1\xf7\x19 G\x9b\x19Y9&\x8aG\f\xa4\x05\x01ϼ,0\x81\xfc\xe0\x0e\u0081\xc1ʠE\xe5,\xb8\x03B&*W\x1b\x04\xbd\x83\x9f\xeb-\x1a\x85\x0em\x03\x1a +j\xebЀu\xc2!\b\a\x02*-\x95\x03\xa9\xc0\xc9\x12\xe1\x0f\x1f\x1f6\xa0\xb7\u007f\xc1\xccY\x10*\aa\xadΤp\x98\xc3Q\x17u\x89~\xec\x1f\xd7\r\xd4\xca\xe8\n\x8d\x93\x91ξu\xa4\xaa\xf3u\xb0\xbc\x0fD\x01\xdf\vr\x12'\xf4\xcb\bT\xc4<\x10\x8d\xd6\xe3\x0eҶ\xcbe\t\xe9\x01\x06\xea$T@~\r\x8fh\b\f\u0603\xae\x8b\x9c\xa4\xf0\x88\x86\b\x96齒\xff\xdd\xc0\xb6\xe04OZ\b\x87A\x00\xda&\x95C\xa3D\x01GQ\xd4x\xc3$)\xc5\t\f\xd2,P\xab\x0e<\xeeb\xd7\xf0\xaf\xda H\xb5\xd3wpp\xae\xb2w\xb7\xb7{\xe9\xe2n\xcatY\xd6J\xba\xd3-o\f\xb9\xad\x9d6\xf66\xc7#\x16\xb7V\xeeW\xc2d\a\xe90#FފJ\xae\x18u\xc5;j]\xe6\xff\x10\x05\xc0~\xe8\xe1\xeaN$\x8c\xd6\x19\xa9\xf6\x9d\x1fX\xeag8@\x1b\xc0˗\x1f\xeaW\xd1\x12\x9a>\x11u~\xfd\xfc\xf8ԕ=i\x87\xd4g\xbaw\x04\xb2e\x01\x11L\xaa\x1d\x1a\xcfĝ\xd1%\xc3D\x95{\xe9c\xd1-$\xaa!\xf9m\xbd-\xa5#\xbe\xffW\x8d\x96\x84\\\xaf\xe1\x9eU\fl\x11\xea*'\xc9\\\xc3F\xc1\xbd(\xb1\xb8\x17\x16\xbf9\x03\x88\xd2vE\x84McAW;\x0e;{\xaau~\x88\xbal\x82_^!<V\x98\xf56\f\x8d\x92;\x99\U0007601d6\xad\xbe\xf0\xeaj\xdd\x039\xbee\xfdd;Q\x17\ue677\xba}ҿ\xa2u2\x1b\xf6\x1a \xf5itPD\n-\xbc\x1e\xd0\x1dА\xfc\xf0\x0f\xbc%\xcf`\x02\xb3\xd4b\xce;R\xbc \x88\x80=o\xed\xa2\x80JG-da{\x8aȮ\xcf y\xdan\xb5.P\f\xb5\x04~͊:ǼQ\xdbg4\x18\xac\xee\xf3\xd9\x006iB*\xda5dD\b=\xd5\xfeJ\x8aydq\xc2 \x90\xdcJ\xe5\xe1\xb1\xce=\xe0(\x83\xa8I\x87\xe5\bn\x93b\xe6\x1b\x99J\xb1-\xf0\x0e\x9c\xa9q\x822\xc2\x18q\x9a\xa0K4\xef\xa9di\xfa\a-RȌ\xedO\xa3+\x982\xdeZ\ts\x8e\x11\xfc\x9e\x89r\xd0\xfae\x89\x10\xffB}Z\xbd\a\x19{I\xb0Ń8Jm\xc2҃\x19\xda\"\xe0W\xccj\x87c\xf2/\x1c\xe4r\xb7CCp\xaa\x83\xb0h\xbd\xe9\x9b&\xc8\xf4V\xa6f\xa6\x99y\xb6\x8e\x96\x91$\xa9\xbc\xf2)\xd4iC\x0f\xf7Ul\x84(\x19\rr[T.\x8f2\xafE\x01RY'T\xe6\xd7#\x1a\xbc\xce\xd7\x03sL>\xc3٫È9q\xa2\xa7\x1a\xb5B\xd0\x06J\xb2\a\xe7]\x87\x16\xacmS\xcb\xde\n\xd2Nڋ\xa8\xa9\v\xb4a\xaa\x9cun\xab\x03n&A7\x1c\xf1\xbeD!\xb6X\x80\xc5\x023\xa7\xcd89\x96\x98\xec[\x8a^\x9b\xa0∆ku7-\xb5]\xd8\fH \xb5\xfdz\x90\xd9\xc1\x9by\x92 \x86\x03\xb9F˻\\TUq\x9aZ$,q>L2\xb7\xd1۶\xb0\xe5\x87\xf0\xc66\u007f\xdb\x12tc\xdb\x16\xb4d\x9f\xb2\x8d8\x80ӳ\xcb\xfe\xffIب\xf6\xdf \xb4\x9b\xb3\xa1\xef+\xb4DRI\xee\xfcf\aXV\xeet\x03\xd2ůK\x10\xc9Yi\xe7\xff;f\xcc\xe5\x12\xbf\x19\x8e|W\x89\x9f\xe5\xca\x12D\xe2J3\xfd\xdf!S\xd8X<\x06[\x91̐_\xba\xa3n@\xee\x1a\x86\xe47\xb0\x93\x85C3\xe0\xcco\xda/\xefA\x8c\x14{G\xad\x14.;|\xfeJ\x9e\x97m\x13N\x89t\x19\x0e\xf6\xfek\xf4\xe7\xfb\x86y\x01.p\x80*\r\x96>\xf0}bj\xb6_أ\xfa\xf8\xe5\x13\xe6s\xe4\x814\xc9;[\xc8\xc7\x01\xb2ݩ\x83S\x9e\xba\x8c\xe0\xfa4\xf1\x8dOi܀\x80\x17<y\x8fE( \xe6\b\x9ah\"\xd29'\x0e\xe7VX\xc8^\xf0\xc4`B\xb2dqt\xaa(\xf8\xf6\x82\xa7\x94n\x03\x02\x12N҆$\x10Q\x92>0!8\xb6N'\x1ep\xe2+\xea\xa2\xe5\xc5A\xba\"\x89-\xd2\xfe\r\xcbl\xd8\xd6I\x1a2c?X\xcf\"\xda\x05\aY%.\x94\xcc\x1cX\xe4\xdd\x12S_Ϣ\x90y3\x91\x97\xfb\x8d\x9a\xf6\x86\xfb\xed\x8bv\x1bu\x03\x9f\xbfJ\x1b\xb2\x8f\x9f4\xda/\xda\xf1\x97oBN\x8f\xf8\x1b\x88\xe9\a\xf2\xf6R^m\x13\x1d\xba9\xb4\x04\xe1\xf6m\xe3#\xbc\x86=\xd2\xc2FQ\xdc\x12\xe8\xc1\x19Q?ݼ}跲\xb6\x9c$SZ\xad\xd8T\xae\xc7f\xf2\xc4N\x04\xa9M\x8f#\xe7\xa85\x93\xfa\t\x13\xc1>\x91%\xf1\xe3}\x8e\xb7\x10\x19\xe6\x90\xd7LL\xceL\n\x87{\x99A\x89f?g8\xba\xad\"\xfd\x9e\x86B\xa2\xd6\xf5\xedB\tK3\xed\xb1\x05՝/#\xb3\xa2\x9d\x9b\xd0+2{\xb1\xebDBr\xba\xeb\xf2\x8a\xd8Ĳ\xff\xb1H]\x91\xe7|\x96$\x8a\x87\v4\xfe\x05\xbc8\xb7\xfd\x1e1o!K\xc1I\xc6\xff!3\xc7\x02\xfd\xbfP\ti\x12\xf6\xf0G>\x1a*\xb076d\xb1\xba\xd3\xd0\f\xd2\x02\xf1\xf7(\x8a\xf3T\xf7\xc8\xe24\xe9\x16,\xbc!\u05fb3\x8f\xe5\x06^\x0f\xdaz\x9b\xba\x938\x9aR\xed7i\xe1\xfa\x05O\xd77gz\xe0z\xa3\xae\xbd\x81\xbfX\xdd4ނV\xc5\t\xaey\xec\xf5oq\x82\x12%1\xa9\x1b\x1f\xc1\xa5\xba\xca\x14KFO\x80\x066\xe7N\xe4\xe6\xcea\x9d$\x87\x95\xb6.\x19\x95\am\x9d\xcf,\xf6\xdc\xd2K\xb2X\xe0e(d\xaf@\xec\xfcɟ6\xf1L\x87\xd4\xde \xe1J\\\xb3\xf3\x1a\x96\xd8\xd8d\xc4<P\n\xac\xae\xdb\x1d\xec\xf5\xe9\xb5?\xe8\xe1ID\xc6\xce\xc5\"\xdc\xca\xe8\f\xad\x9d\x17\x91\x04m\xbd\x90$l\x12\x84\xc2\a0\xfe\xc0d>)\x19[\xbaCJD\xbaЕ\xff\xfc\xb5\x93\xbd\xa4\xcdO\u007f/\tߥx\x01\xefٲ\x14Ó\xc1$\x14\xef\xfdȸM\x02 \x1f\x1a\x98}\xcd[=݃\f\x82\xf4{0ӥT\x1b\x9e\x00~xw\xb3\xde(I|\x8b\xe3~\x1fǶDo>\xf0\xeeM\xf5\x884g\xee\r\xf68w\x9e\xe7&G1\x11\xa4Ү\x9bN \xb8\x95\xce?X\xd8Ic]\x17\xd1T\xa1\xa8\x17v\u007f\xdb.\x8d\x9c\xd4gc\xde\x148\xfdُ\xec$\xb2\x0e\xfa5\x9e\xafN\x1ef\x8e5>\x14B\x90;\x90\x0ePe\xbaV\x9c~\xa1\xad\xceSx\x16x\x05\x9dL\xb24\x05A\rU]\xa6\x11`\xc5R'\xd5l\x9e\xa6\xdb\xfd'!\x8bo\xc16'K\xd4\xf5\xac\xe1l[\x8fmO~d\uf83c\x14_eY\x97 J\"}jس\xf3\xc51=\x8eë\x90\x8e-\a\xc1e3\xe24m\xaa\xaa@\x97\xba#\xb7\xb8ӆ\xf7\xb3\x9596\x869H\x81V `'dQ\x9bD\ry\x11m/\x895\x82\xb2x\xbf \"m\xf2\x15\x93\"!\x11\x9b\xe8,\xcek\xebʤ\xbb\x8a\x0f\x06\xd3ܳ\xa5\xa4tt\xcf*#I\x96\xf4{{hAĄ:}w\xd1\xce\xdaw\x17m\xa1}w\xd1&\xdbw\x17m\xb9}w\xd1B\xfb\xee\xa2\xc5\xf6\xddE\xfb\xee\xa2\xcdu\x9b\xd3\xd6K\x18\xf9\x8a\xfb\x89\x1f\x17\xb1H8\x9e\x9eCq\x06~\xa8\xa6\xb8\xf7\xd5\xf7\xa9\x15\x96\x9b\xf1Q#u\xb5\xa1\xac\u007f\xc57\x12\xc6$\xa0-\xbahMISrI\x1b$\x8a\xb7/ ^(\xc2L*\xa7\x1c\xaf\xbeM)\xf8Y*\xf3\xe9י6e6\xb1\xd0T\xc7IF\xe8\x10o6\x90\xdbۭ!\xe9\xd7밟\x1b1\xfd\x9bנ&\x94\xe2,\x14\xe0\xcc\x17\xe6\xce\xd1k\x10z\xf4\tfz\x05\xa3\xbf\x1bz-T\xc9L\xd7Ƅ\x93 t\xe2\xf8ú\xff\x8bӡR\x06^\xa5;\x8c,\xe5\xf5\x80\x8aϰԾ[\xf6\x1a\xe5-\\1\x19\xd2\x11\xb4\x01%\v&猴\xf6\xc8\v\u007f\xae|\bw\xf1\xbe\x9c\x0f?\xd2ji\xde\\Aӯ\x90\x99Pї\x1e\x19\xa5\x17\n\xa7\xd7\xc8\xcc\x17\xb5\\R\x193\xac{\x99\x04\xba\\\x0f\x93\x129.Ծ\xbc\xa1\xe2%\xb1\xda\xf17\x1f\x8c\xa5Դ\xbc\xa9\x92e\xb1 0\xb1~\xa5_\x992\x0f\U00082a95$\xe2,W\xa8\\\\\x97\x12\xea@fב\\\x8d2Rg2\vx\xb2\x06e\xae\xbad!+u^y\x92^S2\v\x9a\xebM\x96+Iޯ^\xf4=|\xe0iU\xb3X\r\xb2\xe8#\xcf\xe3\xb7X\xefqI\x95\xc7\"\xc5\xdeX\xd1\xd1TlL\xcc{i\x1dG\xbfNc\x02hJ\xf5\xc6Du\xc6\x04\xc4ٚ\x8dԚ\x8c\t\xd8\vfwVJf~\x1c\xbf\b\t\x8b\xf6\xad\xf8kI\xd4[\x17\xa6M\x8ef\xd6COEs\x16\xc5~\xc6k0\xe7\xa0\xcc>^\x9c\xa4^]\xaf\u007f\x8c\xe5\xba)\t\xcf\xe0g\xa9r/'$\xe8\x1d?\x81/\nsQL㮴\xfe\xde8\xd0A\xa4a\xb1\x12\x86o\x92oO>[a\xd7\xf0Yd\x87~G8\bK1i9\xea\x86]7a\xdam\x1cE_\xae\xd7\x00?\xe9&\x12\xee^\xb3\xb2\xb2\xac\x8a\x13\xd4\x16\xe1\xba?\xe4m1Ǩ\x04X%*{\xd0\xf1\x0e\xecB\xd8\xf1\xd8\xef=\x12\xd1\xc7\x1b\xb0Y\xa1뼁>\xc1<\xa1N\xf0\xf0̾\x0f\xdf\x1d\xcc\xda{\x94\xc1\xbf\x89\x91\xc4\xf0\x9a\xe5\x8f\xef\x1f\xe1[\xa7\x8d\xd8\xe3/\xda_F^\xa2D\xbfw\xef&z\xd0a1\xe3\x16\v\xb2\xc4\b\x11µ\xe8\x01\xb06\x91\x1evC\x9b\xfc ,\xc7\xd4\xdb\xcc\xfes\xaeXX\xcc\xd3\xd3/~\x01N\x96\xb8\xfeT\xfblʪ\x12\xc6\"Q3.\xcc\x0f\xda\xd2\u007f\x0f\xfauL\xe1\xe9\xb0\xe6\x1f\x87x\x1b\xe4d='m.\xc2\xde_\x9b\x8e\x82\x17I\xb4$\xa8\xcf\xe3\xa3:\x81^\x87I~\x97\xeb\xb1s\x89)8\x9d\xd7%(\xb0\xf6\xc5v\xef{\xf7w\xcac\x99\xba\u007f\uf12b\xed\xf2\r|\xee\x16\xdf\xdb\bG>\xb5ዻ\x1e\x84\xbf\xe8\xfa\xa6K\xf8!C\xdd{\x03e\x9eO\xf7\xe7#\xf8\xa5\v\x93{\xd487\xdeܦ\u007f\x15\xb6ɂ\x8f\x9a\xf8\x16\x9c\x1f\xc9\x1e4A\xc3\x1c\xf0\x88\n\xb4\xe2\xa47_\x89\xf5\xaf\xb1\fǌ%\x93:PBV\xbd\xae\n-\xf2\xb8ã\xcd\n/x<\xb1\xfe2G4\x1f\xec\fL~1`\xa7\xcd\x18\x11\xce\x15\xa67,w\x90\v\x87\xabQ\xa0I\xbaoT\xd82+\xfb\x82n?:G\xf1Ș\xb7\xde\xe7\xdf\xe3fjd\xb4\xbfN;Q\x80\xaa˭7\xe8\"v\x18\xe3\xdf\xe3f\xb0\xe5l8\x06\x99\xd9^~aR9\xdc\xe3\xd0\xe9<_\xd9}\x94\x9f\x8bW\u058c\x9cZ\x99\xad\xb3\f\xad\xdd\xd5E1\x16d4\x92\xfb\xfe\xcb\xe4\x03\xbeŇ\x0f\xb8\x93W\x81|:\x18_\xa7\xf0ǃ%Z+\xf6\xf1ŃW\xb2@{TȎ\xcf\xc8jB@\xda\x1e'\xf5\xef\xfb\xfb\x9c\x98\xc8\\-\xc2\x041\x1d\xd8\xe9\xf5a\xcc/(\xf4\x1ev\xb2\xe0\xae\xe1m\x96`\x9a/\xa4\xc9\xd7J\x9a\x14S\xfe\xb9\xe9H\xb4\xe1\x84(3\xa2}\xc3\b\v\xb9\x97d\a\x89I{a\xb6b\x8f\xabL\x17\x05r\xed\xc99^\xdfr\xb3\x86C\xbb_Q\xd8ť\xfd\xd4\xed\x1br+\x9e\xdb\xfe\xba\x9c\xf0\xaf\x96\xf0\x936N\x1al߈:CH\xf3\xc4\x17\x99nO\x85\xd1ה\xce1\xed\xf6\x8d\x1b,\xe8U\x0f'>\xaet\x13\x9c\xc1\xf1X\xa8\x14\u007f\xd1\xe6\x06J\xa9\xe8\x1f\xf2\xf89\t\x12\a_\x84??d\xb1\x80\xf7\x03\xf5ij':\x86\x14㆘rU\xc7\xcf\xcbW\xf0\x05\xcf=+\u007f\x04\x8e9\xa7\xf9ƞ\x90\xa2.\x1b\xf5`\xf4\x9e\xa2\xf4\x91\x1f\x1b\xe55\xf2ۃ0N\x8a\xa28\xf9I&g\x1f\xf9\xe1\x13\x92\xe1\x9a\xf4^\xc6\xc9\x1a\xb0\\\xa2l\xe8֦\x04\xa4\xf2\x92\xc0\x87\xd7[]\xbb\x9e*iUшX\xc49\xd7\xf0E;\x8c\x19cهI\xca\x17\xad[\xe1n\xa7\x8d\xf3\x19\x88\xd5\n\xe4.xC#piO\xf0)\x89\u007f\xd1\t\xa4k3u\xad\xf4r\xa0cx\x13\xf2\xb5\xefR\x9c\xfcA\xa6\xc82r\xb6\xf1\xd6:Q\x8c\xe8\xb7\xdft0\xc2n'I\x1f\xe6\xff6⇝\x11|\xd3\xed\xdf\xdc&i\xac\x1b\x83\xf3\x94\xe3B\x13\xaf\xdbG-\x1dp\xf9\x01*x5\xd29ҧ\xddc$p\xa4A\x8b\x02,锉\xb7C\xe64;\xffN\xb6w3\x9d\xbe\xec\xc77M\xe7)\xd3\x1d\x16\xa7\x89-[&\xc1Ĳ|!\xa3\xb4q,\xb12;\b\xb5'\xa12\xba\xde\x1f\xa2\\NX\xc6\t\xb8yMHAU\xd4{\x12\xf5p\f\xe3j\xa3:)\x98p0\x93w\xd0\x15\xd9\xcb$\xa6!\x11\x1d_\x15\xbc\r\xaf\x81\xacvF\x97\xab\xc0\vNQ݄Ԉ\x91\x9a\xfc\u007f\n\xe4'\x80\xb6\xd7\xeeY\f\xaa\n\x15\b\x1b\xf0I\xa8\xb2\x9cg\xeb\\\x9e\xc2\t\xe3R\xa3\x8a\xc7^煀\x82!\x8f\xe3\xfb\x18\x12?\xbe\xda\xf4~\xf8\xbe\xe3\rX\xa9\u20c6>\xb1\xe4E\xc1R\x9ca\x90c\xf5ѣ\xb1\xb3\b\xa1\x17\x0f\xf4\xd1\xff\xeb\x86\x02\xc7\xc6\xc2|N\xf1)\x9f\a\xdd\aG\xf6\xb4\xcb[\x88\xc1\x0f\x1c\xa1\xc7\x1f\xe4Ο\xd9e\x84\xf5\x1f\xff\xe6G\xf1\xc7$\x9f\xe5ì\xbb\u009eH\xe3w\xc0'\xac\ffb4\xf0\x00x(\x90\xfc\b\x8b\xd8\xf7\x84>\\\xe4\xf2\x1e\xdf\x16Ľg\x04\x17\xdf\xda|\x9f\xb8\xe6\xf8\xb6\xd8\xed\x9b\x05nﻺWa\x94T\xfb\xa5=\xf6\xef\xa1\xdbH\xe4\x16 \x8c\xc4n#\xcbh\xa2\xb9\xc5ح\x13\xbaE\x1c'\x9e\xc0\x1b\x84s\xef\x14\xbc\x8dځ\xb3\x8f\xac@\xf3\xce\xde\x0e3\x85/mBLd\x19\x92\xb8~\x19\xbe\xa9{}\xcd\u007f\xc4gs\xf9\xcfL+on\xed\x1d\xfc\xc7\u007f^Aȸ>\xc7\xf7q\xe9\xe3\xff\x05\x00\x00\xff\xffj\x17\xd2\xcb\u007fX\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKo#\xb9\x11\xbe\xebW\x14f\x0f\xbe\x8cZ\xb3\xc9!\x81.\x81F\x93\x00\x83x\xd6\xc6\xc8q\x0eI\x80\xa5Ȓ\xc45\x9b\xec\xf0!\xad\x12\xe4\xbf\aŇ\xba\xd5ݲ\xe4A\xb2ˋ->\x8aU_\xbdٓ\xe9t:a\x8d|F\xeb\xa4\xd1s`\x8dğ=j\xfa媗\u07fbJ\x9a\xd9\xfe\xfbɋ\xd4b\x0e\xcb༩\xbf\xa23\xc1r\xfc\x84\x1b\xa9\xa5\x97FOj\xf4L0\xcf\xe6\x13\x00\xa6\xb5\xf1\x8c\xa6\x1d\xfd\x04\xe0F{k\x94B;ݢ\xae^\xc2\x1a\xd7A*\x816\x12/W\xef?T\xbf\xab>L\x00\xb8\xc5x\xfcI\xd6\xe8<\xab\x9b9\xe8\xa0\xd4\x04@\xb3\x1a\xe7\xb0f\xfc%4\xce\x1b˶\xa8\fOwU{ThM%\xcd\xc45\xc8\xe9\xea\xad5\xa1\x99C\xbb\x90(d\xb6\x92H\x1f#\xb1U\"v\x9f\x89\xc5u%\x9d\xff\xf3\xe5=\xf7\xd2\xf9\xb8\xafQ\xc12u\x89\xad\xb8\xc5\xed\x8c\xf5?\xb4WOa\xedTZ\x91z\x1b\x14\xb3\x17\x8eO\x00\x1c7\r\xce!\x9en\x18G1\x01ȘEjS`BD-0\xf5h\xa5\xf6h\x97F\x85Z\x9f\xee\x12踕\x8d\x8f('Y \v\x03E\x1ap\x9e\xf9\xe0\xc0\x05\xbe\x03\xe6`\xb1gR\xb1\xb5\xc2\xd9_4+\xffGz\x00?9\xa3\x1f\x99\xdf͡J\xa7\xaaf\xc7\\YM:z\xec\xcc\xf8#\t༕z;\xc6\xd2=s\xfe\x99))NZ\a\xe9\xc0\xef\x10\x14s\x1e<MЯ\x84\x10\x10D\b\x05!80\x97\xef\x01\xd8'*\x11\xa3qN\xd5\xe0\xae3\xb6\x89\x15x\xeeQI\xfc\xd3L\xe6\xbeC\xb6\x18~50\xda3\xba\x8b-^\"v\x06\xc5'ܰ\xa0|WTҒ\xea\xda\xe5\xb9X\r\xf2J\xa4Sg7~:\x9bK\xb7\xae\x8dQ\xc8\x12\x95\xb4k\xff}\xb2B\xbeÚ\xcd\xf3fӠ^<~~\xfe\xed\xeal\x1a\xc6\f\xa9\xe7\x14\xa48\xd6\xd1\xcd\x0e-\xc2s\xf4\xbf\xa47\x97E;\xd1\x040럐\xfbV\x89\x8d5\rZ/\x8b\xb3\xa4\xd1\tR\x9d\xd9\x1eOw\xc4v\xda\x05\x82\xa2\x13&;\xca\xfe\x82\"K\nf\x03~'\x1dXl,:Ծ\v\uf271\r0\x9d٫`\x85\x96Ȑ/\a%(\xa8\xed\xd1z\xb0\xc8\xcdV\xcb\u007f\x9dh;\xf0&\x1b\xafG\xe7{4\xa3\u007fj\xa6\xc8T\x03\xbe\a\xa6\x05\xd4\xec\b\x16\xe9\x16\b\xbaC/nq\x15|!{\x97zc\xe6\xb0\xf3\xbeq\xf3\xd9l+}\t\xce\xdc\xd4u\xd0\xd2\x1fg1\xce\xcau\xf0ƺ\x99\xc0=\xaa\x99\x93\xdb)\xb3|'=r\x1f,\xceX#\xa7\x91u\x9d\x82f-\xbe\xb39\x9c\xbb\xbb3^\a^\x9bF\x8c\x9a\xafh\x80\"f\xb2\x82t4I\xd1\x02MS\x84\xce\xd7?\xae\x9e\xa0\\\x1d\x95\xd1G?\xe2\xde\x1et\xad\n\b0\xa97h\x93\x127\xd6ԑ&j\xd1\x18\xa9}\xfc\xc1\x95D݇߅u-=\xe9\xfd\x9f\x01\x9d']U\xb0\x8c\x19\v\xd6\b\xa1\x89~_\xc1g\rKV\xa3Z2\x87\xffw\x05\x10\xd2nJ\xc0ަ\x82n\xb2\xedoN\xa8u\x16J.\xbc\xa0\xafQ/^5\xc8\xcf\xfcG\xa0\x93\x96,\xdc3\x8f\xd1/z\xb8f\x17\xbf\x9cL\xcb\x18wn\x1a\x8cst\xee\x8b\x11\xd8_鱼8m<\xe3\xb1A[K\x17\xd3\"l\x8c\xedg\fv\x8a\xc0\xddQ\"U5XC\x1d\xea!#S\xf8\x8aL<hu\xbc\xb0\xf4W+\xfd\xf0\xa2\v\x8a\xa4\x91X\\\x1d5\u007fD+\x8d\xb8\"\xfc\xc7\xde\xf6\x13\x04;s\x80M4k\xedՑb\x90;j>\x8c\xb6e,\x1e?\x97ț\x1c(\xfb[ƪ\x82E\xf6\\\xb3\x81\x0f \xa4\xa3\x02\xc0E\xa2C\xb0\xa8<\xa3\xf59x\x1b\xde$>7z#\xb7C\xa1\xbb5\xcd%\x8b\xb9B\xba\x87\xdc2\xdeD\xa1\x89\xac\xa3\xb1f/\x05\xda)\xf9\x87\xdcH\x9e9\t6e\xae\x8dD%\xdcP\xd2\v^\x16E\xb1(ȫ\x99\xba\xa2\xc3\xe5ic,\x8d\x99\xd4ɂ[\x021\xd8\xd8:\xa7T\xedQ\x8bS5rƍ\x89Qˡ\x80\x83\xf4\xbb\x14\x0e\u0558\xdf\xc1\xab\xbeG\xe3\x05\x8fc\xd3=ޟvH;S\x02Ep\xc8-\xfahm\xa8\xc8|Ȕ*\x80/\xc1ŀڏ\x13e\xc4B\xad\x9c~\xc1\xe3\x10h\xb8\xa6\xdc\\\xc2\\g\xf9\x8eJ\xe7°\xc5\rZ\xd4~4\xa8Sgb5z\x8cq]\x18\xee(\xa4sl\xbc\x9b\x99=ڽ\xc4\xc3\xec`\xec\x8b\xd4\xdb)\x01>\xcd\x1e4\x8bm\xc5\xec\xbb\xf8\xe7\x82\xc8O\x0f\x9f\x1e\xe6\xb0\x10\x02\x8cߡ%\xadm\x82*\x86֩o\xde\xc7\x1c\xfb\x1e\x82\x14\u007f\xb8\xfb\x16\\L\x93<\xe7\x06lV\xd1\xfa\x8fT\xa8E\xa6\b\xa2UҊ\xb1@\x99\x92\x94]gm\xa6X3f\x88c\x15fwP`\xa2\f2\x16Q_p\x18L_q\xb3\\\xec^\xf1\xb1RHK-$\xa7B\xec\xdc7J\x83!\xce\xea\xed\x11\xc1\xfa\x15\xf8\xa5\x880.x\x12 \xe7\xc3+\x1c?t\xf7\xb6mY\nO9\xc79\xf4T@9\xd0H9\x90\xd9!r1(p\xa35y\xa37\xc0N\xa1\xee\xce\xf5c\xfc\x1b#\xc4:\xf0\x17\x1c\x01~ \xcaǸ\xb1`\x9c\x8e\x11/\xc1a\f\xbe\xd7\u0600\xeb6\xce\xd9\x12\xed-\xbc,\x17\xb4\xf1\x94&\x19,\x17\xb0\x0eZ(,\x1c\x1dv\xa8\xa9C\x90\x9b\xe3\xf8]4\x9e\xeeW\x05\xd5Xa\xe4\x1a\xbf`;.C\x8a\xe1sX\x1fGj\x82\x1b\x84l,n\xe4\xcf7\b\xf9\x187\x16\xc0\x1b\xe6w \xb5\x93\x02\x81\x8d\xc0\x9f\x8a\xb5\v\x82\x9e\xf2\xffC\x8e\"ߠ\x9e\u05fc=\xb1\xf3\x16\x87/\x18_\xf1\x9fǼ\xed\x84B\xf9\x9d#\xffy-xɏG%ڟ\x1e\f\xfe\x94*,>\x92*Ϙy\x1e\x9ex\xa5R+\xcf\x16c\xceLu\x81\xb1\x16]c\xb4\xa0\xe6\xe9\xb6:\xade\xf9\u007fW\xad\x8d\xabuz\x1e\xe5zkE\v7\xb5*\xf1\x89\xe6\xcd\xcdJz\xb8\xea\xb6\x02f\xed\xa8Sl\xfb\x95\x9e\x8c\xbfH\x9b\xf2\xaeӧP?\xac!\xe8X\xa9Ō_\xc1\xdf5|\xa2ޖ\xb2\x93\x98\x13\xdfv\xcc\x00\xa4\x03m\x0et\xbcC/\x92\x00\xa3S\xbe\xa6n\x8di\x91\x9b\xe1\xb8t\x90JQƶX\x9b\xfdhƦBӢ:\x02sd:\xfb\xdfT\x1f\xaaw\xbfZ\x17\xa4\x98\xf3\xd4Ԡ\xf8\x8a{9|\xe5\x19\xa2{?8Q\x1c\xff\xe4\x0e\xf4\xe3\xc7\xd2,\xcfl\xde\xf6\xe3\b\x18\x1b\xa9\xa8\x16\x1c\x89\x13m\xc50|\x8f\xfc\xb8\xba\xbfs\xb1\x84G\xed\xc7ʾ\x03Z\x8c\x1d\x13\n\xaa\xe2M~\x97\bΣ\x1d1\x80\x93\xf6\xa2\xceA\x19\xbd\xed9N\x1a\xf9\x95\x82*\xb4dPƂ@O\xa9Io\x81\xef\x98\xdeb\xfb\n\x95\xf9\u007f\x9dS2\x9f\x9eʹ\x16\"\xf5%\xf3\xb8I\xa3Or\xacL\x1f\xbc\x00\xb7\x9b\xc7_\u007f\v\xf7E\xb3\x17ۜ+\xb8\x0f\xf6\x97,M\xa0N}\xfb\"\u070eooo\x87\xcf\xcd7 \xf1ַ\xf0W\xde5\xe0\xc0\\\xfb*\xfe\xeb\xe1PS\xb5z\xb5\x04\xfe\x92v\xa5\xe7\xc3|\x04\xd8\xda\x04\xff\x9agލ\x19t~\xee\u007f\v\x8f\xf1#Ƶ\"\x83\xf6\x14\x8d\xf0`\xa9\x95l_\xc5bP\x18\xcb-\xb7?/-z\xdfZ\xbak\xc3/17\xc85\x9ak\a\x93)_v\xf4\x9aA\xee΄\xf5饸p\x9e36\xfc\xfb?\x936yS\x86l<\x8a\x1f\xfa\x9f\xdaޥ\x00R\xbe\x97ş\x9c\xaa\x9a\xf4\xad\x10\xfe\xf6\x8fI\xba\x18\xc5s\xf9\xc0E\x93\xff\r\x00\x00\xff\xff\x04\x0e\x95\xf5\xa5\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V=s\xe36\x10\xed\xf9+v.\xc55\x11u7)\x92Q\x97\xf8\xae\xf0$\xf1x\xec\x1b7\x99\x14\x10\xb0\x127&\x01dw!\xc7\xf9\xf5\x19\x00\xa4%Q\xf4\xc5)\u008e\xfb\x85\x87\xf7v\x97lV\xabUc\"= \v\x05\xbf\x01\x13\t\xffR\xf4\xf9M\xda\xc7\x1f\xa4\xa5\xb0>|l\x1eɻ\r\\%\xd10ܡ\x84\xc4\x16?\xe1\x8e<)\x05\xdf\f\xa8\xc6\x195\x9b\x06\xc0x\x1f\xd4d\xb3\xe4W\x00\x1b\xbcr\xe8{\xe4\xd5\x1e}\xfb\x98\xb6\xb8M\xd4;\xe4R|:\xfa\xf0\xa1\xfd\xbe\xfd\xd0\x00Xƒ\xfe\x85\x06\x145C܀O}\xdf\x00x3\xe0\x06\x1c\xf6\xa8\xb85\xf61E\xc6?\x13\x8aJ{\xc0\x1e9\xb4\x14\x1a\x89h\xf3\xc1{\x0e)n\xe0\xe8\xa8\xf9#\xa8z\xa1O\xa5\xd4O\xa5\xd4]-U\xbc=\x89\xfe\xfcZ\xc4/4F\xc5>\xb1\xe9\x97\x01\x95\x00!\xbfO\xbd\xe1Ő\x06@l\x88\xb8\x81\x9b\f+\x1a\x8b\xae\x01\x18\xf9(0W\xe3\x8d\x0f\x1fk9\xdb\xe1`*~\x80\x10\xd1\xffx{\xfd\xf0\xdd\xfd\x99\x19\xc0\xa1X\xa6\xa8\x85\xd5\x05\xfc@\x02\x06F\x14\xa0a\x04\a\xc1#\x04\x86!0BE*\xedK\xd1\xc8!\"+M\xfc\xd5\xe7\xa4uN\xac3\b\xef3\xca\x1a\x05.\xf7\f\nh\x87\xd3Mэ\x17\x83\xb0\x03\xedH\x8012\n\xfa\xdaEg\x85!\a\x19\x0fa\xfb\aZm\xe1\x1e9\x97\x01\xe9B\xea]n\xb5\x03\xb2\x02\xa3\r{O\u007f\xbfԖ|\xcf|hot\x12\xf9\xf8\x90Wdoz8\x98>\xe1\xb7`\xbc\x83\xc1<\x03c>\x05\x92?\xa9WB\xa4\x85_3M\xe4wa\x03\x9dj\x94\xcdz\xbd'\x9dFƆaH\x9e\xf4y]\xba\x9f\xb6I\x03\xcb\xda\xe1\x01\xfb\xb5\xd0~e\xd8v\xa4h51\xaeM\xa4U\x81\xee\xcbش\x83\xfb\x86\xc7!\x93\xf7gX\xf597\x8c(\x93ߟ8J7\u007fE\x81\xdc\xcbU\xf6\x9aZoq$:\x9b2;w\x9f\xef\xbf\xc0tt\x11c\xce~\xe1\xfd\x98(G\t2a\xe4w\xc8U\xc4\x1d\x87\xa1\xd4D\xefb \xaf\xe5\xc5\xf6\x84~N\xbf\xa4\xed@*SKf\xadZ\xb8*{\x04\xb6\b):\xa3\xe8Z\xb8\xf6pe\x06쯌\xe0\xff.@fZV\x99طIp\xba\x02\xe7\xc1\x95\xb5\x13Ǵ\xa3^\xd1kah\xef#ڬ`&1gӎl\x19\x0f\xd8\x05\x86\xa7\x8el7\r\xed\x8cݗ\x01o\xcf\x1c\xcb\x03\x9d\x9fZ&/\xa5\xb9\xe7\xd5\xcbCю\x18g]\xb8:)\xf6&^\xd4h\x92\xff\xc8Lə\xb8\xb1\x89\x19\xbd\x8e\x95ʶXJz+\x17\xc8\x1c\xf8\xc2:\x03\xf5\xb9\x04\x95\xef\x9c!/`\xfc\xf3\x98\b\xda\x19\x85'\xe4<\x066\xa4\xbcgЁK\x17\xfc\x8d\xb4tX\xc5\xca\xc2F\x0e\x16Eڋ8R\x1c\x160}E\x9d\xfc\xe4o\xa8\xd9\xf6\xb8\x01儯(k\x98\xcd\xf3\xcc\x17;#\v\xadpF\xc1m\x8eY\xd2\x00\xebV\xc7\u007f\x17\xa1\xd0\xed\xd3py\xd2\nn\xf0i\xc1z\xedo9\xec\x19e\xde\xf2\xd9y[\xd9+\xdf\xd47\xb2\xb4ؔ\x17F\xc9\xfbΝ\xb0(\x1a\xd8\xec'^\x8f-l\xacŨ\xe8n\xe6\u007f\x1d\xefޝ\xfd>\x94W\x1b\xbc\xa3\xfa\xd3\x04\xbf\xfd\xdeԪ\xe8\x1e\xa6\xbf\x81l\xfc'\x00\x00\xff\xff\x8c\xdb\x1fܮ\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdc6\f\xbd\xfbW\x10\xe9!-\x10{\x12\xf4\xd0bn\xed&\x87\xa0i\x10\xec\xa6{)z\xd0\xc8\x1c[]YREj6ۢ\xff\xbd\xa0dχǳ\xbb9T7K\x14\xf5D>>\xd1U]ו\n\xe6\x16#\x19\xef֠\x82\xc1/\x8cN\xbe\xa8\xb9\xfb\x91\x1a\xe3W\xbb7՝q\xed\x1a\xae\x12\xb1\x1f\xae\x91|\x8a\x1a\xdf\xe2\xd68\xc3ƻj@V\xadb\xb5\xae\x00\x94s\x9e\x95L\x93|\x02h\xef8zk1\xd6\x1d\xba\xe6.mp\x93\x8cm1f\xe7\xd3ѻ\xd7\xcd\x0f\xcd\xeb\n@G\xcc\xdb?\x9b\x01\x89\xd5\x10\xd6\xe0\x92\xb5\x15\x80S\x03\xae\xa1\xf5\xf7\xcez\xd5F\xfc+!15;\xb4\x18}c|E\x01\xb5\x1c\xdaE\x9f\xc2\x1a\x0e\ve\xef\b\xa8\\\xe6\xed\xe8溸\xc9+\xd6\x10\xff\xb2\xb4\xfa\xc1\x8c\x16\xc1\xa6\xa8\xec9\x88\xbcH\xc6uɪx\xb6\\\x01\x90\xf6\x01\xd7\xf0Q`\x04\xa5\xb1\xad\x00ƻgX\xf5x\xbbݛ\xe2J\xf78\xa8\x82\x17\xc0\at?}z\u007f\xfb\xfd\xcd\xc94@\x8b\xa4\xa3\t\x9c#8\xc3\f\x86@\xc1\x88\x00\xd8\xefA\x81r\xa0\"\x9b\xad\xd2\f\xdb\xe8\a\xd8(}\x97\xc2\xde+\x80\xdf\xfc\x89\x9a\x81\xd8G\xd5\xe1+\xa0\xa4{P⯘\x82\xf5\x1dl\x8d\xc5f\xbf)D\x1f0\xb2\x99\xa2\\\xc6\x11\xb9\x8efg\xc0_\xca݊\x15\xb4\xc2*$\xe0\x1e\xa7\xf8`;\x86\x03\xfc\x16\xb87\x04\x11CDBWxv\xe2\x18\xc4H\xb9\xf1\x06\r\xdc`\x147@\xbdO\xb6\x152\xee02DԾs\xe6\xef\xbdo\x92\bɡV\xf1D\x87\xc30\x8e1:ea\xa7l\xc2W\xa0\\\v\x83z\x80\x889N\xc9\x1d\xf9\xcb&\xd4\xc0\xaf>\"\x18\xb7\xf5k\xe8\x99\x03\xadW\xab\xce\xf0TT\xda\x0fCr\x86\x1fV\xb9>\xcc&\xb1\x8f\xb4jq\x87vE\xa6\xabUԽaԜ\"\xaeT0u\x86\xeera5C\xfbM\x1cː^\x9e`\xe5\a\xa1\x19q4\xae;ZȜ\u007f$\x03\xc2\xfaB\x98\xb2\xb5\xdc\xe2\x10h\x99\x92\xe8\\\xbf\xbb\xf9\f\xd3\xd19\x19\xf3\xe8\x17\xe6\xec7\xd2!\x05\x120\xe3\xb6\x18K\x123\xf3\xc4'\xba6x\xe38\u007fhk\xd0\xcd\xc3Oi3\x18\xa6\x89̒\xab\x06\xae\xb2\xd2\xc0\x06!\x85V1\xb6\r\xbcwp\xa5\x06\xb4W\x8a\xf0\u007fO\x80D\x9aj\t\xec\xf3Rp,\x92s\xe3\x12\xb5\xa3\x85I\xc9.\xe4kV\xea7\x01\xb5dO\x02(;\xcd\xd6\xe8\\\x1a\xb0\xf5\x11ԡ\xf2\xc7\x006'\x9e\x97+7\x83S\xb1C\x9e\xcfΰ|\xceFr\xfc}\xafN\x85\xe6[l\xbaF\xb4\x82F E=\xbek\xce<^\xc6\x00\x8b\xec]D2\x91X\xc2 q\x15)\x10\x91:\xc6t~\xb4\ftiX>\xa0\x86\x9f3\xe6\x0f\xbe{t\xfd\xca;\x16\xba?jt\xebm\x1a\xf0Ʃ@\xbd\u007f\xc2\xf6=\xe3\xf0<\xcb\xe9A\xde?R\xe7\x86\xd7(R\x8e\x97/1\x1a\\#%{\xe1\xb8\v\xb4\x9eF~\xbe\x9eΑ<\x80S\x8edK\xd1t\x04i\v\xa2CF:\xc8˽\xe1~\xd1#\xc0}ot\x9f7\xe6\x04\x8br\x11ym\xb2\x0e|=|\xa9\v\x13q\x81du&\xdf´\x80?\x9b\xbeP͗\x0e\xa8\xc7\n{\x96\"\xb0\xe2D_\xa1\t\xd9~\n\xb5N1\xa2\xe3\xd1K~#\xe7\x1b\x9e+\nS%\xfdv\xfd\xe1\tex{\xb0\xcc]\xa02\xae\xa0\t\x11k2\x9d\xbc\xec\xb2&ڐk\xf6<\x18e\x9cv\x1a\xa7\x81Z\xcc(~\t&f\x05|\x02\u2efda\x110t\xe5q\x9a\xf7R\xd9!R~\xf8\xb5\x9a\xb7\x1c26\b-Zdla\xf3P\x94\xf8\x81\x18\x87s\xdc[\x1f\a\xc5k\x90G\xabf\xb3@#\xe9w\xd5\xc6\xe2\x1a8\xa6K,[\xbcx\xe8\x15-\x94\xe1ɝ?\x89\xcd\x121\xf6\xc5\xf8(3\xe0\xa2^\xd6\xf0\x11\xef\x17f?E\xaf\x91\b\xcf\xcb\xe8\xe2M\x16\x8b\xe0l\x92\xa4\xb3h\x8f\xa246\xac\xc73i\xb3\xef\x94&\xc4c)\xc1?\xffV\x87\xaaRZc`l?\xce\u007f\x14^\xbc8\xe9\xfc\xf3\xa7\xf6\xae5\xe5\x1f\a~\xff\xa3*\ac{;5\xf42\xf9_\x00\x00\x00\xff\xff\xcbT\xc3P]\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs#\xb7\x11\xbe\xf3Wt\xad\x0f\x8a\xab\x96C\xdbI%)\xdev\xa58\xa5\xc4֪č.[{\x00\a\xcd!\xac\x19\x00\x010\xe42.\xff\xf7T\xe31\x9c\aHJ\xaa\xac3\x17\x89x4>4\xfa\xf150\x9b\xcf\xe73\xa6\xc5#\x1a+\x94\\\x02\xd3\x02\xbf8\x94\xf4\xcb\x16O\u007f\xb5\x85P\x8b\xdd\xf7\xb3'!\xf9\x12\xae[\xebT\xf3\x80V\xb5\xa6\xc4\x1b\xdc\b)\x9cPr֠c\x9c9\xb6\x9c\x010)\x95c\xd4l\xe9'@\xa9\xa43\xaa\xae\xd1\xcc+\x94\xc5S\xbb\xc6u+j\x8e\xc6\vOK\xef\xbe+\xfeR|7\x03(\r\xfa\xe9\x1fE\x83ֱF/A\xb6u=\x03\x90\xac\xc1%h\xc5w\xaan\x1b\\\xb3\xf2\xa9ն\xd8a\x8dF\x15Bͬƒ\x16\xad\x8cj\xf5\x12\x8e\x1dan\x04\x146s\xaf\xf8\xa3\x17\xf3ދ\xf1=\xb5\xb0\ue7f9ޟ\x84u~\x84\xae[\xc3\xea)\b\xdfi\x85\xacښ\x99I\xf7\f\xc0\x96J\xe3\x12\xee\b\x86f%\xf2\x19@ܻ\x875\aƹ\xd7&\xab\uf350\x0e\xcd5IHZ\x9c\x03G[\x1a\xa1\x9d\xd7ֽ\xe2\x10\x00B@\b\xd61\xd7Z\xb0m\xb9\x05f\xe1\x0e\xf7\x8b[yoTe\xd0\x06x\x00\xbfX%\xef\x99\xdb.\xa1\b\xc3\v\xbde\x16coP\xef\xcaw\xc4&w \xd0\xd6\x19!\xab\x1c\f:#\xd8oQ\x82\xdb\n\va\xb7\xb0g\x96\xe0\x18\xe7w\x99_\xd8\xf7wG<@pM\x06\xd0M\r\x108s\x98\x03\xd0\xe9\x13\xd4\x06\xdc\x16I\xf3\xde☐BV\xbe)\x9c\x048\x05k\xf4\x10\x91C\xab3\xc84\x96\x85V\xbc\x90I\xe8\x00\xd6ݨ\xf5\x92nh\xfc\xff\x1a\xd5\x00н⯀\xf2\xa2u\xc3\xe0\xc1\xaa\x8f\xfd\xa6K\v?\xa0u\xa2\x04\x83ZY\xe1\x949\x80\xe0(\x9d\xd8\b4\xb0Q\xa6o6' \xd0\xdc\xdbn\xd2\x00J\x94\xfe\x80Z\xbdB\x11\xd1oVN\x19V!\xfc\xa4J\x1fvȜ\r\x0e\xec\xd9nU[sX\xa7]\x03X\xa7Lָ\tq\x98\x15\xe5&\xb1#\x1f\x1b\xaey\x1a}Ov\n\xb2\xc5$@\x0ed\xbf\xab0\xef9\xa1{\xf7}\bU\xe5\x16\x1b\xb6\x8c#\x95F\xf9\xee\xfe\xf6\xf1\x8f\xabA3\x806J\xa3q\"\x85\xce\xf0\xf52F\xaf\x15\x86\xaa\xbe\"\x81a\x14pJ\x15h\x83\xfd\x856\xe4\x11C8\x0ea\xc9H\fZ\x94\xae\xaf\x92\xf4\xa9\r0\tj\xfd\v\x96\xae\x80\x15\x1a\x12\x93\x0e\xa6Tr\x87Ɓ\xc1RUR\xfc\xa7\x93m\xc9\xcciњ9\x8c\x11\xfc\xf8\xf9 +Y\r;V\xb7\xf8\x16\x98\xe4а\x03\x18\xa4U\xa0\x95=y~\x88-\xe0ge\x10\x84ܨ%l\x9d\xd3v\xb9XT¥LY\xaa\xa6i\xa5p\x87\x85Ozb\xdd:e\xec\x82\xe3\x0e\xeb\x85\x15՜\x99r+\x1c\x96\xae5\xb8`Z\xcc=t\xe9\xb3e\xd1\xf0oḼ\xf6j\x80ub\x18\xe1\xf3\x89\xec\xcc\tP*\x03a\x81ũa\x17GE\xa7P\xf4\xf0\xb7\xd5GHK\xfb\xc3\x18k\xdf\xeb\xfd8\xd1\x1e\x8f\x80\x14&\xe4\x06\xa3+o\x8cj\xbcL\x94\\+!\x9d\xffQ\xd6\x02\xe5X\xfd\xb6]7\xc2ѹ\xff\xbbE\xeb\xe8\xac\n\xb8\xf6\xf4\x81BS\xab\xc9ry\x01\xb7\x12\xaeY\x83\xf55\xb3\xf8\xd5\x0f\x804m\xe7\xa4\xd8\xe7\x1dA\x9f\xf9\x8c\a\a\xad\xf5:\x12=9q^#α\xd2X\xd2\xe9\x91\x02i\xa6؈\x18\xa1(p\xb2\xf1\xf0b 8\xef\xb8\xf4e\xa3\xd3x\xd0\b\xd9\xfbܜ\x84M\xf6bj\n\x98a\xe4D(@=\x8e\xb2d\x8e\x93\x1cac\x80-&\x12N\x1c\x03}Rq\xbc\xb0\x8f;\xc51\a\x9b\xa6\x82۲`\xadĭ(\x1e\xb5RNW\xa1O\xc9\x17\x01ӊ_\xc0\x15Wd`p\x83\x06e\x89)p\x9d#\x0e\x19d\xfd\x94>\xc5x\xda(\xe0LT\xcf\"~w\u007f\x9b\"yRb\xc4\xee\xa6\xeb^\xd0\x0f}\x1b\x815\xf7\x89\xee\xf2\xdaW\xb7\x9b\xb0\x98\x8fiN\x01\x03-0P\xc0.I\x80\x90\xd6!\xe3\xa06Y\x89T\xa8\x009\xbe\xc18\xe3m\x88`1T\x1eS\v\xe9\x1e\x18\xc5N\xc1\xe1\x1f\xab\x0fw\x8b\xbf\xe7T\xdf\xed\x02XY\xa2\xf5\f\xd8a\x83ҽ\xedH9G+\fr\xa2\xd8X4L\x8a\rZW\xc45\xd0\xd8O?|\xcek\x0f\xe0Ge\x00\xbf\xb0F\xd7\xf8\x16D\xd0x\x17\x96\x93\xd1\b\x1b\xd4\xd1I\x84\xbdp[1N\xa6\x9d\x06ȼ\xe2\xb6\xf7~\xbb\x8e=!\xa8\xb8\xdd\x16\xa1\x16O\xb8\x847\x9e\xd6\x1ca\xfeJ\xbe\xf3ۛ\x13R\xff\x10\\\xfb\r\rz\x13\xc0uy\xb8\xeftG\x90\xc1\xf3\x8c\xa8*<\xb2\xaa\xf1\xe7\x93\n\x85\xeaoA\x19ҀT=\x11^0\x9d^\b\x94\xc8'\xa0?\xfd\xf0\xf9$⡾@H\x8e_\xe0\a\x10\xb1\xacъ\u007f[\xc0Go\x1d\a\xe9\xd8\x17Z\xa9\xdc*\x8b\xa74\xabd}\xa0=o\xd9\x0e\xc1**\x92\xb0\xae\xe7\x81\aqس\x03i!\x1d\x1c\xd9\x1b\x03͌;k\xad\x89\xfd|\xfcp\xf3a\x19\x90\x91AU>\x12S\xd6\xdc\bb3DcB.\xf6\xd68I\xe6\xe9\xb3m0\x1f\xa7\xa0\xdc2Ya\xd8/¦\xa5\xecX\\\xbdƏ\xa7\x94$}\x19j2\x0e\x1c\xff\xb7\xe4\xfe\xcc\xcdy\x06\xfd\x8c\xcd\xf5\xab\x8c\xb3\x9b{j\xd7h$:\xf4\xfb㪴\xb4\xb5\x12\xb5\xb3\v\xb5C\xb3\x13\xb8_\xec\x95y\x12\xb2\x9a\x93i\u0383\r\u0605/Q\x17\xdf\xf8?\xafދ\xaff\x9f\xbb\xa1A\x95\xfd5wE\xeb\xd8ū6\x958\xec\xf3\xf3\xd8\xd5*2\xab\xf1\\r\x8b\xfdV\x94\xdbT\x9c\xc4\x18{\u0099\x041a\x1eB3\x93\x87\xafnʤ\xd0\xd6\x10\xa2\xc3<^\xb0͙\xe4\xf4\xbf\x15\xd6Q\xfb\xab4؊g\xb9\xef\xbfno~\x1f\x03oū|\xf5\x04\x01\x0f6ҿO\xb8@\xcc\x1e\x06\x83\x13u\xcc0\xd6n̋\x98\xa1cU\x86\x8a\xf5/\x02\xcf\x11\xb6\xb3\x1a\x18^ӱ\xca\x023\b\f\x1a\xa6\xe9\xe4\x9e\xf00\x0f)^3A\xf9\x99Rpw\xcf\x01L\xebZdSqL䑄F\xbeO\x856\xab쩽g\xcf!H\xb8\xa0\xffxř\xa1\xec\x11@\xe07\x1dm\xf7\xb7Z9^|\x9a\x14\x9f\xd4\"ե\xc4ֆ\x10\xe7\xf9\x02j4\x86\n\x8aQ\x93V|Ԓ\xbd\xd9J\x9d\x83\x9b\xb7\xb3\xca\f\x17\xaa/\xa8+\xc3Eq\xd4i\x88\".]\x1f\x13\x85~meY*b\xa7ë\xfb\xf3\xc7{=\x9d\xe1/q\f\x0f\xe0\x9ch\xc8f{\xd7\xcaq\x8d\\i\b=qa\xa6\x8f\xdb$\r\xb9\xa7\x8e\xc4l7L\xd4\xc8!\xbd\x1d\x8c\xe7d\xa4\xf6\xa5\xacqCA\xaeյb<\x15d\x11^GϨ^\xf7\xb7#W\xf6\x8c\xcc\xd6\"\xf7\x95|F\tSʶQ\xa6a.\xdc\xe6ͳBe[\xd7l]\xe3\x12\x9ci\xa7\xddg\x82E\x83ֲ\xea\x92+\xfe\x1cF\x85:5N\x01\xb6V\xad\xeb\n\xd5AP\xb8\xb2Ѧ^V+gK\xc0\xa193\xa2\xe86Rպ\xf6s\xfa\x81\xe0\xf8\xe0\xe4Q\xad1\x9f\xea^\x13\x13\x00\xfc\x83\xc9%\x844&\xe7`]\xf4:\xeba\xf4\xa1l\x9b\xe9*s\xb8\xc3}\xa6u\xf2\xd0\xd3\xef\xbcN.\x93\xe9\xfb\xd1{Ë\xf6\x1f\x17\xba\xa4\x828\f\xb6\xaaNά\x1c\xabA\xb6\xcd\x1a\r\xe9a}ph\x87\xe1<w+\u1ad9\xa3\x1a{\xf3\xd3\xf9\x05I\xb1@+\x99\xf4\xb7\x87\xe4]N\x01\x17V\xd7\xec\x90\x11\x9c6\xe2\x19\v9\x17\x85\x80\xa3='\xa7\xd6h|\xd7KoS<\xa6\x1b%O\xf0\xeb\xe4\xcfB\xba?\xff\xe9\f\xbf\x11\xd2a5J\x0e\xb1\x9f\xd4\xf9\x9eV\xf9:+\x9cI\xddV2m\xb7\xca\xdd\xde\\\xb0\x82U70y\xc3\xe4\xf9\a;i\xd1\x14rG\xd5Ŗ\x17\xb9\xea\xf0\x89\xf1\x12\xd4\xc1\xe0\vY(>n\xe6r\xd0\n53\xe4\xe9\xfe&\xfcz\xfcT\xf3\x16\xac\xf0\xd7{ķ\x02\x01\vŷ\xa5\xe4D\xc4R\x19̄L\x98\xa6\x95A\x12\x19\xc2\xff=\xf3G\xd6N&\x8d\x1e9\xefɎW\xc4\xfd\x96v\xdd=\u007f\xa4Ê\xdc\x06~\xfdmv\xa49\xac\xa4\x02\x02\xf9\xdd\xf8I\xffM\xb8\xe0Io\xf4\xfeg\xa9d`\xd3v\t\x9f>\xcf\xd2\xd3\xddczz\xa7\xc6\xff\x06\x00\x00\xff\xff\xb6\xe8a\xa8\a!\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\xe3\xb8\x11\xbe\xebWty\x0f\xceV\r\xa9\xddI*I\xe9\xb6kgSJv=\xae\x913\x97\xa99@DS\xec\x98\x04\x18\xa0)YI忧\x1a \xf4\xa4\x1ev\xd5Lx\xb1\x85G\xe3\xeb\xaf\x1f\xe8&GY\x96\x8dTK\x9f\xd0y\xb2f\x02\xaa%|a4\xf2\xcb\xe7\xcf\u007f\xf69\xd9\xf1\xf2\xc7\xd13\x19=\x81\xbbγm>\xa2\xb7\x9d+\xf0\x1eK2\xc4dͨAVZ\xb1\x9a\x8c\x00\x941\x96\x95\f{\xf9\tPX\xc3\xce\xd65\xbal\x81&\u007f\xee\xe68\xef\xa8\xd6\xe8\x82\xf0t\xf4\xf2\x87\xfcO\xf9\x0f#\x80\xc2a\xd8\xfeD\rzVM;\x01\xd3\xd5\xf5\b\xc0\xa8\x06'\xd0Z\xbd\xb4uנC\xcf֡ϗX\xa3\xb39ّo\xb1\x90S\x17\xcev\xed\x04\xb6\x13qs\x8f(j\xf3h\xf5\xa7 \xe7c\x94\x13\xa6j\xf2\xfc\xf7\xc1\xe9_\xc9sX\xd2֝S\xf5\x00\x8e0\xeb\xc9,\xbaZ\xb9\xe3\xf9\x11\x80/l\x8b\x13x\x10(\xad*P\x8f\x00z\x02\x02\xb4\xacWq\xf9c\x94UTب\x88\x19\xc0\xb6h~z\x9c~\xfa\xfdlo\x18\xa0u\xb6EǔԋώYwF\x014\xfa\xc2Qˁ\xf4[\x11\x18W\x81\x16{\xa2\a\xae0\x81B\xddc\x00[\x02W\xe4\xc1a\xebУ\x89\x16\xde\x13\f\xb2H\x19\xb0\xf3\u007fb\xc19\xccЉ\x18\xf0\x95\xedj-n\xb0D\xc7ర\vC\xff\xde\xc8\xf6\xc06\x1cZ+ƞ\xe3\xedC\x86\xd1\x19U\xc3R\xd5\x1d\xbe\x03e44j\r\x0e\xe5\x14\xe8̎\xbc\xb0\xc4\xe7\xf0\x9bu\bdJ;\x81\x8a\xb9\xf5\x93\xf1xA\x9cܹ\xb0M\xd3\x19\xe2\xf58x&\xcd;\xb6Ώ5.\xb1\x1e{Zd\xca\x15\x151\x16\xdc9\x1c\xab\x96\xb2\x00\xdd\x04\x97\xce\x1b\xfd\x9d\xeb\x03\xc0\xdf\xeea\xe5\xb5\xd8ֳ#\xb3ؙ\b\xcev\xc6\x02\xe2m@\x1eT\xbf5j\xb1%Z\x86\x84\x9d\x8f\u007f\x99=A::\x18\xe3\x90\xfd\xc0\xfbv\xa3ߚ@\b#S\xa2\x8bF,\x9dm\x82L4\xba\xb5d8\xfc(jBsH\xbf\xef\xe6\r\xb1\xd8\xfd_\x1dz\x16[\xe5p\x17b\x1c\xe6\b]\xab\x15\xa3\xceaj\xe0N5X\xdf)\x8f_\xdd\x00´τ\xd8\xebL\xb0\x9b\x9e\x0e\x17G\xd6v&R\n9a\xafô0k\xb1\x10\xf3\t\x83\xb2\x95J*Bl@i\x1d\xa8\xa3\xf5\xf9\x9e\xe8\xe1Еg\xae\x8a箝\xb1uj\x81\xbf\xda(\xf3p\xd1\x01\xb6\x9f\x87\xf6$p\x92Yb\x18c/\x1c|\\y$\x14\xa0N\x9bW\x15:\f{$\x8bQ!\xeee=\xb1uk\x11\x1cT\xd2\xf9\x91\x84\x13\x86\b*[}A\x8dG\xdb\a\x84\xc3\x12\x1d\x1aq\xf7\x98!Z\x1b\xf2\b+2),b\x8a\x05\xb6\x03Z\xcc#\xeaa\x88\xa7\xa9\x873\xd9s\x10\xf0O\x8fӔ1\x13\xc3=t>>\xf7\x02=\U00094135~T\\]q\xf6\xed\xb4\x8c\x87\x85\xdc\xc1\x16\x14\xb4\x84\x05\xee%c \xe3\x19\x95\x06[\x0eJ\x94[\x1b$\xc0\x1c\xf6;\xde\xc5Lѧ\xa4m\n\x17\xeaAI\x8e\"\r\u007f\x9b}x\x18\xffu\x88\xf9\x8d\x16\xa0\x8a\x02\xbd\bR\x8c\r\x1a~\a\xbe+*P^\xd4 \x87z&3y\xa3\f\x95\xe89\xef\xcf@\xe7?\xbf\xff2\xcc\x1e\xc0/\xd6\x01\xbe\xa8\xa6\xad\xf1\x1dPd|\x93\xfe\x92ϐ\x8ftl$\u008a\xb8\xa2\xc3KkÀxW\xaf\xf6*\xa8\xcb\xea\x19\xc1\xf6\xeav\b5=\xe3\x04n$\xcaw`\xfeG\x02\xeb\xbf7'\xa4\xfe.\x06Ѝ,\xba\x89\xe06\xf7\xddnDnAr\xa5\x18\xd8\xd1b\x81.\x14\bCOHޒ\x12\xbf\a\xeb\x84\x01cwD\x04\xc1b\xbd\x98\x8fP\x1f\x81\xfe\xfc\xfe\xcbI\xc4\xfb|\x01\x19\x8d/\xf0\x1e\xc8DnZ\xab\xbf\xcf\xe1)x\xc7ڰz\x91\x93\x8a\xcaz<Ŭ5\xf5Zt\xae\xd4\x12\xc1\xdb\x06a\x85u\x9d\xc5zC\xc3J\xad\x85\x85d8\xf17\x05\xadr|\xd6[S\x95\xf1\xf4\xe1\xfe\xc3$\"\x13\x87Z\x84|'\xb7SIR5H\xb9\x10\xef\xbc\xe0\x8dG\x97fz|\x17݇-\x14\x952\v\x8c\xfa\"\x94\x9d\xdcB\xf9\xed[\xe2\xf8\xf8\xeaO\xcf@\tp\x988\xfeo\x97\xe8\x95ʅJ\xf5\n\xe5\x1ev\xbc\xfc\xacr\xd2\x188\x83\x8cA?m\v/\xaa\x15ز\x1f\xdb%\xba%\xe1j\xbc\xb2\xee\x99\xcc\"\x13\xd7̢\x0f\xf8q(\xed\xc7߅?o\xd6%\x14\xe4\xd7*\x14\x16\u007f\v\xad\xe4\x1c?~\x93R\xa9V\xbc\xfe\x1e\xbb\x9d\xf5\x05\xcc\xe1^\t\x8bUEE\x95\x9a\x80>Ǟ\b&\x92\x8aS\xc7Ԭ\xcc\xfa\xab\xbb\xb2\x10\xda9A\xb4\xce\xfan3SF\xcb\xff\x9e<\xcb\xf8\x9b\x18\xec\xe8\xaa\xf0\xfd\xc7\xf4\xfe\xdb8xGo\x8a\xd5\x13\x85n\xf4\x91\xd6N\xb5PY\x12\xba\vu\xd9ǽũ\xae\x1c\xa8\v7k^U\x18z\xa3Z_Y\x9e\xde_\xc01\xdb,L\x18\xb6\x06\xe8\xcb\xc1$K\x1c\xf7l\x15x\x06O\x14u\x01K\xac\xed\x87j\xec\x1eI\xac9\u0088Ե\x01\xcfp\xb0\xbe\x16\xa1\xb4dR@\xed#̆;\x87\x835\xad\xd5\a#\xfb\x9ep0\xb95\xcd\xc1DT\U000aad8a\x15w\xfe5\x8dUؐ\x98\x8d\xf1ͽ\x98Pܾ\xb9\xb5*\xac\x14\x8e\xfb\xaf\x98\xce[\xf9\xeexGx\x8f\xe1tD\xc7\xd4`\xe8W\x02\x0eX)\x9f\x0e\x19\xb2(\xecȋ[CN\x15q\xa8CY'Ug\xa9\xa8F\r\x9b\x97\\\xf0$\x1dfh\xe8o\x87\xaa\x98$\xa8\xf3\xa8C\xef9\x00\xfax_i]\xa3x\x02\xd2\xc6g\"\xe2h\x85\xe9\xeaZ\xcdk\x9c\x00\xbb\xeex\xfaL\x005\xe8\xbdZ\\\x8a\xa0\xdf\xe2\xaa\xd8\xf1\xf5[@\xcdmǛ\x96\xaf\x0f\xa5\x9e\x8a[\xdf{\xc1\xeb\xda\xceJ\xf9KP\x1ee͐\xc7m\x82\xfa\xbc\xcbɃ\xa6k\x8e\x8f\xc9\xe0\x01W\x03\xa3S\xf3\xe8\xec¡?\xb6L\x96\f8\xd0\x04d\xf0K\xf0\x8eW\x11\xd0\x1ft\x89\x83~\x19T\xb6N\xdemY\xd5`\xbaf\x8eN\x88\x98\xaf\x19}b$\xa5\x86\xa1\x1e:\xd4\xde[&\xb7\x12R\xb6\x8b\xa2\xfan\xa2P&\xbcR\x12\xffe\v\x9a|[\xab\xf5\x80ܤI\xb8^\xc5}%\x8e\xb6\x1e\x93\xa2P\xc2?̽\xb6\xf7\x0f\xa0\xee\xad9Q\r\xa6\x90!\xc3\u007f\xfcÙۘ\f\xe3\xe2 \x95\xf6\xf3B\xe8\xcfr\xca\xd79\xe1̅\xefY9\xbe6\xed\xcd\xf6\x16_\xcaxA\xf4p\xbe\xdbM]ǉj\xff\x98o\x99\xa3\x06\x89:\x1a\f\xc8\xf5\x8e\xec\xfe\xbdY?\xb2\xbd\xd9T!\xc5\x1c\xea\x87\xc3O\r77{_\x0e\xc2\xcf\xc2\x1aM\xf13\t|\xfe2\x82\xfe]ڧ\xf49@\x06\xff\x17\x00\x00\xff\xffñ\x1b\xae\xa0\x19\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xc1n\xe36\x10\xbd\xfb+\x06\xdb\xc3^*y\x17=\xb4ЭM[ h\x12,\x9cE.E\x0f\x145\xb2\xa7\xa1H\x96\x1c:u\xbf\xbe\x18J\x8aeY\x897\v\xacn&g\x1e\xdf̛\x19ҫ\xa2(V\xca\xd3\x03\x86H\xceV\xa0<ῌV~\xc5\xf2\xf1\xa7X\x92[\xef?\xae\x1e\xc96\x15\\\xa5Ȯ\xdb`t)h\xfc\x15[\xb2\xc4\xe4\xec\xaaCV\x8dbU\xad\x00\x94\xb5\x8e\x95,G\xf9\t\xa0\x9d\xe5\xe0\x8c\xc1Plі\x8f\xa9\xc6:\x91i0d\xf0\xf1\xe8\xfd\x87\xf2\xc7\xf2\xc3\n@\a\xcc\ue7e9\xc3Ȫ\xf3\x15\xd8d\xcc\n\xc0\xaa\x0e+\b\x18\x99t@\xef\"\xb1\v\x84\xb1ܣ\xc1\xe0Jr\xab\xe8Q˱\xdb\xe0\x92\xaf\xe0\xb8\xd1{\x0f\x94\xfap6\x19h3\x02\x1d\xf2\x96\xa1\xc8\u007f,n\xdfP\xe4l\xe2M\n\xca,\x11\xc9ۑ\xec6\x19\x15\xce\f䀨\x9d\xc7\n\ue10bW\x1a\x9b\x15\xc0\x90\x82̭\x18\x82\xdc\u007f\xec\xb1\xf4\x0e;Փ\x06p\x1e\xedϟ\xae\x1f~\xb8?Y\x06\xf0\xc1y\fLc|\xfd7\x11v\xb2\n\xd0`ԁ<紿\x17\xc0\xde\n\x1aQ\x14#\xf0\x0eGR\xd8\f\x1c\xc0\xb5\xc0;\x8a\x10\xd0\a\x8ch{\x8dO\x80A\x8c\x94\x05W\xff\x8d\x9aK\xb8\xc7 0\x10w.\x99F\na\x8f\x81!\xa0v[K\xff=cG`\x97\x0f5\x8aqH\xf2\xf1#\xcb\x18\xac2\xb0W&\xe1\xf7\xa0l\x03\x9d:@@9\x05\x92\x9d\xe0e\x93X\u00ad\v\bd[W\xc1\x8e\xd9\xc7j\xbd\xde\x12\x8f\x05\xad]\xd7%K|X\xe7ڤ:\xb1\vq\xdd\xe0\x1e\xcd:ҶPA\xef\x88Qs\n\xb8V\x9e\x8aL\xdd\xe6\xa2.\xbb\xe6\xbb0\xb4@|\u007f\u0095\x0f\xa2m\xe4@v;\xd9\xc8\xd5\xf6\x8a\x02Rn@\x11\xd4\xe0\xdaGqL\xb4,Iv6\xbf\xdd\u007f\x86\xf1\xe8,\xc6<\xfb9\xefG\xc7x\x94@\x12F\xb6\xc5Ћ\xd8\x06\xd7eL\xb4\x8dwd9\xffІ\xd0\xce\xd3\x1fS\xdd\x11\x8b\xee\xff$\x8c,Z\x95p\x95\xbb\x1cj\x84\xe4\x1b\xc5ؔpm\xe1Juh\xaeT\xc4o.\x80d:\x16\x92\xd8/\x93`:\xa0\xe6\xc6}\xd6&\x1b\xe3\fyA\xaf\xf9\\\xb8\xf7\xa8E>ɠ\xb8RK:\xf7\x06\xb4.\x80:\xb3/O\xa0\x97[W\xbeZ\xe9\xc7\xe4\xef\xd9\x05\xb5\xc5\x1b\xd7c\u038df\xdc~Y\xf2\x19\xc9\xc9d\xe9\xdb\x18\x97\rϰ\x01x\xa7xҿ\xac\xc8>\x8f\x81\xc5x^\x11!\v\xa1\xa4\x9d\xad\xb2\x1a\u007f\xcf\x15e\xf5\xe1BL\xb7\v.\x12\xd2\xce=\x81k\x19\xed\x14t\xe0\xba\x10I\x8d\x10\x92}\x13\xd9~~_7Rx-a\xb8@t33\x1f\xf3\xde&c\x06\xacB\xbb\xce+\xa6\xda\xe0\xf2\x91\xf2I\xd9P\x8fr\xe8{\xff\xeb\xf3\xbdw&u\xf8|\xdd\\\x88\xe0\xe1\xd4zZ8\xfd\xc2@EB\x81pzq\x9e~C\xadD\xf0\xae\x19H\f\x05\x1d%\xbe7\xc4 \x92S\xc0\xd9\x04-\x96\xdbcf\xb3Tm3\x93\xb9Ƴ\xedY\xfe\xbeh|\xb0\xe2\x14\xdf2@\xb2Øl\x9dB@\xcb\x03L\xbeQ\xbfz\x84\x18\x15y\xd2>\xf2\xa2\xbaP\x017\xe7\x1e#1\x01\x03\x96\x85i\xbf=\xa9\xf9-\x94E[\xea\xb4օNq\x05ra\x14\x02tf!\xef<U\x1b\xac\x80C:\xdf~m\xae`\x8cj{)\xba\xdbު\xbfl\a\x17P\xb5K\xfcB\xeayw\xce\x02.\xc8q\x81\xa9ߩx\x89\xe7'\xb1Y*\x88\xe7\xf9}\x99\x02\xdaԝ\x1fS\xc0\x1d>-\xacnP5\xe7}\\\xc0\x9d\xe3\xe5\xad\x17#\\슳\xc5(\xef\x92f\xa2s\xec\x1byX9\xf6\x90\xd2\x1a=cs7\u007f\xbd\xbf{w\xf2\x18\xcf?\xb5\xb3\r\xf5\u007f=\xe0ϿV=*6\x0f\xe3\x03[\x16\xff\x0f\x00\x00\xff\xff\x83\xf9\xd9\xe0\xf4\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\xbd}s#\xb7\x910\xfe\xbf?\x05Jq\xfd$]Dʛ\\\xf2\xbb\xdbJ=)eWvT\xf6jU+e\xfd\xa4\x1c\x9f\x0f\x9ci\x928\x81\xc0\x04\xc0P\xe2\x9d\xef\xbb?\x85\x060/\xe4\x90\"0Ծ\u0603\xab\xbax\xa9\x99\x1e\xa0\xd1\xe8wtӂ\xbd\a\xa5\x99\x14/\t-\x18<\x1a\x10\xf6_z|\xffoz\xcc\xe4\xf9\xf2\xc5\x17\xf7L\xe4/ɫR\x1b\xb9x\aZ\x96*\x83\xd70e\x82\x19&\xc5\x17\v04\xa7\x86\xbe\xfc\x82\x10*\x844\xd4\xfe\xac\xed?\tɤ0Jr\x0ej4\x031\xbe/'0)\x19\xcfA!\xf0\xf0\xe9\xe5W\xe3\xff\u007f\xfc\xd5\x17\x84d\n\xf0\xf5;\xb6\x00m\xe8\xa2xID\xc9\xf9\x17\x84\b\xba\x80\x97D\x816R\x81\x1e/\x81\x83\x92c&\xbf\xd0\x05d\xf6c3%\xcb\xe2%\xa9\xff\xe0\xde\xf1\x13q\x8bx\xe7^\xc7_8\xd3\xe6\xdb\xe6\xaf\xdf1m\xf0/\x05/\x15\xe5\xf5\xc7\xf0G\xcdĬ\xe4TU?\u007fA\x88\xced\x01/ɵ\xfdLA3ȿ į\t?;\xf2\xb3^\xbep \xb29,\xa8\x9b\x0f!\xb2\x00qqs\xf5\xfe\xf7\xb7\xad\x9f\t\xc9Ag\x8a\x15\x061\xe3\xe7F\x98&\x94\xbcǵ\xd9\t\xe0&\x103\xa7\x86((\x14h\x10F\x133\aB\x8b\x82\xb3\f\x91XA$DN\xab\xb74\x99*\xb9\xa8\xa1Mhv_\x16\xc4HB\x89\xa1j\x06\x86|[N@\t0\xa0I\xc6Km@\x8d+X\x85\x92\x05(\xc3\x02b\xddh\xd0Q\xe3\u05f5\xb5\x1c\xdb座Hn\t\bܔ=\xca \xf7\x18\xb2\xb35s\xa6륭/\xc7/\x89\n\"'\xff\x05\x99\x19\x93[P\x16\f\xd1sY\xf2\xdc\xd2\xdd\x12\x94EN&g\x82\xfdw\x05[ۅڏrj\xc0\xefw=\x980\xa0\x04\xe5dIy\tg\x84\x8a\x9c,\xe8\x8a(\xb0_!\xa5h\xc0\xc3G\xf4\x98\xbc\xc1\xed\x11S\xf9\x92̍)\xf4\xcb\xf3\xf3\x193\xe1\xfcdr\xb1(\x053\xabs<\nlR\x1a\xa9\xf4y\x0eK\xe0\xe7\x9a\xcdFTesf 3\xa5\x82sZ\xb0\x11N]\xe0\x19\x1a/\xf2\xdfT\xdbvܚ\xabYY\xca\xd3F11k\xfc\x01\xc9|\xc7\x0eX\x82w\xb4\xe4^u\xab\xa8\x11m\u007f\xb2\xd8ywy{פ3\xa6ױ\x8fxo\x10_\xbd\x05\x16aLLA\xb9MDj\xb30A\xe4\x85d\xc2\xe0?2\xce@\xac\xa3_\x97\x93\x053v\xdf\xffY\x82\xb6\x04-\xc7\xe4\x152\x152\x01R\x1695\x90\x8fɕ \xaf\xe8\x02\xf8+\xaa\xe1\xd97\xc0bZ\x8f,b\xf7ۂ&?\\\u007f\xd8a\xad\xf1\x87\xc0\xbc\xb6\xec\x97?\xfd\xb7\x05d\xad\x13c_cS\u007f\xcc\xc9T\xaa\x16s\xb0\xaf\x8c[@\xbb\x0f\xad\x1d\xee\xf4[\x0e\xb6\xfe\x97\xb5\xa9\xfc\xa5z\xd0ҏ\x9dD)\xd8?K@\x16\xe7N,l\xb0\x94\r\x90$\xcc\x0f\xc9b\xbc\xf1\xf7-8\xb5\x03\x1e3^\xe6\x90W\xdcvc-k3\xbe\xdcx\x01\xc5\x11e\xc2ҿe\xffvڢ\xfe\xabe\xa7\x1d3\xa6\n\x88\xa5@&\x1c<\xc2\x04.\xb6\x13\xd3v0\x03\x8b\x8e\xc9\xed\\\x1dA9G'\x1c^\x12\xa3J\u0602\x19\xaa\x14]mAL\x90\xcd\xfb\xe2\xa5z\xde3\x04\xce2h\n\n\x87\x1a'd\xa8ڜ\x11\xf9\xa4\xb12\x97\xf2\xfe)L\xfc\xd5>S\xf30\x92\xa1\x8eC&0\xa7K&\x95_\xbb\x17)\x13 \xf0\bYiP\xcco\xc0-\x91\x9dJE\n\xa9\xcdv,l?\x89\xc4\x1d\x8em[\xb8\x13\x85\x1b+\xf3\x8c#l\xb1]h\x8b\x89H\x01v\xae\v{\x12\xebg\x95,ݳ\xebܾ\x81\xf0n\x8c\x90\tՐ\x13\xe9i\xa0\xe4\xa0\xfd\xb7rdO\xf5);\xdb\n\xbaZ\xbc\x93\xbb\x9cN\x80\x13\r\x1c2#\xd5&&\xf7\xc1\xa7\x1b\xfbp\x8e-x\xec\xe0!\x9e\xf7zN\\/l\aHb\x95\x8e\x879\xcb\xe6N$Z\xdaD8$\x97\xa0\xf1\x18Y\xb5m\xb5m\x91䩽\xf7\x1f\xd9u\x90\xea\xf1đZ\x87\xd7u\xb8\xea\xb1\a\xf3\xa9\xc7\x13l\xa8\x8d\xd9Z_\xed\x94#\xf5\xf8e\"6\xf0\xd5\x04\xa2\xbd\xdax\xf5\xb0D\x8b&\x86U}\xaf\xa6\x04\x16\x85Y\x9d\x11f¯OA\xa4\x9c7\xbe\xff\x19oL<\xc5_\xad\xbfyP\x8a߹+OA\xb4\xbbR}\xfe3\xdc\x14\x14\x16\xb7^V\xec\xbd!\xdf5\xdf:#lZmH~F\xa6\x8c\x1bPk;\xd3\xeb\xbc\x1c\x02\x19\xfb\xc8;;\x16\xd4d\xf3\xcbG\xab\xd9\xe8\xda\x1d\xb3'^\xd6_v\nbИۂ\xf9\t\xb8\x04\x8d9\xa6`\xe1\x8c\xc4;\xc4f\xfd\vj\xd7\x17ׯ!߅\x1e\xb2\x1f\xe5m,\xe4bm\xb2\xcdO{\xadw\xdfexէ\xb2 \x9c\xf9\u007fF(\xb9\x87\x95\xd3X\xa8 vs\xa8\xfd\xd0\x16[b\x139\xe8\x87@\"\xbb\x87\x15\x82\xf1\x8e\x85'\xdfޗ\x14ܸ\x87\xd5>\x8f\xad!\xd0\xceɛ{\x0e\x93\xf6\aD\x04\x9a\xa1\xfb#\x8f\xa0\x93(\xf0\xa2\xa7\x17G\xf6g$a\x04\xdc',\xb3ڶ\x863\r7\xf6X\xbb-\xb2\xa7`Ί=\x17\x8a\xbe4\rxZ\x82\x9b\xe8=\xe5,\xaf>\xe4\xe8\xfeJl׆\xdb\xe3Z\x9a+qF.\x1f\x99\xb6S\x139y-A_K\x83\xbf<\v:\xdd\xc4\x13\x90\xe9^\xc4\xe3%\x1c۶xh\xfa\x9b\xf6 n7\xae\x9c[\xa1\xda\x1e\xa6ɕ\xb0\x86\x8b\xc7\az\x0f\xdd\xe7vˇ\xf6X\x94\x1a\x1dJB\x8a\x11\x8a\xcaqח\x1c\xb2\xf7\x04)UkG6\xa7V}\xd4}pO\xb0wV\x92\xb8\xf7\x9d?\x94\xd3\f\xf2`m\xa2\x17\x8f\x1a\x98\xb1\x8c,@\xcdv\t\x8e\xe6(,\u007f\xdfo\n{r]7\")l?\xd1\x1e\x86g\xdd\xf9ӓ\x19ٓ\xbb\xc7Sa\xb3\x9f|t\x8b\xf3n\xfb\xa3O\xaf\bE,\xea\x1fOb\x97\xe69FZ(\xbf\x89\xe0\xf8\x11{\xb1)\xfb\xddĜ\x84\\\xd0\u009e\xdf\xff\xb1b\x0e\t\xfa\u007fIA\x99\xda\xe3\f_`ЄC\xeb]\xef&j~\xc6~\x81ib\xf7wI\xf9\xa6[\xb8cq\xd2\xf2\x16\xe0N\x90\xcb\xe9\x86\xc6rF\x1e\xe6R;\x99:e\xc0\xbb\\6\xed\xc149\xba\x87\xd5\xd1\xd9\x06\x1f8\xba\x12GN\xc0G\xb3\x9bJ[\x90\x82\xaf\xc8\x11\xbe{\xd4G\tړ\x12\xf7zLt:}\xeb\xd1\"\x8b\xa6\xe3\xb7\xf6\xf8z5w\u05ec\xf7\xa2\xc3Bj\xf3\xd7n\x87ݖ\xf9܄7ںi\x87\xdf\xebI\x9d\xdd\xfb\xb0*\xa6j5\xb9\xa9\x01\xe5\x9dx\x8e\xd1\x06\v\xa0\xa7m\xf4\x94\x93\xaer\xd0\xd1\xcaQn\x11\xfc\x04U\xb8\x00\xc0>S\x8c\xd1\x1a-^\"\xf5\xed\xcbǆ\x8fўP\xfb\xef\xe6B\x0e\xad\xd5fr\xb1\xa0\xeb!\xaf\xbd\xa6\xfaʽ\x19h\xda\x03r\xbb\xaff%\x9e\xcb\xfdս@C\x18\xecz`f\xce\x04\xa1\xe1\xf8\x83\xf2\x04EI!\x9f\xe6Dn̩&\x13\x00\x11\xd0\xf7$kp\xe3y\xe5\xf5\x82\x89+\xfc\x00yqp\xf9Njt%mg@u\xb5\xa1\xd5\x0f(q\xf6U\x8ddN\x1e栠E\x15\x9b\x0eo\xab1\xee\tRH\xd3\xf4+X\xb8\x85̏5\x992\xa5Ms\xa2\xfb\x12\\\xa9\xf7%\x87\xc8\x1d\xb6\xab\xbbc\v\x90\xa5I\u0603\xcb\xfa\xedV\xb4rA\x1f٢\\\x10\xba\x90\xe5\x1e\xc2\xdd\r+_آ\n)\xfa\x1dx\xa0\xcc \xbb\xb3p\xd1\xc3b\xa4ݥ\x82\x83\xd9w\x8b'0\xb5\xec(\x93B\xb3\x1cT\by\xbb\x9de\xd2\x1e\xdc)e\xbc\xec\n\xdft\x8dX3U\\*\x95d\xa5\xbeuo6\xbc\x86s\xf9\xd0F\xd0\xde(\x98\xd3%\x106%\xcc\x10\x10\x99\xdd\x17P\x8ee\xe3'<2\x105{\x93\xe5~\f\xde\x0e\x10\xe5b?\x04\x8c\xf0d3\xb1\xd3)\xd6|\xfck\xca\xf8sl\x9b\xa5\xbc\xf4\xa3\xf1}\xfd\xf6\a9\x1a\x15S\xd9_\x84M\x80\xbc\x03\x9a\xaf\xc2\xf9\xa0\xc6XS\x15i@\x12U\x8a&G|\x86\x93\x11c\xdf\xf9Y\x1c\xd2pc\x82\xed\xb1\xb1k\xfe|f\x9aڎ\x05\xf1\xacڎ\xfd@%\xe8R\\3W-\x00VT\x06\xc5\x19\xe7^QM\x84\xe63\x01k\xa0B\xee\x9c^V|z=\xda%\xf2l\t\x83w\xae.FuYs\xf3zC\xb3\x91\xfc\x16}\x04\xbc\x83w%K\xf2@\x85\tD_)s\x85ܓ\xeacw\xd5\r\xaaf\x11Ood\xd6\x05\x955\xa4\xb7\x810j\x85\xe9V\xfbN\xda\rk\x9a\xe62\xbb\xb7\xeaȂ\xce\xe0\xf8X\x93Wo^[R\xb1Z\x87\x15\x19\x11\x12\xc1\r\xe6\"\xb1\x85\x92K\x96[\xd5\xe9=U\x8cN\xb85\x82\xa7\xa0@d\xa0ɗ'\xef/\xde\xfdt}\xf1\xe6\xf24\n\xb85\x9dᱠ\xc2\xd2`\xa9\x834\xafv\xdf.\x00Ē)),\x82\xe2\xb0q5%\x94,\xc3l\xb3*\x13͚Z|鵹(\x88Պ\x83#\x84\x89\xa24\xc1;\xfa\xc08'\x938\x88\xa5\xc8\xe6T\xcc,^_\xcb\xd2\xce\xf3\xcb/\x11+\n\xf22\xf3\a3\n\xa2?L_\x9e\xf9p\x16\xe5\\>h\x94-\xa03Zx\x1cG\xc1ll/\xd1+a\xe8\xe3K\xc2\xc60&G_6\xfet\x14\x05\x13\xb1U(i\x97\xe9\xe2\x11\x0e\x8b\x9c\x19P\x94\x93\xa3&不\xbf\xb4넼I\xa0\xf85\x01KP\xce\x0ep$\xb7\xaf\x03\xdf\r\x053\xaar\x0eZ[\x9e\xfb0\a3\a\xe5\xddJ\x9e\xc8 \xc6\xeb\xec\x86T\xf6|ufJֹ\x91Q\x10C\x1e\xe5}\x95\b<f\xf2<\x97\x99>7T\xdf\xebs&\xacH\x1d\xe5\xd4\xd0Q\x83\xe9\x9e;i8\xf2\xf2y\x14,\xe9Qu\x1c\xcf\u007f\xa3J!\x98\x98\x8dh\xf5\x14\x13#:\xd2s\xe0\xfc8b\x96Q\xe2\u008dh{\xb7\xf9ZL\x80!\xd21\xe1F\x9b\xa3_V\f\xdc}yL\xae\xa5ٕ\x81\xb6}T\"\fq<\xee\xe4\xf1\x97\xd7w\xef\xfe~\xf3\xf6\xea\xfa.\x96\xb57\xc5\xc2vV\x9f\xc6$[b\xa1\x83\xd5\xc7\xed\xfe.\xb1\xd0f\xf5Qp\xb7\x88\x85\rV\x1f\x87\xd8\x0e\xb1\xb0\xc9\xea\xe3X\xf0\xa6X\xd8\xc2\xea\xa3\xc0\xae\x8b\x85\xad\xac>\nj[,lc\xf5Q \xbb\xc5B\a\xab\x8f\x17B\x9bb\xa1\xcd\xea\xe3 n\x17\vk\xac>\nl\xb7X\x18X}\xc7kq\xac\x1e\xc42\x99\xcd\u007f\xe7ͯ\x06+\xaa\xf6<\x8e\x0e\x8dČ\x03&\xda|\xaeK+x^̷]\x82b\xf9\x9e\xb6\xd3*Ds\xb1Q\x90I}\x1cB\xc66\x8a\xb5ʢ\x8dc1)V\x9a\x1bOEκ\xc7f<\xcdߚH\xc7\ai\xe0dL\xde\xf8\f\x03J^\xfdt\xf5\xfa\xf2\xfa\xee\xea\xeb\xab\xcbwqH!\xe9g\x87\x84\xa4\x91\x9e\xa89\xee0\x0f\x13\xf0\xb2[s\x88\x16\xc8n\x14\n\x96L\x96\x9a\xaf\xaa\xf4\xf6\xfeG\u05cd\xf5\x93\xebS\xcaVD\x83Z\xb2,e\xb6\x9dS\xeb\xa3\xea\xb8\xf1\xa4\u0093\xbe\xfa'Ԟ\x04\xc0\xdbmb\xaf\xfc\xa4\x90\xd6!-c\x0f\xf2\x19\xecc7\x9e\xb0\x92\x13 \x1eV\x81j\xccr\xa7\x1a\x95\x00t\xb7\x8dM\xf6N\\l\x0eT\xbf^Ô\x96\xdcyێ\x8e\xc61\xba\x8c\x1b}Y\xec\xd7J\xee\x19@i\x8e\x16\x9b\xbdu\x97\xbcB\xc4\xe00B\xe8\xd8'ƶ\xd4\x0e\x1di\xaf\xba\xc1|\xeed\xb0)\xa3\xf2\xe6\xea\x91.\xe5\x89\vIO\xd9\xec\r-\xbe\x85\xd5;\x98\xa6\x80XG;\xe6\xcc\xfa\xf4\xd2XӠ\x1e\xa8\xf5\xb8\xa9\xa5pžx!1\x19\xc5]\xa3\x85\x93;\x9f\xfd\x8c:\xacEOڒH\xbf\x83\x15F\x9av\x17F[\x95i\xa8y\xc9\x10+\u007f\x88\xd9\xd7pˤȠ0\xfa\\.\xad\xee\x00\x0f\xe7\x0fR\xdd[K쁙\xf9\xc8\xc5\xc3\xf49^\xc39\xff\r\xfeO\x8f\xd9ݽ}\xfd\xf6%\xb9\xc8s\"\x91Ֆ\x1a\xa6%wiw{g\xfav\x8d\xba\xa8\xc0\x19^l?#%\xcb\xff\x1c\xcfl\xc38\x00m\xc8\xc2eb\x1e\x88>n1\x92\xbf\nR\xaa\a\xae,\v\xaf8\x02\x91\n\xc3o\xfb\xa4\xc1n\x1f!a\xd9+\xba=\xd1>\x91\x92\x03]/\xe2\xb0\xdf\xd8?4\xdc5\xf6K\a\xee\x1a\x11\xe1㮁'\xe00R\xe3\xb8\x16\x1b\xfb\xa5\xb3v\x0fop\x162\u007fItY\x14R\x19]\x15,\x18[F\x10\x17ϨG\v\b\xde\xed;#\xffY\xfd\x88wG\xf4\x0f\xc7\xc7\u007f\xfa\xf6\xf2\xef\xff\xe7\xf8\xf8\xc7\xffL\xfdN\r\xb3Qk\xe6\x10\x80u\x01\xd9X\xc8\x1c,\xcb>s\xff\xf4\x96\xd7E\x86\t2\xd7=У\r5\xa5\x1eϥ6W7gៅ̯nz\x82D\x18:A\x05%\aQ\x02\xb6\x15~\x89\x19-J\x0f\x05bz\vM_K\xc6\xd2\xfb\xd7\xf6\xc8\xdcP3\xdf?Ůk<(f\f\b\xb4UA-4\x91\xd33\xcb\x1d\xd1\x14\xe8û%9Z\xbe\x88\x8cP\xb6\x00\xf4\x17lӀ\xa2\x03m#b۳\x9b>\x1c\x8b\x04צe\u007f\xc1KPeS\xf6\x00zqs\x15\n\x0f}D\xc4\xf7\x95lն}\f\xf9\x16\x12ο~\x169\x17\xa0\xf7\x13uuN\xb1\xbb\x83\xb1\xdfM\xde\xed\x833\xacPDE^W):q?\x8e\xb3\xa2Le\xe6\x1e\xc2\x02\x16R\xad\xce\xc2?\xa1\x98\xc3\x02\x14\xe5#m\xa4\xa2\xb3d\xf1\x13\xa6\x8aS\xac\xff\xe5>\x97\xca\xf9\x1b(\u061ci\\\x12O\x03\xaa\x02\x92\x95\xcaZ;|\x15t\x14\xc8?\x9a|\xab觻DҾ\xa3M\xe4u\xb2z?[\xb3\xe6\x1f\xe8\xc6YJ^.@\x9fUVJ\x0f\xc0\xe8\xd2\x14K\xb2\xa4J\u007fT\x8b+gK\xa6\xf7M\x97\xee\x1aT\xac\xde&\xb2&\x82<\xd6-\x82\t\x03\xb3\x1e&\xda\xe8\x10\xc8\xe84\x1f}\xf9\x88\x1e\x9b-KS\x94\x98<\xbc\xa0\xa6\x8aJ=\x162\xcds\x17F\xa3\xa0O\xdet\x98\xbeHqc\xbbQPc@\x89\x97\xe4?N\xfe\xf1۟G\xa7\u007f>9\xf9\xe1\xabѿ\xff\xf8ۓ\u007f\x8c\xf1?\xfe\xe5\xf4ϧ?\x87\u007f\xfc\xf6\xf4\xf4\xe4\xe4\x87o\xdf|sws\xf9#;\xfd\xf9\aQ.\xeeݿ~>\xf9\x01.\u007f\xdc\x13\xc8\xe9響L\x9e\xf2\xe3\xa8\xf6Ќ\x980#\xa9F\x8e\b\x9e,\xf6\xb0k\x04\xe4\x1e\x8a'\xbd\v\x9aH_\xc1K\x9a\x1a\xdbGd\x1d}U\xab^h\xe8\xa9Yi\xc8\x14\x98O\xcf\xe7\xec\xe6\x15\xd4pw\x8b\xa92\xf8\u007f1n\xe8\xfe\xa6\xa7CSm\xb7`\xb1C\x82\x01\xfa\x1e`1\xb4\xbf\xc4:\x12\xfe\v\xf7\x90\x10\x11\tcp\x95\x0f\xae\xf2m\xe3\x97\xee*\xbfu\xe7\xa7\xf6\x93\xa3\xb7\xbb\xdf\xd9\x1c\xfc\xe4I\xd2.\xf9\xe5\xb4պ\x9a\xdcQ\xaf$\xce01\x9706\xb4ߙOX\x17\x92#\x85,JN\xf7\xbe\x1d[\xcdc3sh3\xb90\n\xa6\x17\xafua\xd0:/ݥ\"D\x1f\xc1\xcd\\7r\xc19a\xc2\tI\xfc\xd8\x03\xe3qv0\xa659\xaf\x03\xa1._qi\xd1\xf00\a\x91t\xb7\xd2\r\xa6\x896T\x19&fc\xf2\xbd\x85\xe5\xb41\x9f\x8b\xc2\x04Y\x94ܰ\"2!\xa9\xb2\xb0\xaa\xda$\x84j-3F\x8d\xc7p\xb4@\xe5T\x9b\xb0%\x98\x96c\xe8=f\\f\x90\x83\xc8\x00kY\x95\x91r0\xec\xf9de1z)\x96nn\x94\xe4\xa5K)\x86h\xee\xd3=\xb7\x8f\x9d\xeej\x8f\xafO\xad\xa9\xb3^#\x99\"\xfa\xb8\x1d\f9\xadK\x89U\xf1\xdd8x\xe9*v\x95\xfd\x92d\x86l\xe8\xd6u|\xbaҌS\xa2\xaaJ.>p\x16P\xba\x9a\xbbUŭ\x15\xd5D}\xe1SSo\x9fE\xb5=\xa4Z\xdbS\xa5\xed\xa7\xce\xeeRe{X<\xf5\x89:D\xb2F?\x05\xb4\x87\x12X(\x98\xb2Ǟ\x1c\xe6BT{DX\x0e°)K\xb2\x13\xacΤ\xa0\x00\x81i\xc2@\xb3\xb9+\x83)\xdaI6)4\xfd\td\xe8;\xcf\xc1a\x18\xfa횟c\xe0\xe6\x037\xdf1\x06n\xbe{\xf8\xe3\xf4\x19\xb3\xf2\x0fh)\xe3\xcd\xe5\xf4\xebկ\x1b\xf7\x9f\x91#<\xc7]\xf9\xea\xbcօU\xce\xf1\x8bq\xc7\x12\x8b\xc0\xe2ѳVd%\xe4\\1}\xf9@\xe6l\x16\xeb\x11\xe3\xb0\x04\xee\xf5{\xb2\xa0\x82\xce\\)M#C\xa8.\xf6v\x84eI\x8a\xe5\xad\xc2k\xb8T+8-\x9b\xe2\x92\xc6\xd1r\xdd;N\x13\xce\ue07c\x86\x82˕\xaf\x98)rrk\xa8\xb1l\xe9\x16L\\\x02\\\x12\xf3\xc0\xd5ܔ\x9c\xdfHβ\xa8\x98C\x9b\xf4\xae\x90抒sR \xa81y+ 6,s\xc1\x1f\xe8J\x9f\x91kX\x82:#W\xd3kin\x9c\xb9Y\xdfO\x89;\xbc\xd2\x03%lJ^\xba\xfeh\xc4\xd0\x19\xba.\xaa\xfauqD\xa1Z\x13s\xc2\xe4\x81\xe9\xbevz\xb4\xc0\xdc8\x80\xbf\xc1\xafZ\xd1\xe9\xfe\xfd\xec\xe4\xc3\xd9\x14\xb2U\xc6\xd3y\xd6E\x86ɮu\xf9\xf5\xfa\xdcƹ\x8cV\xda\xc0\"\x94\rC\a\n\xc32\x93\x85\x14\x1a\\A\xbd\x14\x0fW\xb5B\xe70\xd3=\xf78U\xc9+\xa46\xb7\x86\xaa=˴գ}Jo\x02\x18K\xfe\x19\xe5\x1cr\xc2\x16\v\xc8\x195\xc0\xe3\xfd\xe4\xa1\x02h\xb3ܣkw\xe9\x8aI&\xe9\rs*r\x0e\n\xeb\x15z\x1f`\v\xbe\x01\xb5`\x82\xc6\x16\f!Uz\x17\xba,!'4ˤ\xca}-\xb8Pً\xaax\x8d\xbf\xe2x\xa8+5$\xcfz\xbe^4\xe4\t\x97ٽ&\xa50\x8c\xd7\xe5!CmHߨ1\x1aj\x12\x8b\xa9\xfesT\x9d\x89\x11\xf6#;\xffM\xfd'\xfc!V\xf9\xedc\xf9\xecW\xcfws\xacՠ\x04$\rL\xa6\x94\xf1b+\f\xcc\v\x96V}\xb1DU\xd7W\xad\x04M\x9a\x91\x81\x05\x88\xdb]u(\xb2M\xac\x8bF\xef#\xab\x90\xb8\xd17G!\xa1\x16Ps\xec([\x9c\x1ck\f\x85m9\x13Ь_̰&jz\b\xb3y\x82\x1d?\xf2\x16jz\x10\x98)\xec1\xb2jԶts\xef\x93̯\xa44\xe4\xe4\xf8\xfc\xf8t#\xa8u\x9c\x0eu\xca88\xe9\xea\x8a,e\xd5f%\x83\xd4lQ\xf0\x15\xee\xcfq\x8e\x1d\x9d\xfcuXU\xa6ň\t25\xbbˡ \xd4\x19ђ\x18EC\x97\x81\xf4\xb9Zh\x16\xb8Q\xa5\xd7UN\x8e\u007f>>#`\xb2\xd4|`B\x1e\xa486HFcr'I\xa9\xeb\x89'\xc3\\ɒ\bpu\x00\xe0\xb1\xe0,c\x86\xafP\xcc'Ô\xa5q\xc5\x17\xb1A\"\x16ں|d\xc6\xdf\xd3I\a;%_\xe1iw\xaa\x02\xa1\xd6\x18Z\xc2\xf9\x1c(7\xf3\xf4\xfb}\x96.\x85\x14\xa3\xff\x06%\xb1\x8c\x97\xf0\x10S\xbd;\t\xb1\xb3\xe68@\xc6I\x8a\x1ba\xfd\xed\xc4$\x06\xab |\x03\xd1*'\xd9\xe8Hzww\xf3\r\x98\xb6\bKB\x87\x9dQ\xc8\xcfG\x976\xa8\xa9T\x1d-w\x9f\x1e}\xe5\xdf\\\xea$̐\xcd~\xadڸ\xee\x13\xceH\x11i>g7\x8cl\xa7%\xfb\x8cFru\x93\x9e\x88\xf5wYZlM脯\xaa*\xb2\x1a\f9\xb2SOO{f\x02\xf7\xf3\xaf@s\xac\xdb+\xb4\x01\x9a\xa8\"\x1d\xe0\xa85\xe6r\x18\xa5\xc6\xf5ݝ;\x90=v\xb4Y\x02\xcb\xd3\xfe\x18\xcfT:\x9bt\x15^\x14\x14\x8e\xfd\xfa9~$&\xb9\xc1+\xdc.\xf8\xdf'\xbdr\x13ih\u007f\xec\x96\xe8k;'\x16\xef\b\x83\t\x9c&\x1e\x8a\x1e\xb3\xeb\x9f%Lzg\xab\x92\xae\b\x98\xc3U/\x98\xfe\xeee|Z\xda\xfa8\xc8\xfd\x92\xe4\x12^\xcd\xf1\x9ch\xc2\xe9}|<\xf5K\xb5$i\x89\x88\xed\xd7\xfba\xa2\xe7\r\x05\xd2[\xdf\xc2\xcb<\xc9\u05cd7/\x1b\x1bIh\x96\xa5\x15\x8ar\xc3w/G\x86\xa5A-c\x13\x1c\xebћ\xc4\n\x19\xef\xbf\f\xa3ׅ\xb7\xc3\\w;\xc8e\xb7\x8ez\x89\x8a\x88r1\xe9\xc1I\xaa\x02\x18\xca\xd4\x04\xe37\xbe\x873\xa5*\xb6y\x8d\xd3\v!\xdc>\xfa\x1e\xaa0T̀\xbc\xb03\xfd\xe3\x1f\xfe\xf0\xfb?\x8c\x11\r\xc9PC`\x99\nruq}\xf1\xd3\xed\xfbWX\xc4-\x95ʟ\xe5f\x1b\x96mH\x96?\xed\xc8<\x82\xb2\xd8+5\x16:\xeb\xb3\xc3\xd6\xd6\xf0\xfeo\xe7\\֩q\xb6\xe60\x12\xd9\xcdG\xe23}\x84\xd8\b\x0fч\xb6\xb3MV\xdc\xca\xec\xfe\x00\x96\xf6\xf1ݫ\x1b\a\xaa6\xb6\x93v\x81\x8a\xe0bfb)\xf9\xd2\xf5\v\xbc{u\x83\bJ\xdbY\xfb6\xc6\a\xd0շ\xb2s\f7\xe1]jN\x12T\xb6(|\xc7LJ\x14Pδa\x19~\xab\nS$\xda\xf7\xf2>%\x8b\xe7\x93\xf1+\x1c\xbf\r\xe9@\xe8bH>\xcdk\xae\x89\x96\x8b\xa1\x0f\x8bh\xb8&R\xaft\r\x1a\xc9\xc15\x12'\xea\xa5\xea\xa7\xc7\x0f\x1aɧ\xad\x91|n22\xf9\xd5B\xc1\xad\x91EϬ\t\a\xe4@9\x13\xa1\x13ݶ\xa4\x06\x92'l\xa9\xeb\x1d}qsUy\xc7e+\x11\x01\x93W\xa2\xa1\xea2\x9b\x87،\x00\xad\xcf1=\xa2,\x9c\xe7+4\x94\x8c\x8fX\x15\n\xb0\v\x9f\x14gUE\x02D\a\b\xf7#\x98,\xfe\xb4\xa0O\xc6\xe7\x8e\xf8xbخ\xbei\x18\x99\xa2z\x0eة\x02\x1e\x99ѡ\xdb5\xd5R\xb8\x10\xae\xdf>&\xe3\x03\x98L\x93\x82j\xed\x02w\xa6^\x84\xfbȍ̏\x13\xa2\xb7\x8d\t\x91\x99\xa2\x19\x90\x02\x14\x939\xc1\xaa\u007f\xb9|\x88\x9f\xe7\x04fL\xe8@\xbfv\xa2\xe1`X]\t\x92\"\xc2u\xe7\xd9w\xad.Rخ\xbc4\x99L\xe0\xc3\xfeu\x8f\xc5\xf5\x04\xa2諓8M{|J\xca\xf9\xaa>\xa8ᦧ9\xfc&mf\x12\xa5\"\xa1^\xf7z&Q|4\xb0\x95yd\x8fB\x9d\x95ԇ\xfc[\xd4ɴ=UټG\x9b/\x12\x9f\xbe<\xa46=9\x86Ԧ\xbdǐ\xda4\xa46\r\xa9MCjӐڴ\x17\x88!\xb5\xa9\x9aѐڴc\f\xa9MCj\xd3\xd3cHm\x1aR\x9b\xea1\xa46\xed5\x86Ԧ=Ɛ\xda4\xa46m\x1dC qHm\xfa5\x06\x12\x87Ԧ\xb8ׇԦ\x881\xa46\r\xa9Ma\f\xa9M\x91c\xd0H\x86Ԧ_\xa3F\xf2\xb9\xc9\xc8^\xd5\xc5\"_\vy<7JNz\x94\x19\xbb\xc1X=\xcb|\x1a\x90\x9c&\xd7\xd6q\xd3\x19\x93W\xad\xf4\f߄\xdfUi\x89\x82\xe8\x13}\xea\xf4\xa4\xbe\xf5z\xa2k2\x85\xa2`\xfa\xbc\x90\xee\xff\xd59\x05\x8dd\x02\xe7_\x8b\x11\x0e\xa9\xc27%\x8b\xe0\xa9\f\x82$^\xb7;{\x003\x01\xa2a\x1e2s\xa0\x8fv\xd3#c`G\xb6@\x00\x9b\xc4\\\xbb3\x05\xd6\"\xfeɩ >K`3\xda\xdf'\xe1\x02S\xe16\"\xfd\x89\x10\xab\xec\x80mQ\xfe4\x95\\\x1f>\xc2\xff\f\xd1\xfd\xc3G\xf6wD\xf5\xc9J\x96I0\xb7D\xf4}d>\x916;\xa3\xf9!*\x9f\x06\xb3;\x92ߊȧ\x12S\xaf(~\x8f\xe0TO\xe5:ݓ\x9c\xac)\xf9d㻹\x02=\x97<\x9a\u05f6\xf8\xec\x1b&آ\\X6\xa1-{d\xcb*\x9b9\x9eFB\x9e\x93\xd3:\\\x18\xce\x02f9`\x13K\xcaxJ\xa9:,\xad7\xa7\xe8\x9f\xd0e\x96\x01\xe4VN\xbe\xaeC\xe0\xd10\u007f?\xaeV\xee\xbaj0M^\xc4R\x9ek\xa8\x88\xf6\xdd\xef\u007f\x97\xb4\xfb)\x96ab\xc2\xc6\xd3\xc9\x1a\b9\x1a\x93\xfd\x135\xfa\xa8\x1b\xa9\x8e\x94\xe7I\xceؑ\x98A\xfe\x9e(\x1av$e\x10\x96&f\x0f\x94\x90ыs\xf6L\xc4ؑ\x84\xe1q\x94\xa8\x80l&`\xac'R\xa4\xa1<=\xf9\xa2\x87l{\xae\xa4\x8b\xed\t\x17\xa9$Iz'[\xf4O\xb48`\xb7\xc3:s\xa0ww\xfc^.\xba\x03x\x0e{&U<\x17Z\x0e\x91B\xf0\x11\xbb\xcf&\xefj\x9f䉞\x89\x13}\x92&R\x13&v$K\xf4\xf14\xf7L\x94\xe8E>\xa9\xe1\x88\xe4PD\xff0D\xef\x10Ď\x84\x88>=a;C\x0f\xa9\xfd\v\xc3h\x87\x1d\xd6\xc2\a\x89ja3\xe4p\xd0\xd0\xc1\xc1\xc3\x06\xe9I\f\xbb\x13\x18\x1a\x89\b\xa98\xdcL^蓄Ѓ\xa2S\x99\u007fRP%\x99i3\xc1\f\xa3\xfc5p\xba\xba\x85L\x8a<Z3Zk\x88T\x9dW\xed\xc09\xcb<>\x13\xa2u\x1bsN}\xe7L\xc8Å\xda\x10\r\x89g\xad\xa8>\x12\x8aq\n\xbbzӾ=\xf9q\xe3\x16䣹\fܕ\xd2C\x10\xc1_\xe5\x03\x91S\x03\x82\x9c0\x11\xe8 ޏZ;\vj\u007fQu\xac\xed__|\x15Ϲ\xdcd>_\xc7\x0e\xba\xb6\xb4~>\xbf\x9e\xff\xc0\xe1\x1d{\x1e\xf0\xb4\x8c\xf7ѷ\x9c{\xceA\xd8\xe6\xefћW\xb7\xe1{\x81\xf3\x0e\xdc\x04\xbdԾlC\x02\xccϔ\xa8\x92\xd3ΞL9#\t\x9d\xc7v\xa5\x9bթc\xd1`\xb7\xa4\x9a\xd5ic\xf1\x13ݖf\x96\x942\xf6\xd1=\x9ckib\xe9\xe6\xe7\x96\x141\xaf\x9e%*\xf1\xc9\xe9a\x83\x1d\x169v\xa4\x81\rv\xd8'd\x87}\x1e\x16F\xa3\xd6\xc97\x8afps053\xb0+\x92\x97\x8az\x91\x11\x14\xbc${\xc32\x19\x01\x90;NU\x15\xae\xc1\x8a+Ӓ'\x14\xaf*\v)\xda՟\\NE\xb3\x88K4P\x9f\xedұj\xaf(\xa5\x9c\xd0BI\xa7\xf6\x11U\na\xa5\xae?K\x16)\xd6V\xd2i\x12\xb2Y\xb3G\xb3\x99\xdd.\xabba\x15\x1c\x96 _\x1e\xe6 \x82\x96\xe9'lg7\x95*c\x13\xbe\"s\xcaS\xc2/\x0f\xcc\xcc\t%\xf7\x8cs?\xcd1\xb9\x05C̜\xe9Dg*\x97b\x86\x9bA݄᱀̪\x1d\x19\a*\xca\"m\xfdVY]\xc9R\x85\xf5\xfb\xb6qa\x96)I\x1b\x82\xf1\xb3\xb0\xd5\xc7z\xf7\x81M@\xacKP,5\x84:M\x0fL\xc3Y\x1f̆6\xa3\xee\x1c\xb8u\x17J.Y\x0e9\x99\xac\xd2\xe8_樵\x8e\xc9{\x84\x17\xf8\xbe\x90b$`F\xadm\x14\u007fR\x9d\x10wg\xde\xcd\xd3գ\x109˨I\xb0\xb14\x16֫\xcb\xe9\x91%\xa3\x88\x85\x06\xe5F\x03=\x11\x92HT\x8aK\xc1\xcc\nc\xa3\xf3Ґ\\>\x88\xd31\xb6\x9eMaR\x94L\xc0P\u007f\xafյ\x12D\x81\xa5\t\b:\xe1)\xca\tf\xe2\xdeu\x12(\x99\x025eBw\xbf\x195\xd0\xe9\x0fp\xf4p\xd8\xe3\xc0\xb4\x8f\x80NI)4Dߟi؇\u007f\xfc\xd7\x0fg\x1f\xb2\x05\xc8\xd2|R\x0e\u00879\xcb\xe6M\u007f\x03[\x80&\xb2\xecsm\xcdH\xf2\xc2O\xab\x9b\"\x9e\xb9}\xe4/Ϋ\x98\xa45Ɔ\xd8;\xe2F\xeb\xd5\xfc\xaa\xc4\xe9\xa8uS\xcb\xc3^_\xdf\xfe\xf4\xdd\xc5_.\xbf\x1b\x93K\x9a͛eH\x05\xa1VnD\xc1D\xb92\xa7K \x94\x94\x82\xfd\xb3t\x9d\xc7\xc9I\xf5\x9dӐ\x83\x1f\x057-_?\xc9R\xb4\x82\"\x8a\t\xb46\xe8;\xa6\xb1\xd1+B\xf1\xe9\xacR\x03\x99*\xb9\x88cJ-\xeb\x91\\Z0\xce[\x84\x96\xe6\x1c\x14\x90\x19[F\nY\v\xd57G\xa6yH*\xc6#lO\x8f\xd5b\xe9D\x96\x91&\xd0\x1c\x88\x00cOw\x15\xe1\x92B\xb7jږ\x1at\\~\xf9\xa4\xc4T\xeaB\xb1\x05U\x8c\xaf\x9a\x93\xb4\xea\xeb\xb5\f~\xb8U\xac\xa8m\xa2\xf0\xf5\xdb\xcb[r\xfd\xf6\x8e\x14\n\xcbz\xba\x9c\xe1h\v\xd2n/\x99\x80\xdd \xb7\xe1\xf9\x98\\\x88\x95\xfb\x90\xe3\xe5\x91Z\x06g\xda\x00Z*ޕ\x10\x9a\xd6\x1f}5\xc6\xff;\xb2;\xa8bCDUzy\xb6q\xc9\xc6y.\xd8$\xf2\x1e).\xbdA\x03=\xef\xd8$\xa4z\xad]\x9a\xf0뺱\xa8WP\xb8\xbe\xec\xb1ҒV$\x8d[\x88\xccО?\x9e\xec\xd3Iw\x80f\xcd%\xc5{\xdd:\x1d^\xd3\xcaa\xe5\xe85\xc1\xdf#Eì\xba\xba\t\xe4\xe84j\x94\x04\t@-\x1dZ\x9d\x84\xe5n\x82.A\xe2\x8c|E\xfeD\x1eɟ\x12 \xfe\xf1\x0f\u007f\xf8\xfd\x1f\xe3\xfdY}\xf4\x89>ʨ\xf3v_\xdd\xf4\xdc\xe7\xef-\x1b\xb3\x90\xec\xce\x18I&,鎋\xb3\xec\r(+&<\xc5\xc4㲇\xc7\xd6.\xe1\x93${\x97\x85q5\xad\x95/g\xf4'@\xac\x9c\xb0[\b?\xc5\x17K\xfe\xe4\t\xdfN\xf1\xafR\x9bk\xcfΘn\xcc8\x89\"\xfc\xe1&\vj\xb2y\x9b\xdfZ\x13\"\xe9\xd8\xd7\xe5\x97I.1\x96\xe5\xae\x03\xcdYB\xbe\xf0\xc7;\xbai\xe9\xb3-Jݤ\xa8>\xactͭ\x8f~\n\xaf\x97\xbb\x82\xe5\x89<\xa1\x90\xb97\x18\xec\x92\U000c640c\xb5\x18\xdc\xd8b7\xf8(EZ\xf1\x97\xfab\xbe\xe5\x85\x19\x15\xee&\xf1\x14\x94\u009bf)(]a\xc6$\xcb \x81,{p\xc1BI#3\xc9Sh\v\xb5Ɨ\xe4\xeeU|\x19\xc5v機\x03\xfah]\xb4\xfaM2a\xfe\xed\xf5͙\x9d\xd2\x19\x91\x8aܾ\xba\xbb\xe9w\x95\x89\x90\xa3\xbbW7G\x1fpOҢS\xa3\xb6.\x17\xf9n\xa0\x828\xeb\xacOE\x81\xd8D\xe7V\b\xd0Z0\xa3\x05-F\xf7\xb0\x8a\xd2yӱ\x94\x84\xa3\xcdI\xbb\xc5/\xe8\xfe7\xc1\x14М}B\xc5\x14<\x97\xaa\xe7\xd5]Ua!\x97\x91N#\xb4\xf6\x02t\x10y!\x990\xba\xab\xd4B\x14\xd8M\x93\xf1\x93IY\x1cJ-t\x8c\xa1\xd4\xc2\xd61\x94Z\x18J-\f\xa5\x16\x86R\v\xddc(\xb50\x94Z\xf8\xac\x92\xa7\x87R\v\x8d1\x94ZH\x18C\xa9\x85mc(\xb5\xb0\xd7\x18J-l\x8e\xa1\xd4B\xe7\x18J-t\x8c\xa1\xd4¾c(\xb5P\x8d_\xce\x15\x9f\xa1\xd4§z\xc5g(\xb5\xb0\xcf\xf8<.B\r\xa5\x16\x86R\v\x01/C\xa9\x85\xa81\x94ZX\x1bC\xa9\x85ϕ\xa8\x86R\vC\xa9\x85\xa1\xd4B\xf7w\u007f\xedv\xd8Pj\xe1S\xb5\xc3>\x0f\vc(\xb50\x94Z\x18J-\f\xa5\x16\x86R\vC\xa9\x85\xa1\xd4\xc2Pja(\xb5\xf0+\xf2*&i\x8d\n\xb4,U\x16g\a\xb7\x89\xec\x95\\\x14\xa5\x01\xf2.\x80\xaa\x94娅\xa3,a\xbay\xa3\xff\xc3v\"̤\x98\xb2\x99W\xf4\xce\x17T\xd0\x19\x8c*\xfc\x8c\xea\xfbw\xe7\x1f\"7\x9e\xb3\x05\x8b+\xb2`G]\xb1ই\x87#Ѡ\xeekN\xf74\xa6\vj\f(\xf1\x92\xfc\xc7\xc9?~\xfb\xf3\xe8\xf4\xcf''?|5\xfa\xf7\x1f\u007f{\xf2\x8f1\xfeǿ\x9c\xfe\xf9\xf4\xe7\xf0\x8fߞ\x9e\x9e\x9c\xfc\xf0\xed\x9bo\xeen.\u007fd\xa7?\xff \xcaŽ\xfb\xd7\xcf'?\xc0\xe5\x8f{\x029=\xfd\xf3\x97\xd1S=\xb0q\xda>\x8f\xdf!\xe5\xd4)Eȷ\x17\xf4\xd12\xd8xRX\xc8R\x18w\xcb\xc6\x1d\xf3\xeaD\xb84\xac\xd8CI>\x95\x83I\xfa\x18\xda>\x1fm8\x9f\x11c8\x9f\xee|\xbe\xf3\xb4\xd3>\xa1\xd1s\\x\x95i\xc7\t\x8d\x86\x19\x047\x1a\xba\xd5<\x99&r\xc1\x8c5\xa7S\xae\x197\n\xa9\xe0\x95\x94\xa6\x8b\xda\xf1\xaax-o\xeanS0ݼ\xa0Ѹ\x14.\x83훢\x96RQ\xc7)\x90\xe7\x8cr\x982\x01\xb9SO\u007f}\xfc.\xe95\rY\xa9\x98Y\xbd\x92\xc2\xc0c\x94c\xbf}^nۀ\x88ی\xf8C\x13&Dd\xe1\xae\x1d\xadU\bÛ\u007fq*+\x10U\n\xf4g\xb9:\x17`\x9cs\a\xcdp\xbcٳ6\xf9(\xf0\xc1\xf5\xe2<Z\xff,ْr\x10\xa6\x01\xfd\x06\x8d\xe3\xe6\a\x9eCC6T\xdf\xd7T\t#k*Ux;\x0fhş\xe0\xd1|\x10\xed\x18U\x8f\x1bŖ\x8c\xc3\f.uF9\x9e\x96~\xe6\xf2\xc5\x16\xa8\xd1\aޢBI\xae\xc9\xc3\x1c,'\"4\xb8\x10\xb1\xc8\u008c&$e/\xec^\x15ar\xda\xf9:\xad\xa2WPe\xa9\"\xf8(\xa3\x01c-\xa2\x89\x94\xdcߘ\xe4\xabz\xfe,-\x04%\xe4O\x02\x1e~\xb2\xb3\xd5d\xca\xe9\xacrMj0\xa9i\xa2\xf5Q\xadܱ\a\xdb0\xa6Q\xae\x13\xca\x1f\xe8J\u05ceﴫ\xe5\x0e\xe2K\xf2\xe2\x14\xf9\x03դ\x9acN~w\x8a\x19V\xaf.n~\xba\xfd\xfb\xedO\x17\xaf\xdf\\]\xa7\xf1q\xbbg\x10\x19\xf3\xcfhA'\x8c\xb3\x14\xc5s#s\xbc\t\f\x05g\x9e\x9f\xe7J\xc6_YB|\x87XH-\xdf\xfay\x97\x9a\x15\xe1\x90즭\t\xc7\xfb.\x15\x15\xa6rz7\xc8Q\x95°\xc5\a\xbd\xd4M\xf3\xfe\x17\xba/\xf2\x1c\xf2~(9\xdc]\x98Wa\x1a\xab\xba ]\x12TBn\xde\xde^\xfd\xdf5\xda\\\x15}\x12\xf4?\xc2]TB\xecA\xea\xbd\xc7\xef\\\xfd\x8aa\x97w\x8e\xcf\xef\xc6q\xa5\a\xf4\xcbI|W\x8av9\xdb\x1an\x82~\x92Ø\xdcT\x91\xe2\x16\xb4\x06S\x8f\xe7u\n\x88\x05)\f\xa3\x9c\xaf\x9a\x9a\xb0\x91X\x93!>\xa8)\xb6\xe4\xaeO)\xd7ь<]\x1a[E\xe6\x8d5\xdf{\xedb\x05\x85\xe4 \xa4\xf1\x1e\xbf\xa4\xd3 \xa7\b\x8d8\x9fB\xe3\xb2@K\xe2%)\x99\xb50f:\xe0\xfc\xa6\x9a9F\x98\xe2S>4\xac\x9bq^\x18W^\x86\xa4\xa0\xbf\x02\x9acM\x99\x82\x9a\xb9\xcbS]P}\x0f\xb9\xfb!QǮB\xb2v\xc6\xd5\xd2\xefV\x05$\xc7SQ\xb7vٿ\x18\xe7\x8d\xf7\xc6\xf6(\xefE\U000f70af\xdeIi\xbe\xaeʘ\xf4\"\xe4ｵԎ\x03E#e\x8ee\xbb\xed\xfcF\xb8\x89X\xb6\xa5Yi\xc5S_\x8a\x95\xf0\x81\x19\x84*Ņ\xfeF\xc92Z\x15\xd8Pֿ\xb9z\x8d\xfc\xb2\xf4\x192¨\x15\x96\xa6Ja\x12\xed3W\xd9c\u007f\xf39MI\xd96\x15{\b\xe1z\xf2\x86\xae\b\xe5Zz\xc31!\x18\xdc\xe5!!\xdeU\x93r3z\"\xcd|ݧ\x83\xeca\xf3;\xf1\x05\x8c\xea\x04\x9bʓi\x97\xd0ǅ\x84`\xe9=hR(\xc8 \a\x91ES\xef\xc7I\x83@ʿ\x96²\x97^\xb4\u007f\x15\xf2\u007f\x9c˸\x9fU\x8f\x99Jަ\xa7\x98\xaf\x84̥Ԡ\\r\x98*!m\xe3\xbf-'\xc0\xc18G\t\x16\xb9\xa5\xc6y\xfe\u0602\xce\xe2O\x135\x95(4\x92\x80Х\x02\xef47$\x97\tf\x80\xaf#e\x97\xfe\xb7\xab\xd7\xe4+rb\xd7~\x8a\xe4?\xa5\x8c\xa7T}\xc1\xdb\x1fk܄M\xc3\x14-J\xe3u\x02\x81ƾr\xac\xfa\x8c\bIt\x99\xcd\x03NS\xbcC\xc1y\xe5oH\xe1\x15\xb6\x815}\x02\xac\xa9\xa7`\xfd\x9b\x06\xd5[\xae\xfe\xed\x03\xc8\xd5>n0˛ڻ\x86\f\x85,\xc0М\x1a\x9a\x12|+E\xa3:\xe2\xdaQH\xa1\xdd\xddG\x01I;\x1a\xe6\xaf\xec(|\x1c)\xad\xe1;&\xcaGw;\xa0\xbfC\xf9\xf6\x12\xc1\x11\x1fJJ\x91(\x13 \xb4(8s\xe5\xfb\xd6Z\xc4\\\xb5H7m\xef7MM\x14\x0f\x94siՌ\x84\xf0\xb8\xa2\"\x97\x8b\x8d\xc5[C\x14h\x82U\xdcXp\xc7\xe1\xdcv\xd8\xe2ew}8\u007fm\x87\xad\x8f\xeb\x9e\xc3\x12\x12\xaa\x94\xaf7Q\xb2P\xacA\x1a\xa8\x06\xc1&y?9\x9d\x00w\xaa\xa1;9z\xf3\xe4${E\x13\x9d\xaaJ\xf2\xfe%/\xdeI\x0e.7> ɂ\xfd\xc5\xe0\b_\xee\x8b#\xf4>\xb5p\x94\xecE\xff\x14qT&hxd\x1dGVMl\xe3Ȃ\xfd\x85\xe0(9\x04\xa1!\xcb䢸Qr\xca\xe2\x0f\xeb\x86\xe8\xf7\xe0\xea\xe4\x9cx\xd1_j\xe8\xca\"G=\x12\x81\xc7k\xe4~2T5.=Q\xe3d\x9e\xbf\xc5\x15\r\xf4\xffk\xa8\x10ȵ\xcf\xd6\xf4\n\xff\xd5\xf8\xd96\xf3\x85\n\x99\a@\x1fT\xbaɌr\xecH\x94F\x17d\x9d6\xd6\x01\xf6\xb8\xcfE\\c;\x0f'\xe4\xf4aK\x16\xfc%\xc13@Bs?\x99C\xa3v\xbc\xbb\x80w\xe7\xee\xcbX\xd8I\x80õ8\xab\xa7\x84\xe4\xab<Į\xec\x17Ӧ+}\xa9\xec7UO%\x8bp\x10yj%\xa8\x82\x9a\xf9\x19Q\xc0\xf1\xe2^`h\xf7Ρu\x9c\xb6O\x8d\x05\a\xce\x106\x0e\xf5l&E\xda%v\\5\x86\x05\x82F<E\x1eh\x19\xfc\xd1w\x81\xd8\x12\xfa\xa4|\n\xd2\xfcȭ\xa4\xf2x\xa6E\xd5\xec\xb8g\"\xf7\xf7\xc6Z\xc8\xf7\xae\xb0\xb4\xb3\xe7\xec2\xbc\xf5\xc9\xf2&s}I\xfe\x91v\xf6\xaa\r#\xa3ͣ\x9d\x04\xb1\xc9\x0e:\x8ev\x12L\xc7\x0e\xde9s1\xd4T\x1a\xf5tE\x13\xe7\xc5j\x06;+\x04$䲆Qq\xaf\xbf\t<\x83\x96E\x8e\x88\x90\xbd8Y\xcd\x19\x03\r\x1c}\xd8\xf3\x95^\xbe!A\x1dNV\xa9\x1e\x98\xc8\xe5\x83>\x947\xe5{\a.\x98Ιew\x86\x89YB\nF\xedQ\xa1\x9c\xb72\xf4\x0e\xe1R\t\x9c\xa0ꓺ\xe9:H\xf6\xd9;bn\xfb\x12{\xfb\x0f\xb6\xb87jwE\xbc3e\x87{ù+\xa2A~\x1c\xf7\xc6l\xa1\xe9+e\xbfk\x18\xe5\xb7E|\x87\x1f\xb2N\xcb\u07fc\xb9\xbdh\x83L\xe3\xec\x98\u05eb\x9czla\x12\x9a/\x98\xd6L\n\xf2\x00\x93\xb9\x94\xf7IpOB\xea\xfc\x8c\x99y9\x19gr\xd1Ȣ\x1fi6\xd3\xe7\xfed\x8f,vҚ\x9c0\xc1í\a\xe7!\x14F\x87\x88\x81]L\x9a\x96Ua\x15\t\xd0w*\xf4\t\xae\x9bh\xbfN-R\x857\x16>\xb8J\xb5I\x8a\u05c9\x05ş \xc7d\xbc\xf8\xea2\x8djO\x8e0\xeb}I\x13\xb7v/]\xe8\xe7÷\x11p\xa6Z\x06\xba\u007f\x1b\x81\xbfְH\x0e\xae8D\xa2\xddǦ\xad\x86\u07b5B\xe2\"ډ\xb6\xe41\xd6n\xf3S<n:\x1d\x92\xeax\x90pT\xd0\xdbċ9\x1d\xa1\x83\x00\xe5\x8d\x15hI\x10\x83\xb13\x97B*w\xbc\xadJ/EB\xcfo7\xd0\u007f\xe5\xf2͐f\xbd\xa2\xd1خW\xe9\xe9\x9ev\xf8t8Lo\xc3\xda@Vmq\x8e\x9d\xf42\xf5\x0f\xcc̱}\xd3\x1cZ\x1fHǬ\x02\x8d\tK\x82\x80RR\xf9{#!\xd1 \xb5\xae\xb2\xd3\u007f\xf0r\x8be\n\xd4\xfe\xebX\xf7ɠ%\xad\xf6\xb1\xf6\x03\xdar\x1c\x98N!C\x93\xbd\xb1sI\xc0]\x88\xe6\xa4\xee7毆[&d\xbf\x99x\xbc\x16\xec\xd1b\xa0\xc9\x06zb!\xf4\xc5\xea\x06y:&\xe4*\xcd\x10\r\x17\xbb\xcf,\xa7iB\xf77\x8bRIA\xb4:S\xe3&\xfap^\x9ad\x00\xcc\xea\xb53J\x97\f)\xf9\x16\xa4\x99sq\x101\x8c\xb9\x17\x1e\x98=\x82\x9e\t\xa5\xfa\xc46\xf376\xf21Ҝc\xeb9\x1c\xc1?\x96\x1cCؑ\xcbAX|\x18\x97\xb8\x9c\xa9\x83\xe6s\x90-9\x1d\xe9\xd9M\xe4\xb93\x9c\xc8\xf3E\x9b\xc9\x01\"\xce\xe4\xa3Dy\xd2.~\xbb\x8a\xce=\xdb\xfc\xde6\xa04<\x9aV\xf5\x88Z\xbb\x17\xa7\xae\xaa}U\x15\x9b\xafB5~\xf6߱9\xf3\xed\xf6\xf3B\xbab\x03\xcdR\xf7\xbe\xafi\x9c\x9aR\n\xc3x\b^-\nn\x8d\xc7\u058c\xa3\xb3!\x11V\xa3\xdf\xf0Y\x85\x8c\xba\xbd\xb1/\xf4\x1fw^\xfe\v\xc5P\xd5\xd28\xd4\xf3\xbe\xa9>\xe5\xa2\x1f\x91\x1a\xb0o?\x8f%ی\f\xf16\x92\xb3\xe9\x14\xc2\r\xe7H\xb1WPE\x17\xd6p\xd0ħ\xfeN`\xc6\xdc5\xd3J\xb5\x8a\x8cPTE\xc2Μ\xba\xc7\fY\xb0\xd9\xdcyi\b\xc5R\x94\xf1\xe5&\x8d$\\Ҝ \x17\x97\x8a<P\xb5\xb0\x16\v\xcd\xe6X\xbf\x91\n\x92\x97\xd1\a\x1f;ɭF\xdaP\x03D\x16\xe0JJ\xb8\xbd\xb1\xf8vյ\xe2\xc8th>=4\x9f\xde{\fͧ\x87\xe6\xd3C\xf3\xe9\xd814\x9f\x1e\x9aO\xef=\x86\xe6\xd3C\xf3\xe9\xa1\xf9\xb4\x1bC\xf3\xe9\x8414\x9f\xde6\x86\xe6\xd3{\x8d\xa1\xf9\xf4\xe6\x18\x9aOw\x8e\xa1\xf9t\xc7\x18\x9aO\xef;\x86\xe6\xd3\xd5\xf8\xe54=\x1b\x9aO\u007f\xaaMφ\xe6\xd3\xfb\x8cϣ5\xdc\xd0|zh>\x1d\xf024\x9f\x8e\x1aC\xf3\xe9\xb514\x9f\xfe\\\x89jh>=4\x9f\x1e\x9aOw\u007f\xf7\xd7n\x87\rͧ?U;\xec\xf3\xb00\x86\xe6\xd3C\xf3\xe9\xa1\xf9\xf4\xd0|zh>=4\x9f\x1e\x9aO\x0fͧ\x87\xe6ӿ\"\xafb\xe2M\x91\x9cE\xb5lۣ[@J\xed\x93P\xbb\xd32\xb2r:\x05\x85\xc2\x17g\x17\x94\xa3\xb4K`\xa13T%\xba}F,6\nT@sW\xf3\"\xce/\xd89\xadP\x84\x14ۗ\xb9{\xa9\x91\xe1\x01r\xf9\xf6\xeb\xda\xe9\x9a\xd2\xea \xedv \xae\xe7\xad\xc8\xd2\xef\nՄ\xd0Q\x9d5\x0e\xb7.\x01?\xe3R\xfb{\xb2\x88\xeclN\x85\x00\xee\x95n\x16\x87\xd99\xd5d\x02 \x88,@8\xb5\x85\x12\xcdČ\x03\xa1\xc6\xd0l>\xb6+\x88\xf3\x9ey\"\xf0]\xeb\xea\x99j\xa3\x80.\x1c1(X\xc4\xf6\x19\xb4S$4SRk\xb2(\xb9aE5I\xa2\x01KeD&-]M\xeb\r\xc6$\xf1\xfa\x02\xeaY\xb5\x8a\xe89\xba2h\x8d3o\xa82g\xd8\x0evQ\x98\x95\xbbK\x15'\xf8\xb0k\xa7҆d\x9c\x810~ծ>#\xce\xf3\x8c\xc4\xe6\xc6\xe3\xf5]\xb7\vڣV\xe4\xe8\x15)\x8cv7}\xd2&꧘3\xed\xbdo\xfa\x8cP\x13\x04e4\xd1\aZB\xb2\x0f\n\x9c\x9b\xb5\xff)q\x9auI\u007f]_5\xab\x99\xe1\x94\xd38\xc500\xa5\xb3V-\x87\xda>\xc4$wd\xabQ`\xb1\xec\x90\xc3\x02\x1e\x1c\x01K\xcb? \x03\xb6D\u007f\x90\xe5\x8cQ\x10\u05f9\xe8\xb33ц\xee\xfa\x06\xb4\xa63\xb8\x89L\xb1\xd9\xe6 \xc6,\x9b\x9a\xb8\"\r.\xacFfdC\x87\xab/\xa0\xb4-\xd0(\xb0\v\xb7\xc6\xca\xe6|P\xcc\x18@\"\xc6\xceU\x98y\x18\x99\a\xbe1\xb9\xe6\xf5\x987\xe1\x83\xeeC\xb1Tk\xf5)\x91\xbbK\x1d\x13 \x13\xc5`J\xa6LP\xee\xefa\xc4]8\xc2~\x16T[ҤZ\x83B\x9f\x8bw;\x05\xdc\xc4\x11\xec\xf7\x1e\x91F\x95\"\xa3\x8d.\x97X\xfd\x8eM\xc9\f\xefzD\x1a\x13s*ȿ~\xf5\xef\u007f$\x93\x95Ղ\xd186\xd2P^m \a1\x8b\xac\xed\xef\xc5S\xbb\x0eYE\t\x9c-X\xac[\xc8\x1a\x03\xbf\xbb\x9f\xb4\x03\x8d\xe79,\xcf\x1b\xf49\xe2r\x16\x87\xd3W\xe1~eug2F\x91Or\xedw\xb0\x01\xc9Y\xb6Jf\x04\xa1y\x0e\x99\xcb\a\xe7\xca\xebyb\xeb+\x8e\x85,J\xeeR2\xbe\x0e\x95%\xa3@\x96\x1a6\xabau\xf2\xc1Xj\bS[\xef\x10\xee\xaeL\xf9\xa5\xc4)-\xbe\xf0\x9c\x0f\x8dW=s\xd0O\xfc5\xe5|B\xb3\xfb;\xf9\x9d\x9c\xe9\xb7\xe2R\xa9\xc86\xfbH\xfd\x01\x1f\x9cZ-f^\x8a{ly[\xd7\x1a\x96q\xd2V\x96\xa6(M\xb8\xe4\xddt\xef\x86͌\xae\aY)h\xc13\\\xcf\x0e\x1e\xed\xb9E\xf7l\x1c;\xf0\xc5w\x1cw\xe1rV\xcd[\af\x10{#\xe8w_\xfd\xeb\xbf9\x96E\xa4\"\xff\xf6\x15^\x19\xd5gN\x88\xa1n`\x15\xd9\x05\xe5<6\x98\xd5d0\x96\xe8\xc7\x1dL\xe2\xd9y\x84Ig\a\xcfbr\xdf\xdd\xfd\x1d\xedmf4\xf0陫=\x12<\x88Q@\x8fQ\x89;\xf6R\x16\x8b\xdc|\x04\x83v)y\xb9\x80װdY\\\x84\xbf\x85\xea\x16\x94\x10\t\xe2L\x1b\"\xe3\xaa@L\xb8\xcc\xeeI\xee\x015\xeef\xac\xf7\xb1\x8e\xc1L\xc2-\x94\xad\xab\xab\uf7d0\xd8fD\vZ\x14U-\aE\x1fZ\x8bE^\x12}\x01\x85\xa6\x06\xaaӳ:\xdctc\x15\xf6\xf0n\x03\xab5\xa0@0E\xac\xf4s\xc3\u07fc\xdehH\x15:\xe8%\xe5\x1a\xf8=qz\x9a\xdd9\xe4\xcc\xf1\xe1\xf5\x1eI\x0fiwzZ8\x16U\xae\xc0\x82\x1ao\xd3$\xe6\xcf \xd5\x16\xa04\xd3V\x81y\x8fg\xe2\x15\xa7l\x91~\xc3?\xa5!A\x8f\x16\xb0)y\t\xa3\x06\x9dF\xbe\x18\x8d\xe8^u\x8f\xe2\xee\xb68\x96\x86-}ӹ\xfe\x8d\xcc= dծ\r\xb35e\xa3\xc9a[\xa1\x87^\nG_\xb6\xff\xbe\xc6Q\x93\xeb\xbbu\xc6\x1fg<@\x0e\xa6g\xf6\x1f\x83}\xe3\xe4\x0f\xc0\xbd\x91o\xfbe\xf4\xad;\xd7t\xd8x\x82j\x98^\xdeG2v\xb9\xb1\t\xe0-\x05\xf9\xe9\x91\xe3\x97\xc7\x1f\x94\x87;t+Y\xd0\x19Z#=\xb1\xbe\x0e\xae_\xa1Yk&#Īg\f\u0085\xbc\xaam\x9e\x04\xd4]\xa8\xaf\xe5p0\x9f\xb0\xf2X\x02\xc4\a\xba\"T\xc9R\xe4.\xf6P\a\xa5ެ\xa1\xe3Z\x8a\x94)\xfb路4Uմ\xc54\x01&ȋ\xf1\x8b\xaf>7\xc1\x8f+Y\x13\xfc\x89\x85\x9f\x1b|\xeb\x83b!\xb4l\uf2497\xde\xc5ZwXO*;\xe9b@\b\xe4A1\xe3\xa9\xf9\x81i '\xb1^\xf30\xa4jֲ<m\xbb\xf4\x92z\xb7\xf7+z\xaa\xcb\xc93H\x06\xc7\xd0\x13ЃL\xa8\xcb\x17\xaf\xd3av\x88\x95&ҏR:}\x9c\xb8\xd9\x1c\xbb\xaaW\xa7\x1f\xf4\x90\xf8-\xbb|,\x12:ǵ\xb6\xed\xf2\xb1\xa0\xe8\xf5/\xea\xfdK9'\xb5\b߾\u007f\tp\xb7\xab\x05\u007f\x819]&\xc9?\xcd\x16\x8cS\xc51\xb5\xec\xd6a\x92LJC@,\x99\x92\"\xe9\xf6\x05!K\xaa\x18V\x1bW\x80\xb5 3\xd0\xe4˓\xf7\x17\xef0C;\xa5p\x97+\xd3\xe9\xf7\xa7Ԯ\xbcx_\x8c6\x16\xb9~\bj\x92N\x80\xeb\x0eA\xc0\xa7\xa5L\xd4\x00\x02~iB\xaa\x12!\x8bҔ\x94c\xc1\xb6\x8c\x97\x9a-?\xa4,J\xb5\x1c+]\xfb\x17d8\xfa\x92\x81\xafY\x14\xbfY+\x8fX3\xf2\x8d\n\x84\xd1\t\x1b\xa8\f\xd6\x15n;\xd3j\"\xe984\xa8j&\t{\x87\xba\xaf\x9e\xea\xae\x10\xfa\x9eo\xb1\xa9Kk\xd9\x06\v:\xfb\b\xae\xf5X\x9a\x8e\xa2\xcahz\x8c\xa3D\x9f\xf7\xb9\xdf\xd4\xdbj\xb1{\xd3\xf7\\s^\xc7\x05}ĄJ\x8a\xc7u\xcf\x15\xca)\u0382\xbc\a\x0eJ\x06\xb1\xf4@\x99\xa9\xee\x9b2\xc1Ltg\t4\x9c\\=\xe5\xfd\b j\xeb\xf7ޗ=\x1f|z۞\"\xb3\x9dd\xf5\xe4,v}\u007f\xc7\xcbLd\xbc\xcc\xe1\x15/\xb5\x01\xf5\x0e\xb4,Ug\xf4c-\xba\xdc\xf9V\xe3R\xe9\x83\x0f8e\ue451\xced\xd1\xc9\x1eT\xfdr\xa5\xcf\xf8I\xe5\xa1\xe0\x04\xdeO\xaenҸ\xca^\xdaH\x05[*k\x8b\x92\xf3\xb5K\x8d\x9d}\x13\xecsV;\xd9r\xb7k\x97\xfd\x10\xa6h\rI]нQ\xd6x\xc1%\xe0k\xce2t؋\xf0\a\xf7_v\xd6\xfe#\x1d+t{\xe9\x92P1/\v\xa3\xb3g\x98\\!\xea/\xb8\n\n\xeeÛ\xcb\xdf\xea\x14\xdcy\x90\xf6BZ\x17\x1d\x86\x89D\x12Y\xfd\xfc\x1a\xc2\x02\xe5샯M\xb2ib\xac\xa6A\xff܄f\xf7e\xf1i\xa1\x0f;P\xdf\x02G\xdd\xe0\t\xd4}\xd7|֡m\x01\x86._\x8c\xdb\u007f\xb1\xb65\xe3\x06\xb3\x90;U\xb3\a\x97\ti\xb1\xe6.\x9c\xe5l\xc9\xf2\x92\xf2\x16\x056pV\xa3\x16/\xa42ޕ \x85%q\xfd\xfb-\x1cW\x17\x06\xa3\xcf\xean/0:~\xac\xfa\xedSa\xbbYp۽\xb8\xf6\x8aâ\x8f\xe3\xfav\xe0:\xe0ѳvk?lM\xb3\xbd\xf3\x05\t\xc3s\xb8\xf2\x8b\xeb\xd7\xdbԛ\x9d.\xfb\xd6T/vLǟ\x99j\xc3wua\xf0\x8a\x98\xbf\xf3\xa5\xcf\b%\xf7\xb0:sɯ\u0097\xa1\xf7@\\\xd7`\xaf6\xdc\xc3vUž\xec\xe0mC\xcc>\x0e\xfc{\xd8\xe9\xfbj\xa1\xe3\x1eVU\xd8\x1d\xf1b\u007f\b\x01\xd0\x1a\x15\xbe\x8d\xfbnٿ3ʹ\x97\xbe\x11\xb0\xb6\xf7\xf4+4+\xb0\xc4\xe7HŮ\xe1X\xfbV\xcdR\xe89+\x9eJ\x8e\xa1\x98\xb2-\xa7\x01\xfbU\xf3^\a\xde\xd1ߕ8#\xd7\xd2\xd8\xff\xb9|d\xfa\x89\v9v/_K\xd0\xd7\xd2\xe0ӽ\x91㦶7j\xdc\xe3H\xd2\xc2\xf1H\xbc\xa4\x84ߨ\x96y\xf5\xf4\xfd\xf7\n\xc5L\x93+a\x19\x95\xc7AuYQ{\xf0\xcd;\x86\xc8\xd5vk\xa2\xee\xdb-\xf8\x0e\xad\xf6\x1bM\xcc5?\xb5\x1b\xe5\xadi\xb8)8\x8f\xb6\xfb\v&h\x17\x9cf\x90\xfb>\x13v㍢\x06flw\xfb\x81\x05\xa8\x19&\x1ad\xf3]\xab\xda#t\xb8\xa7\xe2}\b\x15y;\xab\x19Uh\u007f\x0e\x15\xda\xcb\x10\x14\x9f[\xb0\x11:\x89Q~\xf3$G{\x12c\x9b\xb2\xc8}\xda\vsZX\xca\xff\x1f˞\x91\x88\xfe\x97\x14\x94)=&\x17\xfe\x86ʖ\xef6\xdf\xf0\xbaN\x13\xb8\x85\xcb4\xb1\xbb\xb0\xa4\x1c\\\xa1b*\b\xec,\xbf\"\xa7\x1b\xd2\xf2\x8c<̥F\xc9P\a\x91\x8e\xeeaut\xd6:![ ڇ\xaf\xc4\xd1Y\x15/k\x1d\xcaJNa\b\xe3\b\xffv4\xde\x10\xb0[`?!vwRɎ?VZ\xf7\x1b\x97ڴ\xb9\xf3\xfb\xd2\xc7N\xdaب\xc0\xd8\xfcf\x8b8\x9a\xcaqˬ\xe8\xfa$U30]&\x88ט1\x95aL.\xc4j\x03.^\x8c\xebT\xb9\xbd\x11W\xd1Y\xd1j_\x84--1C\xa2\x01\xca'.\xe9nC\xd8>\xb8\xb9k;6\x05\x05\xa8Zµ\xcc\xe1F\xaa\xae\xfc\x8ev\xbcf\xfd\xf9\x0e\x8b\xb6\x81\x14\xc9s̳\xc7G;拺\xb1\u05cb\x0fi|\xfa\xef\u07fc\u007fj=\xef\xaa\aw/\x84bo\r\xb7_\x1d\xeb\xb0ﻻ6\x82\x16z.\r9\t\x97\xda3.\xcb\xdc\xdf\xecW\x1d\xf1\x9e\x1e\xab\xd4\xd9\x1c\xf2\x92Cw\xd3\xc1\x8d2\x95\xe1Ѡ\xfb\x95\x82\xfd\xb3l\xb7\xe8\r\x1e*\xfft\xd7A\xa8qR\x99\xd6\r\x8f\xaaeG\u007f\xc1\xfd\f_\xf2V\xa4\x87\xbc%\x15\xbe\tҝ\x05\xa9\r\xdeS\x12\xa6Qt-\x98\x9d\x99o\xcf\xe1\x1f\xef\xbcf\x17ְ\xed8t\xb0\x8fn\xe1:\xf2_݈\x88o9V.\x97\xbe\r\xa3\x8b\xe6n]\xce}F\vS\xaa\xd0\xfe\xbaT\xd8\x0f\xacnaB\x03\xe6<\x8aZ`\xb7\x1b\x06\xde/Ȥ\xb8c\vІ.\x8a'(\xe4\xd5\xe6\x1bv\x03\xa4\xcauU\xe9\xa4\xe9\"\xa8\x9bnuYŴn\xf5\x96\x8f\x1b\xb0\x1d\x18\xd4\xc9,h\xc8\t,A\x10\u007f\xc9\a#\xef\xce\x01\xd1\x01\xf4\x0e\x8d\x13\xb5\xc4X@\x80\x83\xf9\xbeS\xa9\bvի\xa6\xbeI\x11\xe1\xeaxN\r\x8c:o\x12\xeeu\x12;\xa5\x8e\xeb\x91\xfb\x04\x82/]#]\x94@\x19\xe6\x89\xd9\xed\xe5ܽ\x1dn\x1e\xf8\xab~\x0f\xa0\x80\xcc@X\x14wr\x1c\xafʺVO\x16\xb1\xfe\x04W\x0e\x9c;\xd7\x1d\xab\xa4<\xb4\xf0\xc5\n8A\xaalu\x99\xe1#\x9d\xb7\xacv]\xa0\xf77>\xde\x01\xd5]yK-D|\xdd|\xd6\xdb*\x0e\a\xb8\xf4\x8c\xba>y\xaec)S\xd0I\xfa~J\x12\xbf\x1cq\xc6\t)\xe6T?\xc5.o\xec3UK\xaeơ\xac8\xe5\xbb-s\x02Q.6\x81\x8f\xc85<t\xfc\xfa5\x12\xfd{\xdfV\xb9\xe3(\x8dȕ\xb8Qr\xa6\xba\xaaĎ\xc2\xc1ꠐ\x11\xb9\xa1\xca0\xca\xf9\xea\xeb\xeen4\xe1\xebQ\xb8\xf3SyRKp\x8fՊ(\x13\xee\xfcYJ\xa5\x93Ы\xdao챮\xe9\xb8c\x93\xc3G\xc7\xd6\b\x85\xe0\xa8`m\xa0\x98\x82\xa5\xcd\b\xa6S\xa9\x8cS|G#¦\x9e\u007fv)\x1f\x94qT\xe1\\\x10\x8d0S\x1b\x88\x95@\x92\x8aP\xb1\"\n\x89\x15[\u007f-\xe8ʹ\xe0i\x96\x95\xf6x\x9ekC\xbb\xe4L/\x87\x1c\x1a\x95\x9eȶX{m_p\xf3\xf9\xaa\xfb~U\xa7\x04\xc19\xd4a\xa7l\xc7\x19\xb6V\x03\xc3[\xfd\x95\x0e\xaa\xed)\xeb\xb6D\x9e*\xaa\x81w\"\xaf\xb6\x1b\xc8\xed\x80[\xf5pX\x80\xbbR\xb9\xb1\f\xd9T\x91\xb7\xbb\x13\x99\x0e\xaf\xda=\xcb\xe6XQ\xcf̕,gU\xbb\xf4m\ft\x9b[\xb1\xc4K\xbf\x05/g\x96\xac\xbd\xa3ϔJ4\xac\x17\xef\xfa\xcb\xeb\xe9\xee\x02\xba\x1b\x85;twݒxO)\x80\xad\x87\xfbI\xf6\xea\xba\xfb\xa7+\x91\x97\x15K\xbd\xdcG6\xbf_{|-\x90b\xa5t\r\xd1\xcb\xd3\x0e䜰\xa9\xf3\x9afv֛\xc9;\x1f8 \xf2@\x95`b\xf6\xd4\xe2\xbf\xf7\x8fu\xa8&\x1eB\x87rұ\x88J]\x89RN\xc2$\xb7\xe4\xfaT\nK\x0f\xf5\xa4\xf3\fm\xfc\x88\x84\x9c7\x90\xec\xbf\xe4\u007f\xa9\xd5zW\xe6\xc2\a*\x1dn\xef\x99\xc8_\x86\x84\xc0\x82\x97\x8ar\xff\xcfL\n\xc7\x16\xf4K\xf2Ï_\x84\x05\xbd\a\xa5\xab\x1f\xff_\x00\x00\x00\xff\xffu\xec\xfc\xa4\xa5\xec\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\\_oܸ\x11\u007f\xf7\xa7\x18\xb8\x0fi\x01\xef\xfa\x82>\xb4\xf0[\xce\xf1\xa1ƥ\xb9 v\xfdR\xf4\x81+\xcdZ<K\xa4JR\xebl\x0f\xf7\u074b\x19\x8a\xfa\xb7Ҋ\xda8\xe8\xf5`\xbe$\xe6\x92\xc3\xe1o\x86\xc3\xe1pĳ\xd5ju&J\xf9\x80\xc6J\xad\xae@\x94\x12\xbf8T\xf4\x97]?\xfdծ\xa5\xbeܽ={\x92*\xbd\x82\xeb\xca:]|F\xab+\x93\xe0{\xdcJ%\x9d\xd4\xea\xac@'R\xe1\xc4\xd5\x19\x80PJ;AՖ\xfe\x04H\xb4rF\xe79\x9a\xd5#\xaa\xf5S\xb5\xc1M%\xf3\x14\r\x13\x0fC\xef\xbe[\xffe\xfd\xdd\x19@b\x90\xbb\xdf\xcb\x02\xad\x13Ey\x05\xaa\xca\xf33\x00%\n\xbc\x02\x9bd\x98V9\xda\xf5\x0es4z-\xf5\x99-1\xa1\xd1\x1e\x8d\xae\xca+h\u007f\xf0\x9djN\xfc,\xee\xea\xfe\\\x95K\xeb~\xecU\u007f\x90\xd6\xf1Oe^\x19\x91w\xc6\xe3Z+\xd5c\x95\v\xd3֟\x01\xd8D\x97x\x05\x1fi\xa8R$\x98\x9e\x01\xd4\x13\xe3\xa1W Ҕ\xa1\x12\xf9'#\x95Cs\xad\xf3\xaa\b\x10\xad E\x9b\x18Y:\x86\x82\xe8\x80ނ˰;\n\x95\x9f\xadV\x9f\x84ˮ`\x1d@_\xd3\f\xeb\x9f=B\x1f\xdb\n\xb7'Ƭ3R=\x8e\ru焫\xec\xfc`\x96ۭ\xcbL\xd8\xfeX\x9e@\xe4h\xef\xe0\xdah\x05\xf8\xa54h\t\x1dHY\x89\xd4#<g\xa8\xc0i0\x95bV\xbe\x17\xc9SU\x8e0Rb\xb2\x1e\xf0Ysү\x9c\xe3\xe5>Cȅu\xe0d\x81 \xea\x01\xe1YX\xe6a\xab\r\xb8L\xdayL\x88H\x8f[\xcf·a\xb5g(\x15\x0ekv\xc6dy\xa0\xfc=\x9a\xef\x1eq\x9c\x98\xffy\xf7֫h\x92a!\xaeꖺD\xf5\xee\xd3\xedß\xefz\xd50Ѓz\x96 -\bx\xe0\xf5\x03\xa6^\xe9\xe02\xe1\xc0 I\r\x95\xa3\x16\xa5\xc1U@&mH\x02h\x03%\x1a\xa9S\x99\x04D\xb9\xb3\xcdt\x95\xa7\xb0A\x02w\xddt(\x8d.\xd18\x19V\xa8/\x1d\x8bԩ\x1dp\xfc\x86&\xe5[y-BˊS\xaf;Lk\x1c\xbcnK\xdb\xf2\xcf\x00\xf7\b\x035\x12\n\xf4\xe6gL\xdc\x1a\xee\xd0\x10\x99\xc0u\xa2\xd5\x0e\r!\x90\xe8G%\xff\xd3ж\xa4\xb1\x8e\x15\xc9am6\xda\xc2\xeb\\\x89\x1cv\"\xaf\xf0\x02\x84J\xa1\x10{0H\xa3@\xa5:\xf4\xb8\x89]\xc3ߵA\x90j\xab\xaf s\xae\xb4W\x97\x97\x8f\xd2\x05K\x9c袨\x94t\xfbK6\xaarS9m\xece\x8a;\xcc/\xad|\\\t\x93d\xd2a\xe2*\x83\x97\xa2\x94+f]\xb15^\x17\xe9\x1f\x82D\xed\x9b\x1e\xaf\ak\xc5\x17\xb6\x97G$@\x86\xd3+\x8c\xef\xeag\xd1\x02MU\x84\xce盻\xfb\xae2I;D\x9fq\xefhX+\x02\x02L\xaa-֫qkt\xc14Q\xa5\xa5\x96\xca\xf1\x1fI.Q\r\xe1\xb7զ\x90\x8e\xe4\xfe\xef\n\xad#Y\xadᚷ'\xd2ê\xa4Փ\xae\xe1V\xc1\xb5(0\xbf\x16\x16\xbf\xb9\x00\bi\xbb\"`\xe3D\xd0\xddY\x87\x8d=j\x9d\x1f\xc2.8!\xaf\xb0\xc6\xefJLzK\x86\xfaɭLxa\xb0\xe5kL\xc0\xc0\xfa\xf92\xbej\xa16=\xd4|X\u007f\xd4\xda\xc4\xee\t\a4\xa161\xeb\x83_&\xd0䟰(i\xb9ΰx_7#\x16\t\xa3\xb4\xf1v\xc2f\x19̛\xae\xad\x1a\x1c\x18\x15\x1e.C\xc2k'\xd3\xdaj\x1c\xa0y\x1cQ\xcf\xd8VT\xb9{ \x97\x01\xed\xbd\xfe\x8c\xd6\xc9d\xac\xe5`\x12\xefG;\x06y\xa3%\x84]\x86\x86\x16'\xff\xc0\xf6n\x94.\U0001ac58\xb2\xc9\x13O\xb4en<\x02d;\xf3\x1cJ\x9d\xc2Ώ\x04\x9b}`\xfaP6\xad|6Z\xe7(\xc6P\xc3/I^\xa5\x986\x1e\xd5(.\x83\xd9\xde\x1ctb\xdfSHEZF\x9e\x1e\xb1\xaa\x9a_'\xe6\xc9\xfb\x950\bd(\xa4\xf24Az\xb7d3\xa1pT\xa4\xc3b\x82ϣ\x1a\xe9\v\xf9\xb8b\x93\xe3\x158S\x8d\xe9z\xa0!\x8c\x11\xfb#\x98\x05\xff|\tdM\x9fڜ\xe72a\x0f\xb41ڌ\x1aC31\xbf\xffC\xc02\xad\x9fb@\xfa\x1b\xb5k7'H\xf8\x18\x04\x1b\xcc\xc4Njc\x87\x1e\x0e~\xc1\xa4r8\xb5\x8e\x84\x83Tn\xb7h\x88\x16;ԍ\xff}\f\xac\xe3&\x82\x8a9.\xf8\x83y\xb5B'\xe11\x1aSSaS<I\x15\x98q\xb2\xd8U\tR\xa5r'\xd3J\xe4 \x95uB%~~\xa2\xe1o|~0\xa7\x10\a\xfc{\x03\x1cfAR\xea\xedlZ!\xb9\xa3\x856\xe3\xca\x11\xca!\x99i\x186\x82,\xa0\x9eڎ\xdab\xe8\xc0X\xb3\x92\xf2\x96\xdaڝ\x8bVR\xde)\xcc\xc5\x06s\xb0\x98cⴙ\x86'F\t|\x89\xb5\x9f\x13ȎX\xd2v\xcf E\x9d5\xa2mq\x1a\x9e3\x99d\xde\u007f#-cZ\x90j\xb4l1DY\xe6\xfbc\x93\x86\x18ͨ\a\x9b3\x1am\x890\x1fC\xbaS\x86\xa4-\x916\xb8-3ָ\x8fz\xa36\xaf\xa0\xf7\xd8T_\xa5\xec\xb7\a\xdd_^\xd9\tnI\xe7\xbb\xdb-`Q\xba\xfd\x05H\x17jc\xa8\x92\x83\xd5\xf2\xf1;\x13\xdci\xab\xe5v\xd8\xfb\xc5WˋH\xada\xe3w\"4ެ\xee\xea\xbdj\x91\xc0>t{^\x80\xdc6\x02K/`+s\x87\xecK\xcd1\xdaqtf%\xf7\x92\x00\xc5\xee\xbdT\n\xe1\x92\xec\xa69\xd2F\xf4\x18`5$\xe0\xfd\xf2p\x86a\x19D\x90\x84Ʃ\xe0(\x884X\xf8\xe8\xca=\xaf\x8f\xb6\x86=\xc0w\x1f\xdfc:\a\x19\xc4k\xea\xc1\xa4\xde\r<\x9d.\v<\xc1(\x92\x9dI\xb1\x9b֜\xf1|\f\xed\x02\x04<\xe1\xde{V\xa3\x87˱B\xa2\x15\rI\x83\x1c\xd0c3\xf2\x84{&UG\xe8\xa2\xe8-Q\x15_\x9ep\x1f\xdbt\x00*\xf1W\xc7(<\xbaT\xc1\xb3\x88YJmi@\xad\xd7\x0e8\x1d7YXf\x94B\t\x88\x9f8\xedF`\xbd\xb0\xf4\x13\xee\xdfX/>Z5\x99,\x17 @\x06\x1b,\xf2\n\v\xf1\xd8\a\x91˴\x19\x8c\xd7\xc9\x02\x8a\xb7\xea\x02>jG\xff\xdc|\x91\x96XT)\xbc\xd7h?j\xc75\xdf\x14b?\x89\x13\x01\xf6\x9dyY*\xbf-\x10.\x8b\xc6oy\xe0-\x94T\xb4\x11\x9b\xb4p\xab\xe8|\xe6\xf1Y\"\xa6\f\x03s\x9e\xad\xa2\xb2\x1c\xd1UZ\xadx\x9b\x0e\xa3- \xda\xe5\xab\x16\x956=I],\xa48\xcab\xcd\xde=\xedV\xfe\x97\x83X\xf8\xb1b\xb0\xccE\x82)\xa4\x15\xc7\xdb9\xf0.\x1c>\xca\x04\n4\x8f\b%\xed\x1b\xf1J\xb5\xc0\x92\xfbr\x82\x16ƻ\x16\xa1\xd4\xdbB\x1a\xc7؊V}d\xcb \xe6\xa8\xe6\x13Q\xf6\xe3\xcd\xe3f\xc9\xdb;\xfbCQ\xe8wo\x8f\x97\xed,\v\xe5u\xe8\x83x&\xbd\xfbQ\b\x0e\xf6\xfeB\xdb+\xab\xf7\xafq\xbb\xa1\x90Ʈ\xe1\x1dߝ\xe7\xd8\xed\x1f\xa2\x84\x9d\xa1\xa2H\x12'\xd2\x02\xe9\xc9N\xe4\xe4>\x90\xf1V\x80\xb9w&\xf4\xf6\xc0\x83\x8a31ϙ\xb6~\xcf\xdfJ\xcc\xf9v\xeb\xfc\t\xf7\xe7\x17\a\xd6\xeb\xfcV\x9d\xc7\xd1$\x9b\u007f`\xb4\x1a\xafE\xab|\x0f\xe7\xfc\xdb9;fK\x96\xc8\t\xce\xdb\x02\xad\x8en\xca\xd7\xcfK\x8e\x02\x9c\xe1 \xdb\xe3usIK.\xfc\xdc,\xa2u\xba\xd4\xd6-b듶\xce\a\x00{\xee\xf6H\x840\xe6\xf4WG\rAl\x1d\x1a\xb0N\x9bp!Jfw\x10 '\xc9\xdbyٓ\xa8\x9bh\xa4'L\x87\xcc\xf3\xd6Bx\x9b~\xeeoJ\xe9\xff\xf34\x13v\x96\x98vit\x82\xd6ΫR\xe4\xce1\x13\xb0m\x82\xb5\xc2\x1f\u07b6Q\xa69&\x94\x1c\xca2W\x9c\xa0=\xe1`s\xf3\xa5\x13w&3D\u007fǨ\xf2)<\x02\xe7T\x15\x85\x18^\xceG\xb3{\xed{\x87\x05X\x13\xf3\a&\xf3X\xb1QY\xe67\xd7*\xf9[s<\n\xa9ny x\xfb͜\x15\b\xa6\x1cO=\xca\\\x87\xfe\xad@\x9a\x8a\xd8\xf3+\x84\xabf\xcdw5\x06{\x92=\xbcɈ\x97\x14\x903\xad\xb4\xeb\x06k\xea\x91\xdeX\xd8Jc]\xcb\xf0\x02\xaa\xd2\xf2u\xf2\xb7=c\xaa\x1bcN>b\xfe\xe4{w\u008a\x99~\xae\x13#\x96\x1c\xac\x03\xf8\x99\xd8!\xc8-H\a\xa8\x12])\x0ex\x91\xb9\xa0a\x16P\xf4B\xf4\x9bI\xe4\x9e\xd9鬪\"\x1e\x90\x15k\xa7T\xb3ѱn\x97\x1f\x84\x8c\x8bN\xc1ibu\xb2@]\xcdn\xedm\xe9gv\xf8\u07bd\f\x98B|\x91EU\x80(H,K\u038d[\x9f?\x18\xd2e\xbc\xac\x9f\x85tu\x06\xa1\xbfX]fM\x13]\x949:\x84\rn\xb5a{`e\x8a\x8d\xfbP\xcb\u007f4\xdfd\xaa\b\xd8\n\x99Wf\x81\x8d^,\x99\xa5\xe7\xb6\xda<\xbd\xfca,\x9e\x91\x15\x83\x19\x19t_\xe04\xcf\xef\x1f\xa5Y\xe62\u007f2\xf8\xf2\xaeii$i\xa9\x9e\xf3Ngi\xb2\xf7\xda\xf7Nk\xe5\x15j?\xe5\x9e\xceReN^\xddӦ\xbc\xba\xa7\xaf\xee\xe9\xab{:(\xaf\xee\xe9\xab{\xfaꞎ\x97W\xf7\xb4S^\xdd\xd3\xe8\xfd#\x86\xc3\x15t><;\x99\xab\xc8\x14\x8c9\xb6gƪ3\x8d\xae\xf3\xca:4K2\xa4o\xc7{\x8e\xe4\xd0'\xbeɊ?\x06\x9cҚ6u\xa5\xdd\xf4\x9a\x94iZ\x92a1\xf9o1\"\xbc\xf0\xe84\xe8\xe9l\xfb\xd8\x04\xba\xb9\xb4\xb9~\xeex\x93\xae\xe6\xff7\x01\x88\xd3a\xf8Zz\xfe\x1b\x9fn\xceU?\xf7\x8d\xcf\x01\x81\xe3\xdfd^ydZ\xdbL2\xdb\xf1D\xfc\xa9\x1d>`9\xb8\\\xe8\x83iz\x89߿i,#\xb2ͦs\xcc\xea[Ktb\xf7v\xdd\xff\xc5\xe9:\xe3lbf\xcf\xd2e\xfe\xfb#:\xba\xaa\xc7nZ{\xd0\xd3\xfaۿ!\xc6\x13\x14\xb5\x01%s/\x80@\xa1\a?\xfcT\xfa#\xf2\xc9\xeb|\xfe\xa0\x16\x9f\x97\xb64\x1b\xad\xc9\x1f\x9a\xdfU\xbe\"\am\xd9\a\x02\xb3\xf9f1LCL\x96\xd9x\xfe\xd8\f\xd5%\xb9e\xb1g\xf0\x88<\xb2\xf8\xec\xb18x\x80\xbf؍\xcd\x19\x8b\xf6\xdab\xf3þMVXd.X'\xc3k\x96\xe4\x89\x19`р\xc5e{E\xe7xu2\xb7\xe6\xd1:\x92\xd95\x9e\xaf5Kr,\x9f+&K+\x8a\xd7\xe8ܬ&\xe3j>\x92\xf8U\x19Y/\x9f\xfb\xfd\x92~\xfe\xf1\xfc\xaa\xa8\xac\xaa\xa8\xb3\xc0<\xcfQySK\xb3\xa5\xa2P]\x9a\x19\xd5d=\x1d\x198*\x1f\xea0\xd7\xe9\xd8Tf\xb3\xa0\xa63\x9c\x8e\x91\x1d\xcb}\x8a\xc8k:B\xb2\x9b\xf1\xb4\xd8\r\x98զ\x99\x06\xe3_Շ2\xbf\xd7\xe6\xff\v\r\xfc\xdaIk\x93\xa2\x99=\x95,a}\x96\xed~\xbcr0\xfe\xe0s\x9d\xf019\xb5\xea\x9ex\xa6\xbc(\xdd|>\x92\xc0\x8fR\xa5^\x93h\xb1t|\x1a~\xa1\x82\x13\xcc\x1a7k:\xe3\xb6\xf5h\a\xa7-\x8b\xa5 \xa3\x9e\xc2f\xef\xa3Bv\r7\"ɚ\x86\x13\x14y\xe4LX:\xd9\x17\xc2\xc1ys\x8c\xbd\f=\xa9\xe6|\r\xf0\x83n\"\b\x9d\xcfC'\xe8ZY\x94\xf9\x1e*\x8bp\xde'\xf4u\xa7\xb0IݱJ\x946\xd3\xe1%\x81\x88\x83\xd8]\xbf\xc7H\xbc$\xbc#\x90\xe4\xbaJ\x9b\x11\x8e\x88[\xa8=|z`O\x8e\xbf\x9eNگ\xcckO-\x9c\xab\x06\x1f\xa1O\x90\x9cz<b\x11d\xd3Q\x15\xeb\xb4\x11\x8f\xf8A\xfbw5b0\xeb\xf7\xe8=\xadR\xdb\xd5\x10c\xad\x93&'5\xd9\xcfmH\xb0\xbdz\xa9W[\x1b\x84\"n\xa7L\xee\xcc:w.\x8f\x98\xdc\xfd\xfd\a?!'\v\\\xbf\xaf|\x84kU\nc\x91\x90\x0e\x13\xf5\x9d6Ӗ0\xd3ϐ\xeb\x1a\x87\xef\x87\xf30\xc8W=\x1cL;i6\xfe\t\x8b\xa0\xbe\x01\xba\x18\x95\u007f\x18\xef\xd99,w\x84x,&\xa6\xb7\x93\xb4\x84\xb5:\x91l\x8b8D\xc17,\xdf\ue345c\x1e\xd9\x11\x93QY\xfc\xe9Y\xa1\xf9\x1c\x16\xaa\xbdU^R3\xaf\xae\xfc\xe3\xa0c\x10\xf0\x98\xf9 \xfb7h>\xb6\xef\xa9\x1a 럖\xf3f\x9c\x81\v\xef\xd0\x1cB7\xb3\xfe\xa7\xd7\xfe\xb8G\xbd\x1a\u007f\xfaeռFs\x16\x81\xac\u007fo,\xe6\x81\x1f\xff\xa8[\"JW\x99z{M*\xc3\x0fN\x10\x11\xf4\xef1\x9c\xf6\xc4O\xfb\xdcٌ,\xdb\a\xd0\xda\b\xc3\xecsk#\xf2k\x1e\v\x9a|=\xc7\xef\xae\xfe9\xb4\x15\xd1?M\x9c\xa3\xeb\x80\x1f蘙\xe9'j\xd3\u070e\xd7@s\xc7\xf0\xb0\xc7\xdd\x14\xeb\xe3ם+\xf8\x88\xcf#\xb57\x8a&qx\x18\xf5w\x9a\x98r\x84b\xecy\xb3\xa3S\xdc5\xbd\xf8By\xc4Z\xf4\xcdܠ\xf9 R-\xf2\xbcC\xd1_\x1e\x8f\x89\xf5\x8fr\xeb\xc3F\t\xcd\xe9O\a-&\r\xd7Q\xa35e\xb0F\x97\xd4A\xa5E\xb3ô\xa3$\xf5\x1eޭ\xa96\a\xef\xac\xd4\v\x13~\xf9\xf5\xac]\xa3\"I\xb0t\xf5\xfdH\xf7\xfd\xc9\xf3s\xfe#</\xc9\u007f&Zy\xb7\xdb^\xc1?\xffu\x06\xf5v\xfc\x10ސ\xa4\xca\xff\x06\x00\x00\xff\xff\x1f<\xd7\xfe\xadS\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V͎\xe44\x10\xbe\xe7)J\xcba/$\xbd+\x0e\xa0\xdc\xd0\xc0a\x05\x8cFӫ\xb9 \x0en\xa7\xd2mƱCU\xb9\x87\x06\xf1\xee\xc8vҝN\xd2̀\x84o\xb1\xeb端~REY\x96\x85\xea\xcd\x13\x12\x1b\xefjP\xbd\xc1\xdf\x05]\xfc\xe2\xea\xf9\x1b\xae\x8c\xdf\x1c?\x16\xcf\xc655\xdc\x05\x16\xdf=\"\xfb@\x1a\xbf\xc3\xd68#ƻ\xa2CQ\x8d\x12U\x17\x00\xca9/*^s\xfc\x04\xd0\xde\tyk\x91\xca=\xba\xea9\xecp\x17\x8cm\x90\x92\xf1\xd1\xf5\xf1C\xf5u\xf5\xa1\x00ЄI\xfd\xb3\xe9\x90Eu}\r.X[\x008\xd5a\r\x8c\x14\x95DI`\xc2\xdf\x02\xb2puD\x8b\xe4+\xe3\v\xeeQG\xc7{\xf2\xa1\xaf\xe1\xf2\x90\xf5\aP9\xa0m2\xb5M\xa6\x1e\xb3\xa9\xf4j\r\xcb\x0f\xb7$~4\x83To\x03)\xbb\x0e(\t\xf0\xc1\x93\xdc_\x9c\x96\xc0L\xf9Ÿ}\xb0\x8aV\x95\v\x00־\xc7\x1a\x92n\xaf46\x05\xc0\xc0T\xb2U\x0e\\\x1c?fs\xfa\x80\x9d\xcaN\x00|\x8f\xeeۇOO_m\xaf\xae\x01\x1adM\xa6\x97\xc4\xf7Jd`\x18\x14\f(@<(\xad\x91\x19t B'\x90Q\x82q\xad\xa7.\xe5\xe8l\x1a@\xed|\x10\x90\x03\xc2S\xa2|\x88\xac:\x8b\xf4\xe4{$1#\x1b\x83ڥ\xfa&\xb73\xac\xefc8Y\n\x9aXv\xc8\xc9\xd3@\t6\x03\x03\xe0[\x90\x83a \xec\t\x19\x9d\xccQ&~ZP\x0e\xfc\xeeW\xd4R\r<pLV\xb0M\xac\xd6#\x92\x00\xa1\xf6{g\xfe8\xdb\xe6HHtj\x95\x8cur9\xc6\t\x92S\x16\x8e\xca\x06\xfc\x12\x94k\xa0S' \x8c^ \xb8\x89\xbd$\xc2\x15\xfc\xe4\t\x13\x995\x1cDz\xae7\x9b\xbd\x91\xb1\xeb\xb4\xef\xba\xe0\x8c\x9c6\xa9\x81\xcc.\x88'\xde4xD\xbba\xb3/\x15\xe9\x83\x11\xd4\x12\b7\xaa7e\x82\xeeR\xe7U]\xf3\x05\r}\xcaﯰ\xca)V\x16\v\x19\xb7\x9f<\xa4\x86\xf8\x87\f\xc4v\xc8\xf5\x91Us\x14\x17\xa2\xe3Ud\xe7\xf1\xfb\xedg\x18]\xa7d\xcc\xd9O\xbc_\x14\xf9\x92\x82H\x98q-RNbK\xbeK6\xd15\xbd7.W\x97\xb6\x06ݜ~\x0e\xbb\xce\b\x8f\xb5\x1bsU\xc1]\x1aE\xb0C\b}\xa3\x04\x9b\n>9\xb8S\x1d\xda;\xc5\xf8\xbf' 2\xcde$\xf6m)\x98Nѹpfm\xf20\x8e\xb9\x1b\xf9Z\xe9\xeem\x8f:f0\x92\x18\xb5Mktj\x0fh=\x81ZS\xa9ބ$i\xfcK,\xc3$\xc9hf\xf3%\xf6\xe7\xebh\xd6\xc7Iz9(\xc6\xf9\xe5\f\xd3C\x94\x99\xfb\xb7\xa6E}\xd2\x16\xb3\x89<M\xf0u(\xf1\xa0\v\xdd\xd2g\t\xf7\xf8\xb2r\xfb@>N\xd64ׯύڀ\xfc\xbf\xd9\x1b\xb7\bw\x1eY\x96J\xff\xb0騞\f\xe8\xc1\x10Pp.\xf6\xedbB& \xf3I\xbe\x901\x82\xdd\n\x9aU<\x9f\\\xeb\xd3&\xa0\xa2c%\xb9\x9fpH\xf6\xe0'\xe3Z1x;\xd7\xf9,\x87כ\b\xcd'\xfdI\xff\x9br\x1c7\x86p\xd5w\x99P\xad>D\x8fk\x8c\xaf\xf7׀2X\xabv\x16k\x10\nK\xed\xac\xab\x88\xd4i^5c\xa9]\xf6\xa9W\nh\xa1\x10\xfb\xe4\xe5\x80\xeeV7\xc0\x8b\x9aO\xf9+ϰ;\xddR\xbd;/\x87˖ʥ[C\x9cݥ\x98\x15\xce\xdeD\xcaj\xf6rI\xafn\x1e\vB\xb6S\xd9qf\\\xb5Ƹ\x88,c\xb8\ta5ً\xcbd\xbe\x99\x84\xc7\xe2I\xed\xa7\x01s\u061d\xff\xf4c \xc3H\x86?\xff*.\xd39.s\xbd`s?߂߽\xbbZgӧ\xf6\xae1y\x89\x87\x9f\u007f)\xb2cl\x9e\xc6\x1d4^\xfe\x1d\x00\x00\xff\xff;,8\xce>\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xdb6\x10\xbd\xebW\f\xd2C.\x95\x9c\xa0\x87\x16\xba\x05n\x0fA\xd3`\x11\xa7\xbe\x14=\xd0\xe4Ȟ.E\xb2\x9c\xa1\xdb\xed\xaf/HQ돕\xb7Y\xa0э\xc3\xe1\x9b7o>\xec\xa6m\xdbF\x05\xdabd\xf2\xae\a\x15\b\xff\x16t\xf9\xc4\xdd\xfd\x0fܑ_\x1d\xdf6\xf7\xe4L\x0f\xeb\xc4\xe2\xc7O\xc8>E\x8d?\xe2@\x8e\x84\xbckF\x14e\x94\xa8\xbe\x01P\xceyQ\xd9\xcc\xf9\b\xa0\xbd\x93\xe8\xad\xc5\xd8\xee\xd1u\xf7i\x87\xbbD\xd6`,\xe0s\xe8\xe3\x9b\xee\xfb\xeeM\x03\xa0#\x96\xe7\x9fiD\x165\x86\x1e\\\xb2\xb6\x01pj\xc4\x1e\x8eަ\x11٩\xc0\a/\xd6\xeb)XwD\x8b\xd1w\xe4\x1b\x0e\xa8s\xec}\xf4)\xf4p\xba\x98 *\xaf)\xa7mA\xdbT\xb4\x0f\x15\xad8Xb\xf9\xf9\x19\xa7\x0f\xc4R\x1c\x83MQٛ̊\x0f\x93\xdb'\xab\xe2-\xaf\x06\x80\xb5\x0f\xd8\xc3\xc7L1(\x8d\xa6\x01\xa8\xf2\x14\xca\xed,\xc0\xdb\tQ\x1fpTS.\x00>\xa0{w\xf7~\xfb\xdd\xe6\xc2\f`\x90u\xa4 E\xe4\xe5D\x80\x18\x14\xccL\xe0\xaf\x03F\x84mQ\rX|D\xae\xa4\x1fA\x01f\xfe\xdc=\x1aC\xf4\x01\xa3\xd0,\xf0\xf4\x9d\xb5י\xf5\x8a\xd7\xebL}\xf2\x02\x93\xfb\n\x19\xe4\x80s\xfahj\xb6\xe0\a\x90\x031D\f\x11\x19\x9d\x9c\xcau\xfa\xfc\x00ʁ\xdf\xfd\x81Z:\xd8`\xcc0\xc0\a\x9f\xac\xc9\xedx\xc4(\x10Q\xfb\xbd\xa3\u007f\x1e\xb1\x19ė\xa0V\t\xd6ʞ>r\x82\xd1)\vGe\x13~\v\xca\x19\x18\xd5\x03D\xccQ \xb93\xbc\xe2\xc2\x1d\xfc\xe2#\x02\xb9\xc1\xf7p\x10\tܯV{\x92y\xac\xb4\x1f\xc7\xe4H\x1eVeBh\x97\xc4G^\x19<\xa2]1\xed[\x15\xf5\x81\x04\xb5\xa4\x88+\x15\xa8-\xd4\xdd\xd4\xed\xa3\xf9&\xd6A\xe4\xd7\x17\\\xe5!w\x11K$\xb7?\xbb(\xed\xfeL\x05r\xa7O\x8d0=\x9d\xb28\t\x9dMY\x9dO?m>\xc3\x1c\xba\x14\xe3Z\xfd\xa2\xfb\xe9!\x9fJ\x90\x05#7`\x9c\x8a8D?\x16Lt&xrR\x0e\xda\x12\xbak\xf99\xedF\x92\\\xf7?\x13\xb2\xe4Zu\xb0.\xbb\x06v\b)\x18%h:x\xef`\xadF\xb4k\xc5\xf8\xd5\v\x90\x95\xe66\v\xfbe%8_\x93\xd7Γj\xe7\x03V\x97؍z-O\xf2&\xa0\xbe\x18\xa0\x8cB\x03\xd5\xc9\x1e|\xbc\xd2U\xcds\xbe\x8c\xd7]\xb8/\x0f8L;~\xa0\xfd\xb5\x15@\x19S~!\x94\xbd\xbb\xf9\xf6\x19\xc1\x16\xf2^\x97H\xb9Q\a\x1f3\xa3#\x19\x8c\xed\x9cge\x92bM\x98\xd0\x1a\xee\x9e@\xdeм&Y \x9fҼ\xe0qW\xdd2\x93,\xf4\xfcl\xdaPX\x17fY\x9fj\x8f\xb7\x18,d\x9c;\x9c\"^\xcdj\xfb\x18\xe0\x8bzG\x94$~y\xf7\x94g\xd5sW;H\xa7\x18\xd1I\xc5\\ش\xffO\a\x85\x83b\xfc\x0f͗#\xdc\xe5\x97s\x19,\r\xa8\x1f\xb4\xc5\t\x10\xfc\xb0\xd0m/\xa2\x9c?ti|ʭ\x85wGEV\xed,.\xdc\xfd\xea\xd4\xcdۛ\xc5_\xac\xe7\x13#\xe7ujz\x90\x98&\xec\xdae\xd5r\xaa\xbe\xd2\x1a\x83\xa0\xf9x\xfd\xaf\xe7ի\x8b?.娽\x9b\x86\x95{\xf8\xed\xf7fBE\xb3\x9d\xff\x81d\xe3\xbf\x01\x00\x00\xff\xff\xbf\xca\xff\xa71\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4Y\xcdn#\xb9\x11\xbe\xf7S\x14\x9c\xc3$\x80\xd5\xde\xc1\x1e\x12\xe8\xe6\xd8^\xc0\x88\xc70\xec\xc1\\\x16{\xa0\xbaKj\xae\xd9d\x87\xc5\xd6XY\xec\xbb\aE\xb2\xff\xd4?\x92&ȴ\x81\x81\xc8b\xf1\xab\xafȪ\"\x99\xacV\xabDT\xf2\x1bZ\x92F\xafAT\x12?\x1cj\xfeE\xe9\xfb?(\x95\xe6f\xff9y\x97:_\xc3]MΔ\xafH\xa6\xb6\x19\xde\xe3Vj\xe9\xa4\xd1I\x89N\xe4\u0089u\x02 \xb46Np3\xf1O\x80\xcchg\x8dRhW;\xd4\xe9{\xbd\xc1M-U\x8e\xd6+o\xa6\xde\xff\x94\xfe=\xfd)\x01\xc8,\xfa\xe1_e\x89\xe4DY\xadA\xd7J%\x00Z\x94\xb8\x86\x8d\xc8\xde\xeb\xcab\xa5d\xe6\x05)ݣBkRi\x12\xaa0\xe3iw\xd6\xd4\xd5\x1a\xba\x8e0:B\n\xe6\xfc\xd3+z\xed\x14\xf9>%\xc9\xfdk\xba\xffI\x92\xf32\x95\xaa\xadPSP|7I\xbd\xab\x95\xb0\x13\x02\t\x00e\xa6\xc25<\x8b\x12\xa9\x12\x19\xe6\t@d\xc1\xc3[\x81\xc8sϫP/Vj\x87\xf6Ψ\xbal\xf8\\A\x8e\x94YY\xb1H\x83\x12\xc8\x19+v\b\xca\x04\xac\xf0\xbd0\x84\x11\x00\x81\xb0\b\r\f?#k\xfa\x9d\x8c~\x11\xaeXCʼ\xa5\xc1\xafOQC\x14b\xda\xd6\xf0\xe6\xbbb\x93;\xb0\x01\xe4\xacԻK \xb9b\b(3\x95\xc4\x1c\x9c\x99\xc1\x93#9\xa9\xfd\xd8IP\xf7]\xffe\xc8z\x0e\x01r\xc2\xd5\x04Tg\x05\b\x82\a-6\n\xf3\x9b_\x84T\x98\x7f\x13J\xe6\xfd\t\xfa\x10\xfd\xc0\xb4*\x04a\xec\r\\\xbd\xf4ZN\x01z\xae\xcb\rZ0ۖ\x98\x96\x14OW\x8f\x82Y\x04\x9d_\x03\xf1\x14%\x03\x9a\xd7c\xaf\aH\xbc\xaevh\xa70=\tr\xe0d\x89\x1eAX\x14\xbdu%\b\xb2\x02\xb3w\xccakl\x8bۙn\x81\xcdBU\x82\xdc\xdbAg\x98\xf3\xee\x8eb\x01\xa7\x9f5\xf4\xc5\xf6\x004o\xf4\x05\xb1\xfdg\xdfKY\x81\xa5\x8f7\xfc\xcbT\xa8o_\x1e\xbf\xfd\xfc6h\x86\xa1]\xa3\xfd\xecC\x93Ե\xa9I\x1d\x02\xf1\xe4m\xceLY)t\x987ֵ\n\x81]%`3\xb7\xc2\r\bm\\\xc1.\u0558\xb6\xc3*k*\xb4N6\x01(|\xbd\xc8\xdbk=B\xfd\x89\r\vR\x90sȍ\x18c\xc8\xc0<r\xc1\xc0\\!\x89\xbd`\x91P\xbb\xfe\xaai>F\xaf\xc1l~\xc7̥\xf0\x86\x96\xd5\x00\x15\xa6V9ӱG\xeb\xc0bfvZ\xfe\xa7\xd5M\xcdrT\xc2a\x8c\x81\xdd\xc7K\xc9j\xa1`/T\x8d\xd7 t\x0e\xa58\x80E\x9e\x05j\xdd\xd3\xe7E(\x85/\xc6\"H\xbd5k(\x9c\xabh}s\xb3\x93\xae\xc98\x99)\xcbZKw\xb8a\x0fY\xb9\xa9\x9d\xb1t\x93\xe3\x1e\xd5\r\xc9\xddJج\x90\x0e3W[\xbc\x11\x95\\y\xe8\x9a\r\xa6\xb4\xcc\xffbc\x8e\xa2O\x03\xac\xa3\xdd\x18\xfe|:X\xf0\x00\xa7\x03\x90\x04\"\x0e\r\x86vDs\x13\xb3\xf3\xfa\xf0\xf6\x15\x9a\xa9\xbd3\x06J!\xf2\xde\r\xa4\xce\x05L\x98\xd4[\xb4~\x1cl\xad)\xbd\x9bQ畑\xda\xf9\x1f\x99\x92\xa8\x8f\xe9\xa7zSJ\xc7~\xffw\x8d\xe4\xd8W)\xdc\xf94\f\x1b\x84\xba\xe2\x1d\x94\xa7\xf0\xa8\xe1N\x94\xa8\xee\x04\xe1\xff\xdd\x01\xcc4\xad\x98\xd8\xf3\\Я \xba\x7f\xace\x1dY\xebu4I~\xc6_\xa3}\xfeVa6\xd8;\xac@n\x9b(\xc0QL\x8c\xa3C\xb7{\xe7wp\x9c\xfb8K\x1d\x8b\x1c\x01\xbc\x1f\x8f\xe0\xe5\xc5\x1e\xe6 \x17v2\x1e\x05\x99\x91J8#\xb1\x0emX\xe0\x9f\xff\x94ؠzC\x85\x993\xf6\x84\tO}Y ?\x88\x068\xfa\xf9 \x85\xc7-hs\xbcr\xf9#t\xd7 \x94\x1a\x8c\x8d\x04čԚ9,bƶq\x99\xc8\xd9{\r\xce\xd6c\xc2\xe6]\xc8_)\\V<|T\x16\xa9-\xc5\x00\x16I8\x1e\xc2N\x14\xbe\x80d\x17z:#5\xc6\xfa\xed)-\x96~\xdbO\xea\x06\xf8Z\xe0@Ηm\xb7\xcf\xf7S\xc6\xf2'\x1d\x963@\x8f\xa0\xde.\xc0\x89\xa1\xad\xe9q\x85\x98rT\xf88\x1c\b\xa9)\x84@\xba\x06\x01\xefx\b1_h`\x82E\xa3\x04,\xfa|\xe1}\xf9\x8e\x87Y\xa5B\xb7\x89aFf\xd9u1\x8a\xe3a\xbe\xf3\x88\x8ew<4;.\xf0\xc2\r\x1e37\xb5$\x89\xaaR\x12)\x99T\x18\xbf\xa9Mv\xd6v뾆\xb5\xb3\xe1\xb74w\x99$8\xe2\x13\xa7\x01\xe5\x83\x02\x15\xb2\xeaJ\xeb\xe9\x7f\xecu\xbfV\x9b\xb4\xec\xeb\xdd\x16O\b&\x8f\xfa\x1a\x9e\x8d\xe3\xff\x1e>$\xb9e:ؗ\xf7\x06\xe9\xd98/\xfd?\x93\x13\xa0\x9dMM\x10g\xe7\n\r\xc2Zq`\xfb\xfay\x9b|4r\x05&\xb3\x1a{>aM\x8f\x1a\x8cm8\xe0\x05\x12'\t\xea˚|\xa2\xd5F\xaf\xb0\xac\xdca\xc9d\x88s\x0f\xf4{\xa2\x88\xe7\xe83ןjQ\xe3\x10F\x80\x00_\xb9\x8a\b=\xa1&T|Ԅ\xbc\xf6D\xf8JF8\xdc\xc9lQu\x89v\x87Pq\x9c[\xb2j1\x0e]\xe0\xebF\xcc㞑\x8a\x81\xeb\xa8`\xeb\xbe\xd5B\xa8Y\xb5\xb4\xcf\b\xcc\x14\x1c\xe7\xe2\xf3\t\xc1\xa7\xc6\x196\xfa'\xfbS\x11\xed$c\x83uߛ\x9a\x97\xac\x80RT\xbc\xf2\xff\xe0\xf0\xec\x17џP\ti)\x85[\x7fA\xa1\xe6\xd6\x7f\x7f\x84\f\xb5E_9\xeb\x95\x04셽P\x9c>\xfc\xa1\aP\xf9d2\xa3\xd4lG\t\xf6:^Qp\xe8\xddJT9\xe3\xbez\xc7\xc3\xd5\xf5`\x87\xcchd\xe1G}\x15R\xcfhS\xb6y\xcahu\x80+\xdfw\x95\x8e\x12\xec\x8c\xee\x13iwq\x95,t\x96\xe2\xe3\x15\x9d\x9d\xf4\xf9\xc0\x99_ZA\xe6\xa40ߡ\x14\xfa\xe0\xcf\xe4\xc4\xe7\xd4C\xd8ñ@dw;\xc7\xfb\xbe=:\xf7\xbf\rn\xf9\xb0\xb5\x93{\x1eUW`4H\x97\xc2=nE\xad\xfcy\x01~\x1e\x1b:\xbe&\xe8\xfe\r/\x8aN\x18\xf36\x10>]\xe8\xb6e\xedH-,^j\xa5\xc9\x05;\x88\x0e:{A+M~\n}+ظ\xc2l\x1d\xea\xc9\xfaT\xb67##\x9d0{W2t\xc4g(\xa5\xae]\xef\xea\xe0\xcc\xe2v\xd6\xd6\xe9x\xb9\x9a:\xb6\x1cIL^\a..\xf2p˳Nf\xd9\x1c\x1f\xcd\xfc\b\xc8D\xc5G\xf9x\xfbR[\xcbq%\xde\xcc\xf1\x95\xc5@#\xfc\xf8Ym+\xa4\xaa\xed\xb8\xfd\b\xe6/Q\xcc\xd7\xf2G\xc7\x1a.\x12Y\x8d?_q\xc2\r\x87\xad\x91B\x98\xb8\xc2\xeb\x96\n\a\xadB\xecQ\x7fr\xb0A\xd4͑\x8d\xa4\xce&\x9c?\x9b`\x97ٍv\xf8\v\x1d\x9bS\x172zVL(\x85\x81eKv\xa4\xc9\xe5\xb5z\x8cU3\xbdG&\xddF\xe1\x858\xd8ygF!\xc0w\xd1\v\x91!\vD\"$\xb1\a\xa2\xa1b'\xa4\x06\xa33\x04ɷ`\"+\x16J/\x9e\xb7\x14\x1f\xb2\xacK\xd0\xed5\xae\r\x01~\x8a\x98SQ5\x86k\x0f\xec,r\xe2m\xf6lD]\x061\x19\x17\x9b\x9b\x00r\x91\xf9\xee\x15\xe6\x1cDO\x13\x03\xdb\xd5Ǆ9ف\xe4Yf\x94\xb6\xabd΄\xad\xb1\xa5p\xe1vx庻\xe4\v\xa3\xe6\x99|\x94H$vx\x16\x05_\x82l\x93\xe7\xd0Zc\xfb\x16\x9f\xb2\xec\x04\x98\xe9pބ\xec\xa8{jѮ⢘\xe8Z(Y\xce\xcc;S\x05\xf1\xf0\xae\x7f\x9d,\xb2\xf64\x10n\xc8SK/\x11#\x85p\xd6\xdbD\x9a\\\xbe\x96\xce\xe2`\xd2e\xfeu\xe8\x84\xed\xfe\xbd\xa81\xb9\x9f\xfcڝr\"\xdf\xf1\x1f\xea\xba\x1cϳ\x82g\xfc>\xd1\x1a_\xb9&zf\u07bd\xce2v\xf4\x10u\xc2\xf0\xd7c\xf9\x86\x84.\x9c6\x1elS\xd1H#\\\x90\x9c\x96\xa2\xef\xbe5\xf9\x81\xb7\xec)\xec\x1dCA<^\xa2)\x99y\xaf\xf1\x8df\xa71D\x81\xa9]\xf9W\xb9\r\xb7L\x19;\xe4o\x17d\xfe\x05?\xfc\xf0\xa6\x9d\f\x04\xa3F\xe2W\xa3\xbc\xa7:\x16\xee\xfd\x96z\xd3>\xc1\xac\x93Aq\b\x7f\xfc\x99tu\xa2\xc82\xe4s\xcb\xf3\xf1\xe3\xfc\xd5\xd5\xe0\xa5\xdd\xff̌\x0e\xc7gZï\xbf\xf1S\xba3\x16\xf3\xf8:Fk\xf8\xf5\xb7\xe4\xbf\x03\x00\xa3\xbaO\xdf\xd3 \x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]o\xe46\x92\xef\xfd+\n\xbe\a\xef\x01\xdd\xed$\xf7pw\xfd6\xf1Ln\x8d\xcdN\x8c\xf1\xec\xec\xc3b\x1f\xd8Ru7\xd7\x12\xa9%){:\x87\xfb\xef\x87*\x91\xfa\xa4>\xda\xe3\x04\xc9b\xac\x00\x19Kd\xa9\xaaX\xacoѫ\xcdf\xb3\x12\x85\xfc\x84\xc6J\xadv \n\x89\x9f\x1d*\xfa\xcdn\x1f\xff\xcbn\xa5\xbey\xfav\xf5(U\xba\x83\xdb\xd2:\x9d\x7f@\xabK\x93\xe0[<H%\x9d\xd4j\x95\xa3\x13\xa9pb\xb7\x02\x10Ji'趥_\x01\x12\xad\x9c\xd1Y\x86fsD\xb5},\xf7\xb8/e\x96\xa2a\xe0\xe1\xd5O\xdfl\xffs\xfb\xcd\n 1\xc8\xd3?\xca\x1c\xad\x13y\xb1\x03Uf\xd9\n@\x89\x1cw\xb0\x17\xc9cY\xd8\xed\x13fh\xf4V\xea\x95-0\xa1w\x1d\x8d.\x8b\x1d4\x0f\xaa)\x1e\x8f\x8a\x86\xefy6\xdfȤu\x7fj\xdd\xfcQZ\xc7\x0f\x8a\xac4\"\xab\xdf\xc4\xf7\xacT\xc72\x13&\xdc]\x01\xd8D\x17\xb8\x83\xf7\"G[\x88\x04\xd3\x15\x80'\x87_\xb9\xf1\b?}[AHN\x983\x8b\xe87]\xa0zs\x7f\xf7\xe9?\x1e:\xb7\x01R\xb4\x89\x91\x05q  \x06҂\x80OL\x16\x18\xcf~p'\xe1\xc0`aТr\x16\xdc\t!\x11\x85+\r\x82>\xc0\x9f\xca=\x1a\x85\x0em\r\x1a \xc9J\xebЀu\xc2!\b\a\x02\n-\x95\x03\xa9\xc0\xc9\x1c\xe1\x0fo\xee\xef@\xef\xff\x81\x89\xb3 T\n\xc2Z\x9dH\xe10\x85'\x9d\x959Vs\xff}[C-\x8c.\xd08\x19\xf8\\]-\xa9j\xdd\xed\x91wM\x1c\xa8FAJ\xe2\x84\x15\x19\x9e\x8b\x98z\xa6\x11=\xee$mC.KH\a0\xd0 \xa1<\xf2[x@C`\xc0\x9et\x99\xa5$\x85Oh\x88a\x89>*\xf9s\rۂ\xd3\xfc\xd2L8\xf4\x02\xd0\\R94Jd\xf0$\xb2\x12\xd7̒\\\x9c\xc1 \xb1\bJՂ\xc7C\xec\x16\xfe\xac\r\x82T\a\xbd\x83\x93s\x85\xdd\xdd\xdc\x1c\xa5\v\xbb)\xd1y^*\xe9\xce7\xbc1\xe4\xbet\xda؛\x14\x9f0\xbb\xb1\xf2\xb8\x11&9I\x87\x89+\rވBn\x18uE\x04\xdbm\x9e\xfe[\x10\x00{\xdd\xc1՝I\x18\xad3R\x1d[\x0fX\xea'V\x806@%_\xd5ԊІ\xd1R\x1d\x99;\x1f\xde=|l˞l\x8b\x15]\x15ߛ\x89\xb6Y\x02b\x98T\a4<\x0f\x0eF\xe7\f\x13UZI\x1f\xfd\x92d\x12U\x9f\xfd\xb6\xdc\xe7\xd2Ѻ\xff\xb3DKB\xae\xb7p\xcb*\x06\xf6\be\x91\x92dn\xe1N\xc1\xad\xc81\xbb\x15\x16\x7f\xf1\x05 N\xdb\r1v\xd9\x12\xb4\xb5c\xf3CPv\x9ek\xad\aA\x97\x8d\xacW\xa5\x10\x1e\nL:\x1b\x86fɃLx[\xc0A\x9bF_T\xea\xaaٮ\xe3[\x96\xaeD\xe7\xa4P\x86\xfbv\x80\xc9m3\x926\xd7A\x1eK\x83\x16N\xfa\x991\xaa^\vN\x98\xbd\xc82\x92\xb0\x00\x1a\xd3.2\xd5uw\x00%\xb3u{\xaeuڈ#B\xa6+\xba\xae\x1b\x18D\xa5\xb4PF\x81\x91\xb5\x10\xfb\fw\xe0L\x89\x83\xc7\xe3\xc4\xd3%\xb2\xa36ҝ\xf2\xd8\xc3\x1e\vބ\xb1D\x1e!^O\x9eeA\x148\xc0\xb3t\xa7-\xbcŃ(3\x96v8\xfe,{\x8b\x17.T\xe5\b\x92\x1b\x9e5\xf2\xe8g\xebґGJ\xab!\xbb&\x04;\\\x19m\x9d\x05\xfc\xfa\x91\xc6\x05^\xb5\x97\x92\x01\xac+\xc5\xf0-Q\xfd\xdf,\xc3D\x05i\xdc(dh\r\xff\xee;\x1eO\xa4m\xe1\xee\x00?\xa3\xd1\xeb\xee\x8a\\[\xda0\xc4V\xc8\x02\x1aq\xf1\xa1+\x17\x9fe^\xe6;\xf8\xee\xbb\xf8s\xa9\xaa\xe7\xdfD\x1fW\xfc\"\xdbqD3\x181\xb2\xf3\xe9?\x8f\xe2'\xb6\xb4\xf6\xa3\xfe\x80\xd6ɞ>\x18\xb0\xf5mtR\xd0\th\xe1\xf9\x84\ue106\xd47?`\x8b8\x80\t\xacQ-\xa6\xb4\x00N<\"\x88 \xc2dY\xb3\f\n\x1d\x9c\x00\v\xfbs@v\xc8\xc1\x8a\xc0\xbd\xd6\x19\n\xd5{\x9a\x9a\xf3\x87rN\xb7\xbc\xe5A\x11\nZ\xdbJ\xab\x8c\xccp\xa1\rm\x94SLp\xa5\xc3ܮk\x94\xc9v\x9f\xb4~\xb4 \x1d<\x13\x0f\x98>(\x8b5o<]:x6\x92M\x9e\b\xdbv\x1d\x81\xeb\xc4#\x8d\xb1J\x14\xf6\xa4\x9d\x05m\xc0\x94J\xd1M~\xc1e,\xc1\xcfIV\xa6\x98֎\xa4\x9daϻ\xc1\x04\xd2\xc0NHEv\x9c\xdcZ\xf2\x85T\xf3\x94\\\xc5\x01H\x00a\x10ȒJU\xc1c/\xb0\xe6\xf0\x90\b\xe6\xe7\x10\xb7\x19\xfd0\xa3\x8e\xab\xb9\xc2\x18q\x1e\xe1K\b8\x96\xb2\xa5\x1e\xef\xfd\x9aL&\xec\x11\xd7\xde\vs\xa6\U0009f149\t\xceo\x98),_3\x8c\xf8#\x8di<1H8n\x83=\x9eēԆ\xb6\x8bp\xc11\xde#\xe0gLJ\x175J\xc2A*\x0f\a4\xa8\x1c\x14'a\xd1\x12+\xa7\x182m_\xc3\"D\x1f\xf6\xe8h\x16\x92$\x95)\x1fC\x9d4D\x7f_\x85\x1fB\x94\xb6&\x05R*\x95O2-E\x06RY'\x14\x01'\xedV\xe35\xa4gr\x91\a8W\x0eZ\xc0\x9cV\xa2\xe3\xaci\x85\xa4-r\n\x11\x86C\xed*\xfa\x02\x80Q\xb2\xf7\x82\x14\xb6\xaeDԔ\x19Z\xff\xaa\x94-b\xa3\x03bz\xac\xb7\"Ut\x93\x89=f`1\xc3\xc4i\x13g\xc7\xdc\"/\xd7k#\\\x8ch\xb8\xc6\x18\x10\xa9\ra\x13 \x81,\xd9\xf3I&\xa7*\xf0 \tb\xa3\x02\xa9F˪O\x14Ev\x1e#rv\xe5\x17l\xf4\xc5[~\xc9\xe6\x1f\xf26H\xcf嬭g\xb6\xcc,q\xb6\x16\apz\x02&\xfc\x8b2V\xaa\xbe\xe4-\xe6\xec\xdd`\xea\xeb\n-ɪD\xcb\x1e.\xe6\x85;\xafɑ\xf1w\xe7 R\x04Ҽ\xffw\xbc0\x97K\xfc]\x7f\xe6\xabJ\xfc\xe4\xaa\xccA\xa4U\xa9_\xff;\\\x146\x16\x0f\xdeV,^\x90\x1f۳\xd6 \x0f\xf5\x82\xa4k8\xc8̡\xe9\xad\xcc\x17\xed\x97\xd7`\xc6\x12{GW.\\rz\xf79D\xb63\xa3{|\xe9O\x06\xd9\xf6细y\x06.\x99\xf5\x7f\x96\xd2`N\xb9\xe1-|<a\xe7\x0e\xfb\xfeo\u07bf\x1d\v\x80/\x92\xbc\x01!ozȶ_\xed\x9d\xf2\xa5dxק\x8eo8=i\xd7 \xe0\x11ϕ\xc7BI\xdf\x02\x8d\xa0\x17\x8dD:\xfd\xcb g{y\xfb?\xe2\x99\xc1\xf8\xf4\xed\xec쥢\xe0\xf3\xafx^2\xac\xc7@\xc2\xc9\xe7I*N\xd2\r\xa2\x8do-\x96\x01\xafdj]4\xb7\xd6\x17)\x92p\x05\u07bf\x80\xccz\xd9\xeaX\x85d\xe3\x11\xcfה\xf2\xcd8\xebgO#\xa9\xac\xe1\xe54K\x16\uf590\x8c\xff$2\x99\xd68V\x91ĝZ\xaf\x16\x01\x84\xf7\xdaݩ5\xbc\xfb,\xad\xaf\x87\xbc\xd5h\xdfk\xc7w~\x11vV\x88\xbf\x80\x99\xd5D\xde^\xaaR\xdbćvV\x7f\x81p\xd7\xc9X\x92\xb3zy\xa4\xa5\f\xbb6\x81\x1f\xf4пn\xda>t\x7f\xf2\xd2:\x8a^\x94V\x1b6\x95\xdb؛\x98\xb5v\xb5\x00\x1eU\x1dLgE\x86\xa8\xd5/\xad^\xb8\x10\xecG\xf2\xbc\x984\xe2\xa7\xc1\"\xa3\xfa\x1e\xa4%3\x93k%\xc2\xe1Q&\x90\xa39\xe2j\x16 \xffW\x90~_\x86\xc2B\xad\xfb\"\t[f\xdaÏWݽ\"R\xecڐJ^0*,\xf6\xecЉD\xe9K)b\x13\xcb\xfe\xc7,wE\x9aru[d\xf7\x17h\xfc\v֢\xb3{[\x88\x91\xc8\t\xc8\x05\xe7]\xff\x97\xcc\x1c\v\xf4\xffA!\xa4Y\xb0\x87\xdfp\xb1:\xc3\xce\\\x9f\xc5j\xbf\x86\xde -\xd0\xfa>\x89lX|\x1b\xfe\x90\x82U\x80\x19{\x15\x84]\xdfcY\xc3\xf3I[$A\x80\x83\xc4h\x96\xb9{I\vW\x8fx\xbeZ\x0f\xf4\xc0՝\xba\xaa\f\xfc\xc5\xea\xa6\xf6\x168I|\xc5s\xaf\xbe\xc4\tZ(\x89\x8b\x86Q\x14\xb6[-\x14\v\nC\x83'@\x13\xebJ8\x85\x85\xdb\xd5\x17\xcaa\xa1\xad[\x8cʽ\xb6\x8e\x93T]\xb7\xf4\x92,\x96\x97!\x9f\xbd\x02q\xa8z\x11\xb4\tUfR{\xbd\x84+\xad\x9a\x9dְ´2b\x15P\n\xac\xae\x9a\x1d̀\xedUU2\xa2\x7f\x83H\xe8\xc94\xaa\x04\xb70:\x19-Z^\xa0\xad;\xac\x1c\xf2\xacN\x10\x8a*\x80\xa1\xe4\xdd\\R\xf2r\x87\x94\x9847\xa6\x87\xea\xbbϭ\xec\xa5P\x9c+\x9e\x15\xbeK\xf1\xf2\x95\xe7\\\xf4{\x15\x16\xa1x[\xcd\f\xdb\xc4\x03b\xcd!̱$]eW\v\x80v\x84\xf3\xb7`\xa6s\xa9\xeeHnw\xf0\xed\xab\x9bu\b%#|\x89\xe3~\x1b\xe66L\xafo\xa8\x91j\\\xec\x87*\x8a\xcf'4\xd8Y\xb9a\x9e\x9b\x1cŅ )\xab\xdbJ'\x10\xdcB\xa7\xd7\x16\x0e\xd2\xd8:\x90d\xcc\x17B\x1c/\x13\x7f\xe1\nk\xf5Θ\x17\x05N?U3kB)M\xf8\x1c:>F뻱\x8b\x8bBH9\x18\xe9\x00U\xa2K\xeax\xe2\x18\x02\xf9\x15\xd5\x12T\nz1˖)\x88\xe9.\x86\xfeφ\xa5N\xaa\xc9<Msm\xe0\a!\xb3_b٨QN\x97n\xb7`ho٨\xa5\x91\xaa\xccA\x9f\x92p\xfaV\x03\x109\xb1~\x11L \xbbKXtW\x1c\x9e\x85t\\\xf6!\xb8\xb4\x04\xa4Ϩ\xcf\"C\xb7tG\xee\xf1@\xb5\xa9D++S\xac\r\xb3\x97\x02\xad@\xc0AȬ43F\xe9E\xbc\xbd$\xd6\xf0\xcabv\xe4B\xd7m\xe9\xcb7l\x01W\xaf\xf0\xc6%ں0\xcb]\xc5{\x83\xcbܳ\xb9\xa4\xb4W\xbaP\x18I\xb2\xa4_\xdbC\xf3\"&\xd4\xf9\xab\x8b\xf6\xd5E\xfb\xea\xa2}uѾ\xbah_]\xb4\xaf.\xdaW\x17\xed\xf7\xe7\xa2\xcdaT}\x03\xb4z!\x16\v\xca\xd3S(N\xc0\xf7\xdd\x14\xb7\xd5\xf7@\xc1͉\xd8\xc9X'E\x7fV\xa4Q\xd7\x7fh\xb4\xe1o\xa4b\x12\x10\xfc\xa6\xfa\x03\x9d=6-\x97\x14\xc3\x04\xf1\xe6\"`\xcf\xe3\\]Ȩ\xa9\xee[9\xe8\xda٭.m\xf3\xe9\xf6\x99\xd6m6\xa1\xd1T\x87\x97\f\x00\x87\xcff,g&\xdb=$\xdd~\x1dv\xa0\x03\xa6\xdb\xd5b\x1fgrk/bZL\xb2\x02\"\x17\x8a\xcd\xe2\xc6\xdc)~\xf5B\x8f.\xc3\x1a\xa1\xfam\xf1\xcba^\xa5|\xff\xaa\xcd#\x9aY~\xf5\xc7\a\x17N\x95\xf9\x1e\r\xc9\x18S\x10:qm\\\xc5\xd4l\r\x9d\xce\xcc5L\xa1,\xc8x$\xa5\xa1\xae\xde\xec\xdc\xfbd\"\x18,\xfe~\xee:\xe6N\xf8\xc6\xff\xf1O(&>\x8f\x98\xfa4b\xa6\x9bh\xbc\x87\x880\x11\xfc\x9d\xd5ӷ\xdb\xee\x13\xa7}G\x11\xb7\xf8\x0f`RS\x17*\xa00T\x1d\xdb\xed\xc1a_:\x1d\x957*<\xf3\xf7J\"\xcb&vuG\f\xe1'\xc6]d\xdbKEk:L\xeb\x17\xe1bcz\xdc\xebO\x99\xea4\n6\x8e\x83\xb4\xedj\xac`~Yimt\a~A/\xd1t\xf3\xcf%\x1dD\xfd\xfe\xa0Q\xa0\xf3}CK\"\xec\x99\x1e\xa1\x17t\x06\x85\x9e\x9f\t\xa80\xd3\x0f4\xa9\n\xc3\x15\xb8\xb6\x18\xfd\xa5\x1d?\xb3\x8d\x93\v\xfb|\xba\x1d<\xd3 /\xe8\xeeYĜ\xf9N\x9e\x0ek\x96\xf4\xef\xf8~\x99Ւ~\xacٮ\x9dH?\xce\xea® \xdf\x185х3\t1֡\xb3\xbc\xf7f\x124\xf7\xe5\xccw\xdcL\xea\xa1\v\xd6z\xca\xfc\x87\x9f\xf9Xa\\\xd5\xccv\xcdL\xf8\xfaK\xf0k\xf5\x85\xc4ѻ\xa4\x1bf\x96c\x1d\xb9_\xde\xf9Rw\xb6\x8c\xbc\xf7\xd2~\x97n?\xcb\b\xd0%].#],#\x10'{[\x96\xf6\xae\x8c\xc0\x9e1\xbb\x93R2\xf10\xfe\t\xfb\xbc}\xcb~-\x89z)aڤh&#\x99\xa5hN\xa2\xd8\x11\xf8\x9fz\xefl\x85ύ\xabYa֎\x8ebK\xae\xeb\xd6\xf9\x04\xe8$\x87JN\xa8\xb1\xab\xe5'\xd0\x03\x0eE\x9b6\xe7\xc6ߋ\x03\xadC\a\x9af\xc1b!H\xe9\xa6\xf4\xd9/\xa7\x80\xed\x16މ\xe4\xd4\x1d\b'a)\xb9\x95Gݰ\xab:\x9c\xbd\t\xb3\xe8\xce\xd5\x16\xe0\a]g\fj\x88v\rV\xe6Ev\xa6\xe4.\\u\xa7\\\xea@OH@\x00|\xaf3\x99\x9cw\xd3K\x17֬\x1a\\q\xd1 \x7f\x1eIq\x16\xf5\x1f\xdf\xf2Y\b\x7f&]\xa3Z\xf1\xd4\x00\xae?\x9b\x86\x12\bp\xd2Y\x1a\xd2x\xd5\xd7\xcaP\xd0\x1b(k\x1c\xbe\xc8N1\x91)\x95u\x9f\x01\x89\xf3ո\bXi\x9b8\xefbFMogQ\xc8\xff\xe13v\"\xcfz\x9czs\x7f\xc7C\x83\x10\x1e\xf9\x97\x90\xf9\fL\x87=\x12\xdd5\vG\xd4\x16w$\xb7!F*\b\xf5\xaf\xbc\x11j\xa7b\xb2֑P\xa9\x9bN\xbca\xec\xb6,\x87T\x96\xd4\xfecsi\xd2M!\x8c;\xb3\n\xb1\xeb6\x0e3F~\xbbz\x81\x1e\x1b\x1e\xd6\x12\xe5m8\xb3\x858I\x10;;\xb6\xcfї\xe01ޢ8ۜ\xf8\x8ax\x04V\x0e1\xd90\xa7V\v\x93\xad\x13\x9b?|\xc2\xef\xcfNح&\xe9}莎\xa4=\xc3\xc9\tI\xa6˴9 `\x00\x16h\xc9H\xd2\xee?]\xdb\x16\x93\x82\xce\xf0\xc1MH#\x84\x14Bx\xfc\xfd\xeb\xa7A\xfda+?\xfa\xb3V\xe68\xd1\x1d\xed\xe3p\x16\xa7\xe0\xc0\x04}\x16\x04C\f \x82\xa7\xa3\x0f\xac\xa96zS\xd8d\x88\t\xcb\xd8ޚ\x90#\xe7\xb2\x19b>~\xfc\xb1\"\xc0\xc9\x1c\xb7oK\xc3h\xd0ƷH\xdc\f\x84U\x1c\xd8\xd3?O\xfay\x00\x13 Ӟ\xe6\xef\xfbx\x1b$\x96T\x99티/\x8bL\x8b\x14\xcdG\"p\x9a\x8c\xbf\xb4\x86\xf6\xb5\x03\xfd;\x80\xaa-\x8a?\xfc\x82\x8e\xf5\x18@\x86\xfaԌ\xfa\xb4\xa6\x83$V\x9c\xadü\x9dw\x8d\xa4\fC\x820\x02u4e\x18/2n\xfc\x99%\x91\a\x8f\xba\x90\xe2\x12VV\x04\x85=\x1c\xa4\xcd\xcep\xf5S|V+a֒w\x92u:O`\x00\x12F\xe1\xb4\xceW\xa3\x04%\x19\x1d\xeb\xe5~\xbbZ\x1c\xadN\x90=\x1e\xf9\x8d\xe8E:߭\xec\xbd%v\x06\x15\x0f\v'\xce\xf9\x16\x83*\xa5\xecA\x90\xe0\xbd\xf0\x18*\xe1\x1ce\x03\xe6\x16\xe7\x8d\x1f6̏\xd3V\xee\xb8\xd3\xe4\x9a\xee1zF\x84u\xc2T\xa7\x88qB\xf1H\xe7\x0f\x92.?QZP\xa1\xcf\x10\a@\x94\xba6\xa6,\xbc7,\xbc\xd4G\xe0\x92\xe8\n\xe3\xe0Y\x90\x8awF\x8ek\xaex>\xdcׅ;g!N3\xe4v8\x83O\xbc3\xa9\xd7\a2o\x1d\xebC\x88\x85\xdas\x045h\x81\xabj\xd9\xfc-TB\x91I\n\xf8\x84\xc4\x1e.5\x13+\x98;v۟\x13\x81چ\xe2kٕn\n&ã\x17N\U000a3426V-\xe30Y\xad\x91\x83\x19a\xc2\xd0\x02Wa\xca\x0e\xe8\x00\xb9M\x14\xe8\"c\x1a\xddr\xad\xa3\xad.8DM\xda\xee\x89U/9C\x8cT\xc8\xd6oP\x02GǷhu\xed<\xc3\xf9\xcbtӜ\xbd\x15\x94N\xfc\x90\xb11\x95\x1c=\\l\xe4`\xb1\x91CŦ\x98geWWZ\xbf\xcb1\x9dc\xe6\xc3\xdd\xd8\xcc\xda\x1ej'\xb2\x96\x9a\xf0z&\xca\xcbۇ\xbb\x9eֶ\xbescBCOn\xe7\x01e^R_@Y=s\x8c2[&\xf4\xddʡ̲\xae\xd6\xf7\xb8\xd4\xf3_\x9dLn\x1b\x9f\xd3\xdc\xdc\x1b\xe5\xf3}\xdcs\x1e\xce\x18\xe3ِ\xa3\xb5\xe2\x88^\x84\x9f\xc9\x1f<\xa2\xa2\x04ht\xa9|n\xb8\xe9\x80\xf1.\x8fG\xbf*O\x89\xc4Qa\x8f_\x10*s\xadQ\xd1Jg\xa6\x8fT>\xe4\xa1\xfe\x80K\xef(_ȓυ4K\x1c\xebw\xf5@\xe2\r[\x1e^\x88\xe6 X\xcc\xe4Q\x92WJ\x8bt\xa4Cʎ\xb8I\xe8|]\xfe\xa2i\xfb\xabj:\xdfg\xf4\x01\x85\x9d%\xed\x87\xf6X_\xe6\xe0\xc5\xf0_\xf8\vV\xe0\xb4 \xa8\x9c\xf4\xd5\xea2\xf6\xed7\xd5t\x85̶\x17a\xca\xfa>z$\xed\x10\xd3\xf6ذ\xc1\xbc&\xae\xb8\x19N\xa8]\xfb\xd0l\xf8>\xbar\xf1\x0f:\xdf\"\x97\x8a\xfeG\xc97\xaeG\x84\xc9\x17\xe1O\xedz\x8b5\xe1\x1f;\x83\xc7TD\xdd'l'\xf5`_\x19\xf8T4\xdb\x13}\xa8\x92Q\x84\x1d\xbd\xe6\v\xb7\t\x81\xb1$'\xcb(\xacF. \x8f\x05\x8cdu\x11\x81\x17\xa1\xccg\xa2\xcd {Oc\x02\x9a\xbe\xed\xc2\x1f\xc1\xac\x0f\x93\x01\xfd\x98\t~\x8f\xc3\xf8\xb3\xfa\xe0\x05S\xae\x84\xc6\xceG\xa6!w\xea\xde\xe8#9'\x91\x87\xb5Q\x89<\xbb\x17\xc6I\x91e\xe7\x1f\xe2l\xdc\xc0\xe8\x83[:f-\xfe\xe8-\x92\xa3\xa6\x8e\x97\xec\x84\xc2\x130\xc7t?\xac)\xa8Б̴yI\xb9\x8a=}\x87\xd3\xd6\xfeM\xff\xe4\x00n\xf3\xce-Փ}C\r\xef\x896L\xda\"h\xdd\x06\x0f\am\\U\xbf\xd9l\xa8ow4\x05L2\xc9=&\xd5I\xc6\xe4\x9f\xd5u\xceF\xe1p\xa6Ȱ\xde\xe4#\x9frq\xa6d\x88T\"I([\x817։\f\xb7\x97*\xf6\xe9d/\a\x9bdw0\xfdK$\xee\x180\xfc\xae=>H{\xb3\x1d\x19\\\xc59ng\xae\xccq\xd49\xa1\xff(V\xe3\xd38\x1d\xaan\x13N\xed\f[2\x03\x91t\xcaܖ\xa5\x8b\x95\xc5\xddx\xf1\xb7C\xd9\xc7z\xf0\x98\xae\xf1\xc4iZ\x96=\xb3,\n\x15\x80\xbc\x11.p\xfb\xb9\xb4\x94\xc9I\xa8#\t\x95\xd1\xe5\xf1\x14\xe4rę\x19\x81\x9b\x96\x84\x14\x14Yy$Q\xf7M,\xae4\xaaUg\xf3m-i\v]\x91<\x8eb\xea\xcb\xf8\xe14\xfd\x1b\x7f\xe6܆2B\x1b\xbf\x16\\\xe0[\xfb\u0092\x91\x9a\xa2~ʄ\x8e\x00m\x0ewb1(\n꽲\x1e\x9f\x05\xdf\xf2L/\xebT\xa2\x97b\xfc:\x1cܭ&\xd7\xfb\xa13\xd8ۺ\xb1\x00:d\x0f\x06 \x01\x1e|ٌ\x9b;\xe1\xb6\xffw\r\xa8\xc0\xa5B{\x1e\xd7y\xbd(P\xed\x97\x13_\xda\xc4\x1b\x8b\x06\x11q'\xfe\xed\xa2o\x7fU\x87\xf0\xa96>\uf584\x01\x8d\xadj\a\x04uc(\x05\x04\rD\xef\xba\x0f \x02\xfcA\x1e\xaa\x8e\xa7\x84\xb0n\xfdm\x82/K\x9c-bC\xac\xa3\xc2;x3\xc4_Oz\x98\xec<֮\"\xbc\xa5~\xa9DD}?\x80\xfb\f\xc9Ű\x88]\xe7\xf5z\x04\xe9\xf8\x0e\xeafH\x17{\x9b\x9fF\xa6\xbd$\xe8\x0e\x7fc\"\x00\xfb2\xe7\xeci$h\xbe\x8c\xa0z\xda\x17\xc7ڿ(u\x1f\xb0\xb4\x97\x92V\xcd\tt5\x145\xe2xm\xfd{\x06p\x9b\xea\x99\xd7\xe7\x1c\xa6\xd3\xc1\xe1\x8a\xda\x04\x04\x14\x06\x9f\xd8\"\xf8\x05\xef\x02^\x83\x8dyD4\x80:M\xfc`i\xfc\xeb9W\xa0\xb34$f\x03\xa0\xaa\x9d9T\x1d\xede\x1c|\x16\x86\xf2\xf6sZ\xea\xaf~X$]\xe1!D\x12\x16\x03\x90Ф0\x82\x937b\xe3\xb7\xed|E\xc0q\xe4\xa8\xea^\x0e\xe3\x952\x16QK:\xb8\xc9&(miG\xff&\x7f\xa7)$\x88$A\xd2\b\xef\xfb\x7f\x8d\xe7\xea\xaa\xf3\aw\xf8\xd7D\xab\xcaa\xb1;\xf8\xdb\xdf\xe9\xef\xecp\xd1\xcfk4\xbb\x83\xbf\xfd}\xf5\xff\x03\x00\xa1a\xd6\x03\xb9h\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xcc\x1a]sܶ\xf1\x9d\xbfb\xc7yЋ\x8e\xe7(\x0fm\xf9ґ\xe5t\xc6\x13\xb9\xd6X\x8e\xfa\x90f&8byD\x04\x02,\x00\xde\xf9\xdc\xe9\x7f\xef,>Hޑ\xf7\xa1\xc4\xfd\x10o\xc6&\xb0X\xee\xf7.\x16\xc8\x16\x8bE\xc6Z\xf1\x84\xc6\n\xad\n`\xad\xc0\xcf\x0e\x15\xbd\xd9\xfc\xf9\x8f6\x17z\xb9\xf96{\x16\x8a\x17p\xd7Y\xa7\x9b\x8fhugJ|\x8b\x95P\xc2\t\xad\xb2\x06\x1d\xe3̱\"\x03`Ji\xc7h\xd8\xd2+@\xa9\x953ZJ4\x8b5\xaa\xfc\xb9[\xe1\xaa\x13\x92\xa3\xf1\xc8ӧ7\xaf\xf3?\xe4\xaf3\x80Ҡ_\xfeI4h\x1dk\xda\x02T'e\x06\xa0X\x83\x05\xacX\xf9ܵ\xd6i\xc3\xd6(u\xe9\x81m\xbeA\x89F\xe7Bg\xb6Œ>\xbd6\xbak\v\x18&\x02\x86HV`\xe9\x8dG\xf6\x18\x90\xddGd~^\n\xeb~8\x0es/\xac\xf3p\xad\xec\f\x93\xc7\xc8\xf2 \xb6\xd6\xc6\xfdu\xf8\xf4\x02V\x96\xf8\x01\xb0B\xad;\xc9̑\xe5\x19\x80-u\x8b\x05\xf8\xd5-+\x91g\x00Qf\x9e\x91\x050ν\x16\x98|0B94wZvM\x92\xfe\x028\xda҈\x96@\x12/\x10\x99\x81\xc4\rX\xc7\\g\xc1ve\r\xcc\xc2\xed\x86\t\xc9V\x12\x97?*\x96\xfe\xef)\x06\xf8\xd5j\xf5\xc0\\]@\x1eV\xe5m\xcdl\x9a%\t\x17\xf00\x1aq;b\xc0:#\xd4z\x8e\xa4{f\xdd\x13\x93\x82\xf7Z\aa\xc1\xd5\b\x92Y\a\x8e\x06\xe8-H\bHD\bIB\xb0e6~\a`\x13\xb0 ?J\xa9\x9c|+\x82\x06\xb2\x89\x14x:\xc0\x12觑H\xfd\bm2\xfc|b\xb4{xo\xd7x\fٞ(\xdeb\xc5:\xe9Ƭ\xb2\xf5\xc0\xec\f[-\x969\x0f\xab\xe2l\xe0\xe4\xed\xdeX\xf8\xeaJk\x89Le\x03\xd4\xe6[\xffb\xcb\x1a\x1b\xef\xbc\xf4\xa6[T\xb7\x0f\uf7be{\xdc\x1b\x869C:p\nR\x1c\x1b\xe9\xa6F\x83\xf0\xe4\xfd/\xe8\xcdF\xd6z\x9c\x00z\xf5+\x96nPbkt\x8bƉ\xe4,\xe1\x19\x05\xa9\xd1\xe8\x01MWDv\x80\x02N\xd1\t\x83\x1dE\x7fA\x1e9\x05]\x81\xab\x85\x05\x83\xadA\x8bʍś\x1e]\x01S\x91\xbc\x1c\x1e\xd1\x10\x1a\xb0\xb5\xee$\xa7\xa0\xb6A\xe3\xc0`\xa9\xd7J|\xe9q[p:\x1a\xaf\xc3\x18\"\x86\xc7\xfb\xa7b\x92L\xb5\xc3k`\x8aC\xc3v`\x90\x84\x00\x9d\x1a\xe1\xf3 6\x87\xf7d\xefBU\xba\x80ڹ\xd6\x16\xcb\xe5Z\xb8\x14\x9cK\xdd4\x9d\x12n\xb7\xf4qV\xac:\xa7\x8d]rܠ\\Z\xb1^0S\xd6\xc2a\xe9:\x83K֊\x85']\x11\xc36o\xf87&\x86s{\xb5G\xeb\xc4k\xc3\xcfG\xcd\x13\x1a\xa0\x88\x19\xac ,\r\x8c\x0e\x82\x16j\xed\xa5\xf3\xf1\xfb\xc7O\x90>핱\x874\x99Ű\xd0\x0e* \x81\tU\xa1\xf1\xeb\xa02\xba\xf18Q\xf1V\v\xe5\xfcK)\x05\xaaC\xf1\xdbn\xd5\bGz\xffG\x87֑\xaer\xb8\xf3\x19\vV\b]K\x8e\xc9sx\xa7\xe0\x8e5(\xef\x98\xc5\xff\xb8\x02H\xd2vA\x82\xbdL\x05\xe3d;\xfc\x11\x96\"Jm4\x91r\xe1\x11}\xcdz\xf1c\x8b\xe5\x9e\xffp\xb4\u0090\x85;搜\x87\xeda\x84\xe4\xe2\xb3\xd8\xf6@睛\x1eV\x96h\xed{\xcd\xf1p\xe6\x80\xe4\xdb\x1ep\x8f\xc6\x16M#,\xb9\xbe\x85J\x9bÌ\xc1\xfa\b<~R\xa4\xca's\xa8\xbafJ\xc8\x02>\"\xe3\x1f\x94\xdc\x1d\x99\xfa\x9b\x111\xb2_\xa0H\xfa\x05\x12\x1fw\xaa|@#4?\xc3\xfc\x9b\x03\xf0^\x04\xb5\xdeB\xe5\xcdZ9\xb9\xa3\x18dw\xaa\x8c\xe8'8\x01n\x1f\xdeEc\x89\x0e\x14\xfd-\xca*\x87\xdb蹺\x82\xd7\xc0\x85\xa5\x02\xc0z\xa4SaQyF\xf3\x058ӽ\x88\xfdR7\x14\x81\xa7q}\xc2\xf9\xdd\x00I\xc1\xb7\x12\xeb\xceD\xbeIՎ\x99\x15\x93Ғu\x0e\xaa\xb7^\xf7}&\x1f?B\x85\xe8\xd1'+f\xb0'\a\xf95tJ\xa2\xb5{\xc8\xfa\xef\x82\xf0A\xa6\xb1(7h\xa7\x029n\xe6\xf40\xb9\xd6F\xb8z\xc6\xc0&l\xdf&\xd8T\x13\xf5\x8bG\x94%\xee\tf`a\x169\xc0V\xb8:O\xf5\x01E@X\x7f\x11픇\xe3^@\xcf¯:2\xf5ź\xf9\xaf/@i55\x903FB?I\xe1\xf4\x02y\xdd\x13\\\x92U\x92\x05\xd5\"\x1e\xc1u\xb0\xf5o\x89\xeb?\xf90A\\P\x16\x9e\xc5\f#\xf0\x9b\x1b\x0fO\xac\xe5\xf0\xae\x82/h\xf4\xf5\xbeF\xae,\xc4R\fd\"\xa3\xb3\xc8\xe7e۰Ϣ\xe9\x9a\x02nn\xe6\xe7\x85\n\xf3\xafg\xa7\x83\xbc\xa8\x9eX\xa3\x99@\x1c\xc9\x06q;V\x89\xf5T\x94\xe3m\xc4)\xeb=\xa9\xa8=]\xdcyw!e\x90\xe4Z\xa37\x82\xa3YPJ\x12\x95({w\xf2i\x02*\x81\x92\xdb\xfcE\xac\x18䨜`\xb28CI\x0fH\x1fuL\xa8h \xc38\x15X\xa6\x89U\xacr\xa8\xf8\xac\a9\xed\v\x05\x8b\xdc;\xd2~\fya\x1cx\xc6\xdd\xdc\xf0\x01\xed\x9fj\x84gܥ\xc0f\xb14\xe8Ȁ-J\xaa\x19\xc9Ds\x80\xf7\x9duD\xdaajN\x7f~o\x94V?\xe3.\xff-^\xe8\xf7\x16\xe7I\xbe\xa2\xddj\"\xd8`\x85\x06\x95\x9b\xad\xa3\xa8\x19`\x14:\xf4\x8d\x06\xaeKKel\x89\xad\xb3K\xbdA\xb3\x11\xb8]n\xb5y\x16j\xbd \x81/b\xd2Z\x12)v\xf9\x8d\xffg\x96\"\x80O\x1f\xde~(\xe0\x96sЮF\x03\x9dŪ\x93\xc9\xd0F[\x8ak\xa0\xea\xeb\x1a:\xc1\xff|\x95\xcd`:'\x17\xedu\xc5\xe4\x05\xb2\xa1\xe2JT;\xd8\xd6\xe8\x89\"\x11=\x06\xadh\x03T\x9c\x92\xb2\x9b\xa8͐\xde\xe7\xa3\xd3tS7\xfe\xa3Z\x80\x8a\xb6)I\v2\xa7\x97\xb8Y\fjEv\x92\xb1\x98Q@(.J\xe6\xd0\x1e\xe4\xd7\x18\x93S\x84<Z\x99\xc4\n\xa4_\x98g/a\x1cUiv\x81\xa2\xd3\xe4~\xdf\x03\xf6q(V\x92a簰\x82\xe3\b]4\xe7\t\xd2~\x03{\xb0\xcf=\xac/\xf2\x17\x06\a&\xa5\xde\xfe\xa8\"\x01sz\x9c\xb0t{\xb0$\xe0\xa0\xdd\x0e\xe3iߕ\x88=\xca\u0378,\x06W3\a̠\xbarI\x14T\x1a\xa5.\x91G\xa80UY\xb0\xc2J\x9bcH\xe3\xfaس\x01TT1\xd2^\xcb]Yh\x90)\xe7\x13l#ֆ9\"w\xd4Hp\xfa,Ұ\x97\x8e\xbbs\xf2\x9cP\xb2r\xa0p\xb2W\xc5]Bl\xcd6\b\xf8\xb9%\x0f\x9aj\xee\x9c\x11^\x1e\xdc\x7f\xc0]\x8c\xe3A\x9a1г\x14\x12\x84\x8afue}\xcbƷ\x00g\xd1\x02\xd4Z\xf6j\xfe\xeef\xb1ڹ\x80o\xacv*r\xa3\xccb\x06\x9b\xe7\xee\xb4m\x9e\xe4\xef\xf7$\xb0\xa3\b\xc1\xa7\xb6\v\x93\xd8\x05\x01\xfbt2\xfb\x7fMh_9\xa9](\xa7\xd3\xc9\xedw$\xb8\xa3\xf8\xe0\\\xea\xbb\xc4\x01O\xa5\xc0\xe3i\xf0L*\xfc\xaay5\f\xc6vI\x91\x9d\x94\xea\x871lj\xad@,\xa5c\xe2\xb2\xe8(pZPH-\x12f\xe6\xd8s\x9a\xf2\x9d\xa2\xca\xd1i`}Y~e#\x91\xa9]\x92g/\v\n\xab\xae|FWdg\r\xe4\x8d\aL\xf5@XFᠳ\xe83\xc092.0ےݡ\xb9\x84\x96\xbb[\x02\xec\xbb(\f\xeena\xd5).1Q\xb4\xadQс\x8b\xa8v\xc7]\xe4\xd3\xfdc\x92\xaao@\xc5\x16p\x92\xed<\x0fa\xbfQ\x00\x05\xea\xdf\xc2dk\xb0\x12\x9f/`\xf2\xc1\x03&\x81\xb7\xcc\xd5 \x94/o،\xf8C\xbe\x99\xc5\n\xbdR\xe0C\f\n\xbfA=\xa7<(\x90\xf3\x12'J2.\xb232\b`\xbd\x14\xe2\xb2\x14\xd4\xf7[\x85y\xf6\x02\x8e⩓\xd0\xea/\xc4\x1a\xaarw\x86\x98\xa7\xe9\x8a\x13\x8d\xbct\xaa5\xc1\t\xb1\xc9a\f\xdaV+\x9f\xfc/k\xe3\r$\x7f\xbdf\u07bcZ\x17\xa0Ǒ\xeb`.)/\xbb@\xd9\xe1\x04\xafȎJu\xb6\xfb\xfc\xe8W\xf5\xd2%\x81\xe9\x95E\xb3\x19\xb5\xb3\xf7P\xc2\x7f\xa7\x8b\xfdj\xd4Ʀ\xe3\x12\x05\x9d\xa2^QH\xe49\xfc]\xc1[:\xfa\xa0\x9d\x14/Hѳ\xb5\xaa\xb0\xa0\xf4\x96\x96\x8f\xf0y\x14@e3\xa5^j\xe6Si\xecwcaj+\xa4\xa4\x14k\xb0ћ\xd9\x14K]\x15\x83rGU\xbe\xae`s\x93\xbf\xce_e\x97\xb5\a\xbf~\x93\x9cNm\xa9\xe7\x8d\xfc#n\xc4\x05\xcd\xe2W\xf7\x93\x15\xc9\xf1{w\xa0\x97_\xd2Y\xca\xd2D\xb0_&\x88\x01*!\xe9\x00n&N\x8cwJ\x87\xc7\xd5o\x1eﯨ\x1dK\xbd\xa4\xd1\xf1\xe6\xf0l\xe9p\x94\x1a\xea\xc8A\xa8\x982J\xd9Y\x87f\xc6\x00z\xedy\x9d\x83\xd4j\xae\xf1\a\xe9\x10\v\xb4\xaf\r\xb9\x8f\xe9\x1c\xe9\xfc\x89\xe2CY3\xb5\xc6\xe1\x902\xd2\x7f\x9aR\xa6&63X\x88P\xc7\xcc\xe3\"\x8dҁ\xf9\x19m\x0e\xca<~9 Q\x9f4\x9b\x14\xf3R\xb9gǲ4E\xe0\x85\x1b.\f\xfc\xfe\x80\x19\xecz\xc8\x05\x17Jb\x7f\xc1\xbc4FV\x8aى\xfd\xfd\x96\xf5\xb9\x00\xf9\xffN\x0e\rZ{\xbe\x04~\x1f\xa0\x88c\x96\x96\x00[\xe9Ν\xf2̫9\x83\x8e\xb7A^B\xa3\xbf\xe3r\x86B\x7f\xeb%i\xa4\xec\f\xed\x12\x87CS\x1a\x9c\xcd-\xf9Ł\xb5\xbf\x96337\xbd\xa8s\x01_\xb3\xb9v2\x18\xf2\xe5H\xafQ\xc8\xe3\x91n\xd5_$(\xb2\xbd\x8c\r\xff\xfcW6$o:\xe7\xa5.\xd2\xe8:\x145_\vx\xf5j\xef:\x95\x7f-\xa9\xaa!\xed\xdb\x02~\xfa\x99nC\x91E\xf3\xb8õ\x05\xfc\xf4s\xf6\xef\x01\x00$\xab\xfd\x8c\xc4&\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VMs\xdc6\f\xbd\xebW`\xd2C.]m2=\xb4\xa3[\xeb\xe4\xe0i\xeb\xf1ؙ\\29pI\xacĚ\"Y\x00\\\xd7\xfd\xf5\x1dPR\xf6Ӊ{\xc8j/$\x81G\xe0=\x00R\xb3Z\xad\x1a\x93\xfdG$\xf6)v`\xb2\xc7\x7f\x04\xa3\xae\xb8}\xf8\x85[\x9fֻ\xb7̓\x8f\xae\x83\xab\u0092\xc6;\xe4T\xc8\xe2;\xdc\xfa\xe8ŧ،(\xc6\x191]\x03`bLbt\x9bu\t`S\x14J! \xadz\x8c\xedC\xd9\xe0\xa6\xf8\xe0\x90*\xf8r\xf5\xeeM\xfbs\xfb\xa6\x01\xb0\x84\xd5\xfd\x83\x1f\x91Ō\xb9\x83XBh\x00\xa2\x19\xb1\x03\x87\x01\x057\xc6>\x94L\xf8wA\x16nw\x18\x90R\xebS\xc3\x19\xad^\xdcS*\xb9\x83\xfd\xc1\xe4?\a5%\xf4\xaeB\xfdV\xa1\xee&\xa8z\x1a<\xcb\xef\xcfY\xfc\xe1g\xab\x1c\n\x99p9\xa0j\xc0>\xf6%\x18\xbah\xd2\x00\xb0M\x19;\xb81#r6\x16]\x030\xf3Q\xc3\\\xcd\x19\xef\xdeNpv\xc0\xb1r\xac\xab\x941\xfez{\xfd\xf1\xa7\xfb\xa3m\x00\x87l\xc9g\xa5\xf0b\xfc\xe0\x19\f\xccQ\x80\xa498H\x11!\x11\x8c\x89\x10\xa6H\xb9\xfd\x02\x9a)e$\xf1\v\x7f\xd3sP:\a\xbb'!\xbc\xd6('+pZ3\xc8 \x03.\x99\xa2\x9b\x13\x83\xb4\x05\x19<\x03a&d\x8cS\x15\x1d\x01\x83\x1a\x99\bi\xf3\x17Zi\xe1\x1eIa\x80\x87T\x82\xd3R\xdb!\t\x10\xda\xd4G\xff\xef\x17l\xd6<\xf5\xd2`d\x11y\xff\xf3Q\x90\xa2\t\xb03\xa1\xe0\x8f`\xa2\x83\xd1<\x01\xa1\xde\x02%\x1e\xe0U\x13n\xe1O\xa5\xc9\xc7m\xea`\x10\xc9ܭ\u05fd\x97\xa5el\x1a\xc7\x12\xbd<\xadk\xf5\xfbM\x91D\xbcv\xb8ðf߯\f\xd9\xc1\vZ)\x84k\x93\xfd\xaa\x86\x1e5anG\xf7\x03\xcdMƯ\x8fb\x95'-\x18\x16\xf2\xb1?8\xa8\xd5\xfc\x15\x05\xb4\x96'\xd9'\xd7)\xd1=\xd1>\xf6U\x92\xbb\xf7\xf7\x1f`\xb9\xba\x8aq\x04\n3\xef{G\xdeK\xa0\x84\xf9\xb8E\xaa~\xb0\xa54VL\x8c.'\x1f\xa5.l\xf0\x18O\xe9\xe7\xb2\x19\xbd\xf0R\x92\xaaU\vWu\x8e\xc0\x06\xa1dg\x04]\v\xd7\x11\xaë\xe1\xca0~w\x01\x94i^)\xb1/\x93\xe0p\x04\xee\x7f\x8a\xd2ͬ\x1d\x1c,3\xea\x19\xbd.4\xed}F\xab\n*\x89\xea\xed\xb7\xde\xd6\xf6\x80m\"x\x1c\xbc\x1d\x96\xa6=\u0085}\x83\xef\x9b\xf9\xf9\x86\xd6g\x82ѡtz\xf2l\xf2P\xb5\xf3\x84'U\xb8:\x00{\x11/b\xa4\xf0\xffd\xa6\xfa,\xdc\xd8B\x84Qf\xa4:-.9\xbd\x94\v$Jt\xb6{\x12\xd4\xfbj\xa4\xc3G\x8c\x8f\f&>͎ \x83\x11xDB\xc0hS\xd19\x83\x0e\\9\xe3o\xa6e\xc0i\x1a\xab\xb0\x99\x92E>\x98\xc1\xcb\xe3\x05\xc7\v1}E\x1d\xfd\xeb;\xd4l\x02v T\xf0\xecx\xf25D\xe6\xe9\xe4,\x0f\x86\xf1\x1b\x14ܪ\xcd%\rP%\xd0\xcdo\x8a\xa0\x7f\x8ce<\xbfi\x057\xf8xa\xf7:\xdeR\xea\t\xf9\xb4\xe4\xd5\xe5vb\xaf\xbeS_\xc8\xd2Ţ<\xdbd}\xe5\xb8\x03\x16Y\x12\x99~\xe1u_\xc2\xc6Ẑ\xee\xe6\xf4\xab\xe3ի\xa3χ\xba\xb4)\xba\xfa-\xc5\x1d|\xfa\xac\xdf\x06\x92\b\xdd\xfc\xde\xe4\x0e>}n\xfe\x1b\x00\x8c\xdb\x1fܮ\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XO\xaf۸\x11\xbf\xebS\fv\x0f\xaf\x05\x9e\xe4\xdd\ue845/E\xfa\x12\xa0\xc1&\xd9\xc0\xef\xe5]\x169\xd0\xe4HbM\x91*\x87\xb4\xe3\x16\xfd\xee\xc5P\x92%\xdb\xf2\x9f\x1c\xf6\xc9@\"r8\x9c\xf9\xcd\xf07Cey\x9eg\xa2կ\xe8I;\xbb\x04\xd1j\xfc\x16\xd0\xf2\x1b\x15\x9b\xbfQ\xa1\xddb\xfbs\xb6\xd1V-\xe1)Rp\xcd\n\xc9E/\xf1-\x96\xdaꠝ\xcd\x1a\fB\x89 \x96\x19\x80\xb0\xd6\x05\xc1\xc3į\x00\xd2\xd9\xe0\x9d1\xe8\xf3\nm\xb1\x89k\\Gm\x14\xfa\xa4|\xd8z\xfbS\xf1\xd7\xe2\xa7\f@zL\xcb_t\x83\x14D\xd3.\xc1Fc2\x00+\x1a\\\x82r;k\x9cP\x1e\xff\x1d\x91\x02\x15[4\xe8]\xa1]F-J\u07b4\xf2.\xb6K\x18'\xba\xb5\xbdA\x9d3o{5\xabNM\x9a1\x9a¯s\xb3\x1ft/њ\xe8\x8597\"M\x92\xb6U4\u009fMg\x00$]\x8bK\xf8$\x1a\xa4VHT\x19@\xef{2+\xef\xbd\xdb\xfeܩ\x9256\tO~s-\xda7\x9f߿\xfe\xf2|4\f\xa0\x90\xa4\xd7-\xc3uf3h\x02\x01\xbd\x05\x10\xdc\xc1(\x10\x16\x84\x0f\xba\x142@\xe9]\x03k!7\xb1=h\x05p\xeb\x7f\xa1\f@\xc1yQ\xe1#P\x945\b\xd6\u05c9\x82q\x15\x94\xda`qX\xd4zע\x0fz@\xb9{&\xc95\x19=1\xfc\x81}\xeb\xa4@qV!A\xa8q\xc0\aU\x0f\a\xb8\x12B\xad\t<\xb6\x1e\tm\x97gG\x8a\x81\x85\x84\xed=(\xe0\x19=\xab\x01\xaa]4\x8a\x93q\x8b>\x80G\xe9*\xab\xffs\xd0M\x8c\x10ojD\x18\xd2a\xfc\xd36\xa0\xb7\xc2\xc0V\x98\x88\x8f \xac\x82F\xec\xc1c\xc2)ډ\xbe$B\x05|t\x1eA\xdb\xd2-\xa1\x0e\xa1\xa5\xe5bQ\xe90\x1c*\xe9\x9a&Z\x1d\xf6\x8bt>\xf4:\x06\xe7i\xa1p\x8bfA\xbaʅ\x97\xb5\x0e(C\xf4\xb8\x10\xadΓ\xe9\x96\x1d\xa6\xa2Q?\xfa\xfe\x18\xd2Ñ\xada\xcfiF\xc1k[M&R\xce_\x89\x00g}\x970\xdd\xd2\xce\xd1\x11hm\xab\x14\x92ջ\xe7\x17\x18\xb6N\xc18RzȜ\xc3B\x1aC\xc0\x80i[\xa2O\xeb\xba\xccc\x9dhU\xeb\xb4\ri\x03i4\xdaS\xf8)\xae\x1b\x1dhHf\x8eU\x01O\x89i`\x8d\x10[%\x02\xaa\x02\xde[x\x12\r\x9a'A\xf8\x87\a\x80\x91\xa6\x9c\x81\xbd/\x04S\x92\x1c\xffX˲Gm210مx\x9d\x1c\xf5\xe7\x16%G\x8f\x01䕺\xd42\x1d\r(\x9d\a1\x9e\xfc\x1e\xc0\xf1\xd4^>\xb9\xfc\x04\xe1+\f\xa7\xa3'\xb6\xbc$!\xde~W\x8bc\xa2\xf9\x13\x16U\xc1\\A\xbd!\x1d{\xfc\xf9x\xff\xeb6\xccg\xef\xac%C\x123\f\x8c+S\x01\x93\xd4Ԧ\xf3\xad\xf9A\x1b\x9b\xf9\rr\xf8G\xb2\xf9\x83\xab\xb2\xb3\xc9\xc9\xfc\x93\xb3\x81\xd3\xfd\xaaЫ3\xb1\xc1g+Z\xaa\xdd\r\xd9\xf7\x01\x9b\xfb$\x87\x82|(R\x97\x04?\n\xabK\xbc!\xf4\xd6\xefWѮ\xb0u\xfe\xba\xe0g\xa7:\x7f\xba\xd7\xeb6\xfeӹͻo(#g\xe5%\xd1\x15r\xc1\xc1\xcbP\xf7\x02+\xa4h\x02\xdd\x14\xba\x85J/y\x87m\x17\x8e\xf4\xf0\xa4\xd2};?\xb9\xf8\x0f\xf9\xc9K8?\xf9\xff\xdc\x12y\x8b\x01i\xa4֝\x0e\xf5\xacF\x80]\xade\x9d\xc82%7\xb36\x91\x93:q\xe0\xf7\x9bϜ\xa0=\xce\x1c\xb0<\x1d\xbc\x99a6\xfel\xf8\x02\x93]\xda \xef\xd9%\xbbC\a\x05\x11\xe2\t3\\\xe5\xc3$?@-\xa3\xf7hC\xaf\x85A\x17\xa7\v\x8a\xec>2\x1aX\xe4\xcb\xea\xc32\xbb\x1a\xeba\x83/\xab\x0f\xdct\x04\xa1mgM\xeb1']YT\xc0s̋<<\x03F\xf7;\xee\xb2\xee\x88(Z\xe9\xf7\x9d\x15\xd7M|w\x10\x1c\x90\x1a\x97\xb2ͥ\xae\xa2\xef\xcaH\x9f\xa8g}\xe2\xf0\xf4\x8d\"\x18\xd7םѥC\x92\xb2\f*\xd0\xf6\x11ty\x94\xbe\xfd\xb6s\xd9˝\xbfX\x1b\\B\xf0\x11\xbf\xb3l\bc\xdc\xee\x8b=\xa8\x9f\x939\xc1\xe4\xcdɒN\a\x9fK\xa1\x86\x1e\xa8Kq\xeaA\x99\xd5\tS$D\x00\xe1\xd1>\x84\xd1ѱ\xa3N\n-\x1e\xe0Yc\xe9\xfc\xb9\xa3\xa7\xa1\x85\x9d`\xdc\x18\x1a\xee{\xc2\x03A\x83\u0086Tg\x1b]q\xdcl\x05bb\x87\xbb\xa9\xb4\xebk\xfbNy\x8d\xa04\xa5\r\xc0Y\x89\x93\x04\xb8\xcf\xd8Zl\x11\xf0[\xab\xfd\\`\xc7$^;gP\x9cv\xf1\xfclp\x7fG\xc8~\xc5=\x10\x9a\x14\x12Fs\x83{\x0e\x8d\x80g\x94\x1e\x03h\v\xaf\xe9\"\xf8@鎕\xae_\xb3j\x01jg\x0ea\xfe\xe5/\xf9z\x1f:}Ӱ\v\x7f8(\xa8\x12O\xcf{w=7\xaf\xfaw\xe6\xe3\xcb\xe8\x17\x9bB\x9dg\xc1\xf5\x8e\xa7^\xba\x00\xf8\x18/Ժ\xee\xb7F\x10\xdc\xd8k5h\xd8\xe0~\xde\xf8\x1b\x1cs\xbb\xf0\x9d\xb9\xf0\xf0iR\xf1<\x96Ȝ<ۤ\x8f\xe5\x90\xfbt\xe5$\xf1\x1dIb\x1bh\xe1\xb6\xe8\xb7\x1aw\x8b\x9d\xf3\x1bm\xab\x9c\xf1\xcf\xfb\xd0,\xd8\x1cZ\xfc\x98\xfe\xb9h\x15\xc0\xcboo\x7f[\xc2\x1b\xa5\xc0\x85\x1a=D\xc22\x1a(5\x1aE\xc5\xe4\xce\xfa\x98\n\xe0#D\xad\xfe\xfe\x90]\xd2w\aN.\x81 ̝Xq?\xaf\xcb=\xecjL\x062d}6;\x0f|\x13\xe2\xa4lnF\xbb\xbbL\xab\xec\x82\xc4\xcd\x03x\xad9\xe8\x1b\x04\xdc\xcf\xce\\l\x06\xae+\x9dWxEY\xe2\x17qO\xb1;\br\xc5\xd9\xd5\xc8̬\xe9\xb4\x11\xe8\t\x8b\x12\x13\xcaYP\x12\xae\x06\xb98\xac;f\xa0=\x05l\xce\x0fR\xe9|#\xc2\x12\xf8v\x9a\a\xdd\xe0\xf7\x96\xb7+\x99\xd5ւ\xf0\x86ϟYf\xae\v:\x9c\xc3\x13\xef\x8b쾋Q\x0e\x9fp73\xfa\xd9;\x89D\xa8\xee\xf7d6\xb6g\x83\xc4_q\xd4\x04\xa5\xbeᘎ\xc4\xf5\xd0<\x1f\b\xb7\xef\x1b\xe1\xbf\xff\xcb\xc6\x16RH\xa6\x12T\x9fN\xbf\b\xfe\xf0\xc3\xd1'\xbe\xf4*\x9dU\xe9\x1b'-\xe1\xf7\xaf\xfc\x1d/u1=?\xd0\x12~\xff\x9a\xfd\x7f\x00\x9d$`\xa9F\x15\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcXOs۶\x12\xbf\xebS\xec\xe4\x1dr\xb1\xa8d\xde\xe1\xbd\xe1\xed\xc5/\x99z\xeaf<\xb6\x9c\x1e29@\xc0JDL\x02\xec.`\xc5\xed\xf4\xbbw\x16\x04%R\xa2,\xfbК:\x98\xc0\xee\xe2\x87\xdf\xfe\x038\x9b\xcf\xe73\xd5\xda/Hl\xbd+A\xb5\x16\x7f\x04t\xf2\xc6\xc5\xc3\x7f\xb9\xb0~\xf1\xf8~\xf6`\x9d)\xe12r\xf0\xcd-\xb2\x8f\xa4\xf1\xff\xb8\xb6\xce\x06\xebݬ\xc1\xa0\x8c\n\xaa\x9c\x01(\xe7|P2\xcc\xf2\n\xa0\xbd\v\xe4\xeb\x1ai\xbeAW<\xc4\x15\xae\xa2\xad\rR2\xde/\xfd\xf8\xae\xf8O\xf1n\x06\xa0\t\x93\xfa\xd26\xc8A5m\t.\xd6\xf5\f\xc0\xa9\x06K\xb0M\xeb)\xac\x94~\x88-\xe1o\x119p\xf1\x885\x92/\xac\x9fq\x8bZ\x16ސ\x8fm\t\xfb\x89N?\x83\xea6t\x95L}H\xa6n;Si\xb6\xb6\x1c~>%qm\xb3T[GR\xf54\xa0$\xc0\xd6mb\xadhRd\x06\xc0ڷX\xc2g\xd5 \xb7J\xa3\x99\x01d>\x12\xcc9(c\x12ê\xbe!\xeb\x02ҥ\xafc\xd33;\a\x83\xacɶ\"\xd2\xd9\x01\xbf\x86Pa^\x10\rtk&8\x00\xdfٻ\x1b\x15\xaa\x12\n\xa1\xa9\xe8&E/\v\bC%|\x18\xea\x84'\xc1ȁ\xac\xdbL\xad\xda\t\x03\aOj\x83P{\x9d\xbc\x97Pt\xf6\xc1\xf2\x1e\x8fu\xc1\x9f@\x93M\\g\vY\xaa\x83t0x\x0eT\xe7X\xe0\xa0Bd\xe0\xa8+P\fW\xee\x86\xfc\x86\x90yq零ƀf\xf1I\xd9\x1a\xcd\x04\xa4\xa4[\xb4\x95\xe21;7\x83\x91#\x1c\x9d\xc8\xe3\xfb\xf4º\xc2&%\x85\xbc\xf9\x16\xdd\xffn\xae\xbe\xfc\xfbn4\fS\xc8G\x01'\xfc)\xc8a\x03\xc1g2Ae\xe7BP\xb4Ru}\xb1\xb3\b\xbb-{\x87`\xfc\xd6\xd5^\x194\xb0&߀r>TH\xa0\xeb\xc8\x01\xe9B\xa8\xd9\xd9\xf2\xeb\xfd\xff\xd9!\x03\xb3\xbdw\vXVد+\xf8\xd0v&}k\xd1\b\xc6P\rba\x85kOX)g\xc0\xd3\xc0\\l3\xaeP\x91\x8f\x9b\n\x14\xdc\xdf^\x83e\x8e\x02\xd6S\x8a\xa2\xbc\xf3b\xa7ؒo\x91\x82퓹{\x06ul0z@\xef[\xf1@'\x05F\n\x18rZ#\xa7\x1d\x9a\xec\xb4.\x8f,\x03aK\xc8\xe8\xc20\xfc\xfaG\xc8r\xe0W\xdfQ\x87\x02\xee\x90\xc4\fp\xe5cm\xa4\xee=\"\x05 \xd4~\xe3\xec\xef;ۼ\xe3G\x85\xbe\xe2\xec\xffR\x9a;Uã\xaa#^\x80\x90֨' \x94U \xba\x81\xbd$\xc2\x05\xfc\xe2\t\xc1\xba\xb5/\xa1\n\xa1\xe5r\xb1\xd8\xd8\xd0\xd7o\xed\x9b&:\x1b\x9e\x16\xa9\x14\xdbU\f\x9exa\xf0\x11\xeb\x05\xdb\xcd\\\x91\xael@\x1d\"\xe1B\xb5v\x9e\xa0;\xd90\x17\x8d\xf9\x17\xe5\x8a\xcfoGX\x8f\x82\xbf\xfb\xa5\xd2\xfa\x8c\a\xa4\xb0JȨ\xac\xdamtO\xb4\f\t;\xb7\x1f\xef\x96\xd0/\x9d\x9c12\n\x99\xf7\xbd\"\xef] \x84Y\xb7F\t \xcb]؋Mt\xa6\xf5օ\xe4s][t\x87\xf4s\\56p\x9fn\xe2\xab\x02.SS\x83\x15Bl\x8d\nh\n\xb8rp\xa9\x1a\xac/\x15\xe3\xdf\xee\x00a\x9a\xe7B\xec\xcb\\0\xec\xc7\xfb?\xb1Rf\xd6\x06\x13}\xc3<ᯉ\x82tע\x16\x0f\n\x89\xa2m\xd76'z\x9f\xb2\xa3\xae\xd3?\xbbʵ\xcf\xe4\xd3\xd9,Ͼ=\x1d\xce\x1c`\xfc\xb0\x13\xeca\xb9ӽp\xbc\xfa3,\xca\xef\xa0'\x9d\xc1q7\x96\x9e\x02\xb3\x9an\x97Gv\xe1\xb9\x06\xfa\xaa-\xe4*}\x06\xfar_\xcbO@\xee\xab\xfd\xbe\x01\xd9S\xb0;\xc6\x19\x8c%\xd4\xc1\xd3әݿn?\xe1\xec^\x96\xd2C\xa0\xf2[\xa8\xbd\xdb\xe4\xf63\x15\v\xa0\x95\x93\xb4\xde\xc8\xdeF\x9d\xae\x7f\xb4\x9c\\u@s\x01\xdaG\x17\xfa&\xba7\xf6*\xf0]\xc7;\x83\xff>\tA\x83\xcau\xde詷<h\x99~\x9a\xea#\xd3⒮\xbb\x8a|\xa7\x9fڬ_\x0f\xdb\xeb[\xceǥ\v \x95\x9ay\xa8\x94\xcb\x1d}\xd2(\x12\x0e\xa9\xcdv\x84\xf9t\x84Gs\x8a\x99\x95\xf75\xaaq\xf0\x88\xb6%<\xe8\x1cs8:\xa2\xf6\x13<ε\x83\xd9\xcc؋\xea_\xdau9;鎩\n\x98t\xfadё\b\xdd\xee\xb8ٝ\n&\xb4^Z\xf7tw8\x1d݁\x9e\x8f\x98\xcbc\x8dt\xee \x93\x03\xc86c'm\xd5a7͐42Oyn\xed\xa9Q\xa1\x04i\x7f\xf3`\x0f\xdc!?\xb9\xa4\xa9U\x8d%\x04\x8a\xf8\x9a\xa4@\"O|f\x8b\x1f\x93\x90\x9c\xaa\x82\xb2\x8eA\xb9\xa7\xac\b\xa1R\x01\xb6\x12\x8e\xe8\xba$%4\xb0\xadl}\f\x03r\xc6\xf4\xa7\x8cS=\xc1\x06l& =\xbb\x8f\x17r\xa0\x88\xd4\xd3\xc1\x1c\xfeh-\xbd\xa4\xc5|\xdc\tJ\xf4m+t\x87i\x9dL!\xff\xb3.Lפ3\xd0\xd3\xc5i*gv\x9d\xe6l\xce\xc8\x0f]l\x8eW\x9a\xc3g\xdcN\x8c\xfe\xaal\xb0n\xf3\xc9SWU'D\xf6\xd7\u0089\xc9\xddEqbntu|\x11M]\xf5\xbd\xbf\xbd>C\xd5}/\xd7\xd3%\xff\x0e;\xc1\xa0\t\xef{\u0091\xcdt\xe6\xda\xdaPIA\xfai\xb9\xbc\x81\x9b\xfbe_\x03.\xd2\x05+\xbfH(y\x9e\xec4\xc5\xcb78Ya\x8f\x06Y\xeeIf\x10c\xb9\x94\x0fG\xe2jw\xe9詒H\x89\\\xc2\x1f\x7f\xce\xf2\xbf\xf2\xb5Ikl\x03\x9aχ\x9fv\u07bc\x19}\xa3I\xafڻ\xees\n\x97\xf0\xf5\x9b|\x80\t\x9e\xd0\xe4\xfb \x97\xf0\xf5\xdb\xec\xaf\x01\x00\xc6>Sp\x13\x13\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Z\xdds\xdb\xc6\x11\x7f\xe7_\xb1\xa3<\xa8\x99\x11\xc1\xc4\xed\xb4\x1d\xbe\xd9r\xd3Q\x9b\xc8\x1aI\xf1\x8b\xc7\x0fG܂\xbc\b\xb8\xbb\xde\x1eH\xb3\x99\xfc\uf77d\x0f\x12 @RR\xeb\xd4䌅\xfb\xd8\xfb\xed\xde~\x83\x93\xe9t:\x11V}DG\xca\xe89\b\xab\xf0\x8bG\xcdOT<\xfd\x95\nef\xeb\xef'OJ\xcb9\\\xb7\xe4Ms\x8fdZW\xe2{\xac\x94V^\x19=i\xd0\v)\xbc\x98O\x00\x84\xd6\xc6\v\x1e&~\x04(\x8d\xf6\xce\xd45\xba\xe9\x12u\xf1\xd4.pѪZ\xa2\v\xc4\xf3\xd1\xeb\uf2bf\x14\xdfM\x00J\x87a\xfb\xa3j\x90\xbch\xec\x1ct[\xd7\x13\x00-\x1a\x9c\x835rm\xea\xb6\xc1\x85(\x9fZK\xc5\x1akt\xa6PfB\x16K>t\xe9Lk簟\x88{\x13\xa0\xc8̝\x91\x1f\x03\x99w\x81L\x98\xa9\x15\xf9\x7f\x8e\xcd\xfe\xa8ȇ\x15\xb6n\x9d\xa8\x87 \xc2$)\xbdlk\xe1\x06\xd3\x13\x00*\x8d\xc59܊\x06Ɋ\x12\xe5\x04 \xf1\x1e`MAH\x19\xa4)\xea;\xa7\xb4Gw\xcd\x14\xb2\x14\xa7 \x91J\xa7,/\t\xe8!\x02\x84\x88\x10\xc8\v\xdf\x12P[\xae@\x10\xdc\xe2fv\xa3\xef\x9cY:\xa4\b\x0f\xe0\x172\xfaN\xf8\xd5\x1c\x8a\xb8\xbc\xb0+A\x98fYDsx\b\x13i\xc8o\x194y\xa7\xf4r\f\x06\xdf\x11lV\xa8\xc1\xaf\x14A\xbc\x11\xd8\bb8Σ<zp\x98\xdf]qZ\x16\x11\\\xb3\x02\xec\xb6F\bRx\x1c\x03\xb0\x93'\x98\n\xfc\nY\xf2A\xe3\x84\xd2J/\xc3P\xd4\x16\xf0\x06\x16\x18 \xa2\x84֎ \xb3X\x16\xd6\xc8Bg\xa2i\r?w\x8ez\xa6lx\xfd\xff\x1aU\x9a\xe6?\x83\x0e\xbc\x02ʋ\u038d\x8b\xd3d<\xf5cw\xe8\xdc\xc1\xf7H^\x95\xe0\xd0\x1aR\u07b8-(\x89ګJ\xa1\x83ʸ\xae\xda\x1c\x81\xc0{ov\x9bҢ\b%Q\xbfGk\x9e\x89\xa7+\x88d7\x0f\xde8\xb1D\xf8є\xc1\xed\xb0:;\xec\xe93\xadL[KXd\xae\x01\xc8\x1b7\xaa\xdc|YqW\xa2\x9b\xc9\x1e\xd8X\xff\xcc\xe3\xe8;\xb4\xb3\x93-\x06\x0e\xb2G\xfb\xed\x12\xc7-'\xcal\xfd}x\xa0r\x85M\xf0\xd7\xfcd,\xea\xb7w7\x1f\xff\xf8\xd0\x1b\x06\xb0\xceXt^e\xd7\x19?\x9d\x88\xd1\x19\x85\xbe\xa8/\x99`\\\x05\x92C\x05RԿ8\x862a\x88ס\x88\x95\xc4!\xa1\xf6]\x91䏩@h0\x8b_\xb0\xf4\x05<\xa0cߙ/\xa64z\x8d\u0383\xc3\xd2,\xb5\xfa\xf7\x8e6\xb1\x9a\xf3\xa1\xb5\xf0\x98<\xf8\xfe\x13\x9c\xac\x165\xacE\xdd\xe2\x15\b-\xa1\x11[pȧ@\xab;\xf4\xc2\x12*\xe0'\xe3\x10\x94\xae\xcc\x1cV\xde[\x9a\xcffK\xe5s\xa4,MӴZ\xf9팍ݩE덣\x99\xc45\xd63R˩p\xe5Jy,}\xebp&\xac\x9a\x06\xe8\x9a\x19\xa6\xa2\x91߸\x14[鲇u\xa0\x18\xf1\x1b\x02ى\x1b\xe0P\x06\x8a@\xa4\xad\x91ѽ\xa0\xb3+\xba\xff\xdb\xc3#䣃\xe6\xf7\x88B\x92\xfb~#\xed\xaf\x80\x05\xa6t\x85ɔ+g\x9apͨ\xa55J\xfb\xf0P\xd6\n\xf5\xa1\xf8\xa9]4\xca\xf3\xbd\xff\xabE\xf2|W\x05\\\x87\xf4\x81]bkYse\x017\x1a\xaeE\x83\xf5\xb5 \xfc\xea\x17\xc0\x92\xa6)\v\xf6yW\xd0\xcd|\xf6\xff\x98\xca<I\xad3\x91ӓ#\xf7u\x90s<X,\xf9\xf6X\x80\xbcSU*y(v\x9c\xe20E)z\x84\xc7\r\x97?\xa3\xde\xe9p\xd1\x01\xb2wc{26\xdd\xf1\xa9\xd9aF\xdf7 \nP\xe7\xcd\xd9\xcb\"\xb8a\x8c\xa0\xe4`\xfb<\x9d\xb8\x06\xfej#\xf1\f\x1f\xb7F\xe2\x18l\xde\n~%\xa2\xb6rn\xc5\xfe\xa8\xd5zx\n\x7f\x8d~\x110k\xe4\x19\\\xe9D\x01\x0e+t\xa8\xd9\n\xcd\xd9\xc4a@\x13z!}\x88\xf1\xb8R\x9c\xf2\ua8c8\xdf\xde\xdddO\x9e\x85\x98\xb0\xfb\xe1\xb9g\xe4\xc3\xdfJa-C\xa0;\x7f\xf6\xe5M\x15\x05ŴXP\x02\xac\xc2\x12{A\x02\x94&\x8fB\x82\xa9F)r\xa1\x02l\xf8\x0eӎ\xab\xe8\xc1\x92\xab܇\x16/\x94\x06\xc1\xbeSI\xf8\xc7Ç\xdb\xd9\xdf\xc7D\xbf\xe3\x02DY\"1!\xe1\xb1A\xed\xafvI\xb9DR\x0e%\xa7\xd8X4B\xab\n\xc9\x17\xe9\ft\xf4\xe9\xcd\xe7q\xe9\x01\xfc`\x1c\xe0\x17\xd1\xd8\x1a\xaf@E\x89\xef\xdcrV\x1aVm\x16ǎ\"l\x94_)=\x19%\t\x82\xb3\xe5\xc4\xf6&\xb0\xeb\xc5\x13\x82I\xec\xb6\b\xb5z\xc29\\\xb0\xfb\xe9\xc0\xfc\x95m緋#T\xff\x10M\xfb\x82\x17]Dp\xbb8\xdc5\xba=\xc8hyN-\x97\xb8Ϫ\x0e\xff\xf1\x16\\\xa3\xf6߂q,\x01m:$\x02aE\xd9Q\xa2\x1c\x80\xfe\xf4\xe6\xf3Q\xc4{:,/PZ\xe2\x17x\x03*\x955\xd6\xc8o\vx\fڱ\xd5^|a\x1fR\xae\f\xe11\xc9\x1a]o\x99\xe7\x95X#\x90\xe1\"\t\xebz\x1a\xf3 \t\x1b\xb1e)\xe4\x8bc5\x16`\x85\xf3'\xb55g?\x8f\x1f\xde\x7f\x98Gd\xacPK\xcdp8jV\x8a\xb3\x19Nc\xc2d\xd4FEG(R\x1b\xe81\xccr%\xf4\x92\xf3\x9apIU\xcb\xe9Iq9\x19\xd9tΎ\x87)ɸ\t\x87\xd4\xe4\xd0q\xfc߂\xfb3\x99c%{\x0es\xdd*\xe3$s\xdc\vq\x1a=\x06\xfe\xa4)\x89Y+\xd1z\x9a\x995\xba\xb5\xc2\xcdlcܓ\xd2\xcb)\xab\xe64\xea\x00\xcd\x18\n;\t\xff\xbd\x9a\x97P\xcd>\x97\xa1^\x95\xfd5\xb9\xe2sh\xf6*\xa6r\x0e\xfb\xfc8v\xf9\x902\xabýl\x16\x9b\x95*W\xb98I>v\x94$\xb0\x056BF\xd7,\xf4\xf6\xab\xab2\v\xb4u\x8ch;M\r\xb6\xa9В\xff&E\x9e\xc7_%\xc1V=\xcb|\x7f\xbey\xff\xfb(x\xab^e\xabG\x12p\xfe\xf6\xfb\t\xf3\xc9IF\xef{\x8bs\xea8\x92\xb1\xee\xd6\x14\x93\x17\x00\xf5b9\x92\x8au\x1b\x81\xa7\x12\xb6\x93\x12\xe8\xb1\xf1(\x96\x04\xc2!\bh\x84\xe5\x9b{\xc2\xed4\x86x+\x94c\xb6\x84\xcf\xe5\xf4\x02AX[\xab\xd1P\xecM7\tM\xf9\xbe\xa0\xc0J\xf1\x92{hmm\x84D\xf7\xc8\\\x9c\x86\xffsgi\xbe\x03\xa6\x9cU0\x93\x8a\\\xac\x84\x96uj6pi6\xa0\ry_\xecn\x15pS\x016\xd6o\xaf\xf2\xcd*\x82\x96\xc6\xca\x0f\xd4m3\x04;M\xfbF&\x9e\x8cUb\U000826cb\x98\xce\b$u~\x15\r\x92\xaat/lJ)\x9asm\x11\x9a\x8c\x03\x92p\xaaV8\n\x91\xcbuNb\xfb\x10\xa7\xb0\x18\xab\x11\x0f\xd6p\x9du0d\x8d<\x18\x19m\xf8\xe5\xc9^C\xf2\xa4\x8eq\xfa\xdd\x1e\xd8\xcd\xc9r;\xac\xcf\xea\x15\x9d\xab\xcf]uS\xbd\xbe\xe0.\r'\xed\xfd7\x1a\xa7\xaf\xf7z\xb8#\xf4\xb6\x9cL\xba\xcf]w\x91\x8d\x8f\xbb\xed\xe9\x8c1\x95\x85\x0e\xb9\xb8\x93k\xdb@\reȨ9ᯄ\xaaQ&\x92T\x1c\xee\x19\xa1ڥ\xb2\xc0\x8a3\xb7h\x87\xb9NM\xf0vY+\xb71B\xd3\xe8\x92N\xd0d\xbb\v\x9d\xe1\x11!\f3\xd9ʸF\xf8\xd8䜎\x12\xe5WGbQ\xe3\x1c\xbck\xf1\xf9jέ\x1d\"\xb1<g\x8a?\xc5U\xac7\"o\x01\xb10\xad\xdf\xd5\xef=_yII\xa7\x8a\x97`\xb1\xa3\x95q\x0f\b\x17\xcfY{\xab\xb6\xaeÞT\xff\xed\xea\xad\xf8\x1e\x8e\xcb>X\xe0\xf0\x98\xd7\xfa\x04\x80\xf0\x1e\xe9\x1cB^3f`;\xefu\xd2\xc2N\xf9\xdf[܌\x8c\x0e\xde\x7f\xed?Ӭ\xe1#An\n?\x04kx\x11\xff\xe9\xa0s\"H\xcb`e\xeal\xccƋ\x1at\xdb,б\x1c\x16[\x8f\xd4w\xe7\x03\x9a\x90\x8a\xbc\xbd\x18;\xfb\xf3\xfdEJ\xa9n-\x85\xe6\xe6P\xb0.o@*\xb2\xb5؎\x10\xb6\x19!\x97al\\\xec\x02\xf6\xfa\x9c\x8dڢ\vS/m2\x05L\xef\x8d\x1e1\xab\xae=+\xed\xff\xfc\xa7\xd1\x15\xd1H\xb8u\xbf<\b\x0ei\x9e\xc5\xf9n\xebǏ\xff\xefO8\x12m\xf8KZXZ\x19\x7f\xf3\xfe\x8c\x16<\xec\x16fk\x18\xbc\x15\xc3\x1d\xb5\xa4\n\x03\x8a\xd0\xf1-\xc5KT\xb5\xff\xe6\xf5\x1c\xd4\xde\xe23Q(\xbd\xf3\x1d\xa2\x01x@+\x1c[zxAp}\xf8\x06\xeb\nHq\x03+$p1/\x8d=\t\xe2\xe0\xc4ٕq8\xe22a\x18VzA\xa4\x0f\xff\xf7\x8c\x1f\xa3z2\x18\f\xc8e\x87v\xea\x9cwG\xdaE.Mw*\x9dr\x1b\xf8\xf5\xb7\xc9>\xcdᶣ\xf5(o\x0f\x7f\xe9pq\xd1\xfb\xe9Bx,\x8d\x8eE\x06\xcd\xe1\xd3g\xfe}Bx\xa3\x99\x8a_\x9açϓ\xff\f\x00\xa4i\xc9\xfd\x1e\"\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_o\xe3\xb8\x11\x7f\xf7\xa7\x18\xe4\x1e\xd2\x03\"\xf9n[\xb4\x85\xde\xee\x92^\x91\xf6.\x1b\xacs\xfb\xb2\xd8\aZ\x1cYl$\x92\xe5\x8c\xecu\x8b~\xf7b(\xca\x7f\x15\xdbIq\xdbu\x80\xb5D\xf2Ǚ\xdf\xfc\xe1\f=ɲl\xa2\xbc\xf9\x88\x81\x8c\xb3\x05(o\xf0\v\xa3\x95'ʟ\xffL\xb9q\xd3\xe5\xf7\x93gcu\x01\xb7\x1d\xb1k? \xb9.\x94x\x87\x95\xb1\x86\x8d\xb3\x93\x16YiŪ\x98\x00(k\x1d+yM\xf2\bP:\xcb\xc15\r\x86l\x816\x7f\xee\xe68\xefL\xa31D\xf0a\xeb\xe5w\xf9\x9f\xf2\xef&\x00e\xc0\xb8\xfcɴH\xacZ_\x80\xed\x9af\x02`U\x8b\x05x\xa7\x97\xae\xe9Z\fH\xec\x02R\xbe\xc4\x06\x83ˍ\x9b\x90\xc7Rv]\x04\xd7\xf9\x02\xb6\x03\xfd\xe2$Q\xafͣ\xd3\x1f#·\x1e'\x0e5\x86\xf8\xef\xa3\xc3?\x1b\xe28\xc57]P͈\x1cq\x94\x8c]t\x8d\n\xc7\xe3\x13\x00*\x9d\xc7\x02\x1eT\x8b\xe4U\x89z\x02\x90\b\x88\xa2eI\xc5\xe5\xf7=VYc\x1bI\x95'\xe7\xd1\xfe\xf0x\xff\xf1\xf7\xb3\xbd\xd7\x00>8\x8f\x81͠^\xff\xd91\xeb\xce[\x00\x8dT\x06\xe3\x85\xe1\x02\xae\x05\xb0\x9f\x05Z\xec\x89\x04\\\xe3 \x14\xea$\x03\xb8\n\xb86\x04\x01}@B\xdb[x\x0f\x18d\x92\xb2\xe0\xe6\xff\xc0\x92s\x98a\x10\x18\xa0\xdau\x8d\x167Xb`\bX\xba\x855\xff\xda`\x13\xb0\x8b\x9b6\x8a1q\xbc\xfd\x18\xcb\x18\xacj`\xa9\x9a\x0eo@Y\r\xadZC@\xd9\x05:\xbb\x83\x17\xa7P\x0e\xbf\xb8\x80`l\xe5\n\xa8\x99=\x15\xd3\xe9\xc2\xf0\xe0Υk\xdb\xce\x1a^O\xa3g\x9ay\xc7.\xd0T\xe3\x12\x9b)\x99E\xa6BY\x1bƒ\xbb\x80S\xe5M\x16E\xb7\xa20\xe5\xad\xfe&\xa4\x00\xa0\xeb=Yy-\xb6%\x0e\xc6.v\x06\xa2\xb3\x9d\xb0\x80x\x1b\x18\x02\x95\x96\xf6\x8an\x89\x96W\xc2·\xbf̞`\xd8:\x1ac\x0f\x14\x12\xefۅ\xb45\x81\x10fl\x85!\xae\x83*\xb862\x8eV{g,Ǉ\xb21h\x0f\xe9\xa7n\xde\x1a\x16\xbb\xff\xb3Cb\xb1U\x0e\xb71\xc6a\x8e\xd0y\xad\x18u\x0e\xf7\x16nU\x8bͭ\"\xfc\xcd\r LS&\xc4^f\x82\xdd\xf4\xb4\xfd'(Ebmg`H!/\xd8\xeb0-\xcc<\x96b>aP\x96\x9aʔ16\xa0r\x01\xd4Q\x1a\xc9\xf7\xa0\xc7CW>sU>w~\xc6.\xa8\x05\xfe\xecz\xcc\xc3I\a\xb2\xfd8\xb6f\x10N2\x8bD\xa8|\xef\xc1A\x04R\v<\x02\x05h\x86ū\x1a\x03F\xf7\x90lkJq/G\x86]X\v\xb0 \xa0\xde\xd7\xe9\x84!\xe4\xcf;}F\x8dG\x97\x02\"`\x85\x01\xad\xb8{\x9f!\xbc\x8by\x84\x95\xb1CX\xf4G\x01\xb0;\xc2\x04qЀ/\x89\xf82\xf5\xa7\xb2\xe7\xa8\xc0?<\xde\x0f\x19s`8\x89\xce\xc7\xfb\x9e\xa1G\xfe*\x83\x8d~T\\_\xb0\xf7\xf5}\xd5o&X\u0093\x02o\xb0Ľd\f\xc6\x12\xa3\xd2\xe0\xaaQD9\xb5A\x02,`Zq\xd3g\x8a\x94\x92\xb6)\\\xa8\a%9\xcah\xf8\xdb\xec\xfd\xc3\xf4\xafc\xcco\xb4\x00U\x96H\x02\xa4\x18[\xb4|\x03ԕ5(\x12\x9b\x9b\x80zƊ1o\x955\x15\x12\xe7i\x0f\f\xf4\xe9\xdd\xe7q\xf6\x00~r\x01\xf0\x8bj}\x837`z\xc67\xe9o\xf0\x19\xf1{\xa1c\x83\b+õ\xb1\x93QHPr`'\xb5WQ]V\xcf\b.\xa9\xdb!4\xe6\x19\v\xb8\x92(\xdf\x11\xf3\xdf\x12X\xff\xb9z\x01\xf5w}\x00]ɤ\xab^\xb8\xcdy\xb7\x1b\x91[!\xb9V\f\x1c\xccb\x81!\x16\bc\x1fY\x82K\xb4\xfc-\xb8 \fX\xb7\x03\x11\x81%:\xfb|\x84\xfaH\xe8O\xef>\xbf(\xf1\x16G\xf8\x02c5~\x81w`lύw\xfa\xdb\x1c\x9e\xe4+\xad-\xab/\x12\xabe\xed\b_b\xd6\xd9f-:\xd7j\x89@\xaeEXa\xd3d}\xbd\xa1a\xa5\xd6\xc2\xc2`8qc\x05^\x05>\xe9\xadC\x95\xf1\xf4\xfe\xee}\xd1K&\x0e\xb5\xb0\"\x8e\x9cN\x95\x91\xaaAʅ8\xd8{\xa3\xa1\x17\x10\xa9\x8bx\"fY+\xbb\x90\xfa!\x1a\xa9\xea\xa4\fȯ'#\x8b\xce\xc5\xf1\xf1\xd1?\x1e±\x048L\x1c\xff\xb7C\xf4B\xe5\xc4\xc9.Q\xeea\xc7\xcbO*'\x8dA\xb0\xc8\x18\xf5Ӯ$Q\xadD\xcf4uK\fK\x83\xab\xe9ʅgc\x17\x99\xb8f\xd6\xfb\x00ME\x14\x9a~\x13\xff{\xb3.\xb1 \xbfT\xa18\xf9kh%\xfb\xd0\xf4MJ\r\xb5\xe2\xe5\xe7\xd8\xf5,\x150\x87k%,V\xb5)\xeb\xa1\tH9v\x14\x12$\x02[\xa5\xfbԬ\xec\xfa7we!\xb4\v\"\xd1:K\xddf\xa6\xac\x96\xefd\x88\xe5\xfd\x9b\x18\xec\xccE\xe1\xfb\xeb\xfd\xdd\xd7q\xf0μ)V_(t\xe5O\xaa\xb9{-TV\x06C19\xa9臽\xc9C]9R\x17n\xe6\xe4\x93W\bJVy\xaa\x1d\xdfߝ\x91c\xb6\x998Ȱ5@*\a\a,qܓU\xe0\ty:\xdf8\xa51<ɔ\xd3\x12\xfd\xba3u\x90I\x90\a\xa9\x06\xa8\xfep\xaf\x95\xd5Mj\xb4\xa5-9\u0086}mr\xb8\xaf\x00[\xcf뛁mC\xd0ј>h\xbb\xf6X\xd8,\xad\x1b\x19xvި\xd7\xd0\xd2\xcbt\x86\x90\xbe\xe5\x19k=\x92\x81Ľ\xd3\t+\xe5~4\xd3\x11$\xbc\xc5pҩJ]\xb9/a6\xdeP\x1d\xcc\xf1n\xbf\xe0\xca\x0e\x02\xe4`p\xeb\xb1\a\x03\xbd\x92\x93\v\x82P\xea\xe2\xee\xa0\x039\xddo\xc6\x05\x03\xb3}\xda\xe3\x04#\x1c\xbf\xbd\xe3,\x9d\xd4\xd3\xfb7o\xa7\xad|{\xbc\"^\xef\x04\x9dB\xc0\xb4\x18۸(9\xac\x14\r\x9b\x8cY\x14v\xf0\xfa\xa5\xf1\xbe\xa9tA\xa3\x8eծ\x14\xe3\x952\r\xea\x01\x93\xa4\x12E\xa0x\xcfq=V\xdc\r@\x12.\xb1%\x1f\x11\xfax]\xe5B\xab\xb8\x00\xb9\xdd\xc8\x04\xe2h\x86\\I\xaay\x83\x05p\xe8\xf0r\xf7\x94\xdb\b\"\xb58\x17A\xbf\xf4\xb3Dt5,\x015w\x1do:\xe1\x14J\x89\x8akJ^\x90\xbfF\x18_+:'ʣ\xcc\x19\xf3\xb8MP\x9fv\xb9Sy\xe9\x01W#o\xef\xedcp\x8b\x80tl\x99l\xf0\x92\x91\xde(\x83\x9f\xa2w\xbc\x8a\x80\xb4\xd19\x0e\xd24\xa8]3x\xb7cՀ\xed\xda9\x06!b\xbef\xa4\x81\x91!5\x1c\xa1BjI\xb6Ln\x11\x92%u\x0f\x95\x9a\xacRY\xb9Ȉ\xfe\xcb\x0e\xb4!ߨ\xf5\b\xae\x1fD\x94\x9eA\xdcW\xe2h\xeb1\t\x1c$\xfc\xe3\xd8k\xafD\xa2PwΎ\xb8\xcbn\xc8\x18\xcb\x7f\xfc\xc3\xe8\x8c\xde\r\xe5Bwq\x90JӸ\x10\xfa\xe3\x9aǷ\xff\xdfwx!\x05\xa74\x1cx\x93\x0f\xce\xf8\xc2lo\xf2\xb9\x8c\x17\xa1\xc7\xf3\xddn\xea:NT\xfb\xdb|\xcd\x1c5J\xd4\xd1\xcb(\xb9\xde\xc1N\u05c9\xe9\xcd\xf6d\x93+ Ϩ\x1f\x0e\x7f\x81\xb9\xba\xda\xfbA%>\x96\xce\xea\xf8\xa3\x12\x15\xf0\xe9\xb3\xfcf\"\tE\xa7F\x84\n\xf8\xf4y\xf2\xdf\x01\x00ð\xe1E\xb7\x1a\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOo\xe3\xb6\x13\xbd\xfbS\f\xf6w\xd8\xcbO\xf2.zh\xa1[\x9b\xb6@\xd0$X8\x8b\\\x16{\xa0\xa9\x91͆\"Y\xceЩ\xfb鋡\xa4X\x96\x948\xbb@\xa3\x1c\"r\xe6\xf1͛?bVEQ\xacT0\x0f\x18\xc9xW\x81\n\x06\xffft\xf2F\xe5\xe3OT\x1a\xbf>|\\=\x1aWWp\x95\x88}\xbbA\xf2)j\xfc\x15\x1b\xe3\f\x1b\xefV-\xb2\xaa\x15\xabj\x05\xa0\x9c\xf3\xacd\x99\xe4\x15@{\xc7\xd1[\x8b\xb1ء+\x1f\xd3\x16\xb7\xc9\xd8\x1ac\x06\x1f\x8e>|(\x7f,?\xac\x00t\xc4\xec\xfeٴH\xac\xdaP\x81K֮\x00\x9cj\xb1\x82\x88\xc4FG\f\x9e\f\xfbh\x90\xca\x03Z\x8c\xbe4~E\x01\xb5\x1c\xbb\x8b>\x85\nN\x1b\x9dwO\xa9\vg\x93\x816\x03\xd01oYC\xfc\xc7\xe2\xf6\x8d!\xce&\xc1\xa6\xa8\xec\x12\x91\xbcM\xc6\xed\x92Uqf \a\x90\xf6\x01+\xb8S-RP\x1a\xeb\x15@/A\xe6V\xf4A\x1e>vXz\x8fm\x96U\xde|@\xf7\xf3\xa7\xeb\x87\x1f\xeeϖ\x01B\xf4\x01#\x9b!\xbe\xee\x19%v\xb4\nP#\xe9h\x82h\\\xc1{\x01쬠\x96\x8c\"\x01\xefq \x85u\xcf\x01|\x03\xbc7\x04\x11CDB\xd7\xe5\xf8\f\x18\xc4H9\xf0\xdb?Qs\t\xf7\x18\x05\x06h\uf4ed\xa5\x10\x0e\x18\x19\"j\xbfs\xe6\x9fgl\x02\xf6\xf9P\xab\x18{\x91O\x8fq\x8c\xd1)\v\ae\x13\xfe\x1f\x94\xab\xa1UG\x88(\xa7@r#\xbclB%\xdc\xfa\x88`\\\xe3+\xd83\a\xaa\xd6\xeb\x9dᡠ\xb5o\xdb\xe4\f\x1f\u05f96\xcd6\xb1\x8f\xb4\xae\xf1\x80vMfW\xa8\xa8\xf7\x86Qs\x8a\xb8V\xc1\x14\x99\xba\x93\x80\xa9l\xeb\xffž\x05\xe8\xfd\x19W>Jn\x89\xa3q\xbb\xd1F\xae\xb6W2 \xe5\x06\x86@\xf5\xae]\xa0'\xa1eI\xd4\xd9\xfcv\xff\x19\x86\xa3s2\xce@\xa1\xd7\xfd\xe4H\xa7\x14\x88`\xc65\x18\xb3\x1f4ѷYqtu\xf0\xc6q~\xd1֠\x9b\xcaOi\xdb\x1a\x96\xbc\xff\x95\x90XrU\xc2U\xeer\xd8\"\xa4P+ƺ\x84k\aW\xaaE{\xa5\b\xff\xf3\x04\x88\xd2T\x88\xb0oK\xc1x@\x9d~\x04\xa5\xeaU\x1bm\f3\xe4\x85|M\xe7\xc2}@-\xe9\x13\x05\xc5\xd54F\xe7ހ\xc6GP\xb39R\x9eA/\xb7\xae<[\xa5\x1fS\xb8g\x1f\xd5\x0eo|\x8795\x9ap\xfbe\xc9g '\x93E:T\xfe^4\x9ca\x03\xf0^\xf1\xa8\x7fY\x19\xf7<\x06\x16\xe3y%\t\xf2\xdb*ig\xa7\x9c\xc6\xdfsE9}\xbc\x10\xd3킋\x84\xb4\xf7O\xe0\x1bF7\x06\xed\xb9\xce\x10Aj5&\xf7Md\xbb\xf9}]K\xe15\x06\xe3\x05\xa2\x9b\x89\xf9\xa0{\x93\xac\xed\xbf\x05\x85\xf6mPl\xb6\x16\x97\x8f\x94G\xca\xc6t\x87\x1e\xbb\xde\xff~\xbd\x0fަ\x16\x9f?7\x17\"x8\xb7\x1e\x17Nv\x1f\xa8H\x9c#F3P\x18j\x85 \xf8\xba'\xd1\x174I[|C\fR%&\xe2d\x82\x16\xcb\xed1\xb1Y\xaa\xb6\x89\xc94Ǔ\xed\x89~o\x1a\x1f\xac8M\xba\xf9\xf5\x01\x92\x1d\x06\xb1u\x8a\x11\x1d\xf70ү\xdf?B\xac\"\x1e\xb5\x8fܨ.T\xc0\xcd\xdcc &`\xc0\xa6ų~{R4C\x84\xe5Nk|l\x15W \x1f\x8cB\x80f\x16r\xcfS[\x8b\x15pL\xf8\xf6\x1a\x91\x01O\xa4v\x97\xa2\xbb\xed\xac$\"5\xb8\x80\xda\xfa\xc4/H\xcf\xfb9\v\xb8\x90\x8e\vL\xc3^\xd1%\x9e\x9f\xc4f\xa9 \x9e\xe7\xf7e\n\xe8R;?\xa6\x80;|ZXݠ\xaa\x8fK֞\x97\xb7^\x8cp\xb1+f\x8b$W\xc3z\x94g\xea\x1a\xb9_9\xf5\x90\xd2\x1a\x03c}7\xbd\xbd\xbf{wv\x19ϯڻ:\xffGB\x15|\xf9*\xd7m\xf6\x11\xeb\xfe~K\x15|\xf9\xba\xfaw\x00\x83\xf9\xd9\xe0\xf4\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xfbo#7\x92\xff\xef\xfa+\nN\x00\xcd|ג3\xdf\xdc\xee\xdd\x19\x87\v\xbc3Nb$\xf1\x18cc\x16\x8bl.Ku\x97$\x9e[d\x87d\xcb\xd6^\xee\x7f?\x14\xc9~\xe9\xe5&[\x9e\xc7B\xee\xc1n,\xab\xab\xc9b\xb1\x9e\x1fV\xb3\x9c\xbfG\xa5\xb9\x14\xe7\xc0r\x8e\x8f\x06\x05\xfd\xa6\xc7\xf7\xff\xa6\xc7\\\x9e-_\r\xee\xb9H\xcf\xe1u\xa1\x8d\\\xbcC-\v\x95\xe0\x1b\x9cr\xc1\r\x97b\xb0@\xc3Rf\xd8\xf9\x00\x80\t!\r\xa3\x8f5\xfd\n\x90Ha\x94\xcc2T\xa3\x19\x8a\xf1}1\xc1I\xc1\xb3\x14\x95%^>z\xf9\xd5\xf8_\xc7_\r\x00\x12\x85\xf6\xf6;\xbe@m\xd8\"?\aQd\xd9\x00@\xb0\x05\x9e\x83Bm\xa4B=^b\x86J\x8e\xb9\x1c\xe8\x1c\x13z\xd8L\xc9\"?\x87\xfa\x0f\xee\x1e?\x107\x89w\xeev\xfbIƵ\xf9\xa1\xf9\xe9\x8f\\\x1b\xfb\x97<+\x14\xcb\xea\x87\xd9\x0f5\x17\xb3\"c\xaa\xfax\x00\xa0\x13\x99\xe39\\\xb3\x05\xea\x9c%\x98\x0e\x00\xfc\x9c\xeccG~\xd4\xcbW\x8eD2ǅ\xe5\x13\xfd&s\x14\x177W\ufffem}\f\x90\xa2N\x14ω\r\xd5\u0600k`\xf0\xde\u038d\x06`\x17\x01̜\x19P\x98+\xd4(\x8c\x063G`y\x9e\xf1\xc42\xb1\xa2\b \xa7\xd5]\x1a\xa6J.jj\x13\x96\xdc\x179\x18\t\f\fS34\xf0C1A%Р\x86$+\xb4A5\xaeh\xe5J\xe6\xa8\f/\x19뮆\x1c5>]\x9bː\xa6\xeb\xbe\x05)\t\x10\xba!{\x96a\xea9D\xa35s\xae멭O\xc7O\x89\t\x90\x93\xff\xc6Č\xe1\x16\x15\x91\x01=\x97E\x96\x92\xdc-Q\x11s\x129\x13\xfc\x1f\x15mM\x13\xa5\x87f̠_\xef\xfa\xe2\u00a0\x12,\x83%\xcb\n<\x05&RX\xb0\x15(\xa4\xa7@!\x1a\xf4\xecW\xf4\x18~\xb2\xcb#\xa6\xf2\x1c\xe6\xc6\xe4\xfa\xfc\xecl\xc6M\xb9\x7f\x12\xb9X\x14\x82\x9bՙ\xdd\n|R\x18\xa9\xf4Y\x8aK\xcc\xce4\x9f\x8d\x98J\xe6\xdc`b\n\x85g,\xe7#;tA\x13\xd6\xe3E\xfaE\xb5l\xc3\xd6X͊$O\x1b\xc5Ŭ\xf1\a+\xe6{V\x80\x04\xdeɒ\xbb\xd5M\xb4f4\x173\xbb$\xef.o\xef\x9ar\xc6u\x8b(x\xbe\xd77\xeaz\t\x88a\\LQ\xd9\xfb\x9c\xb4\x11M\x14i.\xb90\xf6\x01I\xc6Q\xac\xb3_\x17\x93\x057\xb4\xee\xbf\x15\xa8I\xa0\xe5\x18^[\xa5\x02\x13\x84\"O\x99\xc1t\fW\x02^\xb3\x05f\xaf\x99\xc6g_\x00\xe2\xb4\x1e\x11c\xbb-AS\x1f\xd6?\xeeˎk\x8d?\x94\xcak\xc7z\xf9\xdd\x7f\x9bc\xd2\xda1t\x1b\x9f\xfam\x0eS\xa9Zʁ\x94Y\xbdawoZ\xba\xdc\xee'\r\xb6\xfe\x97\xb5\xa1\xfc\xb9\xfa\"\xc9\x0f-a!\xf8o\x05Z\x15\xe7v,n\xa8\x94\r\x92P\x8eϊE{\x90{xJ\xffR\xb5zW\x88'F\xf9\xc6~\xa9\xe4\x0fjx\x98\xa3\x99[Q\xc4\xea\xd1^GH\x91\xd1\xceΥZ\x97C\xba\x1eH\xb7r\x03\x0f\xf6\xbb\xa9$\xbd\x81,\x99\x037\xb8(\xe7\xeb&z\n\x0f\xdc\xccea \x9931\xa3=\xc4\xc4\xca\xcc7\xe7@\x17\x17~\x03\xac)\xd6\xf2r,\x98H\x99![\xd7y\xf8\x98dE\x8aieq\xf4\x13\xfc\xb8ܸ\x81T\xa3a\\\x90\x0e \x13HS\x11\xf5_ɤl\x90\x04`\n\x81v!\x17\x8e^9\v\xcf\xd2\xcdY\x10\x97\xb6\fn\xef\n\x83\xb5\xf5l\x92\xe19\x18U\xe0ƟݽL)\xb6\xda\xc1\x98\xd2?\xe9ʗ\xea\xfb^)f<\xc1\xa6\xb1\xb4\xd2M\xe2\xce\f\xf1`\x83(|\xe2\\\xe1\xdap1+gy#3\x9e\xac\x9edͶ\x9b\x1a[\xaa1C\x98\xe0\x9c-\xb9T\x1b$\xc1j%\xfajÙ\xa8\r\x8a\x84IE$\xa5M*\x80\x1b`\x99B\x96\xaeܸ\xf5\x93{ǚ\xe7\x94O\xa7\xa8\x1af\x86\xf6$\xa6\xa3\"/\xfd\x8a\xcde@Q,6\xb90\x02!\xc5&{G\xde\xee\f\x02\xd6l.\xe5\xfdS\"\xf8=}\xa76\xa0\x90X\a\xbb\xe2\xa8\x17:\xaf\xab&\b\xf8\x88Ia\xac\x8f\xb9~\xa5\x05\xed&\x90\nr\xa9\xcdn\xf1\xdbm\x06\xbcf\u07b5w\xf6\xca\xee.\xabU\n\x10M\xb4e\xc1\xa4@\x1a\xeb\x82\x04\xa8\xfe\xae\x92\x85\xfb\ued85\xf7\x1c\xdf\xce\x11\x980\x8d)H\xbf\xf9\x8a\f\xb5\x7fVj\xa5\xb0Vo\xa7;IW\x93wN_\xc6&\x98\x81\xc6\f\x13#\xb7(\xe9.\xfc쮲w\xf0q\x8b\xf2n\xef\xc2zb{H\x02\xed\xb6\x879O\xe6\xce\x1f#ٴ\xbb\x19R\x89\xda\xea/\x8a\x19V\xbb&\xf9\xe4\xda?\xb9\x1b\x02tY\x17\xad\xb6\xc9\xdbR\xd2\xc2Y[ݹ\xa9\xdf\xfc\xe7F\xee\xa1\t\xff\xa4\x8c\xe5b]\xf2:s\xf6j\xe3\xd6\xc3\n-\xb1\x94\xa3\x1e\xc3\xd5\x14p\x91\x9bթ\xb5\x1c\xeeӧ(\xb2,k<\xff3^\x98p\x89\xbfZ\xbf\xf3\xa0\x12\xbfwU\x9e\xa2H\xabR=\xfe3\\\x14k,n\xbd\xad\xe8\xbc ?6\xef:\x05>\xad\x16$=\x85)\xcf\f\xaa\xb5\x95\xe9\xb5_\x0e\xc1\x8c.\xf6\x8e\xae\x053\xc9\xfc\xf2\x91\xf2RU.\f\xa0#_\xd6o\x06\xde\fUچ\xf9\t\xba\xe4\xd3\xfcVp\x85\vJ\x8f\x8d\xe1n\x8e\xadOȥ\x87\x8b\xeb7\x98\ue4fa\x8e\x92\xb71\x91\x8b\xb5\xc16\x1f\xedÍ\xae\xd3\xf0\xaeO\x15\xba٬\x8d>\x05\x06\xf7\xb8r\x1e\v\xe5\xc2rT\x8c\x1e\xb4#\x88[\xbf\x14\xda$\x98\xdd\xfe\xf7\xb8\xb2d|V\xebɻ\xbb\x8a\x82OKᖨ\xe3I\x06Ҙ|\xae\xc1q\x92>\xa0\xb9ُ:ˀW2\x95.zj\xad\x83\x14Iy\x95\xbc\x8f\x98f\xb5lu2\xcd-\xec\x902a\x99\xcd\xf1\xe89\xcf;Q\xb6\x86\x93$\xcb\xee\x962G\xf9\x9ee<\xad\xc6\xe8\xe4\xfeJ\x9c\x0e:\x11\x84ki\xaeĩ\v\f\xb5\x95\x927\x12\xf5\xb54\xf6\x93ga\xa7\x1bx\x043ݍv{\t\xa7\xb6\x89\x0f\xcddg\a\xe1v\xff\xae\xa6VΪ\xe5\xe1\x9a\x12\x8fR\x95\xfc\xa0?\xfa\xc7\xed\xb7\x0f\xed\x9fE\xa1\rE/B\x8a\x915\x95\xe3mO\xba\xdc\x15\x02o\xbb\xa4j\xad\xc8\xe6Ъ\x87\xba\av${G\x9e\x97\x9d\x1a\xf1Sa\x9eQ\x8d\xa3\x8c6m\n\x99\x19\x9c\xf1\x04\x16\xa8f8x\x92\xa0\xfd\x97\x93~\xef6\x84\x8eZ7Jº\x99\xf6\xf2ǫ\xee\xb5\xdc\xfa\xb6kD;\xb7÷\xca\xc5~\xf2\xab;2\xc7}fdM\xac\xf5?\x9e\xe4.KS[\xe6c\xd9M\x80\xc6\x0fX\x8b\xd6\xeem\f\x8cD\x8e\xc1\x82\xe5\xb4\x7f\xff\x87̜\x15\xe8\xff\x85\x9cq\xd5a\x0f_؊]\x86\xad{}\xfe\xa8\xf9\x18z\x02\xd7@\xeb\xbbd\xd9fMb\xf3\x87\x14\xac\x00̬WA\xa3[\xf7XN\xe1a.5\x92 \xc0\x94c\x96\x0e\x9e\xa0Hs=\xb9\xc7\xd5\xc9\xe9\x86\x1e8\xb9\x12'\xce\xc0\a\xab\x9b\xca[\xb0\x89\xee\x13{\xefI\x1f'\xa8\xa3$v\xfa\x9a\xd8Zq\xd8!\x16ͪC]n\xf0n\xeex\xd0S\x0es\x99v\x1eɍL\xbd\xd7\xe6F\x93K\xb70\x14\x9fj[\x06\xb0\xbf\xfa\xac\xd6\x1e\xaa\xbexl\x99_屸\x18\xc3;\xafi\xaa\xfc\xa9\xa7\xe5\xbe\xffA\xdd\xf8\xceq\xcd\xfe\xd8f\x9da\xd6\xdei\\\xa2b\xd9\x13D\x81\xbeO{\xd4$s\xb77\xa6\\Q  \x10&+\x1b\xca;Ϙk14`P-\xb8`ۓ\xa4\xed\x8bk(\xf4\xd3\"\x1f\xe2殫\x81\xa7\xefX\xe3\xdaA#\x9fCF?\x81\xb6\xf8\xf9\xa2\xa0犄\xfaFC\xa1\xa2\x12\x18\x15=od\x14\x13\x1duV\xae\xed\xab\\\x97\xc8i\x1f8Rz\x9eh\xe9P\x11S$\x8bC\"\xa7\r\x06\x1f0zz\xc6\bꙢ\xa8g\x8c\xa4\x9e/\x9a\n\x8c\xa8\x82\xa3\xaaH),o꾬\xdd#\xac\xeeQV`\xa4\xd5\xd9y\x8d\x9be#\xea\xe82ɸ\xc8+b\xbdZ\x1a\xe0\x80\x11\xd8\xf3Fa\xcf\x14\x89=[4\xf6,\x11Y\xa0\xc4v\xfejU#;\x1f\x04\xc8NU\xf2k\xc6j\xee\x03\x8f\x97\xca\xe5\x93S\xea(\xbc]\xd4Ũ\x1d\xc1\xec\xfdf5\xd4AO\xf6\x11\x02\xe3\xfb\xed\xf0\x8f\x1dl\xbb)\xefh\xfb\xfb>\xee+\xebu\xfb\x91\x11~7\xf8H\xb22*\xe4\rO\r*\x0f\t\xb1\x9fU\xf5\xa4\U000605cdh\xcda\xcb`+\xb8\a+\x01)6\\\xdfK\x13<\x96\xb1\xcb\x10C<n\xe2\xcbS\xdfY\x9b\xd1\xe5c\x03\xb1\u0084\x85ߴ&2\x1e\x1c6\" \xa0*[G\xefv\x1a\xeakwg\xb9\xeb<!\x17\v\xa9YauJ'\xaa-\x19\xb2\x80*\xcahp\x01\xacT]\x04\xba\xb2\x02\xc5(2\xefHt\xce4L\x10EɾNj-\xd0O\t\xb4y\x00\v.\xaelz\x19^\r\x0e\xef\xcfT\xec\x8aZΒ\xd5ՂV\x1fX\xcbى$i\"\x8b\xacSؒ\x8aM\xf8\x14\xe5c:\x92$LK\xa3J\xedU\xfaP\xfb\xb4Lc\xa0]\x9d\xee.I\x98\xa8\x15\xa6\xd9\xd1)\x12Y\x98\x885\xb8\xacﮔ\x00\xcdv\xc1\x1e\xf9\xa2X\x00[Ȣ\xa3\x93\x02\xa4\xcc\r_T\xe8h\xbf\x02\x0f\x8c\x9b*\xc9G\x9a\x916_\"\x17y\x86[\xc0\x86ۯ\tN)5\x98H\xa1y\x8a\xaaD\xef\xd3\xdc\v\xf2\x1d\x81\xc1\x94\xf1\xac\xd8\x06\x06<\x00\x8f\xa5\xb8TJ\xaa\b\xfe\xbeuwV\xc2D\xa9܇6\x83:\x11%\x16\xcc\xd9\x12\t>\xc1\r\xa0Hh]\b9A*\xdb>\xc23C̶\x1dc\xd8\xf5\xd3M\xc1\xefƒn\xfb\x19ٝ\xcd\xc5\xde\xdcl}\x8d\xe0[Ƴ\xe7X6\x92</\xdc\x11K\xf7\x97\xfa\xee\x0f\xb25*\xa5ґ\xa4\xc3\x16\xbf\xb3@b\xbf?\x981\x14\xae\xdb\xed!A\x15\x1eE\xec\xec\xe43\xec\x8c.\xcei\xb9\xc8^/?\xf9\xcdξ;\xa1\xf7x\a\x9d\xd7Z\xd4+\xc1\xeb\xd5d\u0092xVo\x87\x1eP\x19:\x1d!\x86W-\x02\xe4\xfb\x94\x8e3\x91\xaemf\xd7\x1d\xefĆ\xa5\x04㧸ҚO\xefG\xbb3I;@ս]\x97ִ\xaa\x80\xb9q\x8e\xaf\x9eLG\x8a>I\xbe\x92\x05<0:p儾r\xe6:\x84aq\xab\xea3\x17j\x16\xf0\xed5\x06\f/J\x97\xb5<\xa9\x87¨\x95=9\xd6u\xd0e\xc2\r!\x95\xc9=*\xe0\v6\xc3\xe1P\xc3\xeb\x9fޔ\xa5\x1f2\x19\x01\x16\xc1/\xac\xc3\xf5\xe6J.yJ\xae\xd3{\xa68\x15\xdd@\xe1\x14\x15\n\x02V~\xf9\xe2\xfdŻ_\xaf/~\xba|\x19D\x9c\xc2~|̙ \x19,tiͫէ\t\xa0Xr%\xc5\x02C\xb9q5\x05\x06\xcbr\xb4Iu\xa8\x8eB\xadl齹 \x8aՌ˄\x0e\x17ya\xbc\x8e\x84\a\x9ee0\xe9\xea\xc8xgP\xd8\x03T\xc4\xd77\xb2\xa0q~\xf9\xa5\xadR)L\x8b\xc4o\xcc \x8a~3}y\xea\xc1\x91,\xcb䃶\xb6\x05u\xc2r\xcf\xe3 \x9a\x8d\xe5\x05\xbd\x12\x86=\x9e\x03\x1f\xe3\x18N\xbel\xfc\xe9$\x88\xa6\xe5V\xae$M\xd3.\xba\xe7b\xc6\r\x95I\xe1\xa4I9l\xe1/i\x9e\x986\x05\xd4>MP\x05\x16&\xb5ȅ\x148(_;c*\xcdPkҹ\xcdcv\x95\x90\xed<Ƴ\xfb\xa2\xd3\x1a\xd2l=\xf4Y\x1f\xf3\f\xa2X\x9eɽ\xaf\x8e!ѩ\xd0T&\xfa\xcc0}\xafϸ \x93:\xa2#\x9b\xa3\x86\xd2=s\xd6p\xe4\xed\U000e8324G\xd5v<\xfbB\x15Bp1\x1b\xb1\xea[\\\x8c\xd8H\xcf1ˆ\x83\x9dC\xeag.\"\xfc\x91\xd8(6\"1\xb1M\xa3_V\n\xdc\xe5J\xc7T\xff\xa9\xc2\xcf\x00\xb2P\x9b0\xcb\xe3\xf1V\x1d\x7fy}\xf7\xee\xaf7o\xaf\xae\xef\x82H\xaf\x99\x85ݪ>NI\xb6\xcc\xc2\x16U\x1fDu\xafYh\xab\xfa \xba;\xcc\u0086\xaa\x0f\"\xba\xcd,l\xaa\xfa \x92[\xcc\xc2\x0eU\x1fDv\xdd,\xecT\xf5AT\xdbfa\x97\xaa\x0f\"\xb9\xdd,lQ\xf5ATw\x98\x85\xb6\xaa\x0f\xa3\xb8\xdb,\xac\xa9\xfa \xb2\xdb\xcd\xc2Q\xd5\xf7V\xf5(\x96\xd1j\xfeG\x1f~5TQ\xb5\xe6aN\x80\x91\x16\x91\xc1E[\xcfm\xf3\n\x9e\x97\xf3\xad\xf9]\x8a\xe5{ֆ\x9e\x88\xe6d\x83(C\xbd\x1d<9Ҭ\xac\xce\xfd\x86\xf9x1QZ]\xe9\v\xbdg\x8d1\u05cd\x06\x10\xf1\xfch\xf2d\f?y\x94\x05\x83\u05ff^\xbd\xb9\xbc\xbe\xbb\xfa\xf6\xea\xf2]\x18Sz\xec\x9d\nHӓ5\xc3-\xe1a0Ex\xc2s\b6ȥ\xcc\xe0\x92\xcbBg+\x9f\xf8I\x9b\xab\x17\xb9u\xfdV[۹\x1e\x96\xb7\x02\x8dj\xc9\xf7\xd6Xw][\x87\xd6\xc7\xd5\xe9\xe8\xf0D\xd0\xdc\x13\r7ܞ\b»cb\xef\xfcD\xd0<hd\xfc|\xf1q\xa7(9\x82\xe2a\x1d\xa8\xaenT\x04\xd1\xfd16tD7\xb5/\xeb~\xbd\xc1)+2\x97m;9\x19\x0f?\xb8\x8a\xfdVɎ\x05\x94\x9dj\xf6ւ\x0e\xaa\x8aACW\xf40BC\x0f.n\xb9\x1d\x1a\xd3\x18\x8d\u0c65eL\x19\x8c\x1d\xeck\xe5}Iz\xcag?\xb1\xfc\a\\\xbd\xc3i\f\x89u\xb6{\\\xbf\x85\xe0\x86\x86\x06\xf5\x8f\xf5z\xdc\xd0\xc2yҟ/\xc1H\xec\xbd<\xb9\xf3\xe8q\xeb\xc3\x12{\xe2\xa6\xd4sc\xf5\xf3\xee\xb6Nl\xd8p\xf3\xa2)V\xf9\x10\xd35pK\xa4H07\xfaL.\xc9w\xc0\x87\xb3\a\xa9\xee)\xe9F\xa9\xa0\x91\xab\x87\xe93\x9a\xa8>\xfb\xc2\xfe_\x8f\xd1ݽ}\xf3\xf6\x1c.\xd2\x14\xa4U\xb5\x85\xc6i\x919\xe8`\x10\xf2y\xfd\xaa\xfb#\x9e\x02\xb5\x92;\x85\x82\xa7\xdf\f\a\x91\xe4\x0e!\x1b\xd2.,\xcb\x0e$\x1f\xd4\xe1\x87OW\xa5\x95\x8a&J\xb5+\xac5\x02\xa5\t\xa8\xfc\xd6\x15\xee\xbb\x1f-\xee\x1d\xddhJ\xfbz\xb3u\xfb\xe9^\x1a\xee\a{\xeeQ>\xdev\xd9\x1dp\x18\xab1\xac\xcdFwH\xee\xe6O\x8d(=\a]\xe4\xd4\xc5OW\xbd\x17Ǥ\bN\a\x11d\x1b\r\x1c\xc7\x15&\xf4\x14\xfe^}h!\xa5\xfa\xe7\xe1\xf0?~\xb8\xfc\xeb\x7f\x0e\x87\xbf\xfc=\xf695\xcdF\xdb\xdcC\x10&P\xcdX\xc8\x14Ie\x9fZ\x8c\xcd\xd8G^\x17\x89\x05\xc8\\\xf7`\x8f6\xcc\x14z<\x97\xda\\ݜ\x96\xbf\xe62\xbd\xba\xe9I\xd2\xd2\xd0\xe3\xe1Gr\x02v\xf5\xb0\x8d\x96tO͋j4Ͳq\xb0\x95\xf7oi\xcb\xdc03\xef\x0e\xb1\xdb\xf6\xf3\xa0\xb81H8\x0f{h\x92\x12\xbb\xa7\x94\x06\xb0\xa1@\x0f\xbaF\xc2\xc9\xf2U`\x85\xf2\xc0\x86mZ\xb2\xe8@\xcbh\xb9\xed\xd5M\x1f\x8dU\xa56I\xfd\x959\x92\nMك\xe8\xc5\xcd\xd5\xee^\x87\x1f\x8c\xf1}-[\xb5l\x1fþ\x95\x80\xf3o\x9f\xc5Ε\xd4\xfb\x99\xba*\x9dv^\xb6\xae\xed\xd2\x17j\xf7O\xc6\x17ܟN\xac\x1a.\xbfp\x1f\x8e\x93\xbc\x88U\xe6\x9e\xc2\x02\x17R\xadN\xcb_1\x9f゠\f#\x82Q\xb1Y\xb4\xf9)\x87j\x87X\r\xdc?.\x92f\x93\x05\x9b#}9\x88 \xe9\xe1<I\xa1(\xda\xc9V\xa5\x8f\x82\xe9G\xb3o\x95\xfcl\xef\xf6\x1c'\xe4U\xc1\xa2g\xacY\xeb\x0f\x9b\xc6YʬXй\xee2J\xe9A\x98\xe8\xa1XRbg\xad\x83\xf7\aՏ\x00)_r\x1dr\xf8y\xfd\x87\x89\xd5\xdbH\xd5D\xffF~\x12\xd4\xe5~\x86\xaa7\x9d^\xccX\x13\xa4[o\auOWI\x16\x86\xd0\x06S\xa9\x16̔\x9a\x13\x1fs\x19\x97\xb9+\x7f*][{I6a\xfa*&\x8d\xed74\xa1\x92\x958\x87\xffz\xf1\xb7?\xfc>z\xf9͋\x17?\x7f5\xfa\xf7_\xfe\xf0\xe2oc\xfb\x1f\xff\xef\xe57/\x7f/\x7f\xf9\xc3˗/^\xfc\xfc\xc3O\xdf\xdd\xdd\\\xfe\xc2_\xfe\xfe\xb3(\x16\xf7\xee\xb7\xdf_\xfc\x8c\x97\xbft$\xf2\xf2\xe57_F\x0f\xf9qTghF\\\x98\x91T#'\x04O\xf6\x1c\xe9\xc2\xdc\xf3ÈҰl\xa1r^Q>\x84\xc76\xfc|]\xab^l\xe8\xe9YiL\x14\x9aO/\xe7\xec\xc6U\xba\xe1\xee\x14S\x97C\xa0\xcfi\xa1\x0f\x9f\x86\xee\x1fz:6\xd5q\v\x1d\v\x1c\x83-\xd0\xf7 kK\xfbKە\xd0?\xe1\x1e#*\"\a\xdba\xc7T\xf91U\xfe\x99\xa6\xcao\xdd\xfe\xa9\xf3\xe4\xb6\xd9c\x0f\xa2\xc7<yl\x9e<\xfa\xe6\xb8ٺ\u05cb\r>\xc0\b#\xb1\x84\xa1\xa5\xfd\xadxB\xefx\x93#\x96˼ȶ\xbd\x8a#\x189T\xda\xfd*&\x0e\xd3X\u07bc֯\x99\xa8q\xe9v\xb4\xe1[p\x13\xeb\x06\x17Y\x06\\8#i\x1fF\xc0\x92P\xa2\xee\xfdF\x98\x02\xa3L\x0f\xe0\x92\xd8`߷Қ~\x10Y\xae)\xeb\xaf\f\x17\xb31\xfc\x85h9\x04\x80Ǣp\x01\x8b\"3<\x0f\x04$U\x11V\xd5[\x05\x98\xd62\xe1\x04\xf4\xadz+\x06\x91̘6\xe5\x92\x10\xf7\xc0\xb0{\x8b\xb8L0%x\x0f\x81\xfa\xa9\x87K\x10\xd1r\xcd'\xd4#\x0e.\xc5ҍ\x8dAZ8H1\x06k\x9f\xedc\xfb\xd8pWھ\x1eZS\xa3^\x83(\xbab\xae_\x009\xad[\xadU\xf5]=\xf80.v\x85~\x89\nCZ\x9c\xb9kէ+\xcf8\x98(\xd8w\xa0\r>l\x98\x11\xef\xe6\xeetqkG5\x8a.|r\xee\xed\xb3\xb8\xb6\x87tk{\xba\xb4\xfd\xdc\xd9}\xael\x8f\x88\xa7\xdeQ\x87\x00k\xf4s@\xa3\xfd8\xd2P8\xe5\x8f\xe7\x83^\\\xbd\x10U\xc8\x01<\xa5wQNyT\x9c@>\x93\xc2\x1cEZ\xbd\xbe\x90\f\xb5w~*\x96\xc7\xc8\xf4'\x80\xd0w\x99\x83\xc3(\xf4۵<\xc7Q\x9b\x1f\xb5\xf9Q\x9bGks\xbf\x9d>cU\xfe\x01#e\u06dd\xe2|\x10\xb9h\xc37\x8d\xf3\xcf6#\xd0L\x18\x1e\xea\xac|\xb5_\xab\x90Q\x9f\xd9'\x86mK\xdb\x04\xd7n=\xc2\xc2WF\x8eΰ\xd0\xf9\x13\x98\xf3YhF,\xa379{\xff\x1e\x16L\xb0\x99k\ajdY\xaa\v=\x1dA\x0e\xa6\xe2i#<v\x87\xcbmր\xd4T&Y\x98,ׯ\xc1\xa765\xf7\bo0\xcf\xe4\xcaw\xfb\x14)\xdc\x1afH-ݢ\t\x03\xc0E)\x0f;\x9b\x9b\"\xcbv\xbd\x10\xb6\xab\xe8]\x11!\xc8\v:\x96cI\x8d\xe1\xad\xc0в\xccE\xf6\xc0V\xfa\x14\xae\xe9\xcc\xcc)\\M\xaf\xa5\xb9q\xa7\"\xeb\xf3)A\x14\x8d\xf4D\xe9\xe8\xc59\xa5\x8c\xb4\x01\xc3f$t\x15\xe2*\f\x81\"Uk`\x0e \xfe\xc0u\xdf8=\xd8`nl\xc0/\xecS\xc9t\xdau\xd5\xcf.>\x19\x9fb\xb2J2\x8c\x16\x9c\x8b\x84\xfe_\xd7-\xeb\xeb}\x1b@\x12@\xaf4\xbd\x8d۷\r\xb3\xc9\x1dn\xdbL\xe6Rh$o\xae\xe2V\x10\xddj\x86.a\xa6{\xaeq\xac\x93G\xbddo)\xd3\x16v\xdb\xfa.\xbd)ɐ\xf8',˨\xf9\xd1b\x81)eֲ\xb0L\x15]e\aЊ\xb7\x96\xaeB\xffr\xfe\xb8\xbaל\x894Ce\xfb\x15\xfa\x1c`\x8b~\xc0\xbb=\xd6/\a\xef\xb2)KJ\x84&\x89T\xa9\xef\x05Wv\xf6b[\xdf\x01\xbf\xff\xaa4\x1ei\x82\xa6\xe5\x91\xd3\xf6\xf0\x83)O2\x99\xdck(\x84\xe1Y\xdd\x1e\xb2\xec\r\xa9\x9d}\x0f\xa6\x1a\xa5b\xaa\xff\x1cU{b4\xa7V\xc4g_\xd4\x7f\xb2\x1f\x84\xa8\x9d>\x9b\xa2{?\xdf'\xf6\x05Y*\x12\r\v\xa6\x94\xe1f\xab\xbch\x81\xa6\x92\xdc\x17\x12*\xaf\x8b&\rh\xefx\x10Aն \xadh\x90\xaaD`Vm\x92Z#U\x17C\xb6\x0f\xd3#{\x01\xed\xe4\x7f\xbbmq$\xc5jH\x90q\x81\xcd\xfe\xc5\xdc\xf6D\x8d&\xdb\xda\xc1N\x1f\xf9\b5\x9adʕ}/˪\xd1\xdbҍ\xbd\x0f\x98_Ii\xe0\xc5\xf0l\xf8r\xa3\xa85\x8c\xa7:\xe5\x19:\xeb\xea\x9a,\x95#\xed1P\xcd\x17yFU\"L\x86\xa9}k\xb3?\x0e\xab\n1\x88\xa4\xe9W\xb9l\bu\nZ\x82Q\xac|sB\xfcX\xa9\xbd\x14\x117\xaa\xf0\xbeʋ\xe1\xef\xc3S@\x93\xc4\xe2\x81\x01\x1e$\xbd\xa5\x8a\xc4h\fw\x92\xdaMU\x03\x8f\xa6IM\x1e\x05\xba&H\xf8H\x05(n\xb2\x955\xf3\xd14\xa9\xeb1)\x19z\xb3\x96o\xb4u\xf9ȍ?\xa7\x13Ov\n_\x91\xab`\x9c\xab@%Ɍ/\xf1l\x8e,3\xf3\xd5 \x92\xac\xed.A\xef\x81\xf9\a5\x0f\xa66^\xc2S\x8cS\xbcQ\xb5\xb3\xdeNu\xff4B\xef\xdcE\x9d\x04\xf8\x0eMo\xf3\xfa\xfd\xdd\xdd\xcdwX\xf7\v\x8f\xd7\xf24\xa2\x12\x9fOb\x9e\xa3\"|\xefǰ\x7ft\xea\xed \xc6\xef{\xa9\x8d\x7f\x7f\x9d\rRD\xccR\x95?F\xb6a\xc9\x1e\xd1\bW7\xb1;\x00௲\xa0R\xe3\x84M\xb2U\xd5E\x96\xda2\x9d\xd0\xd0\xe3a\xcf\\\xd8(\xf7{d)eCH\xc5\"\v\x8c\x98\x0f\xb8\xd5\x1ac9Ⱥ\xbe.\xb4\x91\v\x98\xbb\xe9\rz\xa1\x8e+t\xaa\x97\xfd\xb1\xddS\xd14}\x87\x17\xaa\aY\xf5\xeb\xc7\xf8\x91\x94d{7\xdc\xddݸU\xf0ܜD\xa7\xfb\xe9\x1f\x83\xa4\xb9\f\xbe\xb7s\xd1\xef\b\x00\x17v\x98vS\xf4\x18]_\rԷ\xf0\xb3\x95\xff\xe4\xe19^\xf5\xa2\xe9\xcf^\x86\xc3\xd2\x0e\xbe\xad\x1b\xfde>]6\xd9\xe1}|>\xf5\x83ZF\x02\x11\x9bר''z\xb9;\x87\xf0\xb7\xeca\x9e\xf9\xf9\xe0\x00\"f\x0f\x1bS9$IP\xf7p\xb5]$h\x15\x16\x1d\xfd\x0f\x058\x1eP\xc4\b\x7f\x18˚^\a\xde\x0es\xdc\xed \x87\xddZK\xec\x8a\xed\nD\xb1\x98\xf4\xd0$>\xcbH\xec\xad\x05\xc6/|4\xd1*u0\x86k;\xbc\x12\x8d\x13M\xb1ta\xa8\xaf;\xbc\xa2\x91\xfe\xe9\x8f\x7f\xfc\xfa\x8fc\xb8\xee\xa32\xca\xc22\x13puq}\xf1\xeb\xed\xfb\u05f6\x89\xdbx\xf0\t\x9dl\xb3m\x1b\xf0\xfc\x102skI\x11\xf7(i0\x95\xaa\xcf\nS\xac\xe1\xf3ߤ$(\xa6\x89\xac\xb35/#\xad\x7f\xf4\x91\xf4L\x1f#6\xb2\x9bh\xf0\x81\r\x8fI\xf2[\xaa\xdcG)ǖp\f\xef^\xdf8Ru\xb0\x1dA\x93\xd4m\x99b\xe6b)\xb3%\t\t\x83\xbb\xd77\x96Aq+Kw\xdb\xfa\x80M\xf5\xad\xd0\xd4'\xe1\x1d4'\x8a*\xa5\x12]\xb1\x85\xba+0z\xf5\vO\xecH\xab2E\x14]\x1a\xe9p\xf0\xe1\xbd\xfa\x83\xe5\x15\x86oK8\x10P\x9c\x1eI\x12\xd6S\x13\xad\x14C4\xd1vjb\xf8q4\xc5\xd1#\xd9\xf4H\x9c\xa9\x97\xaa\x9f\x1f\x7f\xf4H>m\x8f\xe4s\xb3\x91ѷ\xe6\no\x8d\xcc\xcf\a=\xf6\xc4\xf0\xc6\x119\x10f\xa2|\x13\xdd.P\x03\xa4\x11KJ\x9bL\xd8\xf6Oev\\\xb6\x80\b\x16\xbc\x12LU\x17\xd4\x0e\xda\xd5f\x04j}f\xe1\x11E\xee2_\xe5\v%\xc3\xfb\xf7\xe4\n\xa9\xf1\xad=\x01Qv$\xb0\xec \x80;}\x88&\t\xdf-6u\xe5\xb1#\xbe\x9eX.W_\x18F\xa2\x98\x9e\xa3\xa6X\r\x1f\xa9\x89\x91\x7fS7\xd3R\xb8\x12\xae_>.\xc3\v\x98\\C\xce4\xbdp\xa6t\xc3\xdd$\\\xb9\xf5F\xa6È\xeamc@0S\xf4\xe2\xed\x1c\x15\x97)خ\x7f\xa9|\b\x1f\xe7\x04g\\\xe8\xf2M\xa3\xc4\xd0rc\x90\xaf\x84Q\x15\xe1\xf2\xd5?cxW\xf5\xc4.\xad\x87,L\"#\xf4\xb0\x9c6\xb9\xb8\x0e \n>:I\xff\xec\xf6)X\x96\xad\xea\x8dZ\x9e\xf44\x87_\xa4M$Q,\x13\xeay\xaf#\x89\x82)\xb6\x91G\xb4\x15jTRc\"\xc1t[\xd2\xc9\t\x84Œy\x8f\xd7|\x95\xb5\x9c#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm\xfa\xf4\xa1MQ\xb7\x958\x9e\x1b\xca\xee\x9c\x0f\"7\xd2\xf0Ƃ\x14x\xe2a@rZ\xcbo\x00\xcdz8c\xa8\xdf\x1dU\xbe\x1e\xbf\xea\xd2\x12D\xd1\x03}jx\x92\xfe\xd0=\x99ʦ`\xfa,\x97\xee\x7fjLA\x03L`G\x18\x84&\x885\xbe1(\x82\xa7\x10\x04Q\xban?z\xc0\"\x01\x82i\x1e\x129\xd0ǻ\xf1\x85\xe3\xf0\x1b\xf7\xa2\x05J\xb2\x11Ta\aR\xa0]:\x8f+\xc86P\x02\x9b\xd5\xfe(\x8a~\x9e\x84\x10ج\xf4GR\xf4S\x1c\xea]U\xfe(\xba\\\x1f\xbe\xc2\xff\f\xd5\xfd\xc3W\xf6\xf7T\xf5a%\x8b(\x9a;*\xfa\xbe2\x1fErG5\xbf\xac\xca\xc7\xd1\xdc^\xc9oU\xe4\xa3\b\xf7\xad\xe2\xf7(N\xf5t\xae\xe33ɑ\xee\x0e\x94`㻹B=\x97Y\xda˦\xfd\xc4\x05_\x14\vR\x13\x9a\xd4#_Vh\xe6p\x19)qN֦\xfb2\x1c\x11\xe6)ڗX2\x9eE\xd4\xe4\\k\xbd9\xb3G\xaft\x91$\x88)\xa6u\n+f\x87|=\xaefn\xabF\xa4\xb9^\x85J\x1e\xa1\x12\x98\xb1\xf1\xdd\xd7\xff?\xf0\xde\xf8\xc80\x12\xb0\xf14X\xc3zu\x83\xc8w\xcf\xf6\x00j\xf4q7b\x13)\xcf\x03\xce\xd8\x03̠\xde1Q4\xf7\x802\x80\x8b\xbe \x88>\x80\x8c^\x9a\xb3'\x10c\x0f\b\xc3\xf3h\xd0'W\xd0\x04`\xac\x03)\xa2\b\xf7\x00_\xf4\xb0m\xcf\x05\xba\xd8\r\xb8\x88\x15I\xe8\r\xb6\xe8\xa3E\xea\x1ch\xec\xbd;\x91\x03\xbdߎ\xdf+E\xd7ӹ9\x00\xa8\xe2\xb9\xd8r\b\bA\x0f\xbe\xf4ɭ\xf5\x02P\xf4\x01OD{\x9c}]\xddx\xc0\xc4\x1e\xb0D\x9fLsO\xa0D/\xf1\x89-GD\x9f\xb2\xee_\x86\xe8]\x82\xd8\x03\x88\x88M\xa2\x95\xac\xdc\x10\x88:\xe3\x11\xb3\xb4\xb0Vv\xa8\\\x02W>\x88\xa2\xd8.9\x1c\xb4tp\xf0\xb2A<\x88a?\x80\xa1\xf4\xab\xe3\xe4\a\xb6\x83\x17\xfa\x80\x10zHt\xac\xf2\x8f*\xaaD+m.\xb8\xe1,{\x83\x19[\xddb\"E\x1a\xec\x19\xb5\x96t\xe87\x06\xbd~ԑs\x91\xf9\xa0\xd7Q+\x983\xff\xe6LL\xcb\x03\xb5e5$\x98\xb2s\x1f\x81\xd9:\x05\xcd\u07b4OO~ܺ\xc5\xc7K\x19\xb8#\xa5\x87\x10\x82\xef\xe5\x03ȩA\x01/\xb8(\xe5 <\x8fZ'\v\xea|Q\xb5\xadiW\xbf\xfa*\x98\xa6\x1f\xcc\xe7\x9bر\xa9-\xad\x9f/\xaf\xe7\x1fp\xf8Ğ'<-\xb2~\xc9=J<\xaee\xf6\xc2\x17\xaf~\r\xdf+;\xeeR\x9b\xd8,\xb5o\xdb\x10A\xf33\x15\xaah\xd8ٓ\x903\x88x\xf3\xd8>\xb8Y\r\x1d\v&\xbb\x03jV\xc3\xc6\xc2\a\xba\vf\x16\x05\x19\xfb\xe8\x19\xce5\x98X|\xf8\xb9\x03\"\xe6ݳ(\x92=\xe0a\xc78\xacW\x1c\xe6\xfd9\a\x03;\xc6a\x9fP\x1c\xf6yD\x18\x8d^'\xdfQ뒛\x83\xb9\x99\xa5\xba\x82\xb4P̛\x8c\xd2\xdb\f\xa4\vU\x15\x86\x8a욄\xa0\x1c7\xbaV3\xd3\"\x8bh^U\xe4Rx\x7f\xc8\xd7K]\x97\xa2f\x13\x97`\xa2\x1e\xed\xb2e\xd6\xdeQ\x8a١\xb9\x92\xb4-QS\xe7\x05AET\xbf\x97\x88)\x14+\xe98\v\xd9X~\xd0|&Xf],b\xb7\xe1\x11\xf6\xe5a\x8e~\\ՀitS\xa9\x12N/\\\x98\xb3,\xa6\xfcB͉\x80\xc1=\xc1\xe9\xdc0\xc7pK\xaf5\xa6\xd7n\xc6%S3)fv1\x98\x1b0>昐ۑd\xc8D\x91\xc7͟\x9cՕ,T9\x7f\xffڸr\x941\xa0\r\xc1\xb3\xd3r\xa9\x87z\xff\x86\r&^\x02\x14\xa9\xee\xe3\xfb4ѻ\x1fO\xfbp\xb6|ͨ\xdb\avu\x88\x1dK\x9eRz`\x15e\xa1H\xcc\xc9k\x1d\xc3{K\xaf\xd4\xfb\xf4z\x1c\x813f\xf82\x9c\xa87\xe2nϻq\xbaW툔'\xf4n\xcd`\x8a\x9a\xfa\x875\xda\xe9\xc1\x923\x9aoSr\x83\x89\xbe\x10\x12\xa4u\x8a\v\xc1͊\xb4\x9f\x9e\x17\x06\xa8\xed\xd9K\x1a|\x84Pq\r\f&h\x98?\xd7J\x9b\xde\x1b,\r(\xd8$\x8bqNnH\x95\xdem\x15P\x98\"3E\xc4\xdb\xfdf\xcc\xe0\xd6|\x80\x05>\x8c\x0f\xbb\x1d\b\xc3D\xad\xeb\xf8\x14\n\xa1\xd1\xf4\x88\x0f\xff\xf4/\x1f.>\xe4\v\x94\x859\x84\xd1>X\x82\xf0aΓy3\xdf\xc0\x17\xd4f\xad\xe8sl\x8drJ~X\xdb%\xe2\x99_\x1f\xf9O\x97U\x8c\xf2\x1aCK\xec-\xf9j\xbe\x90\xbf\xe2X\x95\x8f\bs\f\x18\xe9\xb07\u05f7\xbf\xfex\xf1\xe7\xcb\x1f\xc7pɒy\x83(\x17\xc0\xe8\xdcR\x10MkW\xe6lI\xed\xa9\n\xc1\x7f+\xd0\x05V/\xaa\xe7\xbc,1\xf8At\xe3\xf0\xfaQ\x91\"\x19\n\x1d\xbd@?rm_\xf4j\xa9\x90\xa9\xc1\xc7\\R\xf9G\xc9\xc5 \xbaB@\xf0\xd5\\j\xf2[iM\x94\x819*\x84\x19_\x06\x1aY\x92\x1b\xffrd\x96\x96\xa0b\xbb\x85)\xdbK^,\x9b\xc8\"lm\x88\xa6@C\xbb\xbb\xaap\xd1K\x9c\x9b=m\v\x8d:\f_>)l\xb3\xb4\\\xf1\x05S<[5\aI\xee\xeb\xb5,\xf3p\xab\x90ե\xab\xc9\xc27o/o\xe1\xfa\xed\x1d\xe4ʶ\xf5$\x87քG\x90S%\x170AZ \xb7\xe0\xe9\x18.\xc4\xca\x12\xf2\xba<\xd0ˠ\xc4\x1b\xdaHŧ\x12|\x9e\tN\xbe\x1a\xdb\xeb\x04X\x9a\xaa\xd0\x12Q\x05/O6\x0eٸ\xcc\x05\x9f\x04\x9e#\xb5So\xc8@\xcf36\x11P\xaf\xd6\x06\xac\x0e\x0f\xdd\x10\xeb\x15\xe6\xee\x85\xf1a\\\"\x19)E\xda.\xa1U\x86\xb4\xff\xb2\xe6\xae\x1c|\x98\x04h\xf5\xc0\x9b\xa8t]\x8b=\xb5\x7fR&\xac\x9c\xbc\xb6\xa9\xfe\x1f{\xd7\xff۶\xb1\xe4\x7f\xd7_\xb10\x1e\xce\xf6UR\x92\xe2\xa1x\xcf(P\xf8\x12\xa7Oh\xec\n\xb6\xd3\xdcC\xdb+V\xe4J\xda3\xc9%\xb8\xa4m\xdd\xf5\xfe\xf7\xc3\xcc~!\xa9/\xb4vi\xaby\xcd6\x01\x9a\xc4\xd2p9;3;3;\xf3\x99\xbd\xfe\xa4\xe5R\x85U\x93\xa9\x11G\xe5Q\xe3\r\xbf\aQ\xa8\t\x80\xb8\x89\xc7Jw\x14bĐ\xbc&ߒG\xf2\xad\aEHw}\xe3\xb6U}\xfd\t\x7f\x8f\xc2d\xbb'Ӟ\xfb\xfc\t\xcc\x18P\"\x93)\xec\xf2\x8c{\xf5\xb8\x80^\xb3ǒ\x15\x90\xd9\xd0\x12\xe3\xce\xcb\x1e\x19[x\x85\xcfR\xecaa\x98\x9d\xb0Η\n\xfa=(\xda$\xec\x0e\xc1\xf7 \xf9H\xbe\xc5z\x9bop\x89P)}\xa5\xcd\x19\x97\xb5\xbb\xe8\xd3\xf1U\x1a\xe5&)-\xa3eݬ\t\xbb\x04!\x84\x97\xda[\x13'I,\x10!\x152\x95\xc8\xd0\x7f%\xd5\xf5+\x9fmI\xea\xa6D\xf51\xa5ki}LNj\xbf\x1cr\x82^\x95\xca\xda\xe8\xeb\x80\x01^Y\x8b\xacW\xc4\xd0\x197\xe8[\n?\xf0\x97\xba1\x1flaD3б\x82\xcdY\x01\xf7\xf5^-e\xb3\x15VL\xf2\x88ɃZ\xc1\xbc\x10\xa5\x88D\xe2#[\xe85\x9e\xc1\rn?\xc1\x9c\xea5@\xa4\xado\xab/\xbd\x05\xf3\xe3\xbb\xe9\x10\x964\x04\x04\x86\x9b\xb7\xb7\xd3V\xc1\x83\aͣ۷ӣ\x03\xee\x89\xdf\xedԨv\x1e\xa7\xae!\xc6\xc8J\xc1\xe0\x007[~\x85έ+@\x88`F)\xcdGwl\xe5\xe4\xf3\xfasɋG\x9b\x8bV/\x9f\xd2|o*\x05\xa31\xff\x8c\xc0\x14\xb4\x95\xaa\u05f5\x1dU!\x15\xf7\x8e\xb7I\x18\xed\x19\xea,\x8bs\xc1\xb3Rn\x83Zp\"\xbb\x192\x06\xa8\x85\x00\xb5\x10\xa0\x16\x02\xd4B\x80Z\bP\v\x01j!@-\x04\xa8\x85\x00\xb5\x10\xa0\x16\x02\xd4B\x80Z\bP\v\x01j!@-\x04\xa8\x85\x00\xb5\x10\xa0\x16\x02\xd4B\x80Z\bP\v\x01j!@-\x04\xa8\x85\x00\xb5\x10\xa0\x16\x02\xd4B\x80Z\bP\v\x01j!@-\x04\xa8\x85\x00\xb5\x10\xa0\x16\x02\xd4B\x80Z\bP\v\x01j!@-\x04\xa8\x85\x00\xb5\x10\xa0\x16\x02\xd4B\x80Z\xf8r\xa0\x16\n&EUDnqp[\xc8ފ4\x87\x81i׆\x94u\x96\x1dH\x12\x05\xdb\xc3e#H9\xf0$\xc2Hds\xbeЎޫ\x94ft\xc1F\x96?#\xbb.\xf9\xeax\xf0\U00099184\xa7\xdc\rd\x01~Ո\x05\xd3\x1e\x19\x0eπ\xbao8\xdd3\x98\xcei\t]\xb8g\xe4\xbfN~\xf9\xea\xf7\xd1\xe9w''?\xbf\x1e\xfd\xfdׯN~\x19\xe3\x1f\xfe\xfd\xf4\xbb\xd3\xdf\xcd_\xbe:==9\xf9\xf9\x87\xcb\xefo\xa7\x17\xbf\xf2\xd3\xdf\x7fΪ\xf4N\xfd\xed\xf7\x93\x9f\xd9ů{\x129=\xfd\xee/\x83?88m\xeb\xe3\a\x94\x1c\xfd\x8f3\xed\xb8\xa5\xf4\x11\f\xac\xf3Ji*\xaa\f\xe1:\"\xad\xe6V#T\x19\x96\xabR~6\x8a\xe9m2M:\x80ɠ\x9fA?\xdd\xf5\xf3Z\xcbN[C\x9dטj\x97\xa9CC\x9di\x9a\x83\x1b[\xe2\xed:\xb9$\"\xe5%\x84\xd3>m\xc6\r \x15\x9c\xfe\xd9LQ+[\xe5L\x12{\xe9(v\xb74\x1a4\xccEH<$\xc2ľΤ!i\x9a\xd5\xf7\x14\xe8\f\x8cb6\xe7\x19\x8b\x95{\xfa\xe5\xd9;\xaf\xaf\xc1\x9cȂ\x97+h\xaad\x8fN\x89\xfd\xb6\xbeܴ\tA=7\xcf<\x94\xc6,\x88\b\xa4l\xda\xd843u\xe7\x9f\x13Eh\x96\xaf2\xccg\xa1\xc6HVB\xae\x85\xa90\\\x82N\xae-~\xe0\x93zA\x92\xa0\x99\xf74\x01\xfc\xa5\x9a\xfaT\xc4k\x0f\x18\x0f\x9e_0K*\xefj\xa9d#\x98ua\xf9\xf6ʰ\x15\x1dd\xf6X\x1e\xc4;F\xd7cZ\xf0{\x9e\xb0\x05\xbb\x90\x11MPS\xcfzY\xe6\xf3\x1dT\x1d\x89B\xcfeV\x16\"\x91\x90A\x05K\x04\xa0\x0f*\xe7\x8b \v\v\xeaQ\x94\x9dB\xd1Ln\x16\a\xd2K3\x02\x8e^N\v\x90\n\x93\xa3t&\f)'2\x13\"\xd1\x1d\x93ɪ^?\xf7\xbb\x82\xca\xc4o\x19{\xf8\rV+\xc9<\xa1\v\x9b\x9a\x84^\t\xcf2\xd1ZUͫ\x92g\xdb0H\xf3\x17\x15#4y\xa0+Y'\xbe\xed3=(\x9e\x917\xa7h\x1f\xa8$v\x8d1\xf9\xfa\x14+\xacޞO\x7f\xbb\xf9\xe7\xcdo\xe7\xef.'W~v\x1c\xf6\x8c9\xde\xf9G4\xa73\x9ep\x1fǳ\xa5,PP\xdf$\x06\xa79\x8d\xe3Wq!\xdc[\x96\x90\xdf\xe6.\xc4\xf2\\\xf6\xcb.5\x11\xe1P\xec\xe6\xad\x05;\x93\\\x144+mһ^&\xec1$\xc4\\5\xcf\xd7\xf6\xe98\xc2\xfdKk;x\x1eC\n\xbf\x17K\x9e\xaf\x17\xe6\xadYƪ\x06\xa4\xf3\xa2J\xc8\xf4Ǜ\xc9\x7f\xb6\xde\v\xfd\x1e/j\xbd\x02\x9e~\x05\xfa\xa0H\xbd\xf7\xf8Z\xe1W\x84]\xfe<w\xd9\xd3\x1f'\xb5\x1fЯ&\xf1\xba\xca\x1av\x8cg\r\xba\x8ed\tIE\xcc\xc6pi\x04n\x0e\x93mj\xf5S\xdc\xc5\x0f\xae\x9c\x81d\x06s\xea\x92U\xd3\x13.\x05b28\x93\x14َ\xda\xf59M$\x1b\x1f\xec4\x06G\xe6\x12\xc2\xf7^\xbbh\xa9\x90\x98e\xa2\xd4\x19?/m\x00\xf4\xbfBDD\xe5\x14\x1a\xcd\x02\xad\x13\xcf\xcbɬ\x0fc.\rϧv\xe5x\xc3\xe4L\x150s\xb7\x1f\xc6\xe6a\xee\xe2\x06\x15\xaa\x80\t\x84\x9820\x90V\xe2}jJ\xe5\x1d\x8b\xb1m\xca\xd7\xc7\xd6\xd9\x15\xb5=\xf6\xd5oW9\xf3\xbeOE\xdfZU\xff\xe2=\xaf{6\xd6\xdb\xf6\x01\x8f~̒յ\x10\xe5{\vc\xd2K\x90?\xe9h\xa9}\x0f\xe4H\x91\xa0{\x8d\xe5\xa2\xf1\b7\x11LD\viEK\x9f3a.\x0fm \x8a*;\x97\xdf\x17\xa2\xca{1\x16\x9c\xf5\xef'\xef\xc0+\x86\x80\x04\xe4\x8fee\xb1Bh*G\xc2d\x13\\\xdd\xc6c\x1fuM\x93W\xb5\x8d5\x0f溞\\\xd2\x15\xa1\x89\x14:pt\xa6ȳm\x19\x12\xa2S5>\x9d\xd13Q.\xd7s:h\x1e6\x9f\xe3\x0e`T\x17\xd8\xd8L&\x9c\xa2kt\xdd\xc9\xd2;&\x01\xbc;b1\xcb\"6\xf6\xbf\xcb>`\x19\x04J\xfe\x95\xc8\xc0\xbc\xf4\x92\xfd\x89\xa9\xff\x81\x8cIٖ܁\x17\b\xa7\x8e\xe9)\xd6+\xa1q\xa9$\\WO\xe68\xc4\xcbo\xe3\x7f\xa8f,a\xa5J\x94 \xc8-\x94C\xc2OxJ\x17\xee\xdaDK{\x14\x02\xd2V&\xab\x82\xe9\xa49\xccu\xf1\b\x034\x8e\x14`\r}\x9c\xbc#\xaf\xc9\t\xbc\xfb)\x8a?\x14\\\xfa\xa0\xbe\xe0\xa0\xcd5k\xc2\xe7f\x89\xc0Rg\x92h;\x003\x13M\xf5\x90d\x02\xbaa\x96\x86\xa7>\xd9!\x93\xbc\xd2\x1dR,\x0e\xa6\xe9\xf30M=\x0f֏\x92\x15\xbd\xcfՏ\a8W\xdf\xf9:\xb3ʃ/ڻ\x86\x06\x85\xa4\xac\xa41-\xa93MUNg\bn\xa8\x82\x8f\xecv\xab\x02\x8a\xb63\xcd/L\x15\xfe\x98SZ\xb2\x0f<\xab\x1eUw\x80\xec\xadK7\x17H\x8e\xe8\xab$\x9f\x13\x05\xdaG\xf2<\x81])E[\x9f\xe08i\x8a\xae\xdf\xde\xd7\xeai\xceW<\x1e\xe0F\nʌ\x9diR\x18V\x1a\x8bt\xe3\xe5!\x10e\xd4#*n\xbc\xf0\x16\xe5ܥlΏi(痦l}R\xf7\t\xbbg\x1e(\xe5k\xda\xf2\x01\xa8@\xfd\x83\x91\x1a$\xebA\x95\x90\x84\xceX\xa2\\C\xa59\x16)\xad\x16\xa4\xc1\x81\x93\xaa\x85H\xfaC^\\\x8b\x04\x1b\x83\xa9e\x12\x90\xfd\xd3\xf0\b\xbfܗG\xb7\xab|\x8dG\xdeY\xf4ϑG\x95\x87\x87\xb7\xc1#p\x13\xdb<\x02\xb2\x7f\x12\x1ey_AH\x16A\xc1ٴ\x10s\uebacm!\x84\x91k\x8a\\]\x9c\xe3~\xf4W\x92m\xab\"ǐ\n\x89;S4\x8b\xa1E\xa3鉖\xea\xcc\xd3]\\\xceD\xff\xad^\x9c\xb2\xdaö\x00\x18\x16x\xb7j\x99\x95\x19B\a=\xddDD\x13\x18\xfc\xe3)\x17\x1b\xb2\xb1N\xb0G?\x97\x1el\xa7阚>\x1cɂ\xff\xe2\x91\x190>J&b\xd6\xc0\x8eW\xb3\x8e\xc1\xa3\xd5O\xf3\"l\xda\xe2\xc0O1\xc5W\xb1\xe9\xe5\x86'\xfa-Wh\xa8l\x03\xcaA\xf1D`Y\xecc`ua\xefrH\n\x06\xb57\xf7\xcc\x184\xe8\xbdIXy\xec\xb7O\x8d\x176\x96A\xb3\x12%\x02\xd4\xd2\xc7Pj(\x12\xbc\x160\x1e\xf1\x1c\x8f\x180\xf0G\x1f\x8c\xb0\x1d\x1d\xd8\n\xeb/\xf7U\x96#\xa0Rk\x88\xe7\xad\x1a\xfc\xbe\xe3Y\xac\xfb\xc6Z\xccש0/\x9a:.îOn\xad\x13\xa1\x05;#\xbf\xf8\xe9\x9e\xdd02\xdaTm/\x8aMs\xb0E\xb5\xbdh*sp\xad\xc2E\x9d\xcb!\xa3\xb6\xd5\xf7\"\xbcv\xd9i\x19\xe0Q\xcbj~Y\xeb\xf51C\x1d\x04\x139\x82$\xaa\xa6\xedE\xb4\xb6\x8cF\x06\x8e\x0e\xab_\xa6\xb0\xdd\xf58\x1a\xf9\x14\x95x\xbbT\x0f<\x8bŃ|\xael\xca'E΄\xce\x11\x98\xbb\x92g\v9\xf0\xd4\\0\xed0\x04\xc1\n\xad|\x9e\x94\x8a\xb1\x04vN\xeaf\xea\xc0\x99n\xbb\x17~2\xefJW8\x13ߑި\xd3\x15\xce\x14\xbb\xd2\x1b*7\xe8L\xf2\x8fIo,RI\xdf\x16\xf0ܒ\xd3\xe4&gQ\xefS\xed\xfb˛\xf36I\x0f\x8a\x04\x0e\xf8\a\x9c\t\r\xbb\x044\t\x8dS.%\xc0z<\xb0\xd9R\x88;/\xba'\xa6\xdbx\xc1\xcbe5\x1bG\"mTя$_\xc8WZ\xb3G\xc0\x1d\xbf!'<KL\xd7\x03\x1e\x1a\ffJ\xe9\x1b\x03x\x19/\xa2\x91\xe5*\x1a\t\x84\x1d\xb2\x05\xae\x9bl\xbf\xf2\x05\xa9\u008e\x85\x83\xbbT\x9b\xa2x\xe5\t(\xfe\x848z\xf3E\xa3\xcb4О\x90zc_\xbc\xc8\xe2^\xaa\xab\x9f\x833]\x87jpo՛\xd3\xff\xa8i\x91\x98)p\bϸ\x8f\xcf[\x03\xbdk\x87D\xddh{Ѥ\xe4\x18Vhj\x1e\x8fk\xfa\x9e8\x1eVU\xc0V\xd1$_\xd2\x11&\b0\x9d\x0e\a\x9a\x17E\x13\xec,E& \x80\x9cA\x7fG\x9a\x8b\xccc\xe6\xb7\x16\x10\xc8_\xa9z3R֎Fc\xbb\xec$=O&\xa8r8l\x1dAl p[p\xd4m\x0f\x98zh\xd3\xc2\xf1MK[oW\xf7\xa6xQ,\x98\x04\xaf\x9bg\x84\x15\x85(t߈)4\xc8\x16\xde鄩\x80\xe1\xf8I\x02F\x81\xc2E\xcaq#\xa3\xe5\xc7\xd2z|,\xec\x98\x04\x8b\xc3\xe6s\x16a\xc8\xde\xd89/\xe2\xea>\xf4\xa4\x9e7\x06\xb7a\x0f\xea\nnI=\xc0|\xe07%)\x7f\x04\x0e4Vח\vf.\xd6v\x92\xa7p\xeb\xec\x17\x88\x9a\xc6\xee!\xe1\xed\x05\xeb\xce\"/\xa2%\xb4\xc54'S\xe3&\xea\xeb</\x8apg\a\xf9\x99\xa2\xeaq2\xf8\xd4[\xb4j.\x9e\xe5\x18\x86\b\xc7\x10\x03\xc7^\x1b!\x0f\xb2d{\xfd\x869\x91\xad|x\x91ި\xe10\xf91\xef;\x84\x8eZ\x0e\xc2ݯqu\xcdԳ\xd6s\xec\xaa\xe9\x98\xcc\xfbP|ћ\xe6\x17\xbcm~\x8e\x1b\xe7?\xe6\x96\xc7\xebk\x1aѹ\xe7\x98ߛ\x06\x95FF\x13\xae\x17\a\x1e\xc7)\x16\x85ר\xd8\xc9ʠ\xf1\xf3\xffq\xad\x99o\x8f\x9f\a87,Zo@\xdd빦nn\n\xa4\xf2\x12sy\x05\xf0\x03%k\xafع\x1a\x12i5\xe6\r\x0f-3Lr\xa4`\x1a\xe8\xdfM_\xfe\x1b\x8f!;\xd2\xd8\xe0yO\xed\xa3X\xec\xe1\x01\xeb\xf1\xf3\x90\xb0\x01\x1b\xa9\xef\xdbH\xcc\xe7sf:\x9c\x1d\x8f\xbd\x9c\x164\x85\xc0A\x12]\xfa;c\v\xae\xdaL\xadk\xe5xCaA\u0086\xca\xdd\xe3%I\xf9b\xa9\xb24\x84\"\x14\xa5;\xdcd)\b\x80\x91\x11\xa8ȃ\xe2\xd5\aZ\xa4\x10\xb1\xd0h\xc9`\xdfh\x06\x18\xa4\xae\x8a\x8f\x93\xe4V#\x184\nY6\xa6 %\xd4\xde@':\xb8j\x8e,\rç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xc3\xf0\xe90|:\f\x9f\x0eç\xbf\xbc\xe1Ӳ\x8cyv6\xf0\x14\xb0\xed\xd3\x02t\x11\xb5\x03Qb\xb1;\xc1\x90U\xd0m\x00ڧVg\x9c#K\x7f\xe0\x81\xcfR\x1fݺ\"\x16\a\x05\u0080\x02\x85y\xe1Ds\xfb\xb2\f\b)\x8e/S}\xa9NTyF.~|o5\xcakԁ_w \xbeϏYĞA\x10\x9a\fѼ\x1fx\xe0\xd4D\x89\x90\xbaO\x16\x16G\xa2%\xcd2\x96h\xa7\x9b\xbbq\x16n4f\x8ce\xd0\x7f\x01`:\xb3\x15\xa1D\xf2l\x910B˒F\xcb1\xf9\xb4d\x99\x8f\x10\xe8\xa9u\xf5J%\xd4\xe4\xa6J\x18\n\x96\xba\xce\x19\x84%\x12\x1a\x15BJ\x92VI\xc9s\xbbH\"\x99\x94\xeehr\x93y\xbd\xc1 T\x8d\x06ԡ}\v\xe75*\x18\xb4z\xaf1\x8f;\x04\xfa,\xcd\xcb\x15\x81\xadw\U000ce005s^ȒD\t\x87f#\xb55P\n)\xd4:\x87ĵ6\x1e\xdbw\xd5.H\xcd\xda,\xc6r\x85\xbc\x94\xaa\xd3\xc7o\xa1z\x891\x97:\xfb&\x87\xd0ߤ\x0fJg\xa17\xb2\x84bo\x1c8\xb5j\xfdO\x9e˴\xfb\xc3e\xddjV\x1bCh\xbe\x1f\xf8\xcc_\x19\xb6\xb0\x1c\xea\xf8\x10\x8b\xdcѬ:\x91\x05\x13\xac\xb9\x80\x8a\x93\xb1{\x18$\xc4\"\x06\xbd\xf1TYF'\x8a\xebV\xf4ōh\xc3w\xbddR\xd2\x05\x9b:\x96\xd8\xecJ\x10\x03\x9d\x86p9\x06\\\b\xa4V\x8a\xfa\xdb\xf5\xbe\x1d\xb7#P'\xb2\xa9zG\x1bs>\x140\x9e\x1a\r\"N\xae\x02\xbf;+\x85\xbf\xc4\x1e\xaf\xb5\xc7h\xa6\x9a\a9\x11\xe60\v\xadd\x19L[T\xa5\x91\xb3\x82\xb39\x99sHiAo^%\xdd\x1a\x8ep\x9e\x05L \x01\xe8\x12\tW\t\"3i'\xc3\x1b7\x81\xfd\xa4\x19Y\x16U\x06(\xe6\x16\x04\b`&!\x86Y\x14\x8c\xba:\xefص\xf8\xd7\xd7\x7f\xff\x86\xccV\xe0\x05c\x1dd)J\x9a\x98E\x92\x84e\vGl\x7f}<\xb5qȬ$$0P\xdc1-T\n\xf2\xe6\xeb\xbbY\x1dN\x80\xcd\x7f\x15\xb3\xfbW\r\xf9\x1c%b\xe1\xc6ӷ\xa6\xbf\xd2\xf6L\x1e\x0f^\xf82c\x8b\x19\x10\t\x8fVކ\xc0\f\xcf!K\xf1\x80\xf2\xd0x\x82\x97\xc6j\x0fk\x069\xa8\xbcJ@\xd4\xc6\xe4\xbdA\x96t\"YI\xb6\x89\x86\xb5\xc9\x00\xea(_\xa5\xb0Kk\xdb\x04\xd32\xa5_ŉ\xa8\xd0\xc0s\xfaj\x1c\xcfX\x9b'~O\x93dF\xa3\xbb[\xf1A,\xe4\x8f\xd9\x05\x80\xc98\x91G\xe97\xfcH(x1\xcb*\xbb\x03\x8e\xd4\xcbO\x84\xdbi+\xaa2\xafJ\xd3\xe4\xdd\xd8x\xbb\x99\xcex\x90\xd6A3\x99\xe1zu\xec\x11\xf4\x16ӳN$\xa9\x06\xdfQ\xa9\xb7D,캥1\x06\xae\x1dA_\xbf\xfe\xebߔɂ۰\xbf\xbdƖQ\t\xed\xde<Z\xa2o\x00\x8elJ\x93\x84\x15^~\x01:\x95 \xf4\xe3-F\xe2\xc5mD\xb9z\x86H\xeb\x19C\xee\xdb\xdb\x7fb\xbc\xcdKɒ\xf9P\x8d\xab0\x19D'\xa2\xc7\xe8\xc4\x1d\xebS\x16B\xa3?\"\xa0\xbd\x17I\x050\xaf\xf7<bқ\xd5-*\xe6&(\xe1\x00^\xec\x86\x021KDtGbM\xa8ћ\xa1Ox\xbb\x8d\xe3\xc1\x8bv\xa1\xec|;\xfd\xde3\xb8\xe0q\xa2HHJ\xf3\xdcb9\x14\xf4\xa1\xf5\xb2hK\x9c\x1bP\xa8\x1fC\xfaTu\xa8\xbdquطp\xb5&d\x04&w=\xfd\xf4\xf6b\x93\xa6\xae\x01h(\xba\x99\xa0\xe7A\xd2\xee\x89r4a\xe7\xd0\x1fvc\xb2\xb7\xd5\xeb\xd3\xd3\xd3\xe2qfk\x05RZ\xea\x98Ƴ~\x06\xa56g\x85\xe4\xb2dY\xf9\x13\xea\xc4ۄ\xf2T\xa7\xf7<h\xfa\f$\xf0f\xa8_]¨!\xf0\x8e_tf\xb4g1\x83Oo\x8b2\xd88\xd2\xd7\xc9\x02\xb4\xa4\v\xc0y\x14!\xf4\x110\x98\x85\xe8ѽ\x9e\xca*\xedZ$\xdb\xcb\xe1\xe8k\xf6\x7f\xaay\xa4\x7f\x80V_\x8d\x9bvWgT ES\x1b\xfbfb\xe8P\xe6\x1b\x17\xff\f\xd6\x1bH\x98\xd7h\x99]g\xb2\xa4\x95\xb0\xd1\x02e\x92\xdb3fr$c5\r\xc1\x83<\xb8\xaczy\xe4\xf8\xec؍ӽL\x8eaw!r\nw\xf5\"\xeb\xc9\xf5ur\xfd\x80f!LF\x8avf\f\xd2e\xb1\xc56\xf7\"*K]j\xa9\xcfa\x13>!\xf2\x98\a\xc5\a\x98\nW\x88\nn?\xe1\ue87e\x94\xba\\cǕȘ\x8f\x03!u\x1dȭ\xc5l\x05\x97\x04\xcb\x04xFތ\u07fc\xfeW;\xf8\xf1M\xd6\x0e~O\xe0\xe7\x86\xdd:(\x17\xcc\xc8\xf6\x9e\x9c\xb8\xd4)\xd6zº\x17\xec$\xc4g06\x86\xc6#H\xabji~\xe0\x92\x91\x13\u05ec\xb9\xf9O\x14M,\xcb\xd3vJ\xcf9\xfe\xeb\x13\x05\x9aL\xed\xec\x05N\x06eНiꛎm\xb9x\xe9Os˱\xd2d\xfa\x91Ϥ\x8f\x13\xb5\x9ac\x85zuzP%\xd1[v\xf1\x98\x17=\xb7\xed\xe21\xa7\x98\xf5\xcf\xeb\xfd\x1bx\xa2\x92\"?:\xf6σ\xeen\xb7\xe0?\x18\x806\xfb\x9c\x7f\x92\xa7<\xa1E\x82\xa5e7\x8a\x93dV\x01Z\xf8=/D\xe6\xd5}\x01\xa8\x03\x05G\xb4\xf1\x82!\x16$\xa4D\xfer\xf2\xd3\xf95Vh\xfb\x00w\xc1\xe9\xcc\xcc\xfeTp\x1d\xff\f\x1cm\xbc\xe4\xba\x12\xd4\"\xedAW)\x81\xe1'H&&\x90\r\x7f\xa9G\xa9\x12\x00\x82\x97\x15M\x10\xb0-J*\xc9\xef\xd9\x01\xd5\xcc7r\xb4\xbe\xf6\x9f(pԐ\x81︓\xbdiY\x1a\v\xb7\x7f,7\x11\bݶu2WΠ9C\x87\xdb\xcbj\x1c\xe5Xw\x06\xd9\xf4\x0f8\x87:\xa1\xae\xd1Sg\xac1\xf3͉\xf6z\xb8\xa40\xb1\x0f\x9fZw\x95i'\xa9t\x96G7I\xd4u\x9fg\x03gѻU\xdf\xd43\xd7T\xd61\xa5\x8f\xd8\x1dIQ]\xf7\xa2I0\xd9\b\xb3\xcc~b\t+\x849\x96\x1e(/m\xbf)@6;O\x96\xc0\xc0I\xe1)\x8f\aϾ\xf5{\xef˞\x1f\xdcg\xdb\xf2\x82\xfdC\x88\xbb\xceDEk\x8f\xa6\xfa\v\x10\x82Qs\x89@\xae\x99,E\xc1\xec\x0fkL\xd4\x0e\xba\xc4t\xe5C=\x8f(̹Y0)\xaa\x02\x8ee\xbc\x1a\x82\xaeH4!zr\x98|2\xd1\xfb\x89뉪\x90\x01\x93\x91ȱq\x80\xad\x8e\x1bpڦ\xe8\xd1\xf4\xad\xe4\"\x96O݆\xc0\x00u\xd43\x99C#\xce\xce\xd5\r\xcd{\xd9B\xb0N\xb2\x90\b3\xe9\xf1\x9a\xba.s\x12\x058Q\xf6\x8d4\x9f\xf5[u\x92\xddxc\x91Elsi\xea\xf1\xedw\xe9\xa4[\xbfgk\x8d\x83^\x99\xbe\x96\x88\xb5\x85\xc9Z\x03\xaa\x16\u0600\xdbU\xef\xd2I\x98\xec\x14.^\xfa\xc8\xd6\xfe\xa9\xbd\xfd0\xa1[/\x0e\xf0\xcc\xf5\xebf\xf8\x9e\x86ø\xd6\xf1\xe0yS\x8f\x1a\xa5w\x9f\x8f\xee\x05\xa2\x8c\xae$-\x16U\xea0\x0f\xa8\xb1\xa1*&\xd9Ⱦ\xeabY\xea0\"\xd9V\xca\xee#\xa0N\xa2\xeai\xdc\xe1wʳ\t\xea\x02y\xf3\x02\xe7\xaee\x97\xd7v\x1aV\xdb\r\xb5\xff\xe0r}\x04\rQ\xf5`<#\x15uX\xa87:v\x98?\xb0ŃT-g\xba\xc4\xd6\n\xc9\u07b5\xbf\x95\xdcW\x1c\x1cw\x18\xdeN\xfb0\x1e{pQ\x7f\xfb \x1e\x90\xb1\xa6ƣٓ\xb2>@\fF\x871\xac\xf0\xee\x15\xe4*\b\xb5\xd5H/\xc1c\xa1J\x9a<\xf8\xab\x8b\xa1\xac0\x81\xf9\x7fh3h/\xa2\xe0\xb6@N\x03\xaa\x998d#\"\xf0L\x01\x1e\xd4\xd4\x0f\x19f k\xf6\x16\xcb\xfd\f<\xfcbY\x95\xeeǀ\x11j6\xcf\xf6\xec\x97\x1c\x91\xf7\x94'/\xb1m yZ\xb8=\xb6\xeeS\xfd탨\x86\xb5~{\x92T\x950\xd70 \xc38X\xb4,\xa1\x7f\x00գ\x9e\b\xa5-\xe2\vX\x9f\xfd#ƑY\xc5\xf3\x05$\xfb=|\x84z1x\x86'\xees0\xa2\x8b|6\xd8S\xc2n\xe0\xd3\r\xd3\xf0\xd0\xe8\x03\x02\xc5t\x18\xc6\x03\x11&\x86\np\x18\xee\f\x12\xc8\xc9TħC\xa8\n\x04\xc7\x1c\x10\xae;\xa9\x1a7\xf0D{ǧp\x84\xaa\n\xc8!\x8c\x9e\xb1\xd7d\x03\x7f\xbb1\x02B\x9d?\xd7\x0f\x1f\xf4\x12ڧ\xa4\xa53\x99\xf1\xa4\x84t\xc9FǗy\x16%U\xcc\xde&\x95,Yqm\u0084\xb3A\xa7\xd8L\xb6\x7fk\x8b A^\xb4d\xc5\b\xc5r\x1b\x93\xeb\xc8D[$D&A\xf2\xb1\x819\x84J\xaa\x1a\xbfA\xc73\x10\x1d\xec\xf0\xa7\xb2*I֠t\xb6N\xeb\x83\xcfAN|\a\xa2H\u05ed\x95Y╉\\\xf7dY\xe3\v \xba\x94\xc8\x04\xea\xecļ\x11\x03\xe3\x9f`\xd5\xfa!\x1b\x84\x89\xdeK\xd5\xfa\xd8\xd4\b(鷄\fn\x1f\x12٢!;\xbd\xfeNaދi\xdb\xe4\xd0,\xc4Q\xc8\xeaϯ1\xccH\xce>\xfc\xda\x14\x9b&\xc7j\x19ԟ\x9b\xd1\xe8\xae\xca?/\xf6%tƒ\x1b\x96`F\xfa\t\xd6}h~V\xb1-e%\xbd\x7f3n\xff\xa4\x14P\xd8\x040(;\x8a\xc6\x11A\xa4N%\xc0\x10\xb9{\x1eW4iI`\x83g5k\xc1\xc4g<\xd9֖C\x93\xfa\xfb-\x1e[\x98\x9a\xb1+ߺ\x13\x00Xg\b\x97>\xba\x01s\xdbg\xd6X\xb8\xfe\x95v\xe2\x0f7\x83H\xc3Gm\xda1\xfc\xdf\\\xba\xfau\xbbd\xadϡt\x9d_\xbd\xdbuv\xed\x14\xaf\x8d\xa5\x9ew,G\xeb\x8c\xf9I\xe7\xec?\xed\xfei\xa4\x11h\x88$wl\x85M\x9b\x90\x96\x01\x06SC\x84\x14,\xd1\xd3\x04\x19|j'Q\xf8\xb2\xa27\x1e\xf8\xe7n\xeeXg\xc5E\x8b\x1dwle\xc2y\xc5\x17\xf8\aSv[\xb3B\xfb$\x1dT\xc9\x13i\xb1N=7\xbf\f\xd7\xf6^\xbees\xc1@^\x95\xa8\x00\x8b\xe1*\x1f\x98\x0eҸ\xe4\xf9S-\x19\xb0똤\xd6\xdc'?\xc1Pe\xbb\x1e\xa5y\x93lH\xaeD\t\xff\xbbx\xe4\xf2\x89\xe4\x15\xec\xe5;\xc1\xe4\x95(\xf1ӽ\x99\xa3\x96\xb67k\xd4\xc7asi\xa6\xf2C\xf0~\xea\x19F\xc8\xc0\xfe<\x95\xb8\xb1,\xe6\x92L20T\x9a\a\x16\"Gj\xf2Md\x1b<0\xba^\x99\xe8g\xb7\xe8#\xa3$<\xa3ɹ\xe6\xa3:)\xb6\x97\xa1\x96\x80 3z\x81\x98\x8b\xce\x13\x1a\xb1XO7$\x14\xdcPZ\xb2\x05\xef\xceh\xa7\xacX`y{\xb4\xecz\xabN;\xe4\xb0\xd7]g\x9b\xf9\xefi\x17y\xb7\xa9\x19Y\xb6\xbf\x84\v\xad\xcf\x10<>wp\xc3̯\xa6\xc9\xf4I\x8b\xf6$\xc7Zr\xdfx\xb4>\xcci\x0e\x92\xff\xbf`\x9eQ\x88\xfe\x8f\xe4\x94\x17rL\xce5.\u008e\xe76\xbf\xa1}\x9d&\xf1\x94\xe6\xf0\x00\u0605{\x9a\xc0\xf1\x01\xc3\x012\xc2:A?\xc5|々\x8bi\x00\x80\x00\xd3kK\x17\x8f\xee\xd8\xeah\xd8Ґ\x1d\x14\xe1Ó\xechh\xe1\xcfZJi\xcf)\x1c\xcb\x7f\x84?;\x1ao\x1c\xb0;h?q\xecvJI\xc7\x0f\xad\xd7}\xa9\x1aj\xce\x06\xbe\xf2\xd1)\x1b-\xb9\xb8Z{fK8\x9a\xceq+\xac\xd8\xf6HZ,X\xb9\xe5\xb3\xc6c\xc6\x02\xfa19\xcfV\x1bt\x11\x8ee\vM\xe3\xd4\xd5r\x96\xdb\xda\x05MU\xb5\x987I\xe9\xfb@\xb9=\x10\x86\x0f\x8e]6\x05\xe4\x91\x15\xf7\xecJ\xc4l*\x8aR\x9eu3t\xba\xfe\xf9m\xa9\x91\x9a)\"\x81)}\xfa\xa3\x83\x1d\xb5\x82\xda/vuh\xbb\x82O\x13\xaf\\\x8a\x18\xae\a\x8a'\xde\xeaz\xed\xe3JLl\x1d\x18\xbc\x11\x85\x84\xe9\x9c/.i\xbe\xfb\xe6Cg\x0e\xedv\x11\xc0Զ7\x8cU\xc24^\x17v]\xc4|\xbeR!R\xe3\xeeu\x9b]\xa5\xf6&\x9c\xc5\xce\\\xea\xf6\x1diο/D\x95o\xfb\xd9\x1a\x8fΧ\x13\xfc\xa8\xf1\x1c\x17\xf8\x17\x93\x185\f'3\x06\xefkY\xb7Æ\xa0#Ф\xb8\xe52\xc7\xfe\x95\xfc\xc0\xb3؞\xf0\x9d]K\x11\xb0\xf1|:Q\xab\x1b\x93\xf7\xa2\x00\xf0\\==\xbb\\\xf2\"\x1e\xe5\xb4(Wx\xd6\xc9as\rO\x9c\xb8\xe3\x81\xc71uǳx\x0f\xde\xe2\vj\xbe\x96\xab\x9c\x111'\x05\x93\xff\xcf\xdc\x11\xec\xbam\xc3\xee\xfe\nݺ\x02u\xd6]s\xeb\x8a\x15}\x97\"\xe8\x1b\xde\x0eE\x0f\x9e\xe3$\x06\xf2$O\xb2\x1b\xf4\xef\aR\xa4$ϔ\xad\xbcw\x19z\xea3C\x93\x14IS$E\xc9\x12}\t\x1d\xf9\xd6\xf4\x19\x1d\xe0.y}y@ove遼E\xb9\xa4\xa4FIU\x85\t\xbf\x15\x7fFvrx\xdard\x94\xb0<<mx0؉\xb3\xa3^`T\n~\x0f)&\xe5t3\xb8\vܞ\xcb3\x14۫\x99\x8e4HҾ\xbd\xdbp\xd7ܛk/\xddq\xbav_\xc4e\x9d\xf1\xf9\x98\x80\xf2\xd2N\xba\xffg\x8a+<^b̓\xa0\x178U*\x93\x90S\v&\xea\xe3\x90\xdfё\xf3\x9b(}D\x983\x93\x17R\x94\xa8\xfe\xcfp1\xa2\xedZ\b\xad\xe2\x8c\x7f\xfaF\xa8\x96n\x83%p\xd1h\x99\x87]U\xac\x9c\xb2b\xd6\xf4\xd6\xc5\x01\x8c\x8c\xfe\xf9\xd1\r\xfb*\xbb\x16\xa4s\x8f\b\xa7\xdaf\x18'K\t\x82v\xb2x\xfd|\xbc1\xb7\xe15!\x11Ue^\x9d\x8a\xb6\xbd\xd1PUsc\xf3<lh\xc8\xc7\xe5/`.\x91\xb1GrHP\x13Kr\x83\x14\x9a\xca\xc39n\x8d\vu\xe3\xe3.\xc1\xedKk\xb8\x19k\x8d\x85\x86\xe8\xee\a\xcc+\xd3t\x03\x03c_\xae\x9aO\fa\xd4\x01g\b\x18\x0f~H\xe1\xf3\xf3\bs\xb9\x02\xe9\xae\xcaM*\x84\xf6\xccZ\x9c\xd6Vd\x89\xa2Oé\x10nC\xc0X]\xa6\xf4\x18\x96\x84qy\xafW\xaa\tӠ\v\x9a,u\x83\x1e\x89s\xa7!\xfa\x17=\x0e\xedacYݜ\xd2\xd5\xf1Q~\xd3Bߵ\x7f\x01\xec\xd4;\x15\xc2I\x01\xa5\xd7d\x04\x11\x87\xfa\xac\xcdk\xa4\x92\xfe\u05eeqFo\b\xe2S\nKI\n$ѳ\xde6\xb8\xa6\xa0j\x9d\x1e\xfb\x18\xf5,\xb0\xa27\x827\xef\xeeY,(\xd7}ũ\x85[+\xf69B\xceL\xc1Lck\xa2\xd3\x1clW\x13\x89\xd9[$\xc0/\x128\x82\xa8\x9bز\xb7S\x1fF\xef\xfb~{\xff\x1e\xf8\x06*)\xf2\xf3\xf6\"\xa5\x89\x93u\x7f\xe3p.\t\xb47\x85C{BB1\x9b\xab\x90\x1cU\x14CN\n\xa4\x85\vQ\x13\xefL\x1cJGm\x9f\x9c]\x0fV\v:\x99J{\x97x9\xd0_m\x16o{-\x11\xbb\xa1m\x9cBB\xe3.\xa2\x97\x86\t1\xb5\xde2\x12\xddyG\x8d%te\xcdKIZ;\xa68\xa3G\x8a\x06\x83\xe0\xe0\xd3\xffb\xa9\f\x97ƕ\xd1p\x00H&\x02\x7f\x96R\xb1\xab\xee\xafg\xd7\xea`\xbb\xfc3\xe3\xc6\x17se\x8ee<\x99\x10\xecǝ\"\xf8\x89TȾG\xc0\xb3\x99A\xaafګz\xbdS\x0f\xe3\x1b\x9e~\xd4\xc3\x19\vh\x8cD\xa8\x13\x9c\xc3M:*\xb3(\xf9\x9d\xafQ\xfapaz\x918\x1e\x19Z\xf5I\x04.\x19h\x12\x10^\xb3'N\xd6\xe2\xe6\xf5M\xc8J\x83A\xeduO|\x12\xb8\x15\x9e\xae\xecT\n\xc3\x0e)\xd9\n\xeb\xe3>\xf8>\"\x89\x91\x99x?πY\xf1\xfc\x84\xbcx\x1d&\x88\x98\xfbY\x18\xb6\xca]\xca\x15ۻ9\xa7\b\x9f(D\x03\xed-\x80\x06\xde\xd3\xebWE\x16\x80\xc6A\xc8PƢ\x87,\xe0\x0fc\r\xefCK8\xbc\x8b\xe6\x8cc˻\xb44\xee\x0f\xb6O\x9fߪ̭\xd5\xeaKw\x13\xfe\xea\x05\x825-9Z\xafՃ>Xs\xb6ҽ\x875\xc7\ue094juh,\\\xf4x\xfd\xf9I\x96c\xad\xb2\x0f>6\xba\xed\xa4G+ne *\xb7$K`\xfc\xa1\a\x15\xf4\xd1?\xc4\xc9\xcd\xdf\xd0@;\x0f\x99B\x14\xbd@\x1c_\xba\x83\xfa_\xc7\xf5\xd1~\x8e\x14\xe7\r\xb8\xb1\xeeN'cG\x9fo\xafk\xf8P\xfbݛ\x80\x17T\x0f3\xc7\xfeĘ\xea\xc7X\x97\"\xcaP\v!oe1T~\a0\xcf\xcdO(p\xf5\xbai\xdb\t6\a\xbf\xba\xb1\x91v\xb9\x1bne=\xc6\xc2\xf8\x90\xf4O0\xbb\x85\xc8\x1fRxV\xeahv\x88\u038b\x0e;c\xfd\xbe${\xf5ͬ1_98M\xff_\v۶A\xf8\x87\xe6\xff\x90\xaf\xcb\xcdx\xf83\x00\xe7\xbc\a\xb1a\xd2\xcc|\xbe\x8b\x01\xe6\xaf\xd3ERP\x8b\xb9\xe0\xf5Q\xe3Ś\xe9|a\x15\f\x8a\xc76Oh3H\x8f0\x80ۨ\xe1:\x9d{\x1df\x10\x8f\x93\xd5Iф:\x0e\x8e\x91\xdc5\xa4\xeb\"\\\xf9p\xb9\xd9~{_\xad\xcav\xbe9\x9fm#\xee\xce+\x84\xd9\xce\xff\xdf|\xc0\x8f\xe0m\xff(\xc9\fD\xe7\x9c\xe6\bB\xff\x16\xe4\x1e#F\xda\xcd/0*\xf5K\x7f\xf2\r\xa4-P\xfd\xb6|ӷ\xc2\xc9+\u0093[cu\xaf\xcf[\xcc\xffE`Bb\x840\xf0\xee\x89\x14\x1eR#\v\x94*&K؍\x16\xa5F\x98\xc8\xcc\xc1vvh\xaf\taD\x1bZ\xfc\x11\x15\xf9\x98\b\x99\xdeD\x7f\x89IE?ӝ\x1a*\xf7U(/\xf0\xf4\x8b\xe1:Y\x18\xa6\x8d\xffm\x8d\xf6\xb5T\xb7W߾W\xcc\xd0\x13\f\x823\xda\xedշ\xefտ\x03\x00m\xf4\xb6\xb7]\x18\x02\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[\x8f\xdc:r\xf0{\xff\x8a\xc2|\x0f\xf3\x05\xe8\x96\xed\x13\x04I\xfa\xcd\x19{\x93\xc1\xfa\x1c\x0fl\xaf\xf7a\xb1\x0fl\xa9\xba\x9b;\x12\xa9\x90Ԍ\xfb,\xf6\xbf\aŋnM\xb5\xa8\xb6}\xb2Yxd\xc03\x12Y,V\x15\xeb\xc6\x12\xb5\xdal6+V\xf3Ϩ4\x97b\v\xac\xe6\xf8Š\xa0\xbft\xf6\xf8o:\xe3\xf2\xc5ӫ\xd5#\x17\xc5\x16\xee\x1amd\xf5\x01\xb5lT\x8eop\xcf\x057\\\x8aU\x85\x86\x15̰\xed\n\x80\t!\r\xa3ۚ\xfe\x04ȥ0J\x96%\xaa\xcd\x01E\xf6\xd8\xecp\xd7\xf0\xb2@e\x81\x87\xa1\x9f^f\xff\x9a\xbd\\\x01\xe4\nm\xf7O\xbcBmXUoA4e\xb9\x02\x10\xac\xc2-\xe8\xfc\x88ES\xa2Ξ\xb0D%3.W\xbaƜF;(\xd9\xd4[\xe8\x1e\xb8N\x1e\x137\x8b\x8f\xbe\xbf\xbdUrm~?\xb8\xfd\x8ekc\x1f\xd5e\xa3X\xd9\x1b\xcf\xde\xd5\\\x1c\x9a\x92\xa9\xee\xfe\n@\xe7\xb2\xc6-\xfc\xc2*\xd45˱X\x01\xf8\x89١7\xc0\x8a\u0092\x8a\x95\x0f\x8a\v\x83\xeaN\x96M\x15H\xb4\x81\x02u\xaexMM\x1c\x1c\x90{0G\xec\x8fB\xd7_\xb4\x14\x0f\xcc\x1c\xb7\x90\x05\xa2g4C\xff\x98~u\xfd\xfd\rs\"ĴQ\\\x1cbC}4\xcc4z~0m\xdbe\xf5\x91\xe9\xf0ԍ\xe5\x00$\x8e\xf6\x1a\xee\x94\x14\x80_j\x85\x9a\xa8\x03\x85\x15\"q\x80\xe7#\n0\x12T#\xec\xbc\xff\x83\xe5\x8fM\x1dA\xa4\xc6<\x1b\xe1\xe91\x19ޜ\xc3\xe5\xd3\x11\xa1dڀ\xe1\x15\x02\xf3\x03\xc23\xd3\x16\x87\xbdT`\x8e\\\xcfӄ\x80\f\xb0u\xe8\xbc\x1b\xdfv\b\x15̠G'\xc6\xcb3\xe1\x1f\xc0|}\xc0807\xe4\xd3+\xfb\aa\\ٵH\x7f\xc9\x1a\xc5\xeb\x87\xfb\xcf\xff\xfcqp\x1b\x86\xd4\b\xd2\x0f\\\x03\x83\xcfv\xfd\x80\xf2+\x1d̑\x19PH\\Ca\xa8E\xadp\x13(S\xb4 \x01\xa4\x82\x1a\x15\x97\x05\xcf\x03Emg}\x94MY\xc0\x0e\x89\xb8YۡV\xb2FexX\xa1\xee\xeai\xa4\xde\xdd\x11Ʒ4)\xd7\xcaI\x11j+8~\xddaa9W1'\xdb\\w\xf8[\xed2\x00\fԈ\t\x90\xbb\xbf`n2\xf8\x88\x8a\xc0\x04\xacs)\x9eP\x11\x05ry\x10\xfc\xd7\x16\xb6&\x89\xa5AKfЫ\x8d\xee\xb2\xeb\\\xb0\x12\x9eX\xd9\xe0\x1a\x98(\xa0b'PH\xa3@#z\xf0l\x13\x9d\xc1\xcfR!p\xb1\x97[8\x1aS\xeb\xed\x8b\x17\an\x82&\xceeU5\x82\x9b\xd3\v\xabT\xf9\xae1R\xe9\x17\x05>a\xf9B\xf3Æ\xa9\xfc\xc8\r\xe6\xa6Q\xf8\x82\xd5|cQ\x174a\x9dU\xc5\xff\v\x1cշ\x03\\\xcf֊\xfbg\xf5\xe5\x05\x0e\x90\xe2t\x02㺺\x89v\x84\xe6\xe2`Y\xf2\xe1\xed\xc7O}a\xe2A_\x84\x1fG\xf7\xae\xa3\xeeX@\x04\xe3b\x8f~5\ue56c,L\x14E-\xb90\xf6\x8f\xbc\xe4(\xc6\xe4\xd7ͮ\xe2\x86\xf8\xfe\xdf\rjC\xbc\xca\xe0Κ'\x92æ\xa6\xd5Sdp/\xe0\x8eUX\xde1\x8dߝ\x01Di\xbd!¦\xb1 (\x86m\xa4\xb1\xa3Z\xefA\xb0\x82\x13\xfc\nk\xfcc\x8d\xf9`\xc9P?\xbe\xe7\xb9]\x18V\xf3\xb5*`\xa4\xfd.\xadZ\xba*\xae5\x16\x1f\x1a\xf1 K\x9e\x9fƏG\b\xfd<l\x1d\xf0@\rϤ3\x8c\x84B:\xc3 \x05\x92j\xa9\xa4\x1ab\xe2\xe7\xed\x91,H\xb9hxF\x85\x1e\x935`v\xc8`\x879k4\x89\x1e\xb6\x13\xb3Kܪ\xfbB>\xf7TRw\xdd\xef\x01\xabڜ\xd6\x04\xf6\xbdȑd\xbd\xd1X\x9c7F\xd1T\xe7\x93݄\x8e\x91'\xfa\x91\u05eb\xc1\xbdi!\xa0\xab\xa6\t\x143\x04}\xb0\x8d\x06tDsD50\xee4\v\a-\x83\xd7\xfe\xb7\vD\x85B\xa2\x16\xb7\x06\x8c\xe2\x87\x03*\xd8Y\xb5\xaeω\xe0\xa4r'e\x89L\x8c\x9e*4n\x15\xcc\xcc\xe0\xf6Ch8\x98\x05Ϗ\xc1G\xf1Ã\xb5\x94X\xc0\xeet\x06\x11\x86\x96\x1b\x98Bx\xc4\xdadp\xbf\a\x8df\r\x9c\x94y]\xb2ܯ\x80O\x9f\xde\x05\xf8\xb9\xac\xea\x12M\x94&~\xec-\xf59\xdd\xdez\xb0\xc04\x94R\x1c\xe8\x7f+\xa8{\xb0z\x87|T`u]r\f\x86\xa2ZG\xa0\x92] \x14\x0fL\xed\xd8\x01799˹\xc1\x02$1\xef\x99k̆ʂ.\xf2\x89ٮ\xc4-\x18՜K\xd8\xf4\x1a\xa5\xeb\x11\xb1~\xc3x\x19Y\xa1g,\xf9}hK\x82C\x14\x12M\xb5CE\xf4*\xd8I\xaf\x81\x8b\xbcl\n\xaf\xec\xa3\xf0\x00\xf2F)\x14\x06\xa4\xc0\xb5\xd50\x8e\xa7\x04\xae\x92\x9a\xb8\x91\xd3cG`\x02\x8d,?B\xc1b̥\x8bkK\xfas!\xa4\xab\xe2\x82WM\xb5\x85\x97\xd1\xc7NN\xc9:\x1fPEZ\x10q\xc8{K\xa4\r5='\xcd\xf9\xacH\x06\xa2\x10\x1d;\xbe\xdbT~\x96\xc2\x1c\x939\xed[\xc7&$̱\xcf\xed(<Zzx\x1d\xb7'\xe0\xd9q\xbf;\xbf\xff\x88\xf8\x98L#\xd7\xf8\x9cDψ\x8f\xdfu=\xd0\x00\xbf\xf9\x82\x98p6\xfa6b\xbb\xbaH\xb3ap\x91\x1a\x02\x9e\xc1\x04\x1fQdK\xec&\xd9\xd9\xfb\xaa\u00823\x83\xe5i\x0e\xd3a\xeb\x98%\x95\x16$0\x8f&3\xab(3\x8a\x06\xfd\x84F\xa67\xd8-\xa9\xa0\x11\xce\xfa\xba\xd0\xe0\x99qcUc|\xee\x02\xbf\x98\x16P\xe1\xa2V.\xb4AV,3\xc5\xda0e\xb88\xbcAV\x94\\\xcc2oԜ\x98x\x94\xcf6\xe4\x01\xd6È\xe8A\xde\xfa.\x86\xbdw\x1e\xc8^\xe3\xde\xc68\xe6VSjH\xf3\x02\x15\x16\xde_\xb3\x8482Q\x94X\x00\xcbs\xa9\xdc:\x8a)NZ+#\x0f2\xeb\xf9l\xff\x02\x15\x17\x8dA=\xed\xb5]\x10\x1b\x83UM3\x9c!\xce'\xdf,肢͉\x05w\"\x04\xc1\xd2Ǿ \xc5\xc4\\j%\x9fx\x81E\xdc瞷\xe9\xe4\xb8\xf85\x15{<\xc2\xfc\xaekMl\xd8\xf3C\xa3\xd0q\xb6\xf3\xb2\xc0\x90KR\x964\xbd\x00>F\xc8\xd6_\x16\xbc\\\xf7\xfbk#\x15; \x94\xd2\x05\x17\xb7\x1d\x1cZ\xfa\x93\x9cIpp\xe6\tB\x17+\x0fRqs\x8cx\xe7Q\xb2\xbc\x0e\xed\x03G[\x00\xb3d\x99\x1c\x00\xe0\x99\x9bc\x06opϚ\xd2\x06\xa2p\xf8\x95OX\xfc\xe9p\"\xfcll\xef\v\x8f\x7fզ\xb8\xf0XH\x11'\xe7̚\bWI\xd1n\"=\xdfQ\xdb@\xcb>\xeb-\x90\xb5\x8b\xe7_\x11E\xfe\xdd\xea>\x9a\x19)\x81I\xe8\xd0\xeb\xf2\xd3O\xb6\x0fM\u05ee\xfc_Q\xc9\xf5\x90k\xb7\x9a\"]\";\x94\x01\x95i\x91\xa3\xabb_\x9c\xf3\xf8\xd3O\xd3m.\xdb\xd3y\x9b:cW\xe9\x9fG\xfb3\xe5\x89Q\x7f\x92\x1fP\x1b>\n\xef\xa3$\x7f\x13\xed\x181e\xca?\xb0I\xae(\\ \xa5E\xe4\"\x06\x19\xf6HyR\xbf\x04(aV\x96P\xcb\x02\x9e\xdcH\xb0;\x05\xa4\xe3Խd\x94\xe8*\xd4\xe9C#Rfh\x1bƌs\xb7D\xa5()\xd3VKe\xf4\x84E\xa5\x7f\xdc`\xa5\xd7\xed\x14\xac\xf9\x91\xf2QS\x84\xf8Lt\xb1\xf0\xa0\xa9\xd7v\x11\xcb\xc6\xc0\xb3\xe2d>\x81\x055\x10\x8b\xe9\xe82\xec\x91\xdai\xc1j}\x94FS\x06C5\xc2\xfa:v\x90\xebȄ_(\xdc¢\xddm\xd0\t${{։\xb4\xbea\\\x90KF\xbb d\xb0D\xfb4\n\x91l.36\x9c\xa6$\x9a\x8b\xfb\xb0\x00.z\x94\x8fO\xca\xd29\x8eg\x82\xceI0\x05\x0e\x06S\x8a\x9d.\xd0,\xec]-!Y\xdbǧ:K\x9e\xdb\x18\xbfMhZ\xaaM\xb9\x82t\xfd\x1f$\x98\x95\xcf\x04\"\xfd\x17\xb5\xeb\x12\xb7\x90\xdb-B\xd8\xe1\x91=q\xa9\xf48\xfb\x8f_0o\xe2\xc9\x15\xba\x98\x81\x82\xef\xf7hc#\xbb\xd9\xd4\xeeM]\"ּ\x1f\x10\x985\xd9`4\xaf\x8e\xe9\xc4<K\x8d\xa9\xa9\x90\xf6\x89\xad\xd3\xf0C\x88Ӓoj\xe0\xa2\xe0O\xbchXi]w&h\x00Ҥ-~\xf1\xf9\xcd\n\xc4\x19\xfe\xce\xed\f\xb3 .\r\xb2\xbe\x97\xf3\xa9\xdd\xcf9\x98I\x8e\u008e\x91\xa1\x90S\xb1[\xf7\xe3\x12c\xd6U\xc6\xc2Z\xf0N\xef\xac;N\xb9\xa8\xa8d;,A#eĤ\x9a&O\x8a\x10,ӟ\x13\x94\x8dh\xd2\xce\x10Ѫ\x9eU\xa2\xdded\x1b\xf7ۨJ>Z\xa3fS\xaeVcP\xf6\xf0ti\xd2I\x92\x91\xa84\x16\xa9\x8fTErN\xf7 Mב\xbd\xed\xdd3\xffD\xf5Vl~\x10\xbdOt.\xc6Һ\x88\xea\xf7gݿ\xbd\xb0\xfb\fy?v\xe7&\xdcM\x81J\x91X\x87\xc7?\x18\xe3\xae[-\xf7\xe3\xde\xdf|\xb5|\x13\xae\xb5h\xfc\x830\xcd\x1a\xab\x8f\xdeV-bػ~\xcf5\xf0}˰b\r{^\x1aT\x97\u0098\xee\xa7%\xe9,\xe7\xbe%\x81Rm/]\x153\xf9\xf1m\x9b\xffM\xe81\xa2\xd5\x18\x00\xf0~\fcy\x90\x00\x12Z\xa7\xc2V\bp\x85\x15նd\xb60\xa8\x7f\xc7\xc6;\xaf\x7fys)i\xb0XR\xcf&\xf5z\xe4\xe9\xf4Q\xb0\x13L\x02ٛ\x94u\xd3\xda\x18\xcfVf\xe850xē\xf3\xac\xa2\xc1e\xec\"ֲ\x16\xa4BʋZa$X\x16\x94\xaf^I\x82\xb7DT\xc2\xfe\xcc\xc4\xc6\xcc,Q\t?\x9f{rԥ\x1bv\x16)K)BT\xbfv\xa8\x94$\xb9\xfb\x02\xa54\xa6\xf8\x95\xd3n\x19\xd6\xc6e\xb4@\x1e\xf1tK\xd50\xa5\xcd\xc4\xea\xe3\x85\x14\xe2\xf9E\n\x9b\xb6\xcei\x85\x85Z\xa5Ϭ\xe4E\x8b\xab\x8d\x94\x16@\xbc\x17k\xf8E\x1a\xfa\xef\xed\x17N\xf59$Io$\xea_\xa4\xb1w\xbe+\x89\xdd$\xae$\xb0\xebl\x97\xa5pf\x81\xe8\xb2h\xfc\x0e\a\xeb\xf8\xd0jj\xd9\xc65\x15%I\xe5\xe9\xb3\x00\"\x81\xf1\xc89\xb4\xaaF\x1b\nV\x85\x14\x1bk\xa6\xc3h\v\x80\xf6\xf1\xf2\xac\x92j\xc0\xa9\xf5B\x88Q\x14=z\x9f\xc8;tȟՉ]\xba|=G\x01ECl q5\x8a\x19<\xf0\x1c*T\a\x84\x9a\xecF\xbaP-\xd0\xe4WKa\xbak\x11~\xbcY\x88T\x02Ů\r\xa9\xe8Ė\x81\xcdI\xcdg\x92\xd7_3Kkޭ?\x94D\xfd~e\xf52˲\x90_\x03\r\xd0C\x92\x96\x05\x83\x8aդ\x03\xfeJ\xe6Պ\xf7ߒp\xa8\x19W\x9a갨\xae\xbc\xc4~\xff\x90%\xec\r\x95\x04\x920\xe1\x1aHN\x9eXI\x894R\xde\x02\xb0\xb4\xfe\fa9\xf6\xa0֫\x04\xb8\xf0|\x94\x9a*\x9eN\xb0\xe7X\x164\xef\x9bG<ݬϴ\xd7ͽ\xb8I\x83I:\xffLi\xb5^\x8bM\xe2\xdf\xd8g7\xd61[\xb2D\xaep\xde\x16HurS\x8aL\xb7\xab\x05\xa2E\xa1z\xf0ZD\xfb&\x80w\xe1\xb3\xd57\x92\xe9ZN\x158M\xa0\xf5 \xb5q\t\xc0\x81\xbb\x1d\xc9\x10\xce@\xb5΄\xcf\x1a\x02\xdb\x1bTv\x9b\xd8\xd7\xcb\xd8\xc4\xca(AN\x9co_;\x98\xbe\x98\xeae#\x1d`J\r\xdct\x1a\xc2\x02\xd77n\v\x91~\x9f\x87\x99SO'F\xb5\x92\xf9\xc5\r\xf0\x85\x96c@\xdes:\xb6\xc9Zf9O\x89\xd2Y\x90\x90\x94J\xbe\xce\x15'Ҧ\xb4\x1bM\xec\xed\x97^ޙ\xd1\xcb\x1f\x98'\x89\xf258\xfa:\x88\x8a\x8d\vדѽs\xbd\xc3\x02\xf4\xc0l\x94\xc3ԡ\xb1J%\x19r_\xd4\xff\xde\x1c\x8f\x8a\x8b{Z\r[x\xf5ݜ\x15\b\x9b\x8cxm(s\x17\xfaw\fio\x88\x85\x8e1\xedU?\x1f\xa98\xbc\xcf\xd9\xf3\x9d\x8ctN\x019Ӕ2\xee%k\xfcH\xb7\x1a\xf6\\\xe96\x04\x9f(\x02\x88_\xb3E\n\xdf@\x02\xa4x\xab\xd4\xd5!\xe6{\u05fb\x9d8Y\xa7g_[\x9f\f\x11:\xe2\x1f\xd9\x13R\u058b\x1b@\x91ˆ^\x9d\xb1\xd1\x15\xd20\v :&:c\x92h3S\xebn\xc6?\x1b+\x9d\\\xccfǺk\x03\xbfc\xbc\xfc\x9el\xa5\xba@٘mb\xf3\x11[\xe9\xb53\xaaq\b\xfa\x9a\x84\xd9\x17\xc4\x00\xab\x88-\xc9p\xc1\xfa-\xbc\xea\u07b8p\xbc\xee\x979Z\xf6,\x80hd[\x9e\x1f\xea\bC\tap\x1f<\xff\xa3UvS\x17\x83=\xe3e\xa30\xfb~\x9cY\x1a\xb7y\xf5\x94\xd4z\x81ۺ\x04\x91\x8d5]\xabo8z\xaa\xfd\xa8\xd52\x97\xf9A\xe1\xb7wMk\xc5IJ\xe5\x9cw:\v\xd3z\xafC\xef\xd4\v/\x13\xa7)\xf7t\x16*y\t?\xdc\xd3\x1f\xee\xe9\x0f\xf7\xf4\x87{\xfa\xc3=\xfd\xe1\x9e\xfepO\x7f\xb8\xa7?\xdc\xd3\xdf\xc0=M\xc1p\x03\xbdCY\xae\xc6*\xb1\x04c\x0e홱|\xa5\xd1]\xd9h\x83*\xb8x\x13\x16>Ve4\xee\x19)\xcc\xcf]\x93\x8d=(gJj\x82g؞\xbb\xb1ö\f\xcaF\x8ca1\xd9\r\xec\x14/<\x81\x80s\xd5\xf6\xfc\xac\x02n\xbb\xba\xa6lnX;ޖ\xabY9\x99\xf2،\f\xc3{\xeei\x9b\xb9\xee\xd7\\\rk\xdfl\x1c\x100\xceV\x8b\xbd\xb7Y\xb5\x91L\xd0)i\f\xc8]!fɅ\xf8S\x16ޏ=\x12\x9c\x111;!\xfc\xfb\xa7\xa5\xc1\xca\xc5e\x7f\x94\xea\x11U\x12-\xc7}\xce\xdfb\xb6\xb3\nU\xf7zZ\x8d\xb5do_\n!\x8ab\x01MM^\xa5\x7fù<\x8d^\xcd\n\xe6֞\xbfq;%\xfa\xfeE\xa2ˮ\xe6W\xbd֜T\xad7]\xa3G\x981{<\xcbӫl\xf8\xc4H_\xb1\x17\x05\xe9\xde\x06$\xcd(\xec\xa9`\xe2\xd0\x7f- \xacs#\xa32:\x01\x91J\xe8\xe9\xcdKVv\x10\x06\xe2\v\xef\xed\x1cX\x99]+\x8a\xf3\x81\xeexSy\xaa݈\xaa\xe3n\xc3\x1cΰ(n\xde*\x7fE\r\xdf\xc5ռ\xbc^/\x05i\xffB\xd5\xe5*\xbdx\xfd\xdd\f\xd4%\xb5y\xa99\x8c\x84:\xbc\xf4\xea\xbb4\xf2Е^s7\xabr\xc3\x15(\xbah:-\x1b\xbe\xb6\xaa.\xb1\x96\xaeW!7\v\xf2\xca\n\xbad\x82\xa5U\xcb\r\xc8u\xa9F\xae\x9d\xf6\xfd~\x06$\\\xac\x8c;/\x1d\xa1z\xb7Y\x90\xb1z\xb8\x94*\xb7$\\\x93k\xdbڊ\xb5Y\xb0_W\xd16\xab\xd7\x16\xca\u009c[\x12~\xd2\xe2\xa4\xcb\xf5iIUi3\xf1M*ν:\xabi\x94\x97V\x9b%Qu\xb0nzhLU\x96\xb5Uc\x17\x06N\xaa';\xaf\x15\xbb\x00q\xbe\x8al\xbaBl\x95\xbe\xbem\xedXB]\xd8\x05\x90\xfd\x8a\xb1\xc5n\xc0\xac4\xcd4\x88\x9fؗnk\xcb\xff\r\t\xfc\xdaIKeOq\x99\x89ꖠ>\x8b\xf6`Ѽ\x1f\x8d\xdfKAtn\xb4ò\x1f1NyQ\xb2}\xfd&\a:\xe4\x9247-\x9c\xba\xef\xd3\xd0\x03\x1b\xbewn\xd6t\xc5r\xe7Ѷa\x13uՠ\xb1f\xca\x1fd\xe7\x92\xfe:\x83\xb7t\xf8Yh8\x01\x91\xbaÑiʌT\xcc\xc0M\x9b\x06x\x11zҝ\x9b\f\xe0w\xb2\xcd\xc0\xb4P'k>5\xaf\xea\xf2D\xf5'p3\x04tm\xe80#;a\x90\xa9##Ϙ\x1d\xb8\xecO\x8d\xb4JR\xa1}i\x9c\xa2Q\xf2\xae\xee\xec\x896?\x93v\x13\xbd\xa83\n\xdb\x1f\x11Mi\x1a8\xca2\x1c\xdc\xe5τ\x80\x9aF!\xff3\x9c\x83Q`\xce\v\xca\r?\xbbC\xb9\\\xbb\t\xd0\\wQ\xf1\xd5\x04\x9cW\x1a\xac\xe6\xffi\x8f\xbe\x9ex>\xa2\xe0\xeb\x87{\xdb<\x88\xb2=6\xbb\xcdz\a\x86\xc0\x0e\x89\x16-i/(M[\bՇ\x1a\xd9uj\xff\xb4K\xaau\x8d\xf8\xdc\v\xe39\xe5\xd1_?\xdc;,3+ʹq.\xfd\x91\x1f\\\x15\x9b\x9a)s\xb2JJ\xaf\xfbx$\xb8'\xd9\xea+4\xe7\xf9\t\xb9\x934\x0f\x87\xe5\x12\xbd\t\xf2@\x17\x8c)\xfd58].8\x9e-5\xfe\x0e8\x05RǱ\xdaX*\xae\x16\xa6\xd1g\x94J8tş\x82\xb3]\xcd\xd2\xe2\xe3\xb0G$\x89\x1d\xce\xc0\xc9K\xd9\x14ݱ.Q\xd0@\xec%)}\xf8|\xab{D\f\xfaȇ\x7f!Y\x13\x125\xfe\xf1\x04ȩ\xe3\xef\xbeQ\xaa\xdb\x1f\xd6\xf5ΟՕB\xb3a\x0f\x9f\xf7\xb0\xc2\x19\x9c\xb5\xa0M\xbdxEaB{\xfc\xfa\x18`W\xae\xe9Mx\xb73@\xd8N\xad\xde\x19\x894\xa6L\x98\x1c\x9d\tk'dx\x85ٛFY\x94H\xd5h$J\x87\x89:\x8a\xec\xe2C\xd1E\x96\u009e\x0f\xdb;\x0f\xae\x9b\x87B\"\x93\xdb\xe1\xb8j6M]JV\xa0\xfaD\x93\x9e\x9f\xd6\x1fz\xcd\xc7\xfa\x88~\x0f\xe0Z{\xe7\x0f@\xa2\xaa\x81(thOOj\x0f\xe7\xdes\"\xcfI\x1b\x1clVDҿ!ѻ\xba\xa2\xd2`z;|Cn\x8e\xe1\xf1\xcd\xc1\r<ʚ\xb3kH\xed&\x1a4E\x90R\x9d@\xf5\xcf\xf1\x9e\xbddgo\xbd\\\xda\x13\x92\xfbIXLk\x99\xd3\xc1\x95\x85K1\xdb\n\x03\x9fA^-\xce\f̐\xe2rD}A;7\x1a\xdf?\v\xdah\xf4:Q\xdf\v\xb7(\xb6\xab\x8b$\xfc\xc3Yǰ\x96b\x9a\x9a\xfc\xd7Q\xf33\xf0\x00Rx\xc5\xd2?O\xda\x1dP\x11\x0e-\xcdV\vU\xed\xb4\x9a\x8d\xdb\xc1M\xfc\xc0\xc7M{\x06\xe5*\x81\xb2\xee\xfb\x1c\xdb\xd5$\xf5\xc2t\xfc\a?rV\xd37\x02\xf4\xe0\xb8^\x02b}\x80k\x8f\x7f\xef>\x851\xc3\xcb\xee\xe3\x18A\x05%|\x8a\xe3\f$t\x9f\xad\x88\"J\xff\\t\xe4>\x95\xb1!M~\x1d;\xa3\xeb\x80p\xfe\xf8\xc8\xeb\x1a\x8b\x84\xf9\xfa\x96\xb1\t\xd3a\xb1\xf6lP\xff\xa6W\x98\xd6\x19P\xb0D\xa1\x03hk:6\x16\xb9\x15\xf7p\xbc\xbdܟ\x1d`+\xbb\xa7t\xa2\x1d\x8b\xa9\x95\xdeѫ\x913U\t_\x1a0\xfbMI\xdb~H@\xcfP\xb6\xc56\xb2\x93\x19\x84\xc2\x7f\x17\xc0n=\x1cY̊\xed\x10E\xa0\x83?\xb5\xb7\xc7\x06\xf7\x9d\x18E[1*\x10\xbf=\tq\r\xed\x17^\xfa\x97\x91\xf0\xea\xe5˗\xd9jɮ\xa4=\xf1lf\xc2\x0f\xd4\x06\xf8p\xe5ڎ\xc1\x82OJO\xdc`n\xe0\x17|\x8e\xdc}+\x88u\xe7\xd4rEbX\xd8-\x8bطT\xa8\xc9C\xfc\xb3\x02\x178\xfeԂ\xb3o\x96\xcc\xf1\xbd\x1b\xdd5\x1f\xd5\x04\xd0Nh\aѕ\xe9\xc5d\xff\xff\xf3\xbd;\x10%\xa7\xc9\xfe\xd3*\xd9D^\x98ɴi\x8c*ﳛ\xd67*zk\xc6;\xe6\xfd;\xcd.\xc4i-v\xde\x04\xc0_\xff\xb6\xea\xac\x01\xcbs\xac\x8d\xafD\xe9\x7f\x05\xeb\xe6f\xf0\x91+\xfbg.\x85K\xd0\xe9-\xfc\xe9\xcf\xf4]+\xebc\xfb\xaf\xec\xe8-\xfc\xe9ϫ\xff\x19\x00Q\x97j\xbd3l\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xe36\f\xbd\xfb+\x88\xeda/\xb5\xb3\x8b\x1eZ\xf8VL{\x18\xb4]\f&\x8b\xb9,\xf6\xa0\xc8t\xa2\x8e,\xa9$\x95iZ\xf4\xdf\vI\xf6\xc4I\x9cmZ\xa0\x89/\x96D\xf2\xe9\x91|fU\xd7u\xa5\x82yBb\xe3]\v*\x18\xfc]Х7n\x9e\xbf\xe3\xc6\xf8\xd5\xfe}\xf5l\\\xd7\xc2]d\xf1\xc3#\xb2\x8f\xa4\xf1\a\xec\x8d3b\xbc\xab\x06\x14\xd5)Qm\x05\xa0\x9c\xf3\xa2\xd22\xa7W\x00흐\xb7\x16\xa9ޢk\x9e\xe3\x067\xd1\xd8\x0e);\x9fB\xef\xdf5\xdf6\xef*\x00M\x98\xcd?\x9a\x01Y\xd4\x10Zp\xd1\xda\n\xc0\xa9\x01[`\xa4=\x12\x8b\x92Ȅ\xbfEd\xe1f\x8f\x16\xc97\xc6W\x1cP\xa7\xc0[\xf21\xb4p\xdc(\xf6#\xa8r\xa1uv\xb5ή\x1e\x8b\xab\xbck\r\xcbO\xd7N\xfcl\xc6S\xc1FRv\x19P>\xc0;O\xf2\xe1\x18\xb4\x06f*;\xc6m\xa3U\xb4h\\\x01\xb0\xf6\x01[ȶAi\xec*\x80t\xe9\x89\xd5z\xe4b\xff\xbe\xb8\xd3;\x1c2\xfb\xe9\xcd\at\xdf?\xdc?}\xb3>Y\x06\xe8\x905\x99\x90\xc8]\xbc\x19\x18\x06\x05#\n\x10\x0fJkd\x06\x1d\x89\xd0\t\x14\x94`\\\xefi\xc89zu\r\xa06>\n\xc8\x0e\xe1)S>ެy=\x12\xc8\a$1\x13\x1b\xa3ٱ\xfaf\xabgXߦ\xeb\x94\xebC\x97\xca\x0e9G\x1a)\xc1nd\x00|\x0f\xb23\f\x84\x81\x90\xd1\xc99\xca\xf4\xf8\x1e\x94\x03\xbf\xf9\x15\xb54#\x0f\f\xbc\xf3\xd1v\xa9Z\xf7H\x02\x84\xdao\x9d\xf9\xe3\xd57'BRP\xabd\xaa\x93\xe3\xcf8Ar\xca\xc2^و_\x83r\x1d\f\xea\x00\x84)\nD7\xf3\x97\x8fp\x03\xbfx\xc2Lf\v;\x91\xc0\xedj\xb552u\x9d\xf6\xc3\x10\x9d\x91\xc3*7\x90\xd9D\xf1ī\x0e\xf7hWl\xb6\xb5\"\xbd3\x82Z\"\xe1J\x05Sg\xe8.]\x98\x9b\xa1\xfb\x8a\xc6>\xe5\xb7'X\xe5\x90*\x8b\x85\x8c\xdb\xce6rC|!\x03\xa9\x1dJ}\x14\xd3r\xd1#\xd1\xc6msJ\x1e\x7f\\\x7f\x84)tNƉS\x18y?\x1a\xf21\x05\x890\xe3z\xa4l\a=\xf9!\xfbD\xd7\x05o\\\xa9.m\r\xbas\xfa9n\x06#<\xd5n\xcaU\x03wY\x8a`\x83\x10C\xa7\x04\xbb\x06\xee\x1dܩ\x01\xed\x9db\xfc\xdf\x13\x90\x98\xe6:\x11{[\n\xe6*z\xfc%/\xed\xc8\xdalc\x92\xb9+\xf9Z\xe8\xeeu@\x9d2\x98HL֦7:\xb7\a\xf4\x9e@-\x9947!\xc9\x16\xff\x12˨$\x05͙\xbe\xf8\xfe\x164\xcbr\x92\xfea\xa7\x18\xcf\x17\xcf0=\xa43\xe7\xf1\xad\xe9Q\x1f\xb4\xc5⢨\t\xfe3\x94\xf4G\x17\x87˘5|\xc0\x97\x85\xd5\a\xf2IY\xb3\xae\x03\xdcP\x1b\xe3\xf7fk\xa6\xaf\xea\xf5\x9b\x95S\xf9\x1b6\x97\xea\x99@\x8f\x8e\x80\xa2s\xa9o/\x142=\x17J~q\xc6\b\x0e\vh\x16\xf1ܻ\xde'm\x15\x95\x02+)\xfd\x84c\xb2\xc78\x05ׂ\xc3빾&^7\x11Z\x9e\xfc%\xfdo\xc6In\f\xe1b\xec:\xa3Z\xdcH\x11\x176\xae\xf4\u05c82Z\xab6\x16[\x10\x8a\x97\xd6\xc5V\x11\xa9\xc3\xd9^\x98J\xed8OU_N\u0605Aꓗ\x1d\xbak\xdd\x00/\x8a/|\xce\"\xc3\xe6p\xcd\xf4\xeeu8\xbcl\xa92e\xb4\x90\xb4\xbb\x16\xb3\xc0\xd9M\xa4,f\xaf\f'\x8b\x93\xc7\x05!\xeb\xf9\xd9I3NZc\x9a͚\xdb!,&\xfbb1\xc3\xecf\xd7c\xf1\xa4\xb6\xf3\vsܼ~\xe9\xdb\xeaD\x92\xe1Ͽ\xaa\xa3:\xa7a.\bv\xb3\x814Uh\voޜ\x8c\xb3\xf9U{\xd7\xe5ٞ[\xf8\xf49M\xa4\xe2\t\xbb\x91\x04n\xe1\xd3\xe7\xea\xef\x01\x00;,8\xce>\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x92\xdb6\f\xbd\xeb+0\xe9!\x97JN\xa6\x87vt\xcbl{\xc84\xcd\xec\xc4\xe9^29\xd0$$\xa3K\x91,\x01\xba\xdd~}\x87\x14\xb5\xb2\xbd\xf6v;\xd3Z\xbe\x90\x04\x1e\x80\x87\aJM۶\x8d\nt\x87\x91ɻ\x1eT \xfcS\xd0\xe5\x15w\xf7?pG~sx\xdbܓ3=\xdc$\x16?}B\xf6)j\xfc\x11\ar$\xe4]3\xa1(\xa3D\xf5\r\x80r\u038b\xcaۜ\x97\x00\xda;\x89\xdeZ\x8c툮\xbbO;\xdc%\xb2\x06c\x01_B\x1f\xdet\xdfwo\x1a\x00\x1d\xb1\xb8\x7f\xa6\tY\xd4\x14zp\xc9\xda\x06\xc0\xa9\t{8x\x9b&d\xa7\x02\xef\xbdX\xaf\x8b5w\a\xb4\x18}G\xbe\xe1\x80:\xc7\x1e\xa3O\xa1\x87\xf5`\x86\xa8y\xcd5\xdd\x15\xb4mE\xfbPъ\x81%\x96\x9f\x9f1\xfa@,\xc50\xd8\x14\x95\xbd\x9aY\xb1arc\xb2*^\xb3j\x00X\xfb\x80=|T\x13rP\x1aM\x03P\xe9))\xb7\v\x01ogD\xbdǩP\x9eW>\xa0{w\xfb\xfe\xee\xbb\xed\xc96\x80A֑B\x8eq\xad\x10 \x06\x05K&\xf0\xc7\x1e#\xc2]a\rX|D\xaeI?\x82\x02,\xf9s\xf7\xb8\x19\xa2\x0f\x18\x85\x16\x82\xe7\xe7H^G\xbbgy\xbdΩ\xcfV`\xb2\xae\x90A\xf6\xb8\x94\x8f\xa6V\v~\x00\xd9\x13C\xc4\x10\x91\xd1\xc9ڮ\xf5\xf1\x03(\a~\xf7\x1bj\xe9`\x8b1\xc3\x00\xef}\xb2&\xcb\xf1\x80Q \xa2\xf6\xa3\xa3\xbf\x1e\xb1\x19ė\xa0V\t\xd6ή\x0f9\xc1蔅\x83\xb2\t\xbf\x05\xe5\fL\xea\x01\"\xe6(\x90\xdc\x11^1\xe1\x0e~\xf1\x11\x81\xdc\xe0{؋\x04\xee7\x9b\x91d\x19+\xed\xa7)9\x92\x87M\x99\x10\xda%\xf1\x917\x06\x0fh7Lc\xab\xa2ޓ\xa0\x96\x14q\xa3\x02\xb5%u\x97\v\xe6n2\xdf\xc4:\x88\xfc\xfa$Wy\xc8*b\x89\xe4ƣ\x83\"\xf7g:\x90\x95>\vav\x9d\v]\x89&7\x16v>\xfd\xb4\xfd\fK\xe8Ҍ\x13P\xa8\xbc\xaf\x8e\xbc\xb6 \x13Fn\xc0X\xfc`\x88~*\x98\xe8L\xf0\xe4\xa4,\xb4%t\xe7\xf4s\xdaM$\xb9\xef\xbf'dɽ\xea\xe0\xa6\xdc5\xb0CH\xc1(A\xd3\xc1{\a7jB{\xa3\x18\xff\xf7\x06d\xa6\xb9\xcdľ\xac\x05\xc7\xd7\xe4\xfa\xcb(}e\xed\xe8`\xb9Į\xf4\xeb\xf2$o\x03\xea\x93\x01\xca(4P\x9d\xec\xc1\xc7\x13D\x00\xb5\xcc\xf9e\xbcu\xb8\xaf\x0fx\xbd\xe3\a\x1a\xcfw\x01\x941\xe5\r\xa1\xec\xedU\xdfg\b\xbbP\xf7\x8dw\x03\x8dY\xa8\x83\x8f\x10\xa2?\x90\xc1\xd8.u\xd6LR\xac\x05\x13Z\xc3\xdd\x13\xc8+\x9c\xd7\"\vd\xff|\x1e\xb7\xd5,g\x92U\xbb\xb8\xcd7\x14\xd6\v\xb3\\\x9fjĮyq\xc5Y\xe1\x14\xf1lV\xdb\xc7\x00\xcd\v\xea`Q\x92Έ~\x89z\x8a[\xadsW\x15\xa4S\x8c\xe8\xa4b\x9e@B.\xf6?RP\xd8+\xc6\x7f\xe0\xfcr\x84\xdb카\xc1Ҁ\xfaA[\x9c\x01\xc1\x0fO \xff\xa5\xe8\xf3\x1f]\x9a\x9e\xe6\xd6»\x83\"\xabv\x16/\x9c\xfd\xea\xd4\xd5ӫͿ\xd8\xcf'\x9b\x9c\xdfh\xa6\a\x89i\x8e\\UVw\xd6\xee+\xad1\b\x9a\x8f\xe7_=\xaf^\x9d|\xb8\x94\xa5\xf6n\x1eV\xee\xe1\xcb\xd7\xfc=\x92_\xfd\xa6\xbe\x96\xb9\x87/_\x9b\xbf\a\x00\xbf\xca\xff\xa71\n\x00\x00"),
 }
 
 var CRDs = crds()
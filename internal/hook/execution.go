@@ -0,0 +1,143 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+// maxExecutionOutputLength is how many bytes of the stdout and stderr of a hook are recorded.
+// Longer outputs are truncated to their end, where errors are usually reported.
+const maxExecutionOutputLength = 1024
+
+// Execution is the record of an exec hook executed by a backup or a restore.
+type Execution struct {
+	// Name is the name of the hook, or <from-annotation> for hooks specified by annotations.
+	Name string `json:"name"`
+	// Source is where the hook is specified: annotation or backupSpec.
+	Source    string   `json:"source"`
+	Phase     string   `json:"phase"`
+	Namespace string   `json:"namespace"`
+	Pod       string   `json:"pod"`
+	Container string   `json:"container,omitempty"`
+	Command   []string `json:"command"`
+	// ExitCode is the exit code of the command, if it's known.
+	ExitCode *int            `json:"exitCode,omitempty"`
+	Stdout   string          `json:"stdout,omitempty"`
+	Stderr   string          `json:"stderr,omitempty"`
+	Started  metav1.Time     `json:"started"`
+	Duration metav1.Duration `json:"duration"`
+	// Error is why the hook failed, empty if it succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// ExecutionRecorder records the exec hooks executed by a backup or a restore. It's safe for
+// concurrent use. A nil ExecutionRecorder records nothing.
+type ExecutionRecorder struct {
+	lock       sync.Mutex
+	executions []Execution
+}
+
+// NewExecutionRecorder returns an empty ExecutionRecorder.
+func NewExecutionRecorder() *ExecutionRecorder {
+	return &ExecutionRecorder{}
+}
+
+// Executions returns the recorded executions, in the order they were recorded.
+func (r *ExecutionRecorder) Executions() []Execution {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]Execution(nil), r.executions...)
+}
+
+// Counts returns how many hooks were attempted and how many of them failed.
+func (r *ExecutionRecorder) Counts() (attempted, failed int) {
+	for _, e := range r.Executions() {
+		attempted++
+		if e.Error != "" {
+			failed++
+		}
+	}
+	return attempted, failed
+}
+
+func (r *ExecutionRecorder) record(e Execution) {
+	if r == nil {
+		return
+	}
+	e.Stdout = truncateOutput(e.Stdout)
+	e.Stderr = truncateOutput(e.Stderr)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.executions = append(r.executions, e)
+}
+
+// execute executes the exec hook in the pod with the executor and records the execution.
+func (r *ExecutionRecorder) execute(
+	executor podexec.PodCommandExecutor,
+	log logrus.FieldLogger,
+	item map[string]interface{},
+	namespace, name, hookName, source, phase string,
+	hook *velerov1api.ExecHook,
+) error {
+	started := time.Now()
+	res, err := podexec.ExecutePodCommandWithResult(executor, log, item, namespace, name, hookName, hook)
+
+	e := Execution{
+		Name:      hookName,
+		Source:    source,
+		Phase:     phase,
+		Namespace: namespace,
+		Pod:       name,
+		Container: hook.Container,
+		Command:   hook.Command,
+		Started:   metav1.NewTime(started),
+		Duration:  metav1.Duration{Duration: time.Since(started)},
+	}
+	if res != nil {
+		e.Container = res.Container
+		e.ExitCode = res.ExitCode
+		e.Stdout = res.Stdout
+		e.Stderr = res.Stderr
+		e.Duration = metav1.Duration{Duration: res.Duration}
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	r.record(e)
+
+	return err
+}
+
+func truncateOutput(output string) string {
+	if len(output) <= maxExecutionOutputLength {
+		return output
+	}
+	return "..." + output[len(output)-maxExecutionOutputLength:]
+}
@@ -0,0 +1,127 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestExecutionRecorder(t *testing.T) {
+	item := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "v1",
+		"kind": "Pod",
+		"metadata": {
+			"namespace": "ns",
+			"name": "name"
+		},
+		"spec": {
+			"containers": [
+				{"name": "db"}
+			]
+		}
+	}`)
+	hooks := []ResourceHook{
+		{
+			Name: "freeze",
+			Pre: []velerov1api.BackupResourceHook{
+				{Exec: &velerov1api.ExecHook{Command: []string{"fsfreeze", "--freeze", "/data"}, OnError: velerov1api.HookErrorModeContinue}},
+				{Exec: &velerov1api.ExecHook{Container: "db", Command: []string{"sync"}, OnError: velerov1api.HookErrorModeContinue}},
+			},
+		},
+	}
+
+	podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+	defer podCommandExecutor.AssertExpectations(t)
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "freeze", hooks[0].Pre[0].Exec).Return(errors.New("fsfreeze failed"))
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "freeze", hooks[0].Pre[1].Exec).Return(nil)
+
+	recorder := NewExecutionRecorder()
+	h := &DefaultItemHookHandler{
+		PodCommandExecutor: podCommandExecutor,
+		ExecutionRecorder:  recorder,
+	}
+	require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, item, hooks, PhasePre))
+
+	executions := recorder.Executions()
+	require.Len(t, executions, 2)
+
+	assert.Equal(t, "freeze", executions[0].Name)
+	assert.Equal(t, "backupSpec", executions[0].Source)
+	assert.Equal(t, "pre", executions[0].Phase)
+	assert.Equal(t, "ns", executions[0].Namespace)
+	assert.Equal(t, "name", executions[0].Pod)
+	assert.Equal(t, "db", executions[0].Container)
+	assert.Equal(t, []string{"fsfreeze", "--freeze", "/data"}, executions[0].Command)
+	assert.Nil(t, executions[0].ExitCode)
+	assert.Equal(t, "fsfreeze failed", executions[0].Error)
+
+	require.NotNil(t, executions[1].ExitCode)
+	assert.Equal(t, 0, *executions[1].ExitCode)
+	assert.Empty(t, executions[1].Error)
+
+	attempted, failed := recorder.Counts()
+	assert.Equal(t, 2, attempted)
+	assert.Equal(t, 1, failed)
+}
+
+func TestExecutionRecorderUnexecutedHooks(t *testing.T) {
+	pod := builder.ForPod("ns", "name").Containers(&corev1api.Container{Name: "db"}).Result()
+
+	recorder := NewExecutionRecorder()
+	h := &DefaultWaitExecHookHandler{ExecutionRecorder: recorder}
+	h.recordUnexecuted(pod, PodExecRestoreHook{
+		HookName:   "restore",
+		HookSource: "backupSpec",
+		Hook:       velerov1api.ExecRestoreHook{Container: "db", Command: []string{"restore"}},
+	}, errors.New("not executed"))
+
+	executions := recorder.Executions()
+	require.Len(t, executions, 1)
+	assert.Equal(t, "post", executions[0].Phase)
+	assert.Equal(t, "not executed", executions[0].Error)
+}
+
+func TestExecutionRecorderTruncatesOutput(t *testing.T) {
+	recorder := NewExecutionRecorder()
+	recorder.record(Execution{
+		Stdout: strings.Repeat("a", maxExecutionOutputLength) + "end",
+		Stderr: "error",
+	})
+
+	executions := recorder.Executions()
+	require.Len(t, executions, 1)
+	assert.Equal(t, "..."+strings.Repeat("a", maxExecutionOutputLength-3)+"end", executions[0].Stdout)
+	assert.Equal(t, "error", executions[0].Stderr)
+
+	// a nil recorder records nothing
+	var nilRecorder *ExecutionRecorder
+	nilRecorder.record(Execution{})
+	assert.Empty(t, nilRecorder.Executions())
+}
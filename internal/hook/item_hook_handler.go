@@ -150,7 +150,7 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 			}
 		}
 		for _, rh := range resourceRestoreHooks {
-			if !rh.Selector.applicableTo(groupResource, namespace, labels) {
+			if rh.Scope == velerov1api.RestoreHookScopeRestore || !rh.Selector.applicableTo(groupResource, namespace, labels) {
 				continue
 			}
 			for _, hook := range rh.RestoreHooks {
@@ -174,6 +174,8 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 // DefaultItemHookHandler is the default itemHookHandler.
 type DefaultItemHookHandler struct {
 	PodCommandExecutor podexec.PodCommandExecutor
+	// ExecutionRecorder records the executed hooks, if set.
+	ExecutionRecorder *ExecutionRecorder
 }
 
 func (h *DefaultItemHookHandler) HandleHooks(
@@ -210,7 +212,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 				"hookPhase":  phase,
 			},
 		)
-		if err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations); err != nil {
+		if err := h.ExecutionRecorder.execute(h.PodCommandExecutor, hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", "annotation", string(phase), hookFromAnnotations); err != nil {
 			hookLog.WithError(err).Error("Error executing hook")
 			if hookFromAnnotations.OnError == velerov1api.HookErrorModeFail {
 				return err
@@ -243,7 +245,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 							"hookPhase":  phase,
 						},
 					)
-					err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, hook.Exec)
+					err := h.ExecutionRecorder.execute(h.PodCommandExecutor, hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, "backupSpec", string(phase), hook.Exec)
 					if err != nil {
 						hookLog.WithError(err).Error("Error executing hook")
 						if hook.Exec.OnError == velerov1api.HookErrorModeFail {
@@ -258,6 +260,51 @@ func (h *DefaultItemHookHandler) HandleHooks(
 	return nil
 }
 
+// DryRunItemHookHandler is an ItemHookHandler that reports the exec hooks that
+// would be run for an item, instead of running them. The hooks are selected the
+// same way as by the DefaultItemHookHandler.
+type DryRunItemHookHandler struct {
+	// Report is called with every hook that would be run, with its container
+	// defaulted to the pod's first container.
+	Report func(namespace, name, hookName, phase string, hook *velerov1api.ExecHook)
+}
+
+func (h *DryRunItemHookHandler) HandleHooks(
+	log logrus.FieldLogger,
+	groupResource schema.GroupResource,
+	obj runtime.Unstructured,
+	resourceHooks []ResourceHook,
+	phase hookPhase,
+) error {
+	handler := &DefaultItemHookHandler{
+		PodCommandExecutor: &dryRunPodCommandExecutor{phase: phase, report: h.Report},
+	}
+	return handler.HandleHooks(log, groupResource, obj, resourceHooks, phase)
+}
+
+// dryRunPodCommandExecutor is a podexec.PodCommandExecutor that reports the
+// commands it's asked to execute without executing them.
+type dryRunPodCommandExecutor struct {
+	phase  hookPhase
+	report func(namespace, name, hookName, phase string, hook *velerov1api.ExecHook)
+}
+
+func (e *dryRunPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *velerov1api.ExecHook) error {
+	hook = hook.DeepCopy()
+	if hook.Container == "" {
+		pod := new(corev1api.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item, pod); err == nil && len(pod.Spec.Containers) > 0 {
+			hook.Container = pod.Spec.Containers[0].Name
+		}
+	}
+
+	log.Info("Dry run, not executing hook")
+	if e.report != nil {
+		e.report(namespace, name, hookName, string(e.phase), hook)
+	}
+	return nil
+}
+
 func phasedKey(phase hookPhase, key string) string {
 	if phase != "" {
 		return fmt.Sprintf("%v.%v", phase, key)
@@ -347,7 +394,13 @@ func (r ResourceHookSelector) applicableTo(groupResource schema.GroupResource, n
 type ResourceRestoreHook struct {
 	Name         string
 	Selector     ResourceHookSelector
+	PreHooks     []velerov1api.RestorePreHook
 	RestoreHooks []velerov1api.RestoreResourceHook
+	Scope        velerov1api.RestoreHookScope
+	// PodNamespace and PodSelector select the pod the hooks with the
+	// Restore scope are executed in.
+	PodNamespace string
+	PodSelector  labels.Selector
 }
 
 func getInitRestoreHookFromAnnotation(podName string, annotations map[string]string, log logrus.FieldLogger) *velerov1api.InitRestoreHook {
@@ -399,8 +452,25 @@ func GetRestoreHooksFromSpec(hooksSpec *velerov1api.RestoreHooks) ([]ResourceRes
 				// TODO: resolve the pods resource via discovery?
 				Resources: collections.NewIncludesExcludes().Includes(kuberesource.Pods.Resource),
 			},
+			PreHooks: rs.PreHooks,
 			// TODO does this work for ExecRestoreHook as well?
 			RestoreHooks: rs.PostHooks,
+			Scope:        rs.Scope,
+		}
+		if rh.Scope == velerov1api.RestoreHookScopeRestore {
+			// hooks with the Restore scope apply to any resource. The resources
+			// aren't resolved since there's no discovery helper here, see
+			// ResolveRestoreHookResources.
+			rh.Selector.Resources = collections.NewIncludesExcludes().Includes(rs.IncludedResources...).Excludes(rs.ExcludedResources...)
+
+			if rs.Pod != nil {
+				rh.PodNamespace = rs.Pod.Namespace
+				ps, err := metav1.LabelSelectorAsSelector(rs.Pod.LabelSelector)
+				if err != nil {
+					return nil, errors.WithStack(err)
+				}
+				rh.PodSelector = ps
+			}
 		}
 
 		if rs.LabelSelector != nil {
@@ -465,6 +535,8 @@ type PodExecRestoreHook struct {
 	HookSource string
 	Hook       velerov1api.ExecRestoreHook
 	executed   bool
+	// phase is the phase the hook is executed in, post if unset.
+	phase hookPhase
 }
 
 // GroupRestoreExecHooks returns a list of hooks to be executed in a pod grouped by
@@ -504,7 +576,7 @@ func GroupRestoreExecHooks(
 	labels := metadata.GetLabels()
 	namespace := metadata.GetNamespace()
 	for _, rrh := range resourceRestoreHooks {
-		if !rrh.Selector.applicableTo(kuberesource.Pods, namespace, labels) {
+		if rrh.Scope == velerov1api.RestoreHookScopeRestore || !rrh.Selector.applicableTo(kuberesource.Pods, namespace, labels) {
 			continue
 		}
 		for _, rh := range rrh.RestoreHooks {
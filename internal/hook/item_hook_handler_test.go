@@ -506,6 +506,111 @@ func TestHandleHooks(t *testing.T) {
 	}
 }
 
+func TestDryRunItemHookHandler(t *testing.T) {
+	type reportedHook struct {
+		namespace, name, hookName, phase string
+		hook                             velerov1api.ExecHook
+	}
+
+	tests := []struct {
+		name     string
+		phase    hookPhase
+		item     runtime.Unstructured
+		hooks    []ResourceHook
+		expected []reportedHook
+	}{
+		{
+			name:  "hook from annotations is reported with the pod's first container",
+			phase: PhasePre,
+			item: velerotest.UnstructuredOrDie(`
+		{
+			"apiVersion": "v1",
+			"kind": "Pod",
+			"metadata": {
+				"namespace": "ns",
+				"name": "name",
+				"annotations": {
+					"hook.backup.velero.io/command": "/usr/bin/foo",
+					"hook.backup.velero.io/on-error": "Fail"
+				}
+			},
+			"spec": {
+				"containers": [{"name": "app"}, {"name": "sidecar"}]
+			}
+		}`),
+			expected: []reportedHook{
+				{
+					namespace: "ns",
+					name:      "name",
+					hookName:  "<from-annotation>",
+					phase:     "pre",
+					hook: velerov1api.ExecHook{
+						Container: "app",
+						Command:   []string{"/usr/bin/foo"},
+						OnError:   velerov1api.HookErrorModeFail,
+					},
+				},
+			},
+		},
+		{
+			name:  "only the hooks of the phase are reported, even if they would fail",
+			phase: PhasePost,
+			item: velerotest.UnstructuredOrDie(`
+		{
+			"apiVersion": "v1",
+			"kind": "Pod",
+			"metadata": {
+				"namespace": "ns",
+				"name": "name"
+			}
+		}`),
+			hooks: []ResourceHook{
+				{
+					Name: "hook1",
+					Pre: []velerov1api.BackupResourceHook{
+						{Exec: &velerov1api.ExecHook{Container: "1a", Command: []string{"pre-1a"}}},
+					},
+					Post: []velerov1api.BackupResourceHook{
+						{Exec: &velerov1api.ExecHook{Container: "1b", Command: []string{"post-1b"}, OnError: velerov1api.HookErrorModeFail}},
+						{Exec: &velerov1api.ExecHook{Container: "1c", Command: []string{"post-1c"}}},
+					},
+				},
+			},
+			expected: []reportedHook{
+				{
+					namespace: "ns",
+					name:      "name",
+					hookName:  "hook1",
+					phase:     "post",
+					hook:      velerov1api.ExecHook{Container: "1b", Command: []string{"post-1b"}, OnError: velerov1api.HookErrorModeFail},
+				},
+				{
+					namespace: "ns",
+					name:      "name",
+					hookName:  "hook1",
+					phase:     "post",
+					hook:      velerov1api.ExecHook{Container: "1c", Command: []string{"post-1c"}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reported []reportedHook
+			h := &DryRunItemHookHandler{
+				Report: func(namespace, name, hookName, phase string, hook *velerov1api.ExecHook) {
+					reported = append(reported, reportedHook{namespace, name, hookName, phase, *hook})
+				},
+			}
+
+			err := h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, test.item, test.hooks, test.phase)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, reported)
+		})
+	}
+}
+
 func TestGetPodExecHookFromAnnotations(t *testing.T) {
 	phases := []hookPhase{"", PhasePre, PhasePost}
 	for _, phase := range phases {
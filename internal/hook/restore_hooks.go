@@ -0,0 +1,282 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// defaultRestoreHookWaitTimeout is how long a pre-restore hook, or a hook with the Restore scope,
+// waits for its container to be running when the hook has no wait timeout.
+const defaultRestoreHookWaitTimeout = 5 * time.Minute
+
+// AppliesTo returns whether the restore hook applies to an item of the given resource, namespace
+// and labels. Hooks with the Pod scope only apply to pods.
+func (r ResourceRestoreHook) AppliesTo(groupResource schema.GroupResource, namespace string, labels labels.Set) bool {
+	if r.Scope != velerov1api.RestoreHookScopeRestore && groupResource != kuberesource.Pods {
+		return false
+	}
+	return r.Selector.applicableTo(groupResource, namespace, labels)
+}
+
+// RestoreHookRunner executes the pre-restore hooks and the hooks with the Restore scope
+// in pods already running in the cluster.
+type RestoreHookRunner struct {
+	ListWatchFactory    ListWatchFactory
+	WaitExecHookHandler WaitExecHookHandler
+}
+
+// RunPreHooksInNamespace executes the pre hooks of the hooks with the Pod scope in the running
+// pods of the namespace they apply to. It returns the result of each execution, and the errors
+// of the hooks whose error mode is Fail.
+func (r *RestoreHookRunner) RunPreHooksInNamespace(
+	log logrus.FieldLogger,
+	namespace string,
+	resourceRestoreHooks []ResourceRestoreHook,
+) ([]velerov1api.RestoreHookResult, []error) {
+	var applicable []ResourceRestoreHook
+	for _, rrh := range resourceRestoreHooks {
+		if rrh.Scope == velerov1api.RestoreHookScopeRestore || len(rrh.PreHooks) == 0 {
+			continue
+		}
+		if !rrh.Selector.Namespaces.ShouldInclude(namespace) {
+			continue
+		}
+		applicable = append(applicable, rrh)
+	}
+	if len(applicable) == 0 {
+		return nil, nil
+	}
+
+	pods, err := r.listPods(namespace, labels.Everything())
+	if err != nil {
+		return nil, []error{errors.Wrapf(err, "error listing pods of namespace %s to execute pre-restore hooks", namespace)}
+	}
+
+	var (
+		results []velerov1api.RestoreHookResult
+		errs    []error
+	)
+	for i := range pods {
+		pod := &pods[i]
+		for _, rrh := range applicable {
+			if !rrh.Selector.applicableTo(kuberesource.Pods, namespace, pod.Labels) {
+				continue
+			}
+			res, hookErrs := r.runHooks(log, pod, rrh.Name, velerov1api.RestoreHookPhasePre, preExecHooks(rrh.PreHooks))
+			results = append(results, res...)
+			errs = append(errs, hookErrs...)
+		}
+	}
+
+	return results, errs
+}
+
+// RunRestoreScopedHooks executes the pre or post hooks of a hook with the Restore scope in the
+// pod it selects. It returns the result of each execution, and the errors of the hooks whose
+// error mode is Fail.
+func (r *RestoreHookRunner) RunRestoreScopedHooks(
+	log logrus.FieldLogger,
+	rrh ResourceRestoreHook,
+	phase velerov1api.RestoreHookPhase,
+) ([]velerov1api.RestoreHookResult, []error) {
+	hooks := postExecHooks(rrh.RestoreHooks)
+	if phase == velerov1api.RestoreHookPhasePre {
+		hooks = preExecHooks(rrh.PreHooks)
+	}
+	if len(hooks) == 0 {
+		return nil, nil
+	}
+
+	selector := rrh.PodSelector
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	pods, err := r.listPods(rrh.PodNamespace, selector)
+	if err != nil {
+		err = errors.Wrapf(err, "error listing pods of namespace %s to execute hook %s", rrh.PodNamespace, rrh.Name)
+	} else if len(pods) == 0 {
+		err = errors.Errorf("no running pod in namespace %s matches the pod selector of hook %s", rrh.PodNamespace, rrh.Name)
+	}
+	if err != nil {
+		log.WithError(err).Error("Error executing restore hook")
+		results := []velerov1api.RestoreHookResult{{Name: rrh.Name, Phase: phase, Message: err.Error()}}
+		if anyFailMode(hooks) {
+			return results, []error{err}
+		}
+		return results, nil
+	}
+
+	return r.runHooks(log, &pods[0], rrh.Name, phase, hooks)
+}
+
+// runHooks executes the exec hooks one after the other in the pod, stopping at the first
+// failed hook whose error mode is Fail.
+func (r *RestoreHookRunner) runHooks(
+	log logrus.FieldLogger,
+	pod *corev1api.Pod,
+	name string,
+	phase velerov1api.RestoreHookPhase,
+	hooks []velerov1api.ExecRestoreHook,
+) ([]velerov1api.RestoreHookResult, []error) {
+	var results []velerov1api.RestoreHookResult
+
+	for _, hook := range hooks {
+		if hook.Container == "" && len(pod.Spec.Containers) > 0 {
+			hook.Container = pod.Spec.Containers[0].Name
+		}
+		hookLog := log.WithFields(logrus.Fields{
+			"hookName":  name,
+			"hookPhase": phase,
+			"pod":       kube.NamespaceAndName(pod),
+			"container": hook.Container,
+		})
+
+		result := velerov1api.RestoreHookResult{
+			Name:      name,
+			Phase:     phase,
+			Pod:       kube.NamespaceAndName(pod),
+			Container: hook.Container,
+		}
+		if err := r.runHook(hookLog, pod, name, phase, hook); err != nil {
+			hookLog.WithError(err).Error("Error executing restore hook")
+			result.Message = err.Error()
+			results = append(results, result)
+			if hook.OnError == velerov1api.HookErrorModeFail {
+				return results, []error{err}
+			}
+			continue
+		}
+		hookLog.Info("Restore hook executed")
+		result.Succeeded = true
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *RestoreHookRunner) runHook(log logrus.FieldLogger, pod *corev1api.Pod, name string, phase velerov1api.RestoreHookPhase, hook velerov1api.ExecRestoreHook) error {
+	if !podHasContainer(pod, hook.Container) {
+		return errors.Errorf("pod %s does not have container %s", kube.NamespaceAndName(pod), hook.Container)
+	}
+	if hook.WaitTimeout.Duration <= 0 {
+		hook.WaitTimeout = metav1.Duration{Duration: defaultRestoreHookWaitTimeout}
+	}
+	// the wait exec hook handler only reports the errors of the hooks whose error mode is Fail
+	hook.OnError = velerov1api.HookErrorModeFail
+
+	hookPhase := PhasePost
+	if phase == velerov1api.RestoreHookPhasePre {
+		hookPhase = PhasePre
+	}
+	byContainer := map[string][]PodExecRestoreHook{
+		hook.Container: {
+			{
+				HookName:   name,
+				HookSource: "backupSpec",
+				Hook:       hook,
+				phase:      hookPhase,
+			},
+		},
+	}
+	errs := r.WaitExecHookHandler.HandleHooks(context.Background(), log, pod, byContainer)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// listPods returns the pods of the namespace matching the selector that aren't terminated,
+// sorted by name.
+func (r *RestoreHookRunner) listPods(namespace string, selector labels.Selector) ([]corev1api.Pod, error) {
+	obj, err := r.ListWatchFactory.NewListWatch(namespace, fields.Everything()).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var pods []corev1api.Pod
+	for _, item := range items {
+		pod, ok := item.(*corev1api.Pod)
+		if !ok {
+			return nil, errors.Errorf("unexpected item type %T", item)
+		}
+		if pod.Status.Phase == corev1api.PodSucceeded || pod.Status.Phase == corev1api.PodFailed {
+			continue
+		}
+		if !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		pods = append(pods, *pod)
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods, nil
+}
+
+// preExecHooks returns copies of the exec hooks of the pre hooks, so that the
+// hook specs aren't mutated.
+func preExecHooks(hooks []velerov1api.RestorePreHook) []velerov1api.ExecRestoreHook {
+	var res []velerov1api.ExecRestoreHook
+	for _, h := range hooks {
+		if h.Exec != nil {
+			res = append(res, *h.Exec)
+		}
+	}
+	return res
+}
+
+// postExecHooks returns copies of the exec hooks of the post hooks, so that
+// the hook specs aren't mutated.
+func postExecHooks(hooks []velerov1api.RestoreResourceHook) []velerov1api.ExecRestoreHook {
+	var res []velerov1api.ExecRestoreHook
+	for _, h := range hooks {
+		if h.Exec != nil {
+			res = append(res, *h.Exec)
+		}
+	}
+	return res
+}
+
+func anyFailMode(hooks []velerov1api.ExecRestoreHook) bool {
+	for _, h := range hooks {
+		if h.OnError == velerov1api.HookErrorModeFail {
+			return true
+		}
+	}
+	return false
+}
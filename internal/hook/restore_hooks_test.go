@@ -0,0 +1,288 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fcache "k8s.io/client-go/tools/cache/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type execution struct {
+	pod       string
+	container string
+	hook      PodExecRestoreHook
+}
+
+// fakeWaitExecHookHandler records the hooks it's asked to execute, and fails the hooks
+// with the given commands.
+type fakeWaitExecHookHandler struct {
+	executions []execution
+	failing    map[string]bool
+}
+
+func (h *fakeWaitExecHookHandler) HandleHooks(_ context.Context, _ logrus.FieldLogger, pod *v1.Pod, byContainer map[string][]PodExecRestoreHook) []error {
+	var errs []error
+	for container, hooks := range byContainer {
+		for _, hook := range hooks {
+			h.executions = append(h.executions, execution{pod: pod.Name, container: container, hook: hook})
+			if h.failing[hook.Hook.Command[0]] {
+				errs = append(errs, errors.Errorf("hook %s failed", hook.Hook.Command[0]))
+			}
+		}
+	}
+	return errs
+}
+
+func execRestoreHook(command string, onError velerov1api.HookErrorMode) velerov1api.RestoreResourceHook {
+	return velerov1api.RestoreResourceHook{
+		Exec: &velerov1api.ExecRestoreHook{
+			Command: []string{command},
+			OnError: onError,
+		},
+	}
+}
+
+func execPreHook(command string, onError velerov1api.HookErrorMode) velerov1api.RestorePreHook {
+	return velerov1api.RestorePreHook{Exec: execRestoreHook(command, onError).Exec}
+}
+
+func TestRunPreHooksInNamespace(t *testing.T) {
+	pods := []*v1.Pod{
+		builder.ForPod("ns-1", "db-1").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}).Phase(v1.PodRunning).Result(),
+		builder.ForPod("ns-1", "db-0").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}, &v1.Container{Name: "sidecar"}).Phase(v1.PodRunning).Result(),
+		builder.ForPod("ns-1", "db-done").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}).Phase(v1.PodSucceeded).Result(),
+		builder.ForPod("ns-1", "web").ObjectMeta(builder.WithLabels("app", "web")).Containers(&v1.Container{Name: "web"}).Phase(v1.PodRunning).Result(),
+	}
+
+	hooks, err := GetRestoreHooksFromSpec(&velerov1api.RestoreHooks{
+		Resources: []velerov1api.RestoreResourceHookSpec{
+			{
+				Name:          "db",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				PreHooks: []velerov1api.RestorePreHook{
+					execPreHook("freeze", velerov1api.HookErrorModeContinue),
+				},
+			},
+			{
+				Name:               "other-namespace",
+				IncludedNamespaces: []string{"ns-2"},
+				PreHooks:           []velerov1api.RestorePreHook{execPreHook("other", velerov1api.HookErrorModeFail)},
+			},
+			{
+				Name:      "no-pre-hooks",
+				PostHooks: []velerov1api.RestoreResourceHook{execRestoreHook("post", velerov1api.HookErrorModeFail)},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		failing        map[string]bool
+		expectedErrors int
+	}{
+		{
+			name: "hooks are executed in the running pods they apply to",
+		},
+		{
+			name:    "failed hooks whose error mode is Continue don't return errors",
+			failing: map[string]bool{"freeze": true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			source := fcache.NewFakeControllerSource()
+			for _, pod := range pods {
+				source.Add(pod)
+			}
+			handler := &fakeWaitExecHookHandler{failing: tc.failing}
+			runner := &RestoreHookRunner{
+				ListWatchFactory:    &fakeListWatchFactory{source},
+				WaitExecHookHandler: handler,
+			}
+
+			results, errs := runner.RunPreHooksInNamespace(velerotest.NewLogger(), "ns-1", hooks)
+			assert.Len(t, errs, tc.expectedErrors)
+
+			require.Len(t, handler.executions, 2)
+			assert.Equal(t, "db-0", handler.executions[0].pod)
+			assert.Equal(t, "db", handler.executions[0].container)
+			assert.Equal(t, "db-1", handler.executions[1].pod)
+			// the handler reports the failures of all the hooks, and the hooks wait for their container
+			assert.Equal(t, velerov1api.HookErrorModeFail, handler.executions[0].hook.Hook.OnError)
+			assert.Equal(t, defaultRestoreHookWaitTimeout, handler.executions[0].hook.Hook.WaitTimeout.Duration)
+
+			require.Len(t, results, 2)
+			for _, res := range results {
+				assert.Equal(t, "db", res.Name)
+				assert.Equal(t, velerov1api.RestoreHookPhasePre, res.Phase)
+				assert.Equal(t, !tc.failing["freeze"], res.Succeeded)
+			}
+			assert.Equal(t, "ns-1/db-0", results[0].Pod)
+		})
+	}
+}
+
+func TestRunRestoreScopedHooks(t *testing.T) {
+	spec := velerov1api.RestoreResourceHookSpec{
+		Name:              "migrate",
+		IncludedResources: []string{"configmaps"},
+		Scope:             velerov1api.RestoreHookScopeRestore,
+		Pod: &velerov1api.RestoreHookPod{
+			Namespace:     "ns-1",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+		},
+		PreHooks: []velerov1api.RestorePreHook{
+			execPreHook("lock", velerov1api.HookErrorModeFail),
+		},
+		PostHooks: []velerov1api.RestoreResourceHook{
+			execRestoreHook("migrate", velerov1api.HookErrorModeContinue),
+			func() velerov1api.RestoreResourceHook {
+				h := execRestoreHook("unlock", velerov1api.HookErrorModeFail)
+				h.Exec.Container = "missing"
+				return h
+			}(),
+		},
+	}
+
+	tests := []struct {
+		name               string
+		pods               []*v1.Pod
+		phase              velerov1api.RestoreHookPhase
+		failing            map[string]bool
+		expectedExecutions []string
+		expectedResults    []velerov1api.RestoreHookResult
+		expectedErrors     int
+	}{
+		{
+			name: "pre hooks are executed in the first pod matching the selector",
+			pods: []*v1.Pod{
+				builder.ForPod("ns-1", "db-1").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}).Result(),
+				builder.ForPod("ns-1", "db-0").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}).Result(),
+				builder.ForPod("ns-1", "web").ObjectMeta(builder.WithLabels("app", "web")).Containers(&v1.Container{Name: "web"}).Result(),
+			},
+			phase:              velerov1api.RestoreHookPhasePre,
+			expectedExecutions: []string{"lock"},
+			expectedResults: []velerov1api.RestoreHookResult{
+				{Name: "migrate", Phase: velerov1api.RestoreHookPhasePre, Pod: "ns-1/db-0", Container: "db", Succeeded: true},
+			},
+		},
+		{
+			name: "failed hooks whose error mode is Fail return errors",
+			pods: []*v1.Pod{
+				builder.ForPod("ns-1", "db-0").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}).Result(),
+			},
+			phase:              velerov1api.RestoreHookPhasePre,
+			failing:            map[string]bool{"lock": true},
+			expectedExecutions: []string{"lock"},
+			expectedResults: []velerov1api.RestoreHookResult{
+				{Name: "migrate", Phase: velerov1api.RestoreHookPhasePre, Pod: "ns-1/db-0", Container: "db", Message: "hook lock failed"},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "hooks in missing containers fail without being executed",
+			pods: []*v1.Pod{
+				builder.ForPod("ns-1", "db-0").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}).Result(),
+			},
+			phase:              velerov1api.RestoreHookPhasePost,
+			failing:            map[string]bool{"migrate": true},
+			expectedExecutions: []string{"migrate"},
+			expectedResults: []velerov1api.RestoreHookResult{
+				{Name: "migrate", Phase: velerov1api.RestoreHookPhasePost, Pod: "ns-1/db-0", Container: "db", Message: "hook migrate failed"},
+				{Name: "migrate", Phase: velerov1api.RestoreHookPhasePost, Pod: "ns-1/db-0", Container: "missing", Message: "pod ns-1/db-0 does not have container missing"},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "hooks fail when no running pod matches the selector",
+			pods: []*v1.Pod{
+				builder.ForPod("ns-1", "db-0").ObjectMeta(builder.WithLabels("app", "db")).Containers(&v1.Container{Name: "db"}).Phase(v1.PodFailed).Result(),
+			},
+			phase: velerov1api.RestoreHookPhasePre,
+			expectedResults: []velerov1api.RestoreHookResult{
+				{Name: "migrate", Phase: velerov1api.RestoreHookPhasePre, Message: "no running pod in namespace ns-1 matches the pod selector of hook migrate"},
+			},
+			expectedErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hooks, err := GetRestoreHooksFromSpec(&velerov1api.RestoreHooks{Resources: []velerov1api.RestoreResourceHookSpec{spec}})
+			require.NoError(t, err)
+			require.Len(t, hooks, 1)
+
+			source := fcache.NewFakeControllerSource()
+			for _, pod := range tc.pods {
+				source.Add(pod)
+			}
+			handler := &fakeWaitExecHookHandler{failing: tc.failing}
+			runner := &RestoreHookRunner{
+				ListWatchFactory:    &fakeListWatchFactory{source},
+				WaitExecHookHandler: handler,
+			}
+
+			results, errs := runner.RunRestoreScopedHooks(velerotest.NewLogger(), hooks[0], tc.phase)
+			assert.Len(t, errs, tc.expectedErrors)
+			assert.Equal(t, tc.expectedResults, results)
+
+			var executions []string
+			for _, e := range handler.executions {
+				executions = append(executions, e.hook.Hook.Command[0])
+			}
+			assert.Equal(t, tc.expectedExecutions, executions)
+		})
+	}
+}
+
+func TestResourceRestoreHookAppliesTo(t *testing.T) {
+	hooks, err := GetRestoreHooksFromSpec(&velerov1api.RestoreHooks{
+		Resources: []velerov1api.RestoreResourceHookSpec{
+			{
+				Name: "pod-scope",
+			},
+			{
+				Name:               "restore-scope",
+				Scope:              velerov1api.RestoreHookScopeRestore,
+				IncludedNamespaces: []string{"ns-1"},
+				ExcludedResources:  []string{"secrets"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, hooks[0].AppliesTo(kuberesource.Pods, "ns-1", nil))
+	assert.False(t, hooks[0].AppliesTo(kuberesource.Secrets, "ns-1", nil))
+	assert.True(t, hooks[1].AppliesTo(kuberesource.PersistentVolumeClaims, "ns-1", nil))
+	assert.False(t, hooks[1].AppliesTo(kuberesource.PersistentVolumeClaims, "ns-2", nil))
+	assert.False(t, hooks[1].AppliesTo(kuberesource.Secrets, "ns-1", nil))
+}
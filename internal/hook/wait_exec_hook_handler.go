@@ -23,6 +23,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
@@ -58,6 +59,8 @@ var _ ListWatchFactory = &DefaultListWatchFactory{}
 type DefaultWaitExecHookHandler struct {
 	ListWatchFactory   ListWatchFactory
 	PodCommandExecutor podexec.PodCommandExecutor
+	// ExecutionRecorder records the executed hooks, if set.
+	ExecutionRecorder *ExecutionRecorder
 }
 
 var _ WaitExecHookHandler = &DefaultWaitExecHookHandler{}
@@ -150,13 +153,14 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					logrus.Fields{
 						"hookSource": hook.HookSource,
 						"hookType":   "exec",
-						"hookPhase":  "post",
+						"hookPhase":  hook.execPhase(),
 					},
 				)
 				// Check the individual hook's wait timeout is not expired
 				if hook.Hook.WaitTimeout.Duration != 0 && time.Since(waitStart) > hook.Hook.WaitTimeout.Duration {
 					err := fmt.Errorf("Hook %s in container %s expired before executing", hook.HookName, hook.Hook.Container)
 					hookLog.Error(err)
+					e.recordUnexecuted(pod, hook, err)
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
 						cancel()
@@ -169,7 +173,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					OnError:   hook.Hook.OnError,
 					Timeout:   hook.Hook.ExecTimeout,
 				}
-				if err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); err != nil {
+				if err := e.ExecutionRecorder.execute(e.PodCommandExecutor, hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, hook.HookSource, string(hook.execPhase()), eh); err != nil {
 					hookLog.WithError(err).Error("Error executing hook")
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
@@ -217,10 +221,11 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				logrus.Fields{
 					"hookSource": hook.HookSource,
 					"hookType":   "exec",
-					"hookPhase":  "post",
+					"hookPhase":  hook.execPhase(),
 				},
 			)
 			hookLog.Error(err)
+			e.recordUnexecuted(pod, hook, err)
 			if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 				errors = append(errors, err)
 			}
@@ -230,6 +235,28 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 	return errors
 }
 
+// recordUnexecuted records a hook that couldn't be executed as failed.
+func (e *DefaultWaitExecHookHandler) recordUnexecuted(pod *v1.Pod, hook PodExecRestoreHook, err error) {
+	e.ExecutionRecorder.record(Execution{
+		Name:      hook.HookName,
+		Source:    hook.HookSource,
+		Phase:     string(hook.execPhase()),
+		Namespace: pod.Namespace,
+		Pod:       pod.Name,
+		Container: hook.Hook.Container,
+		Command:   hook.Hook.Command,
+		Started:   metav1.Now(),
+		Error:     err.Error(),
+	})
+}
+
+func (h PodExecRestoreHook) execPhase() hookPhase {
+	if h.phase == "" {
+		return PhasePost
+	}
+	return h.phase
+}
+
 func podHasContainer(pod *v1.Pod, containerName string) bool {
 	if pod == nil {
 		return false
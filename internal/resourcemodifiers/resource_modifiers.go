@@ -0,0 +1,280 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"encoding/json"
+	"regexp"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// ConfigMapRefKind is the only supported kind of object a restore's
+	// resource modifier reference may point to.
+	ConfigMapRefKind = "ConfigMap"
+
+	// supportedVersion is the only supported version of the resource
+	// modifiers document.
+	supportedVersion = "v1"
+)
+
+// JSONPatch is a single RFC 6902 JSON patch operation applied to a restored item.
+type JSONPatch struct {
+	Operation string      `json:"operation"`
+	From      string      `json:"from,omitempty"`
+	Path      string      `json:"path"`
+	Value     interface{} `json:"value,omitempty"`
+}
+
+// Conditions selects the items a ResourceModifierRule applies to. An item must
+// match all specified conditions.
+type Conditions struct {
+	// GroupResource is the group-resource of the items, e.g. "deployments.apps",
+	// or "*" for all resources.
+	GroupResource string `json:"groupResource"`
+
+	// ResourceNameRegex is a regular expression the item's name must match.
+	// If empty, all names match.
+	ResourceNameRegex string `json:"resourceNameRegex,omitempty"`
+
+	// Namespaces is a list of namespaces, which may contain wildcards, that the
+	// item's (remapped) namespace must be in. If empty, all namespaces match.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// LabelSelector filters the items by their labels.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// ResourceModifierRule is a set of patches applied to the items matching its conditions.
+type ResourceModifierRule struct {
+	Conditions Conditions  `json:"conditions"`
+	Patches    []JSONPatch `json:"patches"`
+
+	// nameRegex is the compiled ResourceNameRegex of the conditions, set by
+	// Validate so that it isn't compiled again for every restored item.
+	nameRegex *regexp.Regexp
+}
+
+// ResourceModifiers is the document, stored in a ConfigMap referenced by a restore,
+// describing how items are modified before they're created in the cluster.
+type ResourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []ResourceModifierRule `json:"resourceModifierRules"`
+}
+
+// GetResourceModifiersFromConfig parses and validates the resource modifiers document
+// stored in the given ConfigMap. The ConfigMap must contain exactly one data entry.
+func GetResourceModifiersFromConfig(cm *corev1api.ConfigMap) (*ResourceModifiers, error) {
+	if cm == nil {
+		return nil, errors.New("could not parse config from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("illegal resource modifiers %s configmap: it must contain exactly one data entry", kube.NamespaceAndName(cm))
+	}
+
+	var data string
+	for _, v := range cm.Data {
+		data = v
+	}
+
+	modifiers := new(ResourceModifiers)
+	if err := yaml.UnmarshalStrict([]byte(data), modifiers); err != nil {
+		return nil, errors.Wrapf(err, "error parsing resource modifiers from configmap %s", kube.NamespaceAndName(cm))
+	}
+
+	if err := modifiers.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid resource modifiers in configmap %s", kube.NamespaceAndName(cm))
+	}
+
+	return modifiers, nil
+}
+
+// Validate returns an error if the resource modifiers document is not valid.
+func (p *ResourceModifiers) Validate() error {
+	if p.Version != supportedVersion {
+		return errors.Errorf("unsupported resource modifiers version %q, only %q is supported", p.Version, supportedVersion)
+	}
+
+	for i := range p.ResourceModifierRules {
+		if err := p.ResourceModifierRules[i].Validate(); err != nil {
+			return errors.Wrapf(err, "invalid resource modifier rule at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error if the rule is not valid. It must be called before
+// the rule is applied.
+func (r *ResourceModifierRule) Validate() error {
+	if r.Conditions.GroupResource == "" {
+		return errors.New("groupResource cannot be empty")
+	}
+
+	if r.Conditions.ResourceNameRegex != "" {
+		nameRegex, err := regexp.Compile(r.Conditions.ResourceNameRegex)
+		if err != nil {
+			return errors.Wrap(err, "invalid resourceNameRegex")
+		}
+		r.nameRegex = nameRegex
+	}
+
+	if r.Conditions.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.Conditions.LabelSelector); err != nil {
+			return errors.Wrap(err, "invalid labelSelector")
+		}
+	}
+
+	if len(r.Patches) == 0 {
+		return errors.New("patches cannot be empty")
+	}
+
+	if _, err := r.jsonPatch(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ApplyResourceModifierRules applies, in order, the patches of every rule whose conditions
+// match the item. The patches of a rule are applied atomically: if any of them fails, none
+// of that rule's patches are applied and an error is returned for the rule.
+func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) []error {
+	var errs []error
+	for i := range p.ResourceModifierRules {
+		if err := p.ResourceModifierRules[i].apply(obj, groupResource, log); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func (r *ResourceModifierRule) apply(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) error {
+	matches, err := r.matches(obj, groupResource)
+	if err != nil || !matches {
+		return err
+	}
+
+	patch, err := r.jsonPatch()
+	if err != nil {
+		return err
+	}
+
+	objBytes, err := obj.MarshalJSON()
+	if err != nil {
+		return errors.Wrap(err, "error marshaling item")
+	}
+
+	patchedBytes, err := patch.Apply(objBytes)
+	if err != nil {
+		return errors.Wrapf(err, "error applying resource modifier patches to %s %s", groupResource, kube.NamespaceAndName(obj))
+	}
+
+	if err := obj.UnmarshalJSON(patchedBytes); err != nil {
+		return errors.Wrap(err, "error unmarshaling patched item")
+	}
+
+	log.Infof("Applied resource modifier patches to %s %s", groupResource, kube.NamespaceAndName(obj))
+	return nil
+}
+
+func (r *ResourceModifierRule) matches(obj *unstructured.Unstructured, groupResource string) (bool, error) {
+	if r.Conditions.GroupResource != "*" && r.Conditions.GroupResource != groupResource {
+		return false, nil
+	}
+
+	if r.Conditions.ResourceNameRegex != "" {
+		if r.nameRegex == nil {
+			return false, errors.New("resource modifier rule hasn't been validated")
+		}
+		if !r.nameRegex.MatchString(obj.GetName()) {
+			return false, nil
+		}
+	}
+
+	if len(r.Conditions.Namespaces) > 0 {
+		// cluster-scoped items never match a namespace condition
+		if obj.GetNamespace() == "" {
+			return false, nil
+		}
+		if !collections.NewIncludesExcludes().Includes(r.Conditions.Namespaces...).ShouldInclude(obj.GetNamespace()) {
+			return false, nil
+		}
+	}
+
+	if r.Conditions.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(r.Conditions.LabelSelector)
+		if err != nil {
+			return false, errors.Wrap(err, "error parsing labelSelector")
+		}
+		if !selector.Matches(labels.Set(obj.GetLabels())) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// jsonPatch converts the rule's patches into an RFC 6902 JSON patch.
+func (r *ResourceModifierRule) jsonPatch() (jsonpatch.Patch, error) {
+	ops := make([]map[string]interface{}, 0, len(r.Patches))
+	for _, p := range r.Patches {
+		op := map[string]interface{}{
+			"op":   p.Operation,
+			"path": p.Path,
+		}
+		if p.From != "" {
+			op["from"] = p.From
+		}
+		if p.Value != nil {
+			op["value"] = p.Value
+		}
+		ops = append(ops, op)
+	}
+
+	patchBytes, err := json.Marshal(ops)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling patches")
+	}
+
+	patch, err := jsonpatch.DecodePatch(patchBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding patches")
+	}
+
+	for _, op := range patch {
+		switch op.Kind() {
+		case "add", "remove", "replace", "move", "copy", "test":
+		default:
+			return nil, errors.Errorf("unsupported patch operation %q", op.Kind())
+		}
+	}
+
+	return patch, nil
+}
@@ -0,0 +1,334 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetResourceModifiersFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    *ResourceModifiers
+		wantErr bool
+	}{
+		{
+			name: "valid rules are parsed",
+			data: map[string]string{
+				"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: deployments.apps
+    resourceNameRegex: "^nginx-.*$"
+    namespaces:
+    - ns-1
+    labelSelector:
+      matchLabels:
+        app: nginx
+  patches:
+  - operation: replace
+    path: "/spec/replicas"
+    value: 1
+`,
+			},
+			want: &ResourceModifiers{
+				Version: "v1",
+				ResourceModifierRules: []ResourceModifierRule{
+					{
+						Conditions: Conditions{
+							GroupResource:     "deployments.apps",
+							ResourceNameRegex: "^nginx-.*$",
+							Namespaces:        []string{"ns-1"},
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "nginx"},
+							},
+						},
+						Patches: []JSONPatch{
+							{Operation: "replace", Path: "/spec/replicas", Value: float64(1)},
+						},
+						nameRegex: regexp.MustCompile("^nginx-.*$"),
+					},
+				},
+			},
+		},
+		{
+			name:    "configmap with more than one data entry is invalid",
+			data:    map[string]string{"a": "version: v1", "b": "version: v1"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported version is invalid",
+			data:    map[string]string{"rules.yaml": "version: v2"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown fields are invalid",
+			data:    map[string]string{"rules.yaml": "version: v1\nunknown: true"},
+			wantErr: true,
+		},
+		{
+			name: "rule without group resource is invalid",
+			data: map[string]string{
+				"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    resourceNameRegex: ".*"
+  patches:
+  - operation: remove
+    path: "/metadata/annotations"
+`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "rule with invalid regex is invalid",
+			data: map[string]string{
+				"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+    resourceNameRegex: "["
+  patches:
+  - operation: remove
+    path: "/metadata/annotations"
+`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "rule with unsupported operation is invalid",
+			data: map[string]string{
+				"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+  patches:
+  - operation: merge
+    path: "/metadata/annotations"
+`,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := builder.ForConfigMap("velero", "modifiers").Result()
+			cm.Data = tc.data
+
+			got, err := GetResourceModifiersFromConfig(cm)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestApplyResourceModifierRules(t *testing.T) {
+	deployment := func(ns, name string, labels map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"namespace": ns,
+					"name":      name,
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{
+									"name":  "nginx",
+									"image": "docker.io/nginx:1.21",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		if labels != nil {
+			unstructured.SetNestedMap(obj.Object, labels, "metadata", "labels")
+		}
+		return obj
+	}
+
+	replicasRule := func(conditions Conditions) ResourceModifierRule {
+		return ResourceModifierRule{
+			Conditions: conditions,
+			Patches: []JSONPatch{
+				{Operation: "replace", Path: "/spec/replicas", Value: 1},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		rules         []ResourceModifierRule
+		obj           *unstructured.Unstructured
+		groupResource string
+		wantReplicas  int64
+		wantImage     string
+		wantErr       bool
+	}{
+		{
+			name:          "matching group resource is patched",
+			rules:         []ResourceModifierRule{replicasRule(Conditions{GroupResource: "deployments.apps"})},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  1,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name:          "wildcard group resource is patched",
+			rules:         []ResourceModifierRule{replicasRule(Conditions{GroupResource: "*"})},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  1,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name:          "non-matching group resource is not patched",
+			rules:         []ResourceModifierRule{replicasRule(Conditions{GroupResource: "statefulsets.apps"})},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  3,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name:          "non-matching name is not patched",
+			rules:         []ResourceModifierRule{replicasRule(Conditions{GroupResource: "deployments.apps", ResourceNameRegex: "^redis"})},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  3,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name:          "matching namespace wildcard is patched",
+			rules:         []ResourceModifierRule{replicasRule(Conditions{GroupResource: "deployments.apps", Namespaces: []string{"ns-*"}})},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  1,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name:          "non-matching namespace is not patched",
+			rules:         []ResourceModifierRule{replicasRule(Conditions{GroupResource: "deployments.apps", Namespaces: []string{"ns-2"}})},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  3,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name: "matching label selector is patched",
+			rules: []ResourceModifierRule{replicasRule(Conditions{
+				GroupResource: "deployments.apps",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			})},
+			obj:           deployment("ns-1", "nginx", map[string]interface{}{"app": "nginx"}),
+			groupResource: "deployments.apps",
+			wantReplicas:  1,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name: "non-matching label selector is not patched",
+			rules: []ResourceModifierRule{replicasRule(Conditions{
+				GroupResource: "deployments.apps",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "redis"}},
+			})},
+			obj:           deployment("ns-1", "nginx", map[string]interface{}{"app": "nginx"}),
+			groupResource: "deployments.apps",
+			wantReplicas:  3,
+			wantImage:     "docker.io/nginx:1.21",
+		},
+		{
+			name: "multiple rules are applied in order",
+			rules: []ResourceModifierRule{
+				replicasRule(Conditions{GroupResource: "deployments.apps"}),
+				{
+					Conditions: Conditions{GroupResource: "deployments.apps"},
+					Patches: []JSONPatch{
+						{Operation: "test", Path: "/spec/replicas", Value: 1},
+						{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "registry.example.com/nginx:1.21"},
+					},
+				},
+			},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  1,
+			wantImage:     "registry.example.com/nginx:1.21",
+		},
+		{
+			name: "failing patch leaves the item unmodified by that rule",
+			rules: []ResourceModifierRule{
+				{
+					Conditions: Conditions{GroupResource: "deployments.apps"},
+					Patches: []JSONPatch{
+						{Operation: "replace", Path: "/spec/replicas", Value: 1},
+						{Operation: "test", Path: "/spec/replicas", Value: 5},
+					},
+				},
+			},
+			obj:           deployment("ns-1", "nginx", nil),
+			groupResource: "deployments.apps",
+			wantReplicas:  3,
+			wantImage:     "docker.io/nginx:1.21",
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			modifiers := &ResourceModifiers{Version: "v1", ResourceModifierRules: tc.rules}
+			require.NoError(t, modifiers.Validate())
+
+			errs := modifiers.ApplyResourceModifierRules(tc.obj, tc.groupResource, velerotest.NewLogger())
+			if tc.wantErr {
+				assert.NotEmpty(t, errs)
+			} else {
+				assert.Empty(t, errs)
+			}
+
+			replicas, _, err := unstructured.NestedInt64(tc.obj.Object, "spec", "replicas")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantReplicas, replicas)
+
+			containers, _, err := unstructured.NestedSlice(tc.obj.Object, "spec", "template", "spec", "containers")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantImage, containers[0].(map[string]interface{})["image"])
+		})
+	}
+}
@@ -0,0 +1,345 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// ConfigMapRefKind is the only supported kind of object a backup's
+	// resource policy reference may point to.
+	ConfigMapRefKind = "ConfigMap"
+
+	// supportedVersion is the only supported version of the resource
+	// policies document.
+	supportedVersion = "v1"
+)
+
+// VolumeActionType is the way a volume matching a volume policy is backed up.
+type VolumeActionType string
+
+const (
+	// Snapshot means the volume's data is backed up by taking a snapshot of
+	// its persistent volume with a VolumeSnapshotter.
+	Snapshot VolumeActionType = "snapshot"
+
+	// FSBackup means the volume's data is backed up from the pod's file system
+	// using the backup's uploader.
+	FSBackup VolumeActionType = "fs-backup"
+
+	// Skip means the volume's data is not backed up.
+	Skip VolumeActionType = "skip"
+)
+
+// Action is what Velero does with a volume matching a volume policy.
+type Action struct {
+	Type VolumeActionType `json:"type"`
+}
+
+// NFSVolumeSource matches NFS volumes. Empty fields match any value.
+type NFSVolumeSource struct {
+	Server string `json:"server,omitempty"`
+	Path   string `json:"path,omitempty"`
+}
+
+// CSIVolumeSource matches CSI volumes. An empty driver matches any driver.
+type CSIVolumeSource struct {
+	Driver string `json:"driver,omitempty"`
+}
+
+// VolumeConditions selects the volumes a VolumePolicy applies to. A volume must
+// match all specified conditions.
+type VolumeConditions struct {
+	// Capacity is a range of the form "min,max" the volume's capacity must be in.
+	// Either bound may be omitted, e.g. "10Gi," or ",100Gi". Bounds are inclusive.
+	Capacity string `json:"capacity,omitempty"`
+
+	// StorageClass is a list of storage classes, which may contain wildcards, one
+	// of which the volume's storage class must match.
+	StorageClass []string `json:"storageClass,omitempty"`
+
+	// NFS matches volumes with an NFS source.
+	NFS *NFSVolumeSource `json:"nfs,omitempty"`
+
+	// CSI matches volumes with a CSI source.
+	CSI *CSIVolumeSource `json:"csi,omitempty"`
+
+	// VolumeTypes is a list of volume source types, named after the volume source
+	// fields of the Kubernetes API (e.g. "hostPath", "emptyDir", "awsElasticBlockStore"),
+	// one of which the volume's source must be.
+	VolumeTypes []string `json:"volumeTypes,omitempty"`
+}
+
+// VolumePolicy maps the volumes matching its conditions to an action.
+type VolumePolicy struct {
+	Conditions VolumeConditions `json:"conditions"`
+	Action     Action           `json:"action"`
+}
+
+// ResourcePolicies is the document, stored in a ConfigMap referenced by a backup,
+// describing how volumes are backed up.
+type ResourcePolicies struct {
+	Version        string         `json:"version"`
+	VolumePolicies []VolumePolicy `json:"volumePolicies"`
+}
+
+// GetResourcePoliciesFromConfig parses and validates the resource policies document
+// stored in the given ConfigMap. The ConfigMap must contain exactly one data entry.
+func GetResourcePoliciesFromConfig(cm *corev1api.ConfigMap) (*ResourcePolicies, error) {
+	if cm == nil {
+		return nil, errors.New("could not parse config from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("illegal resource policies %s configmap: it must contain exactly one data entry", kube.NamespaceAndName(cm))
+	}
+
+	var data string
+	for _, v := range cm.Data {
+		data = v
+	}
+
+	policies := new(ResourcePolicies)
+	if err := yaml.UnmarshalStrict([]byte(data), policies); err != nil {
+		return nil, errors.Wrapf(err, "error parsing resource policies from configmap %s", kube.NamespaceAndName(cm))
+	}
+
+	if err := policies.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid resource policies in configmap %s", kube.NamespaceAndName(cm))
+	}
+
+	return policies, nil
+}
+
+// Validate returns an error if the resource policies document is not valid.
+func (p *ResourcePolicies) Validate() error {
+	if p.Version != supportedVersion {
+		return errors.Errorf("unsupported resource policies version %q, only %q is supported", p.Version, supportedVersion)
+	}
+
+	for i, policy := range p.VolumePolicies {
+		if err := policy.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid volume policy at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error if the volume policy is not valid.
+func (p *VolumePolicy) Validate() error {
+	switch p.Action.Type {
+	case Snapshot, FSBackup, Skip:
+	default:
+		return errors.Errorf("unsupported action type %q, must be one of %q, %q or %q", p.Action.Type, Snapshot, FSBackup, Skip)
+	}
+
+	if _, _, err := parseCapacity(p.Conditions.Capacity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetMatchAction returns the action of the first volume policy matching the given
+// volume, or nil if no policy matches. The volume must be either a persistent volume,
+// or a pod volume that isn't backed by a persistent volume claim.
+func (p *ResourcePolicies) GetMatchAction(vol interface{}) (*Action, error) {
+	var v *structuredVolume
+	switch obj := vol.(type) {
+	case *corev1api.PersistentVolume:
+		v = structuredVolumeFromPV(obj)
+	case *corev1api.Volume:
+		v = structuredVolumeFromPodVolume(obj)
+	default:
+		return nil, errors.Errorf("unsupported volume type %T", vol)
+	}
+
+	for _, policy := range p.VolumePolicies {
+		matches, err := policy.matches(v)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			action := policy.Action
+			return &action, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// structuredVolume holds the attributes of a volume that volume policies match on.
+type structuredVolume struct {
+	// capacity is nil if the volume's capacity isn't known, e.g. for pod volumes
+	// not backed by a persistent volume.
+	capacity     *resource.Quantity
+	storageClass string
+	nfs          *corev1api.NFSVolumeSource
+	csiDriver    *string
+	volumeType   string
+}
+
+func structuredVolumeFromPV(pv *corev1api.PersistentVolume) *structuredVolume {
+	v := &structuredVolume{
+		storageClass: pv.Spec.StorageClassName,
+		nfs:          pv.Spec.NFS,
+		volumeType:   volumeSourceType(pv.Spec.PersistentVolumeSource),
+	}
+	if capacity, ok := pv.Spec.Capacity[corev1api.ResourceStorage]; ok {
+		v.capacity = &capacity
+	}
+	if pv.Spec.CSI != nil {
+		v.csiDriver = &pv.Spec.CSI.Driver
+	}
+
+	return v
+}
+
+func structuredVolumeFromPodVolume(vol *corev1api.Volume) *structuredVolume {
+	v := &structuredVolume{
+		nfs:        vol.NFS,
+		volumeType: volumeSourceType(vol.VolumeSource),
+	}
+	if vol.CSI != nil {
+		v.csiDriver = &vol.CSI.Driver
+	}
+
+	return v
+}
+
+// volumeSourceType returns the JSON name of the single populated field of a
+// Kubernetes volume source, e.g. "nfs" or "hostPath".
+func volumeSourceType(source interface{}) string {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return ""
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	for name := range fields {
+		return name
+	}
+
+	return ""
+}
+
+func (p *VolumePolicy) matches(v *structuredVolume) (bool, error) {
+	c := p.Conditions
+
+	if c.Capacity != "" {
+		min, max, err := parseCapacity(c.Capacity)
+		if err != nil {
+			return false, err
+		}
+		if v.capacity == nil {
+			return false, nil
+		}
+		if min != nil && v.capacity.Cmp(*min) < 0 {
+			return false, nil
+		}
+		if max != nil && v.capacity.Cmp(*max) > 0 {
+			return false, nil
+		}
+	}
+
+	if len(c.StorageClass) > 0 {
+		if v.storageClass == "" || !collections.NewIncludesExcludes().Includes(c.StorageClass...).ShouldInclude(v.storageClass) {
+			return false, nil
+		}
+	}
+
+	if c.NFS != nil {
+		if v.nfs == nil {
+			return false, nil
+		}
+		if c.NFS.Server != "" && c.NFS.Server != v.nfs.Server {
+			return false, nil
+		}
+		if c.NFS.Path != "" && strings.TrimSuffix(c.NFS.Path, "/") != strings.TrimSuffix(v.nfs.Path, "/") {
+			return false, nil
+		}
+	}
+
+	if c.CSI != nil {
+		if v.csiDriver == nil {
+			return false, nil
+		}
+		if c.CSI.Driver != "" && c.CSI.Driver != *v.csiDriver {
+			return false, nil
+		}
+	}
+
+	if len(c.VolumeTypes) > 0 {
+		var found bool
+		for _, t := range c.VolumeTypes {
+			if strings.EqualFold(t, v.volumeType) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// parseCapacity parses a capacity range of the form "min,max". Either bound may be
+// empty, in which case the returned bound is nil.
+func parseCapacity(capacity string) (*resource.Quantity, *resource.Quantity, error) {
+	if capacity == "" {
+		return nil, nil, nil
+	}
+
+	bounds := strings.Split(capacity, ",")
+	if len(bounds) != 2 {
+		return nil, nil, errors.Errorf("invalid capacity %q, must be of the form \"min,max\"", capacity)
+	}
+
+	var quantities [2]*resource.Quantity
+	for i, bound := range bounds {
+		bound = strings.TrimSpace(bound)
+		if bound == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(bound)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid capacity %q", capacity)
+		}
+		quantities[i] = &q
+	}
+
+	if quantities[0] != nil && quantities[1] != nil && quantities[0].Cmp(*quantities[1]) > 0 {
+		return nil, nil, errors.Errorf("invalid capacity %q, min is greater than max", capacity)
+	}
+
+	return quantities[0], quantities[1], nil
+}
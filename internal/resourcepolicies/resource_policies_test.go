@@ -0,0 +1,296 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetResourcePoliciesFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    *ResourcePolicies
+		wantErr bool
+	}{
+		{
+			name: "valid policies are parsed",
+			data: map[string]string{
+				"policies.yaml": `
+version: v1
+volumePolicies:
+- conditions:
+    capacity: "0,100Gi"
+    storageClass:
+    - gp2
+    csi:
+      driver: ebs.csi.aws.com
+  action:
+    type: snapshot
+- conditions:
+    nfs:
+      server: 192.168.1.1
+    volumeTypes:
+    - hostPath
+  action:
+    type: skip
+`,
+			},
+			want: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Conditions: VolumeConditions{
+							Capacity:     "0,100Gi",
+							StorageClass: []string{"gp2"},
+							CSI:          &CSIVolumeSource{Driver: "ebs.csi.aws.com"},
+						},
+						Action: Action{Type: Snapshot},
+					},
+					{
+						Conditions: VolumeConditions{
+							NFS:         &NFSVolumeSource{Server: "192.168.1.1"},
+							VolumeTypes: []string{"hostPath"},
+						},
+						Action: Action{Type: Skip},
+					},
+				},
+			},
+		},
+		{
+			name:    "configmap with more than one data entry is invalid",
+			data:    map[string]string{"a": "version: v1", "b": "version: v1"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported version is invalid",
+			data:    map[string]string{"policies.yaml": "version: v2"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown fields are invalid",
+			data:    map[string]string{"policies.yaml": "version: v1\nunknown: true"},
+			wantErr: true,
+		},
+		{
+			name: "unsupported action is invalid",
+			data: map[string]string{
+				"policies.yaml": `
+version: v1
+volumePolicies:
+- conditions:
+    storageClass:
+    - gp2
+  action:
+    type: restic
+`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed capacity is invalid",
+			data: map[string]string{
+				"policies.yaml": `
+version: v1
+volumePolicies:
+- conditions:
+    capacity: "10Gi"
+  action:
+    type: skip
+`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity with min greater than max is invalid",
+			data: map[string]string{
+				"policies.yaml": `
+version: v1
+volumePolicies:
+- conditions:
+    capacity: "10Gi,1Gi"
+  action:
+    type: skip
+`,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := builder.ForConfigMap("velero", "policies").Result()
+			cm.Data = tc.data
+
+			got, err := GetResourcePoliciesFromConfig(cm)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetMatchAction(t *testing.T) {
+	pvWithCapacity := func(pv *corev1api.PersistentVolume, capacity string) *corev1api.PersistentVolume {
+		pv.Spec.Capacity = corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse(capacity)}
+		return pv
+	}
+
+	nfsPV := builder.ForPersistentVolume("pv-nfs").Result()
+	nfsPV.Spec.NFS = &corev1api.NFSVolumeSource{Server: "192.168.1.1", Path: "/data/"}
+
+	tests := []struct {
+		name     string
+		policies []VolumePolicy
+		vol      interface{}
+		want     *Action
+	}{
+		{
+			name:     "no policies match nothing",
+			policies: nil,
+			vol:      builder.ForPersistentVolume("pv-1").Result(),
+			want:     nil,
+		},
+		{
+			name: "policy without conditions matches everything",
+			policies: []VolumePolicy{
+				{Action: Action{Type: Skip}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").Result(),
+			want: &Action{Type: Skip},
+		},
+		{
+			name: "storage class wildcard matches",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{StorageClass: []string{"gp*"}}, Action: Action{Type: FSBackup}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").StorageClass("gp2").Result(),
+			want: &Action{Type: FSBackup},
+		},
+		{
+			name: "different storage class doesn't match",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{StorageClass: []string{"gp2"}}, Action: Action{Type: FSBackup}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").StorageClass("standard").Result(),
+			want: nil,
+		},
+		{
+			name: "capacity within the range matches",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{Capacity: "1Gi,10Gi"}, Action: Action{Type: Snapshot}},
+			},
+			vol:  pvWithCapacity(builder.ForPersistentVolume("pv-1").Result(), "10Gi"),
+			want: &Action{Type: Snapshot},
+		},
+		{
+			name: "capacity outside of a range without max doesn't match",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{Capacity: "100Gi,"}, Action: Action{Type: Snapshot}},
+			},
+			vol:  pvWithCapacity(builder.ForPersistentVolume("pv-1").Result(), "10Gi"),
+			want: nil,
+		},
+		{
+			name: "capacity condition doesn't match a pod volume without known capacity",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{Capacity: ",100Gi"}, Action: Action{Type: Skip}},
+			},
+			vol:  builder.ForVolume("vol-1").Result(),
+			want: nil,
+		},
+		{
+			name: "csi driver matches",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{CSI: &CSIVolumeSource{Driver: "hostpath.csi.k8s.io"}}, Action: Action{Type: FSBackup}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").CSI("hostpath.csi.k8s.io", "handle-1").Result(),
+			want: &Action{Type: FSBackup},
+		},
+		{
+			name: "csi driver doesn't match a different driver",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{CSI: &CSIVolumeSource{Driver: "ebs.csi.aws.com"}}, Action: Action{Type: FSBackup}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").CSI("hostpath.csi.k8s.io", "handle-1").Result(),
+			want: nil,
+		},
+		{
+			name: "nfs server and path match",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{NFS: &NFSVolumeSource{Server: "192.168.1.1", Path: "/data"}}, Action: Action{Type: Skip}},
+			},
+			vol:  nfsPV,
+			want: &Action{Type: Skip},
+		},
+		{
+			name: "empty nfs condition doesn't match a non-nfs volume",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{NFS: &NFSVolumeSource{}}, Action: Action{Type: Skip}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").AWSEBSVolumeID("vol-1").Result(),
+			want: nil,
+		},
+		{
+			name: "volume type of a pod volume matches",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{VolumeTypes: []string{"nfs", "hostPath"}}, Action: Action{Type: Skip}},
+			},
+			vol:  builder.ForVolume("vol-1").HostPathSource("/var/log").Result(),
+			want: &Action{Type: Skip},
+		},
+		{
+			name: "volume type of a persistent volume matches",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{VolumeTypes: []string{"awsElasticBlockStore"}}, Action: Action{Type: Snapshot}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").AWSEBSVolumeID("vol-1").Result(),
+			want: &Action{Type: Snapshot},
+		},
+		{
+			name: "first matching policy wins",
+			policies: []VolumePolicy{
+				{Conditions: VolumeConditions{StorageClass: []string{"standard"}}, Action: Action{Type: Skip}},
+				{Conditions: VolumeConditions{StorageClass: []string{"gp2"}}, Action: Action{Type: FSBackup}},
+				{Action: Action{Type: Snapshot}},
+			},
+			vol:  builder.ForPersistentVolume("pv-1").StorageClass("gp2").Result(),
+			want: &Action{Type: FSBackup},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policies := &ResourcePolicies{Version: "v1", VolumePolicies: tc.policies}
+			require.NoError(t, policies.Validate())
+
+			got, err := policies.GetMatchAction(tc.vol)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
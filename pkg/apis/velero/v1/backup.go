@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -101,8 +102,80 @@ type BackupSpec struct {
 	// +optional
 	// +nullable
 	OrderedResources map[string]string `json:"orderedResources,omitempty"`
+
+	// ResourcePolicy is a reference to a ConfigMap in the Velero namespace holding
+	// the volume policies used to decide how each volume is backed up.
+	// +optional
+	// +nullable
+	ResourcePolicy *v1.TypedLocalObjectReference `json:"resourcePolicy,omitempty"`
+
+	// UploaderType is the type of the uploader used to back up pod volumes
+	// from the file system. If empty, the Velero server's default is used.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
+
+	// ItemBackupWorkers is the number of items of the same resource that are
+	// backed up concurrently. If zero, the Velero server's default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ItemBackupWorkers int `json:"itemBackupWorkers,omitempty"`
+
+	// Compression configures how the backup tarball is compressed. If nil,
+	// the backup storage location's compression is used.
+	// +optional
+	// +nullable
+	Compression *CompressionConfig `json:"compression,omitempty"`
+
+	// DryRun specifies whether the backup only reports the items, volumes
+	// and hooks it would back up, without writing a tarball, taking
+	// snapshots or running hooks.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
+// CompressionAlgorithm is the algorithm a backup tarball is compressed with.
+// +kubebuilder:validation:Enum=gzip;zstd;none
+type CompressionAlgorithm string
+
+const (
+	// CompressionAlgorithmGzip compresses backup tarballs with gzip. It's the default.
+	CompressionAlgorithmGzip CompressionAlgorithm = "gzip"
+
+	// CompressionAlgorithmZstd compresses backup tarballs with zstd.
+	CompressionAlgorithmZstd CompressionAlgorithm = "zstd"
+
+	// CompressionAlgorithmNone doesn't compress backup tarballs.
+	CompressionAlgorithmNone CompressionAlgorithm = "none"
+)
+
+// CompressionConfig configures how a backup tarball is compressed.
+type CompressionConfig struct {
+	// Algorithm is the algorithm the backup tarball is compressed with.
+	// Defaults to gzip.
+	// +optional
+	Algorithm CompressionAlgorithm `json:"algorithm,omitempty"`
+
+	// Level is the compression level, from 1 to 9 for gzip and from 1 to 22
+	// for zstd. If zero, the algorithm's default level is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=22
+	Level int `json:"level,omitempty"`
+}
+
+// UploaderType is the type of the uploader that moves the data of pod
+// volumes between the file system and the backup storage location.
+// +kubebuilder:validation:Enum=restic;kopia
+type UploaderType string
+
+const (
+	// UploaderTypeRestic backs up and restores pod volumes with the restic binary.
+	UploaderTypeRestic UploaderType = "restic"
+
+	// UploaderTypeKopia backs up and restores pod volumes in-process with kopia.
+	UploaderTypeKopia UploaderType = "kopia"
+)
+
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
 type BackupHooks struct {
 	// Resources are hooks that should be executed when backing up individual instances of a resource.
@@ -198,7 +271,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Canceled;Deleting
 type BackupPhase string
 
 const (
@@ -236,6 +309,10 @@ const (
 	// prevented it from completing successfully.
 	BackupPhaseFailed BackupPhase = "Failed"
 
+	// BackupPhaseCanceled means the backup was canceled before it
+	// completed. Its tarball isn't uploaded, so it can't be restored.
+	BackupPhaseCanceled BackupPhase = "Canceled"
+
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
 )
@@ -251,6 +328,11 @@ type BackupStatus struct {
 	// +optional
 	FormatVersion string `json:"formatVersion,omitempty"`
 
+	// Compression is the algorithm the backup tarball is compressed with.
+	// Backups that don't record it are compressed with gzip.
+	// +optional
+	Compression CompressionAlgorithm `json:"compression,omitempty"`
+
 	// Expiration is when this Backup is eligible for garbage-collection.
 	// +optional
 	// +nullable
@@ -324,6 +406,28 @@ type BackupStatus struct {
 	// completed CSI VolumeSnapshots for this backup.
 	// +optional
 	CSIVolumeSnapshotsCompleted int `json:"csiVolumeSnapshotsCompleted,omitempty"`
+
+	// HooksAttempted is the total number of exec hooks attempted for this
+	// backup. The record of each hook is in object storage.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the total number of exec hooks that failed for this
+	// backup.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
+
+	// Attempts is the number of times the backup has been started. It is
+	// greater than one when a backup interrupted by a server restart was
+	// retried.
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+
+	// VolumeSnapshotsReused is the number of the backup's volume snapshots
+	// that were taken by a previous attempt of the backup, so the data of
+	// their volumes is older than the backed up resources.
+	// +optional
+	VolumeSnapshotsReused int `json:"volumeSnapshotsReused,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupReplicationSpec defines the specification for a BackupReplication.
+type BackupReplicationSpec struct {
+	// SourceLocation is the name of the backup storage location whose
+	// backups are replicated.
+	SourceLocation string `json:"sourceLocation"`
+
+	// DestinationLocation is the name of the backup storage location the
+	// backups are copied to.
+	DestinationLocation string `json:"destinationLocation"`
+
+	// LabelSelector selects the backups to replicate. If not set, all the
+	// backups of the source location are replicated.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// SyncPeriod is how often the source location is checked for backups to
+	// replicate. Defaults to 1 minute.
+	// +optional
+	// +nullable
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+
+	// MaxRetries is how many times copying a backup is attempted before
+	// giving up on it. Defaults to 3.
+	// +optional
+	MaxRetries int `json:"maxRetries,omitempty"`
+}
+
+// BackupReplicationPhase is the current state of a BackupReplication.
+// +kubebuilder:validation:Enum=New;Enabled;FailedValidation
+type BackupReplicationPhase string
+
+const (
+	// BackupReplicationPhaseNew means the BackupReplication has not been
+	// processed yet.
+	BackupReplicationPhaseNew BackupReplicationPhase = "New"
+
+	// BackupReplicationPhaseEnabled means the BackupReplication is valid and
+	// its backups are being replicated.
+	BackupReplicationPhaseEnabled BackupReplicationPhase = "Enabled"
+
+	// BackupReplicationPhaseFailedValidation means the BackupReplication has
+	// failed the controller's validations and no backups are replicated.
+	BackupReplicationPhaseFailedValidation BackupReplicationPhase = "FailedValidation"
+)
+
+// BackupReplicationFailure records a backup that failed to be copied to the
+// destination location.
+type BackupReplicationFailure struct {
+	// Backup is the name of the backup.
+	Backup string `json:"backup"`
+
+	// Attempts is how many times copying the backup was attempted. The
+	// backup isn't copied again once it reaches the maximum number of
+	// retries.
+	Attempts int `json:"attempts"`
+
+	// Message is the error of the last attempt.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastAttemptTimestamp records the time of the last attempt.
+	// +optional
+	// +nullable
+	LastAttemptTimestamp *metav1.Time `json:"lastAttemptTimestamp,omitempty"`
+}
+
+// BackupReplicationStatus captures the current status of a BackupReplication.
+type BackupReplicationStatus struct {
+	// Phase is the current state of the BackupReplication.
+	// +optional
+	Phase BackupReplicationPhase `json:"phase,omitempty"`
+
+	// ValidationErrors is a slice of all validation errors (if
+	// applicable).
+	// +optional
+	// +nullable
+	ValidationErrors []string `json:"validationErrors,omitempty"`
+
+	// LastSyncedTime is the last time the source location was checked for
+	// backups to replicate.
+	// +optional
+	// +nullable
+	LastSyncedTime *metav1.Time `json:"lastSyncedTime,omitempty"`
+
+	// ReplicatedBackups is the number of backups copied to the destination
+	// location.
+	// +optional
+	ReplicatedBackups int `json:"replicatedBackups,omitempty"`
+
+	// Failures lists the backups that failed to be copied to the destination
+	// location and haven't been copied since.
+	// +optional
+	// +nullable
+	Failures []BackupReplicationFailure `json:"failures,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.sourceLocation",description="Backup storage location whose backups are replicated"
+// +kubebuilder:printcolumn:name="Destination",type="string",JSONPath=".spec.destinationLocation",description="Backup storage location the backups are copied to"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Backup replication status such as Enabled/FailedValidation"
+// +kubebuilder:printcolumn:name="Replicated",type="integer",JSONPath=".status.replicatedBackups",description="Number of backups copied to the destination"
+// +kubebuilder:printcolumn:name="Last Synced",type="date",JSONPath=".status.lastSyncedTime",description="Last time the source location was checked for backups to replicate"
+
+// BackupReplication continuously copies the completed backups of a backup
+// storage location to another one.
+type BackupReplication struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupReplicationSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupReplicationStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=backupreplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupreplications/status,verbs=get;update;patch
+
+// BackupReplicationList is a list of BackupReplications.
+type BackupReplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupReplication `json:"items"`
+}
@@ -35,6 +35,16 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
 
+	// Encryption configures the client-side encryption of the objects Velero
+	// stores in this location.
+	// +optional
+	Encryption *EncryptionConfig `json:"encryption,omitempty"`
+
+	// Compression configures how the tarballs of the backups stored in this
+	// location are compressed, unless the backups configure it themselves.
+	// +optional
+	Compression *CompressionConfig `json:"compression,omitempty"`
+
 	StorageType `json:",inline"`
 
 	// Default indicates this location is the default backup storage location.
@@ -56,6 +66,22 @@ type BackupStorageLocationSpec struct {
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
 }
 
+// EncryptionConfig configures the client-side encryption of the objects Velero
+// stores in a backup storage location. Objects are encrypted with AES-256-GCM
+// before they're uploaded and decrypted after they're downloaded.
+type EncryptionConfig struct {
+	// Key selects the key of a Secret in Velero's namespace holding the 32-byte
+	// key the objects are encrypted with.
+	Key corev1api.SecretKeySelector `json:"key"`
+
+	// AllowUnencrypted allows reading the objects of the location that aren't
+	// encrypted, such as the ones stored before encryption was enabled. It's
+	// meant for migrating a location to encryption, and should be disabled
+	// once the backups stored before have expired.
+	// +optional
+	AllowUnencrypted bool `json:"allowUnencrypted,omitempty"`
+}
+
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
 type BackupStorageLocationStatus struct {
 	// Phase is the current state of the BackupStorageLocation.
@@ -25,17 +25,23 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemSnapshots;BackupResourceList;RestoreLog;RestoreResults
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemSnapshots;BackupResourceList;BackupManifest;BackupDryRunReport;BackupPodVolumeBackups;BackupHookExecutions;RestoreLog;RestoreResults;RestoreResourceList;RestoreHookExecutions
 type DownloadTargetKind string
 
 const (
-	DownloadTargetKindBackupLog             DownloadTargetKind = "BackupLog"
-	DownloadTargetKindBackupContents        DownloadTargetKind = "BackupContents"
-	DownloadTargetKindBackupVolumeSnapshots DownloadTargetKind = "BackupVolumeSnapshots"
-	DownloadTargetKindBackupItemSnapshots   DownloadTargetKind = "BackupItemSnapshots"
-	DownloadTargetKindBackupResourceList    DownloadTargetKind = "BackupResourceList"
-	DownloadTargetKindRestoreLog            DownloadTargetKind = "RestoreLog"
-	DownloadTargetKindRestoreResults        DownloadTargetKind = "RestoreResults"
+	DownloadTargetKindBackupLog              DownloadTargetKind = "BackupLog"
+	DownloadTargetKindBackupContents         DownloadTargetKind = "BackupContents"
+	DownloadTargetKindBackupVolumeSnapshots  DownloadTargetKind = "BackupVolumeSnapshots"
+	DownloadTargetKindBackupItemSnapshots    DownloadTargetKind = "BackupItemSnapshots"
+	DownloadTargetKindBackupResourceList     DownloadTargetKind = "BackupResourceList"
+	DownloadTargetKindBackupManifest         DownloadTargetKind = "BackupManifest"
+	DownloadTargetKindBackupDryRunReport     DownloadTargetKind = "BackupDryRunReport"
+	DownloadTargetKindBackupPodVolumeBackups DownloadTargetKind = "BackupPodVolumeBackups"
+	DownloadTargetKindBackupHookExecutions   DownloadTargetKind = "BackupHookExecutions"
+	DownloadTargetKindRestoreLog             DownloadTargetKind = "RestoreLog"
+	DownloadTargetKindRestoreResults         DownloadTargetKind = "RestoreResults"
+	DownloadTargetKindRestoreResourceList    DownloadTargetKind = "RestoreResourceList"
+	DownloadTargetKindRestoreHookExecutions  DownloadTargetKind = "RestoreHookExecutions"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -76,6 +82,12 @@ type DownloadRequestStatus struct {
 	// +optional
 	// +nullable
 	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// Encryption is the encryption configuration of the backup storage location
+	// the target file is stored in, if the file is encrypted.
+	// +optional
+	// +nullable
+	Encryption *EncryptionConfig `json:"encryption,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
@@ -0,0 +1,139 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImportBackupRequestSpec is the specification for the backup to import.
+type ImportBackupRequestSpec struct {
+	// BackupName is the name of the imported backup.
+	BackupName string `json:"backupName"`
+
+	// StorageLocation is the name of the backup storage location the backup
+	// is imported into.
+	StorageLocation string `json:"storageLocation"`
+
+	// Tarball is the name of the backup tarball to import in the imports
+	// directory of the backup storage location.
+	Tarball string `json:"tarball"`
+
+	// Upload means the tarball is uploaded to the imports directory through
+	// the upload URL of the request's status, rather than copied there before
+	// the request is created.
+	// +optional
+	Upload bool `json:"upload,omitempty"`
+
+	// TTL is how long before the imported backup can be garbage collected,
+	// counted from the import.
+	// +optional
+	TTL metav1.Duration `json:"ttl,omitempty"`
+}
+
+// ImportBackupRequestPhase represents the lifecycle phase of an ImportBackupRequest.
+// +kubebuilder:validation:Enum=New;WaitingForUpload;InProgress;Completed;Failed
+type ImportBackupRequestPhase string
+
+const (
+	// ImportBackupRequestPhaseNew means the ImportBackupRequest has not been processed yet.
+	ImportBackupRequestPhaseNew ImportBackupRequestPhase = "New"
+
+	// ImportBackupRequestPhaseWaitingForUpload means the tarball to import is
+	// waiting to be uploaded through the request's upload URL.
+	ImportBackupRequestPhaseWaitingForUpload ImportBackupRequestPhase = "WaitingForUpload"
+
+	// ImportBackupRequestPhaseInProgress means the backup is being imported.
+	ImportBackupRequestPhaseInProgress ImportBackupRequestPhase = "InProgress"
+
+	// ImportBackupRequestPhaseCompleted means the backup has been imported
+	// into the backup storage location.
+	ImportBackupRequestPhaseCompleted ImportBackupRequestPhase = "Completed"
+
+	// ImportBackupRequestPhaseFailed means the backup couldn't be imported.
+	ImportBackupRequestPhaseFailed ImportBackupRequestPhase = "Failed"
+)
+
+// ImportBackupRequestStatus is the current status of an ImportBackupRequest.
+type ImportBackupRequestStatus struct {
+	// Phase is the current state of the ImportBackupRequest.
+	// +optional
+	Phase ImportBackupRequestPhase `json:"phase,omitempty"`
+
+	// UploadURL is the URL the tarball to import is uploaded to with an HTTP
+	// PUT request, for requests whose tarball is uploaded.
+	// +optional
+	UploadURL string `json:"uploadURL,omitempty"`
+
+	// Expiration is when the upload URL expires.
+	// +optional
+	// +nullable
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// Errors contains any errors that were encountered while importing the
+	// backup.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
+
+	// CompletionTimestamp records the time the request was processed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Name of the imported backup"
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".spec.storageLocation",description="Backup storage location the backup is imported into"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Import status such as InProgress/Completed/Failed"
+
+// ImportBackupRequest is a request to import a backup tarball, such as one
+// downloaded from another cluster, as a backup of a backup storage location.
+// The tarball is either copied to the location beforehand or uploaded through
+// a URL issued for the request.
+type ImportBackupRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec ImportBackupRequestSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status ImportBackupRequestStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=importbackuprequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=importbackuprequests/status,verbs=get;update;patch
+
+// ImportBackupRequestList is a list of ImportBackupRequests.
+type ImportBackupRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ImportBackupRequest `json:"items"`
+}
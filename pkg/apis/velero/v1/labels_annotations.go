@@ -62,4 +62,12 @@ const (
 	// SourceClusterK8sMajorVersionAnnotation is the label key used to identify the k8s
 	// minor version of the backup , i.e. 16
 	SourceClusterK8sMinorVersionAnnotation = "velero.io/source-cluster-k8s-minor-version"
+
+	// RetentionAnnotation is the annotation key used to record why the
+	// retention policy of its schedule keeps or expires a backup.
+	RetentionAnnotation = "velero.io/retention"
+
+	// CancelRequestedAnnotation is the annotation key used to request the
+	// cancellation of an in-progress backup or restore.
+	CancelRequestedAnnotation = "velero.io/cancel-requested"
 )
@@ -44,6 +44,11 @@ type PodVolumeBackupSpec struct {
 	// volume backup as tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// UploaderType is the type of the uploader that handles the data of the
+	// volume. If empty, restic is used.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
 }
 
 // PodVolumeBackupPhase represents the lifecycle phase of a PodVolumeBackup.
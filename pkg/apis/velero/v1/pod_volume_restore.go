@@ -38,6 +38,11 @@ type PodVolumeRestoreSpec struct {
 
 	// SnapshotID is the ID of the volume snapshot to be restored.
 	SnapshotID string `json:"snapshotID"`
+
+	// UploaderType is the type of the uploader that handles the data of the
+	// volume. If empty, restic is used.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
 }
 
 // PodVolumeRestorePhase represents the lifecycle phase of a PodVolumeRestore.
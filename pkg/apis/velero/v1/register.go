@@ -46,10 +46,12 @@ func newTypeInfo(pluralName string, itemType, itemListType runtime.Object) typeI
 func CustomResources() map[string]typeInfo {
 	return map[string]typeInfo{
 		"Backup":                 newTypeInfo("backups", &Backup{}, &BackupList{}),
+		"BackupReplication":      newTypeInfo("backupreplications", &BackupReplication{}, &BackupReplicationList{}),
 		"Restore":                newTypeInfo("restores", &Restore{}, &RestoreList{}),
 		"Schedule":               newTypeInfo("schedules", &Schedule{}, &ScheduleList{}),
 		"DownloadRequest":        newTypeInfo("downloadrequests", &DownloadRequest{}, &DownloadRequestList{}),
 		"DeleteBackupRequest":    newTypeInfo("deletebackuprequests", &DeleteBackupRequest{}, &DeleteBackupRequestList{}),
+		"ImportBackupRequest":    newTypeInfo("importbackuprequests", &ImportBackupRequest{}, &ImportBackupRequestList{}),
 		"PodVolumeBackup":        newTypeInfo("podvolumebackups", &PodVolumeBackup{}, &PodVolumeBackupList{}),
 		"PodVolumeRestore":       newTypeInfo("podvolumerestores", &PodVolumeRestore{}, &PodVolumeRestoreList{}),
 		"ResticRepository":       newTypeInfo("resticrepositories", &ResticRepository{}, &ResticRepositoryList{}),
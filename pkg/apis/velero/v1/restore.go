@@ -92,8 +92,38 @@ type RestoreSpec struct {
 	// Hooks represent custom behaviors that should be executed during or post restore.
 	// +optional
 	Hooks RestoreHooks `json:"hooks,omitempty"`
+
+	// ExistingResourcePolicy specifies the restore behavior for the Kubernetes resource to be restored
+	// when it already exists in the cluster and differs from the backed-up version.
+	// +optional
+	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
+
+	// ResourceModifier is a reference to a ConfigMap in the Velero namespace holding
+	// the rules used to modify items before they are restored.
+	// +optional
+	// +nullable
+	ResourceModifier *v1.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
+	// DryRun specifies whether the restore should only report what it would
+	// do to each item of the backup, without changing anything in the cluster.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
+// PolicyType helps specify the ExistingResourcePolicy
+// +kubebuilder:validation:Enum=none;update
+type PolicyType string
+
+const (
+	// PolicyTypeNone means Velero does not modify an in-cluster resource that differs from
+	// the backed-up version and records a warning instead. This is the default behavior.
+	PolicyTypeNone PolicyType = "none"
+
+	// PolicyTypeUpdate means Velero patches an in-cluster resource that differs from the
+	// backed-up version so that it matches the backed-up version.
+	PolicyTypeUpdate PolicyType = "update"
+)
+
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
 type RestoreHooks struct {
 	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
@@ -132,9 +162,60 @@ type RestoreResourceHookSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// PreHooks is a list of RestorePreHooks to execute before restoring the resources this hook
+	// spec applies to. With the Pod scope, they're executed in the running pods of each namespace
+	// this hook spec applies to, before the first item of the namespace is restored. With the
+	// Restore scope, they're executed once, before the first item this hook spec applies to is
+	// restored.
+	// +optional
+	PreHooks []RestorePreHook `json:"preHooks,omitempty"`
+
 	// PostHooks is a list of RestoreResourceHooks to execute during and after restoring a resource.
 	// +optional
 	PostHooks []RestoreResourceHook `json:"postHooks,omitempty"`
+
+	// Scope specifies whether the hooks are executed for each pod this hook spec applies to (Pod),
+	// or once per restore (Restore). If empty, Pod is used.
+	// +optional
+	Scope RestoreHookScope `json:"scope,omitempty"`
+
+	// Pod selects the pod the hooks with the Restore scope are executed in. Required for the
+	// Restore scope.
+	// +optional
+	// +nullable
+	Pod *RestoreHookPod `json:"pod,omitempty"`
+}
+
+// RestoreHookScope defines how many times the hooks of a RestoreResourceHookSpec are executed.
+// +kubebuilder:validation:Enum=Pod;Restore
+type RestoreHookScope string
+
+const (
+	// RestoreHookScopePod means the hooks are executed in each pod the hook spec applies to. The
+	// hook spec only applies to pods.
+	RestoreHookScopePod RestoreHookScope = "Pod"
+
+	// RestoreHookScopeRestore means the hooks are executed once per restore, in the pod selected
+	// by the hook spec, if the restore restores any item the hook spec applies to. The hook spec
+	// applies to any resource.
+	RestoreHookScopeRestore RestoreHookScope = "Restore"
+)
+
+// RestoreHookPod selects the pod the hooks with the Restore scope are executed in.
+type RestoreHookPod struct {
+	// Namespace is the namespace of the pod.
+	Namespace string `json:"namespace"`
+
+	// LabelSelector selects the pod. If several pods match, the first one by name that
+	// isn't terminated is used.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector"`
+}
+
+// RestorePreHook defines a hook to execute before restoring the resources its hook spec
+// applies to.
+type RestorePreHook struct {
+	// Exec defines an exec restore hook.
+	Exec *ExecRestoreHook `json:"exec"`
 }
 
 // RestoreResourceHook defines a restore hook for a resource.
@@ -186,7 +267,7 @@ type InitRestoreHook struct {
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Canceled
 type RestorePhase string
 
 const (
@@ -212,6 +293,10 @@ const (
 	// RestorePhaseFailed means the restore was unable to execute.
 	// The failing error is recorded in status.FailureReason.
 	RestorePhaseFailed RestorePhase = "Failed"
+
+	// RestorePhaseCanceled means the restore was canceled before it
+	// completed. The items restored until then are left in the cluster.
+	RestorePhaseCanceled RestorePhase = "Canceled"
 )
 
 // RestoreStatus captures the current status of a Velero restore
@@ -259,6 +344,59 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	Progress *RestoreProgress `json:"progress,omitempty"`
+
+	// HookResults records the outcome of the pre-restore hooks and of the hooks with the
+	// Restore scope. At most 100 results are recorded, the restore's log has the others.
+	// +optional
+	// +nullable
+	HookResults []RestoreHookResult `json:"hookResults,omitempty"`
+
+	// HooksAttempted is the total number of exec hooks attempted for this
+	// restore. The record of each hook is in object storage.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the total number of exec hooks that failed for this
+	// restore.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
+}
+
+// RestoreHookPhase is the phase of a restore hook.
+// +kubebuilder:validation:Enum=Pre;Post
+type RestoreHookPhase string
+
+const (
+	// RestoreHookPhasePre means the hook is executed before the items it applies to are restored.
+	RestoreHookPhasePre RestoreHookPhase = "Pre"
+
+	// RestoreHookPhasePost means the hook is executed after the items it applies to are restored.
+	RestoreHookPhasePost RestoreHookPhase = "Post"
+)
+
+// RestoreHookResult records the outcome of executing a restore hook in a container.
+type RestoreHookResult struct {
+	// Name is the name of the hook spec.
+	Name string `json:"name"`
+
+	// Phase is the phase of the hook.
+	Phase RestoreHookPhase `json:"phase"`
+
+	// Pod is the namespace and name of the pod the hook was executed in. It's empty if no pod
+	// was found to execute the hook in.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Container is the container the hook was executed in.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Succeeded is whether the hook was executed successfully.
+	Succeeded bool `json:"succeeded"`
+
+	// Message is the error of the hook, if it failed.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
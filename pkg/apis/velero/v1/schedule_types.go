@@ -38,11 +38,86 @@ type ScheduleSpec struct {
 	// +optional
 	// +nullable
 	UseOwnerReferencesInBackup *bool `json:"useOwnerReferencesInBackup,omitempty"`
+
+	// Paused specifies whether the schedule is paused. A paused
+	// schedule doesn't trigger backups.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// SkipImmediately specifies whether to skip a run that is due
+	// when the schedule is created or unpaused, and wait for the next
+	// scheduled time instead.
+	// +optional
+	SkipImmediately bool `json:"skipImmediately,omitempty"`
+
+	// MissedRunPolicy specifies what to do when one or more scheduled
+	// runs were missed, e.g. because the Velero server was down. If
+	// empty, runOnce is used.
+	// +optional
+	MissedRunPolicy MissedRunPolicy `json:"missedRunPolicy,omitempty"`
+
+	// StartingDeadline is how late a scheduled run may be triggered
+	// before it's considered missed and handled according to the
+	// MissedRunPolicy. If empty, 5 minutes is used.
+	// +optional
+	StartingDeadline metav1.Duration `json:"startingDeadline,omitempty"`
+
+	// Retention specifies which of the backups created by this
+	// schedule are kept. If set, it replaces the TTL of the completed
+	// backups: they're kept as long as one of its rules applies to
+	// them, and are garbage-collected otherwise.
+	// +optional
+	// +nullable
+	Retention *RetentionPolicy `json:"retention,omitempty"`
 }
 
+// RetentionPolicy defines the backups of a schedule that are kept. A
+// backup is kept if any of the rules applies to it. Only the
+// completed and partially failed backups are counted; the daily,
+// weekly and monthly periods are calendar periods in UTC, the weeks
+// starting on Monday.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast int `json:"keepLast,omitempty"`
+
+	// KeepDaily is the number of days, including the current one, for
+	// which the most recent backup of each day is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepDaily int `json:"keepDaily,omitempty"`
+
+	// KeepWeekly is the number of weeks, including the current one,
+	// for which the most recent backup of each week is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepWeekly int `json:"keepWeekly,omitempty"`
+
+	// KeepMonthly is the number of months, including the current one,
+	// for which the most recent backup of each month is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepMonthly int `json:"keepMonthly,omitempty"`
+}
+
+// MissedRunPolicy is the policy a schedule applies to its missed runs.
+// +kubebuilder:validation:Enum=runOnce;skip
+type MissedRunPolicy string
+
+const (
+	// MissedRunPolicyRunOnce means that the missed runs of a schedule
+	// are collapsed into a single backup that is run immediately.
+	MissedRunPolicyRunOnce MissedRunPolicy = "runOnce"
+
+	// MissedRunPolicySkip means that the missed runs of a schedule are
+	// skipped and the next backup is run at the next scheduled time.
+	MissedRunPolicySkip MissedRunPolicy = "skip"
+)
+
 // SchedulePhase is a string representation of the lifecycle phase
 // of a Velero schedule
-// +kubebuilder:validation:Enum=New;Enabled;FailedValidation
+// +kubebuilder:validation:Enum=New;Enabled;FailedValidation;Paused
 type SchedulePhase string
 
 const (
@@ -57,6 +132,11 @@ const (
 	// SchedulePhaseFailedValidation means the schedule has failed
 	// the controller's validations and therefore will not trigger backups.
 	SchedulePhaseFailedValidation SchedulePhase = "FailedValidation"
+
+	// SchedulePhasePaused means the schedule has been validated but
+	// is paused and therefore will not trigger backups until it is
+	// unpaused.
+	SchedulePhasePaused SchedulePhase = "Paused"
 )
 
 // ScheduleStatus captures the current state of a Velero schedule
@@ -71,6 +151,18 @@ type ScheduleStatus struct {
 	// +nullable
 	LastBackup *metav1.Time `json:"lastBackup,omitempty"`
 
+	// LastSkipped is the last time a due run of this Schedule was
+	// skipped, either because of SkipImmediately or because it was
+	// missed and the MissedRunPolicy is skip.
+	// +optional
+	// +nullable
+	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
+
+	// MissedRuns is the number of scheduled runs that had been missed
+	// when this Schedule last ran or skipped a backup, up to 1000.
+	// +optional
+	MissedRuns int `json:"missedRuns,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable)
 	// +optional
@@ -18,12 +18,12 @@ package archive
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"io"
 	"path/filepath"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -41,16 +41,17 @@ func NewExtractor(log logrus.FieldLogger, fs filesystem.Interface) *Extractor {
 	}
 }
 
-// UnzipAndExtractBackup extracts a reader on a gzipped tarball to a local temp directory
+// UnzipAndExtractBackup extracts a reader on a compressed tarball to a local temp directory.
+// The algorithm the tarball is compressed with is detected from its contents.
 func (e *Extractor) UnzipAndExtractBackup(src io.Reader) (string, error) {
-	gzr, err := gzip.NewReader(src)
+	r, err := compression.NewReader(src)
 	if err != nil {
-		e.log.Infof("error creating gzip reader: %v", err)
+		e.log.Infof("error creating decompressing reader: %v", err)
 		return "", err
 	}
-	defer gzr.Close()
+	defer r.Close()
 
-	return e.readBackup(tar.NewReader(gzr))
+	return e.readBackup(tar.NewReader(r))
 }
 
 func (e *Extractor) writeFile(target string, tarRdr *tar.Reader) error {
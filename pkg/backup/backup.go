@@ -18,7 +18,6 @@ package backup
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -26,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -49,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 // BackupVersion is the current backup major version for Velero.
@@ -78,6 +79,7 @@ type kubernetesBackupper struct {
 	resticTimeout          time.Duration
 	defaultVolumesToRestic bool
 	clientPageSize         int
+	itemBackupWorkers      int
 }
 
 func (i *itemKey) String() string {
@@ -104,6 +106,7 @@ func NewKubernetesBackupper(
 	resticTimeout time.Duration,
 	defaultVolumesToRestic bool,
 	clientPageSize int,
+	itemBackupWorkers int,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
 		backupClient:           backupClient,
@@ -114,6 +117,7 @@ func NewKubernetesBackupper(
 		resticTimeout:          resticTimeout,
 		defaultVolumesToRestic: defaultVolumesToRestic,
 		clientPageSize:         clientPageSize,
+		itemBackupWorkers:      itemBackupWorkers,
 	}, nil
 }
 
@@ -164,7 +168,7 @@ type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
 }
 
-// Backup backs up the items specified in the Backup, placing them in a gzip-compressed tar file
+// Backup backs up the items specified in the Backup, placing them in a compressed tar file
 // written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
@@ -183,11 +187,17 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	backupItemActionResolver framework.BackupItemActionResolver,
 	itemSnapshotterResolver framework.ItemSnapshotterResolver,
 	volumeSnapshotterGetter VolumeSnapshotterGetter) error {
-	gzippedData := gzip.NewWriter(backupFile)
-	defer gzippedData.Close()
+	compressedData, err := compression.NewWriter(backupFile, backupRequest.CompressionConfig())
+	if err != nil {
+		return err
+	}
+	defer compressedData.Close()
 
-	tw := tar.NewWriter(gzippedData)
-	defer tw.Close()
+	tarWriter := tar.NewWriter(compressedData)
+	defer tarWriter.Close()
+
+	// record the files of the tarball with their checksums in the backup's manifest
+	tw := newManifestTarWriter(tarWriter)
 
 	log.Info("Writing backup version file")
 	if err := kb.writeBackupVersion(tw); err != nil {
@@ -203,7 +213,6 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
 	log.Infof("Backing up all pod volumes using Restic: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.DefaultVolumesToRestic))
 
-	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
 		return err
@@ -231,11 +240,14 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	// the request's context is canceled when the backup's cancellation is requested,
+	// which also stops the restic backups of its pod volumes.
+	runCtx := backupRequest.runContext()
+	ctx, cancelFunc := context.WithTimeout(runCtx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticBackupper restic.Backupper
-	if kb.resticBackupperFactory != nil {
+	if kb.resticBackupperFactory != nil && !backupRequest.Spec.DryRun {
 		resticBackupper, err = kb.resticBackupperFactory.NewBackupper(ctx, backupRequest.Backup)
 		if err != nil {
 			return errors.WithStack(err)
@@ -260,6 +272,10 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		pageSize:              kb.clientPageSize,
 	}
 
+	if resumeFrom := backupRequest.ResumeFrom; resumeFrom != nil {
+		log.Infof("Resuming from the checkpoint of attempt %d, which had taken %d volume snapshots", resumeFrom.Attempt, len(resumeFrom.VolumeSnapshots))
+	}
+
 	items := collector.getAllItems()
 	log.WithField("progress", "").Infof("Collected %d items matching the backup spec from the Kubernetes API (actual number of items backed up may be more or less depending on velero.io/exclude-from-backup annotation, plugins returning additional related items to back up, etc.)", len(items))
 
@@ -277,9 +293,23 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		resticBackupper:         resticBackupper,
 		resticSnapshotTracker:   newPVCSnapshotTracker(),
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
-		itemHookHandler: &hook.DefaultItemHookHandler{
+	}
+
+	// a dry run goes through the items the same way as a backup, but only
+	// reports the items, volumes and hooks it would back up.
+	if backupRequest.Spec.DryRun {
+		log.Info("Dry run, reporting what would be backed up")
+		backupRequest.DryRunReport = NewDryRunReport()
+		itemBackupper.dryRunReport = backupRequest.DryRunReport
+		itemBackupper.itemHookHandler = &hook.DryRunItemHookHandler{
+			Report: backupRequest.DryRunReport.addHook,
+		}
+	} else {
+		backupRequest.HookExecutions = hook.NewExecutionRecorder()
+		itemBackupper.itemHookHandler = &hook.DefaultItemHookHandler{
 			PodCommandExecutor: kb.podCommandExecutor,
-		},
+			ExecutionRecorder:  backupRequest.HookExecutions,
+		}
 	}
 
 	// helper struct to send current progress between the main
@@ -301,11 +331,14 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	// progress updates on the 'update' channel. It patches
 	// the backup CR with progress updates at most every second,
 	// but it will not issue a patch if it hasn't received a new
-	// update since the previous patch. This goroutine exits
-	// when it receives on the 'quit' channel.
+	// update since the previous patch. It also saves a checkpoint
+	// of the backup's progress every checkpointInterval. This
+	// goroutine exits when it receives on the 'quit' channel.
+	itemBackupper.saveCheckpoint(log)
 	go func() {
 		ticker := time.NewTicker(1 * time.Second)
 		var lastUpdate *progressUpdate
+		lastCheckpoint := time.Now()
 		for {
 			select {
 			case <-quit:
@@ -324,14 +357,32 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 					}
 					lastUpdate = nil
 				}
+				if time.Since(lastCheckpoint) >= checkpointInterval {
+					itemBackupper.saveCheckpoint(log)
+					lastCheckpoint = time.Now()
+				}
 			}
 		}
 	}()
 
-	backedUpGroupResources := map[schema.GroupResource]bool{}
-	totalItems := len(items)
+	itemBackupWorkers := kb.itemBackupWorkers
+	if backupRequest.Spec.ItemBackupWorkers > 0 {
+		itemBackupWorkers = backupRequest.Spec.ItemBackupWorkers
+	}
+	if itemBackupWorkers < 1 {
+		itemBackupWorkers = 1
+	}
+	log.Infof("Backing up items with %d workers", itemBackupWorkers)
+
+	var (
+		// lock guards backedUpGroupResources and itemsProcessed, which are
+		// updated by the workers backing up items.
+		lock                   sync.Mutex
+		backedUpGroupResources = map[schema.GroupResource]bool{}
+		itemsProcessed         int
+	)
 
-	for i, item := range items {
+	processItem := func(item *kubernetesResource) {
 		log.WithFields(map[string]interface{}{
 			"progress":  "",
 			"resource":  item.groupResource.String(),
@@ -358,31 +409,91 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 			}
 
 			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
+				lock.Lock()
 				backedUpGroupResources[item.groupResource] = true
+				lock.Unlock()
 			}
 		}()
 
+		lock.Lock()
+		itemsProcessed++
+		backedUpItems := itemBackupper.backedUpItemsCount()
+
 		// updated total is computed as "how many items we've backed up so far, plus
 		// how many items we know of that are remaining"
-		totalItems = len(backupRequest.BackedUpItems) + (len(items) - (i + 1))
+		totalItems := backedUpItems + (len(items) - itemsProcessed)
 
 		// send a progress update
 		update <- progressUpdate{
 			totalItems:    totalItems,
-			itemsBackedUp: len(backupRequest.BackedUpItems),
+			itemsBackedUp: backedUpItems,
 		}
+		lock.Unlock()
 
 		log.WithFields(map[string]interface{}{
 			"progress":  "",
 			"resource":  item.groupResource.String(),
 			"namespace": item.namespace,
 			"name":      item.name,
-		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", len(backupRequest.BackedUpItems), totalItems)
+		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", backedUpItems, totalItems)
+	}
+
+	for _, batch := range batchItems(items, backupRequest.Spec.OrderedResources) {
+		if runCtx.Err() != nil {
+			break
+		}
+
+		// items whose order is set in the backup's OrderedResources are backed up one
+		// at a time, in that order.
+		if itemBackupWorkers == 1 || batch.ordered || len(batch.items) == 1 {
+			for _, item := range batch.items {
+				if runCtx.Err() != nil {
+					break
+				}
+				processItem(item)
+			}
+			continue
+		}
+
+		workers := itemBackupWorkers
+		if workers > len(batch.items) {
+			workers = len(batch.items)
+		}
+
+		queue := make(chan *kubernetesResource)
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for item := range queue {
+					processItem(item)
+				}
+			}()
+		}
+	enqueue:
+		for _, item := range batch.items {
+			select {
+			case queue <- item:
+			case <-runCtx.Done():
+				break enqueue
+			}
+		}
+		close(queue)
+		wg.Wait()
 	}
 
 	// no more progress updates will be sent on the 'update' channel
 	quit <- struct{}{}
 
+	if err := runCtx.Err(); err != nil {
+		log.Info("Backup was canceled, not backing up the remaining items")
+		return errors.Wrap(err, "backup canceled")
+	}
+
+	itemBackupper.deleteUnusedVolumeSnapshots(log)
+	backupRequest.Status.VolumeSnapshotsReused = len(itemBackupper.reusedSnapshots)
+
 	// back up CRD for resource if found. We should only need to do this if we've backed up at least
 	// one item for the resource and IncludeClusterResources is nil. If IncludeClusterResources is false
 	// we don't want to back it up, and if it's true it will already be included.
@@ -404,9 +515,42 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 
 	log.WithField("progress", "").Infof("Backed up a total of %d items", len(backupRequest.BackedUpItems))
 
+	if backupRequest.DryRunReport != nil {
+		backupRequest.DryRunReport.complete(backupRequest)
+	}
+
+	backupRequest.Status.HooksAttempted, backupRequest.Status.HooksFailed = backupRequest.HookExecutions.Counts()
+
+	backupRequest.Manifest = tw.manifest()
+
 	return nil
 }
 
+// itemBatch is a run of consecutive items of the same resource. Resources
+// are backed up in the order they're collected in, so that e.g. pods are
+// backed up before the persistent volume claims they use, but the items of
+// a batch don't depend on each other and can be backed up concurrently,
+// unless their order is set in the backup's OrderedResources.
+type itemBatch struct {
+	items   []*kubernetesResource
+	ordered bool
+}
+
+// batchItems splits the items into batches of consecutive items of the
+// same resource.
+func batchItems(items []*kubernetesResource, orderedResources map[string]string) []*itemBatch {
+	var batches []*itemBatch
+	for _, item := range items {
+		if len(batches) == 0 || batches[len(batches)-1].items[0].groupResource != item.groupResource {
+			batches = append(batches, &itemBatch{ordered: orderedResources[item.groupResource.Resource] != ""})
+		}
+		batch := batches[len(batches)-1]
+		batch.items = append(batch.items, item)
+	}
+
+	return batches
+}
+
 func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
 	backedUpItem, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR)
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
@@ -464,7 +608,7 @@ func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.Group
 	kb.backupItem(log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
 }
 
-func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
+func (kb *kubernetesBackupper) writeBackupVersion(tw tarWriter) error {
 	versionFile := filepath.Join(velerov1api.MetadataDir, "version")
 	versionString := fmt.Sprintf("%s\n", BackupFormatVersion)
 
@@ -18,6 +18,7 @@ package backup
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -25,6 +26,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -41,15 +43,19 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -148,6 +154,142 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 	assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.ItemsBackedUp)
 }
 
+// TestBackupCanceled verifies that a backup whose request's context is
+// canceled doesn't back up any items and returns an error.
+func TestBackupCanceled(t *testing.T) {
+	h := newHarness(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := &Request{Backup: defaultBackup().Result(), Context: ctx}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+
+	err := h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Empty(t, req.BackedUpItems)
+}
+
+// TestBackupResumesFromCheckpoint verifies that a backup resuming from the checkpoint of
+// its previous attempt reuses the volume snapshots the attempt took and records how many,
+// deletes the ones that aren't reused, and saves a checkpoint of its own.
+func TestBackupResumesFromCheckpoint(t *testing.T) {
+	h := newHarness(t)
+	backup := defaultBackup().Result()
+	backup.Status.Attempts = 2
+
+	previousSnapshot := func(pvName, volumeID, snapshotID string, phase volume.SnapshotPhase) *volume.Snapshot {
+		snapshot := volumeSnapshot(backup, pvName, volumeID, "type-1", "", "default", int64Ptr(100))
+		snapshot.Status.Phase = phase
+		snapshot.Status.ProviderSnapshotID = snapshotID
+		return snapshot
+	}
+
+	var checkpoints []*Checkpoint
+	req := &Request{
+		Backup: backup,
+		SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+			newSnapshotLocation("velero", "default", "default"),
+		},
+		ResumeFrom: &Checkpoint{
+			Attempt: 1,
+			VolumeSnapshots: []*volume.Snapshot{
+				previousSnapshot("pv-1", "vol-1", "vol-1-previous-snapshot", volume.SnapshotPhaseCompleted),
+				previousSnapshot("pv-2", "vol-2", "vol-2-previous-snapshot", volume.SnapshotPhaseCompleted),
+				previousSnapshot("pv-3", "vol-3", "", volume.SnapshotPhaseFailed),
+			},
+		},
+		CheckpointFunc: func(checkpoint *Checkpoint) error {
+			checkpoints = append(checkpoints, checkpoint)
+			return nil
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").Result(),
+		builder.ForPersistentVolume("pv-3").Result(),
+	))
+
+	volumeSnapshotter := new(fakeVolumeSnapshotter).
+		WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+		WithVolume("pv-3", "vol-3", "", "type-1", 100, false)
+	snapshotterGetter := volumeSnapshotterGetter{"default": volumeSnapshotter}
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, snapshotterGetter))
+
+	require.Len(t, req.VolumeSnapshots, 2)
+	snapshotIDs := map[string]string{}
+	for _, snapshot := range req.VolumeSnapshots {
+		snapshotIDs[snapshot.Spec.PersistentVolumeName] = snapshot.Status.ProviderSnapshotID
+	}
+	assert.Equal(t, map[string]string{"pv-1": "vol-1-previous-snapshot", "pv-3": "vol-3-snapshot"}, snapshotIDs)
+	assert.Equal(t, []string{"vol-2-previous-snapshot"}, volumeSnapshotter.DeletedSnapshots)
+	assert.Equal(t, 1, req.Status.VolumeSnapshotsReused)
+
+	require.NotEmpty(t, checkpoints)
+	assert.Equal(t, 2, checkpoints[0].Attempt)
+}
+
+// TestBackupCompression runs backups with different compression configurations
+// and verifies that the backup tarball is compressed with the expected algorithm
+// and can be read back.
+func TestBackupCompression(t *testing.T) {
+	tests := []struct {
+		name            string
+		backup          *velerov1.Backup
+		location        *velerov1.BackupStorageLocation
+		wantCompression velerov1.CompressionAlgorithm
+	}{
+		{
+			name:            "backup without compression config is compressed with gzip",
+			backup:          defaultBackup().Result(),
+			wantCompression: velerov1.CompressionAlgorithmGzip,
+		},
+		{
+			name:            "backup with zstd compression is compressed with zstd",
+			backup:          defaultBackup().Compression(velerov1.CompressionAlgorithmZstd, 3).Result(),
+			wantCompression: velerov1.CompressionAlgorithmZstd,
+		},
+		{
+			name:            "backup uses its location's compression",
+			backup:          defaultBackup().Result(),
+			location:        builder.ForBackupStorageLocation("velero", "loc-1").Compression(velerov1.CompressionAlgorithmNone, 0).Result(),
+			wantCompression: velerov1.CompressionAlgorithmNone,
+		},
+		{
+			name:            "backup's compression overrides its location's",
+			backup:          defaultBackup().Compression(velerov1.CompressionAlgorithmZstd, 0).Result(),
+			location:        builder.ForBackupStorageLocation("velero", "loc-1").Compression(velerov1.CompressionAlgorithmNone, 0).Result(),
+			wantCompression: velerov1.CompressionAlgorithmZstd,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup, StorageLocation: tc.location}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			h.addItems(t, test.Pods(builder.ForPod("foo", "bar").Result()))
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			algorithm, err := compression.Detect(bufio.NewReader(bytes.NewReader(backupFile.Bytes())))
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCompression, algorithm)
+
+			assertTarballContents(t, backupFile, "metadata/version", "resources/pods/namespaces/foo/bar.json", "resources/pods/v1-preferredversion/namespaces/foo/bar.json")
+		})
+	}
+}
+
 // TestBackupResourceFiltering runs backups with different combinations
 // of resource filters (included/excluded resources, included/excluded
 // namespaces, label selectors, "include cluster resources" flag), and
@@ -1100,6 +1242,187 @@ func TestBackupResourceOrdering(t *testing.T) {
 	}
 }
 
+// TestBackupWithItemBackupWorkers runs the same backup with items backed up one at a
+// time and concurrently, and verifies that the resulting tarballs are equivalent: they
+// hold the same files, with the same contents, and resources are written in the same order.
+func TestBackupWithItemBackupWorkers(t *testing.T) {
+	// the order of API groups isn't deterministic, so only the order of the resources
+	// of the core group is compared.
+	coreResources := func(resources []string) []string {
+		var res []string
+		for _, resource := range resources {
+			if !strings.Contains(resource, ".") {
+				res = append(res, resource)
+			}
+		}
+		return res
+	}
+
+	apiResources := func() []*test.APIResource {
+		var (
+			pods        []metav1.Object
+			pvcs        []metav1.Object
+			pvs         []metav1.Object
+			deployments []metav1.Object
+		)
+		for i := 0; i < 20; i++ {
+			pods = append(pods, builder.ForPod("ns-1", fmt.Sprintf("pod-%d", i)).Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource(fmt.Sprintf("pvc-%d", i)).Result()).Result())
+			pvcs = append(pvcs, builder.ForPersistentVolumeClaim("ns-1", fmt.Sprintf("pvc-%d", i)).VolumeName(fmt.Sprintf("pv-%d", i)).Result())
+			pvs = append(pvs, builder.ForPersistentVolume(fmt.Sprintf("pv-%d", i)).ClaimRef("ns-1", fmt.Sprintf("pvc-%d", i)).Result())
+			deployments = append(deployments, builder.ForDeployment("ns-2", fmt.Sprintf("deploy-%d", i)).Result())
+		}
+
+		return []*test.APIResource{
+			test.Pods(pods...),
+			test.PVCs(pvcs...),
+			test.PVs(pvs...),
+			test.Deployments(deployments...),
+		}
+	}
+
+	runBackup := func(t *testing.T, serverWorkers int, backup *velerov1.Backup) (*Request, *bytes.Buffer) {
+		var (
+			h          = newHarness(t)
+			req        = &Request{Backup: backup}
+			backupFile = bytes.NewBuffer([]byte{})
+		)
+		h.backupper.itemBackupWorkers = serverWorkers
+
+		for _, resource := range apiResources() {
+			h.addItems(t, resource)
+		}
+
+		require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+		return req, backupFile
+	}
+
+	sequentialReq, sequentialFile := runBackup(t, 1, defaultBackup().Result())
+	sequentialContents := tarballContents(t, sequentialFile)
+
+	tests := []struct {
+		name          string
+		serverWorkers int
+		backup        *velerov1.Backup
+	}{
+		{
+			name:          "server default number of workers",
+			serverWorkers: 4,
+			backup:        defaultBackup().Result(),
+		},
+		{
+			name:          "backup's number of workers overrides the server default",
+			serverWorkers: 1,
+			backup:        defaultBackup().ItemBackupWorkers(8).Result(),
+		},
+		{
+			name:          "more workers than items",
+			serverWorkers: 100,
+			backup:        defaultBackup().Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req, backupFile := runBackup(t, tc.serverWorkers, tc.backup)
+			contents := tarballContents(t, backupFile)
+
+			assert.Equal(t, sequentialReq.BackedUpItems, req.BackedUpItems)
+			assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.TotalItems)
+			assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.ItemsBackedUp)
+
+			assert.Equal(t, sequentialContents.files, contents.files)
+			assert.Equal(t, coreResources(sequentialContents.resources), coreResources(contents.resources))
+		})
+	}
+}
+
+// TestBackupWithItemBackupWorkersKeepsOrderedResources verifies that items whose order is set
+// in the backup's OrderedResources are backed up in that order when items are otherwise backed
+// up concurrently.
+func TestBackupWithItemBackupWorkersKeepsOrderedResources(t *testing.T) {
+	var (
+		pods  []metav1.Object
+		order []string
+	)
+	for i := 0; i < 20; i++ {
+		pods = append(pods, builder.ForPod("ns-1", fmt.Sprintf("pod-%02d", i)).Result())
+		order = append(order, fmt.Sprintf("ns-1/pod-%02d", 19-i))
+	}
+
+	var (
+		h          = newHarness(t)
+		req        = &Request{Backup: defaultBackup().ItemBackupWorkers(4).Result()}
+		backupFile = bytes.NewBuffer([]byte{})
+	)
+	req.Spec.OrderedResources = map[string]string{"pods": strings.Join(order, ",")}
+	h.addItems(t, test.Pods(pods...))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	var got []string
+	for _, name := range tarballContents(t, backupFile).names {
+		if strings.HasPrefix(name, "resources/pods/namespaces/") {
+			got = append(got, "ns-1/"+strings.TrimSuffix(filepath.Base(name), ".json"))
+		}
+	}
+	assert.Equal(t, order, got)
+}
+
+func TestBatchItems(t *testing.T) {
+	pod := func(name string) *kubernetesResource {
+		return &kubernetesResource{groupResource: kuberesource.Pods, namespace: "ns-1", name: name}
+	}
+	pv := func(name string) *kubernetesResource {
+		return &kubernetesResource{groupResource: kuberesource.PersistentVolumes, name: name}
+	}
+
+	tests := []struct {
+		name             string
+		items            []*kubernetesResource
+		orderedResources map[string]string
+		want             []*itemBatch
+	}{
+		{
+			name:  "no items",
+			items: nil,
+			want:  nil,
+		},
+		{
+			name:  "consecutive items of the same resource are batched",
+			items: []*kubernetesResource{pod("pod-1"), pod("pod-2"), pv("pv-1"), pv("pv-2")},
+			want: []*itemBatch{
+				{items: []*kubernetesResource{pod("pod-1"), pod("pod-2")}},
+				{items: []*kubernetesResource{pv("pv-1"), pv("pv-2")}},
+			},
+		},
+		{
+			name:  "non-consecutive items of the same resource are not batched",
+			items: []*kubernetesResource{pod("pod-1"), pv("pv-1"), pod("pod-2")},
+			want: []*itemBatch{
+				{items: []*kubernetesResource{pod("pod-1")}},
+				{items: []*kubernetesResource{pv("pv-1")}},
+				{items: []*kubernetesResource{pod("pod-2")}},
+			},
+		},
+		{
+			name:             "batches of resources with an order are ordered",
+			items:            []*kubernetesResource{pod("pod-2"), pod("pod-1"), pv("pv-1")},
+			orderedResources: map[string]string{"pods": "ns-1/pod-2,ns-1/pod-1"},
+			want: []*itemBatch{
+				{items: []*kubernetesResource{pod("pod-2"), pod("pod-1")}, ordered: true},
+				{items: []*kubernetesResource{pv("pv-1")}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, batchItems(tc.items, tc.orderedResources))
+		})
+	}
+}
+
 // recordResourcesAction is a backup item action that can be configured
 // to run for specific resources/namespaces and simply records the items
 // that it is executed for.
@@ -1838,6 +2161,10 @@ type fakeVolumeSnapshotter struct {
 	// Volumes is a map from volume identifier (volume ID + AZ) to a struct
 	// of volume info, used for the GetVolumeInfo and CreateSnapshot methods.
 	Volumes map[volumeIdentifier]*volumeInfo
+
+	// DeletedSnapshots are the IDs of the snapshots deleted with the
+	// DeleteSnapshot method.
+	DeletedSnapshots []string
 }
 
 // WithVolume is a test helper for registering persistent volumes that the
@@ -1921,9 +2248,11 @@ func (*fakeVolumeSnapshotter) SetVolumeID(pv runtime.Unstructured, volumeID stri
 	panic("SetVolumeID should not be used for backups")
 }
 
-// DeleteSnapshot panics because it's not expected to be used for backups.
-func (*fakeVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
-	panic("DeleteSnapshot should not be used for backups")
+// DeleteSnapshot records the snapshot ID in DeletedSnapshots. Backups only delete the
+// unused snapshots of their previous attempts.
+func (vs *fakeVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
+	vs.DeletedSnapshots = append(vs.DeletedSnapshots, snapshotID)
+	return nil
 }
 
 // TestBackupWithSnapshots runs backups with volume snapshot locations and volume snapshotters
@@ -2244,6 +2573,145 @@ func TestBackupWithSnapshots(t *testing.T) {
 	}
 }
 
+// fakeItemSnapshotter is a test fake for the isv1.ItemSnapshotter interface that snapshots
+// the items matching its selector.
+type fakeItemSnapshotter struct {
+	selector    velero.ResourceSelector
+	alsoHandles []velero.ResourceIdentifier
+	phase       isv1.SnapshotPhase
+	snapshotErr bool
+	progressErr bool
+}
+
+func (s *fakeItemSnapshotter) Name() string {
+	return "velero.io/fake"
+}
+
+func (s *fakeItemSnapshotter) Init(config map[string]string) error {
+	return nil
+}
+
+func (s *fakeItemSnapshotter) AppliesTo() (velero.ResourceSelector, error) {
+	return s.selector, nil
+}
+
+func (s *fakeItemSnapshotter) AlsoHandles(input *isv1.AlsoHandlesInput) ([]velero.ResourceIdentifier, error) {
+	return s.alsoHandles, nil
+}
+
+func (s *fakeItemSnapshotter) SnapshotItem(ctx context.Context, input *isv1.SnapshotItemInput) (*isv1.SnapshotItemOutput, error) {
+	if s.snapshotErr {
+		return nil, errors.New("error snapshotting item")
+	}
+
+	name := input.Item.UnstructuredContent()["metadata"].(map[string]interface{})["name"].(string)
+
+	return &isv1.SnapshotItemOutput{
+		UpdatedItem:      input.Item,
+		SnapshotID:       name + "-snapshot",
+		SnapshotMetadata: map[string]string{"key": "value"},
+	}, nil
+}
+
+func (s *fakeItemSnapshotter) Progress(input *isv1.ProgressInput) (*isv1.ProgressOutput, error) {
+	if s.progressErr {
+		return nil, errors.New("error getting progress")
+	}
+	return &isv1.ProgressOutput{Phase: s.phase}, nil
+}
+
+func (s *fakeItemSnapshotter) DeleteSnapshot(ctx context.Context, input *isv1.DeleteSnapshotInput) error {
+	panic("DeleteSnapshot should not be used for backups")
+}
+
+func (s *fakeItemSnapshotter) CreateItemFromSnapshot(ctx context.Context, input *isv1.CreateItemInput) (*isv1.CreateItemOutput, error) {
+	panic("CreateItemFromSnapshot should not be used for backups")
+}
+
+// TestBackupWithItemSnapshotters runs backups with item snapshotters and verifies that
+// the items they apply to are snapshotted, the items they handle are excluded from the
+// backup, and the snapshots are recorded as in progress.
+func TestBackupWithItemSnapshotters(t *testing.T) {
+
+	itemSnapshot := func(item, snapshotID string, phase isv1.SnapshotPhase) *volume.ItemSnapshot {
+		return &volume.ItemSnapshot{
+			Spec: volume.ItemSnapshotSpec{
+				ItemSnapshotter:    "velero.io/fake",
+				BackupName:         "backup-1",
+				ResourceIdentifier: item,
+			},
+			Status: volume.ItemSnapshotStatus{
+				ProviderSnapshotID: snapshotID,
+				Metadata:           map[string]string{"key": "value"},
+				Phase:              phase,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		snapshotter *fakeItemSnapshotter
+		wantFiles   []string
+		want        []*volume.ItemSnapshot
+		wantErr     bool
+	}{
+		{
+			name: "items the snapshotter applies to are snapshotted and handled items are excluded",
+			snapshotter: &fakeItemSnapshotter{
+				selector:    velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
+				alsoHandles: []velero.ResourceIdentifier{{GroupResource: kuberesource.Pods, Namespace: "ns-2", Name: "pod-2"}},
+			},
+			wantFiles: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-3/pod-3.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-3/pod-3.json",
+			},
+			want: []*volume.ItemSnapshot{
+				itemSnapshot("pods/ns-1/pod-1", "pod-1-snapshot", isv1.SnapshotPhaseInProgress),
+			},
+		},
+		{
+			name: "items that fail to be snapshotted are not backed up",
+			snapshotter: &fakeItemSnapshotter{
+				selector:    velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
+				snapshotErr: true,
+			},
+			wantFiles: []string{
+				"resources/pods/namespaces/ns-2/pod-2.json",
+				"resources/pods/namespaces/ns-3/pod-3.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-3/pod-3.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: defaultBackup().Result()}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			h.addItems(t, test.Pods(
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-2", "pod-2").Result(),
+				builder.ForPod("ns-3", "pod-3").Result(),
+			))
+
+			err := h.backupper.BackupWithResolvers(h.log, req, backupFile,
+				framework.NewBackupItemActionResolver(nil),
+				framework.NewItemSnapshotterResolver([]isv1.ItemSnapshotter{tc.snapshotter}),
+				nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.want, req.ItemSnapshots)
+			assertTarballContents(t, backupFile, append(tc.wantFiles, "metadata/version")...)
+		})
+	}
+}
+
 // TestBackupWithInvalidHooks runs backups with invalid hook specifications and verifies
 // that an error is returned.
 func TestBackupWithInvalidHooks(t *testing.T) {
@@ -2560,6 +3028,16 @@ func TestBackupWithHooks(t *testing.T) {
 			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
 
 			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version")...)
+
+			wantFailed := 0
+			for _, expect := range tc.wantExecutePodCommandCalls {
+				if expect.err != nil {
+					wantFailed++
+				}
+			}
+			assert.Equal(t, len(tc.wantExecutePodCommandCalls), req.Status.HooksAttempted)
+			assert.Equal(t, wantFailed, req.Status.HooksFailed)
+			assert.Len(t, req.HookExecutions.Executions(), len(tc.wantExecutePodCommandCalls))
 		})
 	}
 }
@@ -2692,6 +3170,237 @@ func TestBackupWithRestic(t *testing.T) {
 	}
 }
 
+// TestBackupDryRun runs a dry-run backup and ensures that it reports the items, volumes and hooks
+// it would back up, without backing up pod volumes, taking snapshots, running hooks or writing
+// items to the tarball.
+func TestBackupDryRun(t *testing.T) {
+	var (
+		h      = newHarness(t)
+		backup = defaultBackup().
+			DryRun(true).
+			Hooks(velerov1.BackupHooks{
+				Resources: []velerov1.BackupResourceHookSpec{
+					{
+						Name:               "hook-1",
+						IncludedNamespaces: []string{"ns-1"},
+						PreHooks: []velerov1.BackupResourceHook{
+							{Exec: &velerov1.ExecHook{Command: []string{"sync"}}},
+						},
+					},
+				},
+			}).
+			Result()
+		req = &Request{
+			Backup:            backup,
+			StorageLocation:   builder.ForBackupStorageLocation("velero", "default").Result(),
+			SnapshotLocations: []*velerov1.VolumeSnapshotLocation{newSnapshotLocation("velero", "default", "default")},
+		}
+		backupFile        = bytes.NewBuffer([]byte{})
+		volumeSnapshotter = new(fakeVolumeSnapshotter).WithVolume("pv-2", "vol-2", "", "type-1", 100, false)
+	)
+	req.Spec.StorageLocation = "default"
+
+	h.backupper.resticBackupperFactory = new(fakeResticBackupperFactory)
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").
+			ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-1")).
+			Containers(builder.ForContainer("app", "image").Result()).
+			Volumes(
+				builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+				builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+			).
+			Result(),
+		builder.ForPod("ns-1", "pod-2").
+			ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-3")).
+			Containers(builder.ForContainer("app", "image").Result()).
+			Volumes(builder.ForVolume("vol-3").PersistentVolumeClaimSource("pvc-1").Result()).
+			Result(),
+	))
+	h.addItems(t, test.PVCs(
+		builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+		builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+	))
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+		builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result(),
+		builder.ForPersistentVolume("pv-3").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, volumeSnapshotterGetter{"default": volumeSnapshotter}))
+
+	assert.Nil(t, req.PodVolumeBackups)
+	assert.Nil(t, req.VolumeSnapshots)
+	assertTarballContents(t, backupFile, "metadata/version")
+
+	require.NotNil(t, req.DryRunReport)
+	assert.Equal(t, map[string][]string{
+		"v1/Pod":                   {"ns-1/pod-1", "ns-1/pod-2"},
+		"v1/PersistentVolumeClaim": {"ns-1/pvc-1", "ns-1/pvc-2"},
+		"v1/PersistentVolume":      {"pv-1", "pv-2", "pv-3"},
+	}, req.DryRunReport.Resources)
+	assert.Equal(t, []DryRunVolume{
+		{Name: "pv-1", Method: DryRunVolumeMethodSkipped, Reason: "backed up with a pod volume backup"},
+		{Name: "pv-2", Method: DryRunVolumeMethodSnapshot, Location: "default"},
+		{Name: "pv-3", Method: DryRunVolumeMethodSkipped, Reason: "not supported by the volume snapshot locations"},
+		{Namespace: "ns-1", Pod: "pod-1", Name: "vol-1", Method: DryRunVolumeMethodPodVolumeBackup, Uploader: velerov1.UploaderTypeRestic, Location: "default"},
+		{Namespace: "ns-1", Pod: "pod-2", Name: "vol-3", Method: DryRunVolumeMethodSkipped, Reason: "persistent volume claim pvc-1 is backed up from another pod"},
+	}, req.DryRunReport.Volumes)
+	assert.Equal(t, []DryRunHook{
+		{Namespace: "ns-1", Pod: "pod-1", Name: "hook-1", Phase: "pre", Container: "app", Command: []string{"sync"}},
+		{Namespace: "ns-1", Pod: "pod-2", Name: "hook-1", Phase: "pre", Container: "app", Command: []string{"sync"}},
+	}, req.DryRunReport.Hooks)
+}
+
+// TestBackupWithResourcePolicies runs backups with volume policies and ensures that pod volumes
+// and persistent volumes are backed up with restic, snapshotted or skipped according to the action
+// of the first policy they match, falling back to the pod's annotations for unmatched volumes.
+func TestBackupWithResourcePolicies(t *testing.T) {
+	policies := func(volumePolicies ...resourcepolicies.VolumePolicy) *resourcepolicies.ResourcePolicies {
+		return &resourcepolicies.ResourcePolicies{Version: "v1", VolumePolicies: volumePolicies}
+	}
+	storageClassPolicy := func(storageClass string, action resourcepolicies.VolumeActionType) resourcepolicies.VolumePolicy {
+		return resourcepolicies.VolumePolicy{
+			Conditions: resourcepolicies.VolumeConditions{StorageClass: []string{storageClass}},
+			Action:     resourcepolicies.Action{Type: action},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		policies          *resourcepolicies.ResourcePolicies
+		apiResources      []*test.APIResource
+		wantPodVolumes    []string
+		wantSnapshottedPV []string
+	}{
+		{
+			name:     "PVC pod volumes matching an fs-backup policy are backed up with restic without annotations",
+			policies: policies(storageClassPolicy("nfs", resourcepolicies.FSBackup)),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						Volumes(
+							builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+							builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+						).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").StorageClass("nfs").Result(),
+					builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").StorageClass("gp2").Result(),
+				),
+			},
+			wantPodVolumes:    []string{"pvb-ns-1-pod-1-vol-1"},
+			wantSnapshottedPV: []string{"pv-2"},
+		},
+		{
+			name:     "annotated pod volumes matching a snapshot policy are snapshotted instead of backed up with restic",
+			policies: policies(storageClassPolicy("gp2", resourcepolicies.Snapshot)),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-1,vol-2")).
+						Volumes(
+							builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+							builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+						).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").StorageClass("gp2").Result(),
+					builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").StorageClass("nfs").Result(),
+				),
+			},
+			wantPodVolumes:    []string{"pvb-ns-1-pod-1-vol-2"},
+			wantSnapshottedPV: []string{"pv-1"},
+		},
+		{
+			name:     "volumes matching a skip policy are neither backed up with restic nor snapshotted",
+			policies: policies(storageClassPolicy("gp2", resourcepolicies.Skip)),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-1")).
+						Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").StorageClass("gp2").Result(),
+					builder.ForPersistentVolume("pv-2").StorageClass("gp2").Result(),
+				),
+			},
+		},
+		{
+			name: "non-PVC pod volumes are matched on their volume source",
+			policies: policies(resourcepolicies.VolumePolicy{
+				Conditions: resourcepolicies.VolumeConditions{VolumeTypes: []string{"emptyDir", "hostPath"}},
+				Action:     resourcepolicies.Action{Type: resourcepolicies.FSBackup},
+			}),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						Volumes(
+							&corev1.Volume{Name: "vol-1", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+							builder.ForVolume("vol-2").HostPathSource("/var/log").Result(),
+						).
+						Result(),
+				),
+			},
+			wantPodVolumes: []string{"pvb-ns-1-pod-1-vol-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h   = newHarness(t)
+				req = &Request{
+					Backup:            defaultBackup().Result(),
+					SnapshotLocations: []*velerov1.VolumeSnapshotLocation{newSnapshotLocation("velero", "default", "default")},
+					ResPolicies:       tc.policies,
+				}
+				backupFile        = bytes.NewBuffer([]byte{})
+				snapshotterGetter = volumeSnapshotterGetter{
+					"default": new(fakeVolumeSnapshotter).
+						WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+						WithVolume("pv-2", "vol-2", "", "type-1", 100, false),
+				}
+			)
+
+			h.backupper.resticBackupperFactory = new(fakeResticBackupperFactory)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, snapshotterGetter))
+
+			var podVolumes []string
+			for _, pvb := range req.PodVolumeBackups {
+				podVolumes = append(podVolumes, pvb.Name)
+			}
+			assert.Equal(t, tc.wantPodVolumes, podVolumes)
+
+			var snapshottedPVs []string
+			for _, snapshot := range req.VolumeSnapshots {
+				snapshottedPVs = append(snapshottedPVs, snapshot.Spec.PersistentVolumeName)
+			}
+			assert.Equal(t, tc.wantSnapshottedPV, snapshottedPVs)
+		})
+	}
+}
+
 // pluggableAction is a backup item action that can be plugged with an Execute
 // function body at runtime.
 type pluggableAction struct {
@@ -2788,15 +3497,15 @@ func toUnstructuredOrFail(t *testing.T, obj interface{}) map[string]interface{}
 	return res
 }
 
-// assertTarballContents verifies that the gzipped tarball stored in the provided
+// assertTarballContents verifies that the compressed tarball stored in the provided
 // backupFile contains exactly the file names specified.
 func assertTarballContents(t *testing.T, backupFile io.Reader, items ...string) {
 	t.Helper()
 
-	gzr, err := gzip.NewReader(backupFile)
+	cr, err := compression.NewReader(backupFile)
 	require.NoError(t, err)
 
-	r := tar.NewReader(gzr)
+	r := tar.NewReader(cr)
 
 	var files []string
 	for {
@@ -2814,6 +3523,49 @@ func assertTarballContents(t *testing.T, backupFile io.Reader, items ...string)
 	assert.Equal(t, items, files)
 }
 
+// tarball holds the files of a backup tarball.
+type tarball struct {
+	// names are the names of the files, in the order they were written.
+	names []string
+	// files maps the names of the files to their contents.
+	files map[string]string
+	// resources are the resources of the files, in the order they were first written.
+	resources []string
+}
+
+// tarballContents reads the files of the gzipped tarball stored in the provided backupFile.
+func tarballContents(t *testing.T, backupFile io.Reader) *tarball {
+	t.Helper()
+
+	gzr, err := gzip.NewReader(backupFile)
+	require.NoError(t, err)
+
+	r := tar.NewReader(gzr)
+	res := &tarball{files: map[string]string{}}
+
+	for {
+		hdr, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+
+		res.names = append(res.names, hdr.Name)
+		res.files[hdr.Name] = string(data)
+
+		if parts := strings.Split(hdr.Name, "/"); parts[0] == "resources" {
+			if len(res.resources) == 0 || res.resources[len(res.resources)-1] != parts[1] {
+				res.resources = append(res.resources, parts[1])
+			}
+		}
+	}
+
+	return res
+}
+
 // unstructuredObject is a type alias to improve readability.
 type unstructuredObject map[string]interface{}
 
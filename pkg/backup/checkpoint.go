@@ -0,0 +1,151 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// checkpointInterval is the minimum interval between two checkpoints of a
+// backup's progress.
+const checkpointInterval = 30 * time.Second
+
+// Checkpoint records the progress of a backup, so that the next attempt of a
+// backup interrupted by a server restart can reuse the work already done. The
+// items are backed up again by the next attempt, since the tarball of the
+// interrupted attempt isn't persisted, and the pod volume backups completed by
+// the interrupted attempt are found by their backup's label, so only the volume
+// snapshots are recorded.
+type Checkpoint struct {
+	// Attempt is the attempt of the backup that saved the checkpoint.
+	Attempt int `json:"attempt"`
+
+	// VolumeSnapshots are the volume snapshots taken.
+	VolumeSnapshots []*volume.Snapshot `json:"volumeSnapshots,omitempty"`
+}
+
+// CheckpointFunc saves a checkpoint of a backup.
+type CheckpointFunc func(checkpoint *Checkpoint) error
+
+// checkpoint returns a checkpoint of the backup's progress so far.
+func (ib *itemBackupper) checkpoint() *Checkpoint {
+	ib.lock.Lock()
+	defer ib.lock.Unlock()
+
+	return &Checkpoint{
+		Attempt:         ib.backupRequest.Status.Attempts,
+		VolumeSnapshots: append([]*volume.Snapshot{}, ib.backupRequest.VolumeSnapshots...),
+	}
+}
+
+// saveCheckpoint saves a checkpoint of the backup's progress so far, if the
+// backup request has a CheckpointFunc. Errors are only logged since they don't
+// affect the backup itself.
+func (ib *itemBackupper) saveCheckpoint(log logrus.FieldLogger) {
+	if ib.backupRequest.CheckpointFunc == nil {
+		return
+	}
+
+	if err := ib.backupRequest.CheckpointFunc(ib.checkpoint()); err != nil {
+		log.WithError(err).Warn("Error saving backup checkpoint")
+	}
+}
+
+// snapshotTaken returns whether a volume snapshot was taken, even if its data is
+// still being uploaded.
+func snapshotTaken(snapshot *volume.Snapshot) bool {
+	return snapshot.Status.Phase == volume.SnapshotPhaseCompleted || snapshot.Status.Phase == volume.SnapshotPhaseUploading
+}
+
+// previousVolumeSnapshot returns the snapshot of the volume taken by
+// a previous attempt of the backup, or nil if there's none. The snapshot
+// returned is recorded as reused.
+func (ib *itemBackupper) previousVolumeSnapshot(pvName, location, volumeID string) *volume.Snapshot {
+	if ib.backupRequest.ResumeFrom == nil {
+		return nil
+	}
+
+	ib.lock.Lock()
+	defer ib.lock.Unlock()
+
+	for _, snapshot := range ib.backupRequest.ResumeFrom.VolumeSnapshots {
+		if !snapshotTaken(snapshot) ||
+			snapshot.Spec.PersistentVolumeName != pvName ||
+			snapshot.Spec.Location != location ||
+			snapshot.Spec.ProviderVolumeID != volumeID {
+			continue
+		}
+		if _, reused := ib.reusedSnapshots[snapshot.Status.ProviderSnapshotID]; reused {
+			continue
+		}
+
+		if ib.reusedSnapshots == nil {
+			ib.reusedSnapshots = make(map[string]struct{})
+		}
+		ib.reusedSnapshots[snapshot.Status.ProviderSnapshotID] = struct{}{}
+		return snapshot
+	}
+
+	return nil
+}
+
+// deleteUnusedVolumeSnapshots deletes the snapshots taken by previous attempts
+// of the backup that weren't reused, e.g. because their volume was deleted
+// since. Errors are only logged since the backup doesn't depend on them.
+func (ib *itemBackupper) deleteUnusedVolumeSnapshots(log logrus.FieldLogger) {
+	if ib.backupRequest.ResumeFrom == nil {
+		return
+	}
+
+	locations := make(map[string]*velerov1api.VolumeSnapshotLocation)
+	for _, location := range ib.backupRequest.SnapshotLocations {
+		locations[location.Name] = location
+	}
+
+	for _, snapshot := range ib.backupRequest.ResumeFrom.VolumeSnapshots {
+		if !snapshotTaken(snapshot) {
+			continue
+		}
+		if _, reused := ib.reusedSnapshots[snapshot.Status.ProviderSnapshotID]; reused {
+			continue
+		}
+
+		log := log.WithField("snapshotID", snapshot.Status.ProviderSnapshotID)
+
+		location, ok := locations[snapshot.Spec.Location]
+		if !ok {
+			log.Warnf("Not deleting unused volume snapshot of a previous attempt because its volume snapshot location %s isn't used by the backup anymore", snapshot.Spec.Location)
+			continue
+		}
+
+		volumeSnapshotter, err := ib.volumeSnapshotter(location)
+		if err != nil {
+			log.WithError(err).Warn("Error getting volume snapshotter to delete unused volume snapshot of a previous attempt")
+			continue
+		}
+
+		log.Info("Deleting unused volume snapshot of a previous attempt")
+		if err := volumeSnapshotter.DeleteSnapshot(snapshot.Status.ProviderSnapshotID); err != nil {
+			log.WithError(err).Warn("Error deleting unused volume snapshot of a previous attempt")
+		}
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+	"sync"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DryRunVolumeMethod is how a volume would be backed up.
+type DryRunVolumeMethod string
+
+const (
+	// DryRunVolumeMethodPodVolumeBackup means the volume's data would be backed
+	// up from the pod mounting it by the backup's uploader.
+	DryRunVolumeMethodPodVolumeBackup DryRunVolumeMethod = "PodVolumeBackup"
+
+	// DryRunVolumeMethodSnapshot means the persistent volume would be snapshotted
+	// by the volume snapshotter of a volume snapshot location.
+	DryRunVolumeMethodSnapshot DryRunVolumeMethod = "Snapshot"
+
+	// DryRunVolumeMethodSkipped means the volume's data wouldn't be backed up.
+	DryRunVolumeMethodSkipped DryRunVolumeMethod = "Skipped"
+)
+
+// DryRunReport is what a dry-run backup reports it would back up.
+type DryRunReport struct {
+	// Resources are the items that would be backed up, grouped by resource.
+	Resources map[string][]string `json:"resources"`
+
+	// Volumes are the pod volumes and persistent volumes that would be backed
+	// up, and how.
+	Volumes []DryRunVolume `json:"volumes"`
+
+	// Hooks are the exec hooks that would be run.
+	Hooks []DryRunHook `json:"hooks"`
+
+	lock sync.Mutex
+}
+
+// DryRunVolume is a volume a dry-run backup would back up.
+type DryRunVolume struct {
+	// Namespace and Pod are the pod mounting a pod volume, and are empty for
+	// persistent volumes.
+	Namespace string `json:"namespace,omitempty"`
+	Pod       string `json:"pod,omitempty"`
+
+	// Name is the name of the pod volume or of the persistent volume.
+	Name string `json:"name"`
+
+	Method DryRunVolumeMethod `json:"method"`
+
+	// Uploader is the uploader pod volumes would be backed up with.
+	Uploader velerov1api.UploaderType `json:"uploader,omitempty"`
+
+	// Location is the backup storage location pod volumes would be backed up
+	// to, or the volume snapshot location persistent volumes would be
+	// snapshotted in.
+	Location string `json:"location,omitempty"`
+
+	// Reason is why the volume would be skipped.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DryRunHook is an exec hook a dry-run backup would run.
+type DryRunHook struct {
+	Namespace string   `json:"namespace"`
+	Pod       string   `json:"pod"`
+	Name      string   `json:"name"`
+	Phase     string   `json:"phase"`
+	Container string   `json:"container"`
+	Command   []string `json:"command"`
+}
+
+// NewDryRunReport returns an empty DryRunReport.
+func NewDryRunReport() *DryRunReport {
+	return &DryRunReport{
+		Resources: map[string][]string{},
+	}
+}
+
+// addVolume records a volume. It's a no-op on a nil report, so that it can
+// be called whether or not the backup is a dry run.
+func (r *DryRunReport) addVolume(volume DryRunVolume) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Volumes = append(r.Volumes, volume)
+}
+
+// addHook records an exec hook. It's a no-op on a nil report.
+func (r *DryRunReport) addHook(namespace, pod, hookName, phase string, hook *velerov1api.ExecHook) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Hooks = append(r.Hooks, DryRunHook{
+		Namespace: namespace,
+		Pod:       pod,
+		Name:      hookName,
+		Phase:     phase,
+		Container: hook.Container,
+		Command:   hook.Command,
+	})
+}
+
+// complete records the backed up items of backup and sorts the volumes, so
+// that the report doesn't depend on the order items were backed up in.
+// Hooks are kept in the order they would be run in.
+func (r *DryRunReport) complete(backup *Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Resources = backup.BackupResourceList()
+	sort.SliceStable(r.Volumes, func(i, j int) bool {
+		a, b := r.Volumes[i], r.Volumes[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Pod != b.Pod {
+			return a.Pod < b.Pod
+		}
+		return a.Name < b.Name
+	})
+}
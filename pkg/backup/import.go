@@ -0,0 +1,208 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// NewImportedBackup validates the backup tarball read from contents, such as
+// one downloaded from another cluster, and reconstructs the metadata of the
+// backup, its manifest and its resource list so that it can be imported into a
+// backup storage location.
+func NewImportedBackup(contents io.ReadSeeker, namespace, name, location string, ttl time.Duration, now time.Time, log logrus.FieldLogger) (*velerov1api.Backup, *Manifest, map[string][]string, error) {
+	br := bufio.NewReader(contents)
+	algorithm, err := compression.Detect(br)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	fs := filesystem.NewFileSystem()
+	dir, err := archive.NewExtractor(log, fs).UnzipAndExtractBackup(br)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error extracting backup tarball")
+	}
+	defer fs.RemoveAll(dir)
+
+	formatVersion, majorVersion, err := importedFormatVersion(fs, dir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resources, err := archive.NewParser(log, fs).Parse(dir)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error parsing backup tarball")
+	}
+
+	resourceList, namespaces, err := importedResourceList(fs, dir, resources)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+	manifest, err := NewManifest(contents)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+	start, completion, err := tarballTimes(contents)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	items := 0
+	for _, entries := range resourceList {
+		items += len(entries)
+	}
+
+	backup := builder.ForBackup(namespace, name).
+		StorageLocation(location).
+		IncludedNamespaces(namespaces...).
+		TTL(ttl).
+		Phase(velerov1api.BackupPhaseCompleted).
+		StartTimestamp(start).
+		CompletionTimestamp(completion).
+		Expiration(now.Add(ttl)).
+		Result()
+	backup.Status.Version = majorVersion
+	backup.Status.FormatVersion = formatVersion
+	backup.Status.Compression = algorithm
+	backup.Status.Progress = &velerov1api.BackupProgress{
+		TotalItems:    items,
+		ItemsBackedUp: items,
+	}
+
+	return backup, manifest, resourceList, nil
+}
+
+// importedFormatVersion returns the format version of the backup extracted to
+// dir and its major version.
+func importedFormatVersion(fs filesystem.Interface, dir string) (string, int, error) {
+	data, err := fs.ReadFile(filepath.Join(dir, velerov1api.MetadataDir, "version"))
+	if os.IsNotExist(err) {
+		return "", 0, errors.Errorf("backup tarball has no %s/version file", velerov1api.MetadataDir)
+	}
+	if err != nil {
+		return "", 0, errors.WithStack(err)
+	}
+
+	version := strings.TrimSpace(string(data))
+	major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid backup format version %q", version)
+	}
+	if major != BackupVersion {
+		return "", 0, errors.Errorf("unsupported backup format version %q", version)
+	}
+
+	return version, major, nil
+}
+
+// importedResourceList decodes every item of the backup extracted to dir and
+// returns the backup's resource list, in the format of the ones persisted with
+// backups, and the namespaces of the items.
+func importedResourceList(fs filesystem.Interface, dir string, resources map[string]*archive.ResourceItems) (map[string][]string, []string, error) {
+	resourceList := map[string][]string{}
+	namespaces := []string{}
+	seen := map[string]bool{}
+
+	for resource, items := range resources {
+		for namespace, names := range items.ItemsByNamespace {
+			if namespace != "" && !seen[namespace] {
+				seen[namespace] = true
+				namespaces = append(namespaces, namespace)
+			}
+
+			for _, name := range names {
+				path := archive.GetItemFilePath(dir, resource, namespace, name)
+				obj, err := archive.Unmarshal(fs, path)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "error decoding %s", strings.TrimPrefix(path, dir+"/"))
+				}
+
+				entry := name
+				if namespace != "" {
+					entry = fmt.Sprintf("%s/%s", namespace, name)
+				}
+				gvk := obj.GroupVersionKind()
+				key := fmt.Sprintf("%s/%s", gvk.GroupVersion().String(), gvk.Kind)
+				resourceList[key] = append(resourceList[key], entry)
+			}
+		}
+	}
+
+	sort.Strings(namespaces)
+	for _, entries := range resourceList {
+		sort.Strings(entries)
+	}
+
+	return resourceList, namespaces, nil
+}
+
+// tarballTimes returns the earliest and latest modification times of the
+// files in the compressed tarball read from r, which are the times the backup
+// started and completed writing it.
+func tarballTimes(r io.Reader) (start, end time.Time, err error) {
+	zr, err := compression.NewReader(r)
+	if err != nil {
+		return start, end, errors.Wrap(err, "error reading backup tarball")
+	}
+	defer zr.Close()
+
+	tr := tar.NewReader(zr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return start, end, errors.Wrap(err, "error reading backup tarball")
+		}
+
+		if start.IsZero() || hdr.ModTime.Before(start) {
+			start = hdr.ModTime
+		}
+		if hdr.ModTime.After(end) {
+			end = hdr.ModTime
+		}
+	}
+
+	return start, end, nil
+}
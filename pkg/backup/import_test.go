@@ -0,0 +1,113 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewImportedBackup(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		tarball func(t *testing.T) *bytes.Buffer
+		wantErr string
+	}{
+		{
+			name: "tarball without a version file is rejected",
+			tarball: func(t *testing.T) *bytes.Buffer {
+				return test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).Done()
+			},
+			wantErr: "backup tarball has no metadata/version file",
+		},
+		{
+			name: "tarball of an unsupported format version is rejected",
+			tarball: func(t *testing.T) *bytes.Buffer {
+				return test.NewTarWriter(t).
+					Add("metadata/version", []byte("2.0.0\n")).
+					AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+					Done()
+			},
+			wantErr: `unsupported backup format version "2.0.0"`,
+		},
+		{
+			name: "tarball without resources is rejected",
+			tarball: func(t *testing.T) *bytes.Buffer {
+				return test.NewTarWriter(t).Add("metadata/version", []byte("1.1.0\n")).Done()
+			},
+			wantErr: "error parsing backup tarball",
+		},
+		{
+			name: "file that isn't a tarball is rejected",
+			tarball: func(t *testing.T) *bytes.Buffer {
+				return bytes.NewBufferString("not a tarball")
+			},
+			wantErr: "error extracting backup tarball",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, err := NewImportedBackup(bytes.NewReader(tc.tarball(t).Bytes()), "velero", "backup-1", "default", time.Hour, now, test.NewLogger())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+
+	tarball := test.NewTarWriter(t).
+		Add("metadata/version", []byte("1.1.0\n")).
+		AddItems("pods",
+			builder.ForPod("ns-2", "pod-2").Result(),
+			builder.ForPod("ns-1", "pod-1").Result(),
+		).
+		AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+		Done()
+
+	backup, manifest, resourceList, err := NewImportedBackup(bytes.NewReader(tarball.Bytes()), "velero", "backup-1", "default", time.Hour, now, test.NewLogger())
+	require.NoError(t, err)
+
+	assert.Equal(t, "velero", backup.Namespace)
+	assert.Equal(t, "backup-1", backup.Name)
+	assert.Equal(t, "default", backup.Spec.StorageLocation)
+	assert.Equal(t, []string{"ns-1", "ns-2"}, backup.Spec.IncludedNamespaces)
+	assert.Equal(t, time.Hour, backup.Spec.TTL.Duration)
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, backup.Status.Phase)
+	assert.Equal(t, "1.1.0", backup.Status.FormatVersion)
+	assert.Equal(t, 1, backup.Status.Version)
+	assert.Equal(t, velerov1api.CompressionAlgorithmGzip, backup.Status.Compression)
+	assert.Equal(t, now.Add(time.Hour), backup.Status.Expiration.Time)
+	assert.False(t, backup.Status.StartTimestamp.After(backup.Status.CompletionTimestamp.Time))
+	assert.Equal(t, &velerov1api.BackupProgress{TotalItems: 3, ItemsBackedUp: 3}, backup.Status.Progress)
+
+	assert.Len(t, manifest.Entries, 4)
+	assert.Equal(t, "metadata/version", manifest.Entries[0].Path)
+
+	assert.Equal(t, map[string][]string{
+		"v1/Pod":              {"ns-1/pod-1", "ns-2/pod-2"},
+		"v1/PersistentVolume": {"pv-1"},
+	}, resourceList)
+}
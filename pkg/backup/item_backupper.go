@@ -18,10 +18,12 @@ package backup
 
 import (
 	"archive/tar"
+	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -36,18 +38,24 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
-// itemBackupper can back up individual items to a tar writer.
+// itemBackupper can back up individual items to a tar writer. Items may be
+// backed up concurrently.
 type itemBackupper struct {
 	backupRequest           *Request
 	tarWriter               tarWriter
@@ -59,6 +67,29 @@ type itemBackupper struct {
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
+
+	// handledItems are the items handled by the item snapshots taken so far, which
+	// are excluded from the backup.
+	handledItems map[velero.ResourceIdentifier]struct{}
+
+	// dryRunReport is set when the backup is a dry run. Items are then only
+	// recorded in it instead of being backed up.
+	dryRunReport *DryRunReport
+
+	// reusedSnapshots are the provider IDs of the volume snapshots of the
+	// previous attempt of the backup that were reused.
+	reusedSnapshots map[string]struct{}
+
+	// lock guards the fields of the backup request that are updated as items are
+	// backed up, snapshotLocationVolumeSnapshotters, handledItems and
+	// reusedSnapshots.
+	lock sync.Mutex
+	// tarWriterLock serializes the writes to tarWriter, so that the header and the
+	// data of an item are written together.
+	tarWriterLock sync.Mutex
+	// podVolumesLock serializes picking the pod volumes to back up with restic, so
+	// that a persistent volume claim used by several pods is only backed up once.
+	podVolumesLock sync.Mutex
 }
 
 // backupItem backs up an individual item to tarWriter. The item may be excluded based on the
@@ -107,18 +138,26 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		return false, nil
 	}
 
+	if ib.isHandled(velero.ResourceIdentifier{GroupResource: groupResource, Namespace: namespace, Name: name}) {
+		log.Info("Excluding item because it's handled by the snapshot of another item")
+		return false, nil
+	}
+
 	key := itemKey{
 		resource:  resourceKey(obj),
 		namespace: namespace,
 		name:      name,
 	}
 
+	ib.lock.Lock()
 	if _, exists := ib.backupRequest.BackedUpItems[key]; exists {
+		ib.lock.Unlock()
 		log.Info("Skipping item because it's already been backed up.")
 		// returning true since this item *is* in the backup, even though we're not backing it up here
 		return true, nil
 	}
 	ib.backupRequest.BackedUpItems[key] = struct{}{}
+	ib.lock.Unlock()
 
 	log.Info("Backing up item")
 
@@ -141,25 +180,46 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			// nil it on error since it's not valid
 			pod = nil
 		} else {
-			// Get the list of volumes to back up using restic from the pod's annotations. Remove from this list
-			// any volumes that use a PVC that we've already backed up (this would be in a read-write-many scenario,
-			// where it's been backed up from another pod), since we don't need >1 backup per PVC.
-			for _, volume := range restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic)) {
+			// Get the list of volumes to back up using restic from the backup's volume policies and the pod's
+			// annotations. Remove from this list any volumes that use a PVC that we've already backed up (this
+			// would be in a read-write-many scenario, where it's been backed up from another pod), since we
+			// don't need >1 backup per PVC.
+			volumes, errs := ib.getPodVolumesUsingRestic(log, pod)
+			backupErrs = append(backupErrs, errs...)
+
+			ib.podVolumesLock.Lock()
+			for _, volume := range volumes {
 				if found, pvcName := ib.resticSnapshotTracker.HasPVCForPodVolume(pod, volume); found {
 					log.WithFields(map[string]interface{}{
 						"podVolume": volume,
 						"pvcName":   pvcName,
 					}).Info("Pod volume uses a persistent volume claim which has already been backed up with restic from another pod, skipping.")
+					ib.dryRunReport.addVolume(DryRunVolume{
+						Namespace: pod.Namespace,
+						Pod:       pod.Name,
+						Name:      volume,
+						Method:    DryRunVolumeMethodSkipped,
+						Reason:    fmt.Sprintf("persistent volume claim %s is backed up from another pod", pvcName),
+					})
 					continue
 				}
 
 				resticVolumesToBackup = append(resticVolumesToBackup, volume)
+				ib.dryRunReport.addVolume(DryRunVolume{
+					Namespace: pod.Namespace,
+					Pod:       pod.Name,
+					Name:      volume,
+					Method:    DryRunVolumeMethodPodVolumeBackup,
+					Uploader:  uploader.GetUploaderType(ib.backupRequest.Spec.UploaderType),
+					Location:  ib.backupRequest.Spec.StorageLocation,
+				})
 			}
 
 			// track the volumes that are PVCs using the PVC snapshot tracker, so that when we backup PVCs/PVs
 			// via an item action in the next step, we don't snapshot PVs that will have their data backed up
 			// with restic.
 			ib.resticSnapshotTracker.Track(pod, resticVolumesToBackup)
+			ib.podVolumesLock.Unlock()
 		}
 	}
 
@@ -169,6 +229,10 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	// Used on filepath to backup up all groups and versions
 	version := resourceVersion(obj)
 
+	if ib.dryRunReport != nil {
+		return ib.dryRunItem(log, obj, groupResource, backupErrs)
+	}
+
 	updatedObj, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata)
 	if err != nil {
 		backupErrs = append(backupErrs, err)
@@ -189,6 +253,19 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	name = metadata.GetName()
 	namespace = metadata.GetNamespace()
 
+	updatedObj, err = ib.snapshotItem(log, obj, groupResource, name, namespace, metadata)
+	if err != nil {
+		backupErrs = append(backupErrs, err)
+
+		log.Debug("Executing post hooks")
+		if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
+			backupErrs = append(backupErrs, err)
+		}
+
+		return false, kubeerrs.NewAggregate(backupErrs)
+	}
+	obj = updatedObj
+
 	if groupResource == kuberesource.PersistentVolumes {
 		if err := ib.takePVSnapshot(obj, log); err != nil {
 			backupErrs = append(backupErrs, err)
@@ -200,7 +277,9 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		// even if there are errors.
 		podVolumeBackups, errs := ib.backupPodVolumes(log, pod, resticVolumesToBackup)
 
+		ib.lock.Lock()
 		ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, podVolumeBackups...)
+		ib.lock.Unlock()
 		backupErrs = append(backupErrs, errs...)
 	}
 
@@ -237,21 +316,7 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		return false, errors.WithStack(err)
 	}
 
-	hdr := &tar.Header{
-		Name:     filePath,
-		Size:     int64(len(itemBytes)),
-		Typeflag: tar.TypeReg,
-		Mode:     0755,
-		ModTime:  time.Now(),
-	}
-
-	if err := ib.tarWriter.WriteHeader(hdr); err != nil {
-		return false, errors.WithStack(err)
-	}
-
-	if _, err := ib.tarWriter.Write(itemBytes); err != nil {
-		return false, errors.WithStack(err)
-	}
+	entries := []string{filePath}
 
 	// backing up the preferred version backup without API Group version on path -  this is for backward compatibility
 
@@ -262,8 +327,73 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		} else {
 			filePath = filepath.Join(velerov1api.ResourcesDir, groupResource.String(), velerov1api.ClusterScopedDir, name+".json")
 		}
+		entries = append(entries, filePath)
+	}
+
+	if err := ib.writeTarEntries(entries, itemBytes); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// dryRunItem goes through the rest of backing up an item in a dry run: the
+// additional items of pods and persistent volume claims are backed up, the
+// snapshot of persistent volumes is only reported, and the post hooks are
+// reported by the dry-run hook handler. Nothing is written to the tarball.
+func (ib *itemBackupper) dryRunItem(log logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, backupErrs []error) (bool, error) {
+	if err := ib.executeDryRunActions(log, obj, groupResource); err != nil {
+		backupErrs = append(backupErrs, err)
+	}
+
+	if groupResource == kuberesource.PersistentVolumes {
+		if err := ib.takePVSnapshot(obj, log); err != nil {
+			backupErrs = append(backupErrs, err)
+		}
+	}
+
+	log.Debug("Executing post hooks")
+	if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
+		backupErrs = append(backupErrs, err)
+	}
 
-		hdr = &tar.Header{
+	if len(backupErrs) != 0 {
+		return false, kubeerrs.NewAggregate(backupErrs)
+	}
+
+	return true, nil
+}
+
+// executeDryRunActions backs up the additional items returned by Velero's own
+// actions for pods and persistent volume claims, so that a dry run reports the
+// claims and volumes they use. The resolved actions aren't executed in a dry
+// run since plugins may have side effects, such as taking snapshots.
+func (ib *itemBackupper) executeDryRunActions(log logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource) error {
+	var action velero.BackupItemAction
+	switch groupResource {
+	case kuberesource.Pods:
+		action = NewPodAction(log)
+	case kuberesource.PersistentVolumeClaims:
+		action = NewPVCAction(log)
+	default:
+		return nil
+	}
+
+	_, additionalItemIdentifiers, err := action.Execute(obj, ib.backupRequest.Backup)
+	if err != nil {
+		return errors.Wrapf(err, "error executing action (groupResource=%s)", groupResource.String())
+	}
+
+	return ib.backupAdditionalItems(log, additionalItemIdentifiers)
+}
+
+// writeTarEntries writes the item's data to the tarball under each of the given file paths.
+func (ib *itemBackupper) writeTarEntries(filePaths []string, itemBytes []byte) error {
+	ib.tarWriterLock.Lock()
+	defer ib.tarWriterLock.Unlock()
+
+	for _, filePath := range filePaths {
+		hdr := &tar.Header{
 			Name:     filePath,
 			Size:     int64(len(itemBytes)),
 			Typeflag: tar.TypeReg,
@@ -272,15 +402,114 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		}
 
 		if err := ib.tarWriter.WriteHeader(hdr); err != nil {
-			return false, errors.WithStack(err)
+			return errors.WithStack(err)
 		}
 
 		if _, err := ib.tarWriter.Write(itemBytes); err != nil {
-			return false, errors.WithStack(err)
+			return errors.WithStack(err)
 		}
 	}
 
-	return true, nil
+	return nil
+}
+
+// backedUpItemsCount returns the number of items backed up so far.
+func (ib *itemBackupper) backedUpItemsCount() int {
+	ib.lock.Lock()
+	defer ib.lock.Unlock()
+
+	return len(ib.backupRequest.BackedUpItems)
+}
+
+// getPodVolumesUsingRestic returns the names of the pod's volumes to back up using restic. Volumes
+// matching one of the backup's volume policies are handled according to the policy's action, the
+// others according to the pod's annotations and the backup's DefaultVolumesToRestic setting.
+func (ib *itemBackupper) getPodVolumesUsingRestic(log logrus.FieldLogger, pod *corev1api.Pod) ([]string, []error) {
+	volumes := restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic))
+	if ib.backupRequest.ResPolicies == nil {
+		return volumes, nil
+	}
+
+	var (
+		defaultVolumes = sets.NewString(volumes...)
+		res            []string
+		errs           []error
+	)
+	for i := range pod.Spec.Volumes {
+		vol := &pod.Spec.Volumes[i]
+
+		action, err := ib.getPodVolumeAction(pod.Namespace, vol)
+		if err != nil {
+			// fall back to the default behavior for this volume
+			errs = append(errs, err)
+		}
+		if action == nil {
+			if defaultVolumes.Has(vol.Name) {
+				res = append(res, vol.Name)
+			}
+			continue
+		}
+
+		volLog := log.WithFields(logrus.Fields{"podVolume": vol.Name, "action": action.Type})
+		if action.Type != resourcepolicies.FSBackup {
+			volLog.Info("Pod volume matches a volume policy, not backing it up with restic")
+			continue
+		}
+		if vol.HostPath != nil {
+			volLog.Warn("Pod volume matches a volume policy, but hostPath volumes can't be backed up with restic, skipping")
+			continue
+		}
+		res = append(res, vol.Name)
+	}
+
+	return res, errs
+}
+
+// getPodVolumeAction returns the action of the backup's first volume policy matching the pod volume,
+// or nil if none matches. Volumes using a PVC are matched on the persistent volume bound to the claim.
+func (ib *itemBackupper) getPodVolumeAction(namespace string, vol *corev1api.Volume) (*resourcepolicies.Action, error) {
+	if vol.PersistentVolumeClaim == nil {
+		return ib.backupRequest.ResPolicies.GetMatchAction(vol)
+	}
+
+	pvcClient, err := ib.dynamicFactory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, metav1.APIResource{Name: kuberesource.PersistentVolumeClaims.Resource, Namespaced: true}, namespace)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	obj, err := pvcClient.Get(vol.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting persistent volume claim %s/%s", namespace, vol.PersistentVolumeClaim.ClaimName)
+	}
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// matching is done on the bound persistent volume, so unbound claims match no policy
+	if pvc.Spec.VolumeName == "" {
+		return nil, nil
+	}
+
+	pvClient, err := ib.dynamicFactory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, metav1.APIResource{Name: kuberesource.PersistentVolumes.Resource}, "")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	obj, err = pvClient.Get(pvc.Spec.VolumeName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+	}
+	pv := new(corev1api.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pv); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ib.backupRequest.ResPolicies.GetMatchAction(pv)
 }
 
 // backupPodVolumes triggers restic backups of the specified pod volumes, and returns a list of PodVolumeBackups
@@ -317,42 +546,191 @@ func (ib *itemBackupper) executeActions(
 		}
 		obj = updatedItem
 
-		for _, additionalItem := range additionalItemIdentifiers {
-			gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
-			if err != nil {
-				return nil, err
-			}
+		ib.trackItemOperation(log, action, velero.ResourceIdentifier{GroupResource: groupResource, Namespace: namespace, Name: name})
 
-			client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
-			if err != nil {
-				return nil, err
-			}
+		if err := ib.backupAdditionalItems(log, additionalItemIdentifiers); err != nil {
+			return nil, err
+		}
+	}
 
-			item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
-			if apierrors.IsNotFound(err) {
-				log.WithFields(logrus.Fields{
-					"groupResource": additionalItem.GroupResource,
-					"namespace":     additionalItem.Namespace,
-					"name":          additionalItem.Name,
-				}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
-				continue
-			}
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
+	return obj, nil
+}
 
-			if _, err = ib.backupItem(log, item, gvr.GroupResource(), gvr); err != nil {
-				return nil, err
-			}
+// trackItemOperation records the work the action left in progress for the item,
+// if any, so that the backup isn't completed until it's done.
+func (ib *itemBackupper) trackItemOperation(log logrus.FieldLogger, action framework.BackupItemResolvedAction, item velero.ResourceIdentifier) {
+	if _, ok := action.BackupItemAction.(velero.BackupItemActionProgressReporter); !ok {
+		return
+	}
+
+	operation := &itemoperation.BackupOperation{
+		Spec: itemoperation.BackupOperationSpec{
+			BackupName:         ib.backupRequest.Name,
+			BackupUID:          string(ib.backupRequest.UID),
+			BackupItemAction:   action.Name,
+			ResourceIdentifier: item,
+		},
+		Status: itemoperation.BackupOperationStatus{
+			Phase: velero.OperationPhaseInProgress,
+		},
+	}
+	updateItemOperationProgress(log.WithField("backupItemAction", action.Name), action.BackupItemAction, ib.backupRequest.Backup, operation)
+	if operation.Status.Phase == velero.OperationPhaseCompleted {
+		return
+	}
+
+	ib.lock.Lock()
+	ib.backupRequest.ItemOperations = append(ib.backupRequest.ItemOperations, operation)
+	ib.lock.Unlock()
+}
+
+// backupAdditionalItems backs up the items returned by a plugin as related to the
+// item being backed up.
+func (ib *itemBackupper) backupAdditionalItems(log logrus.FieldLogger, additionalItems []velero.ResourceIdentifier) error {
+	for _, additionalItem := range additionalItems {
+		gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
+		if err != nil {
+			return err
+		}
+
+		client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
+		if err != nil {
+			return err
+		}
+
+		item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			log.WithFields(logrus.Fields{
+				"groupResource": additionalItem.GroupResource,
+				"namespace":     additionalItem.Namespace,
+				"name":          additionalItem.Name,
+			}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
+			continue
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if _, err = ib.backupItem(log, item, gvr.GroupResource(), gvr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotItem snapshots the item with the first ItemSnapshotter that applies to it, if
+// any, and records the snapshot in the backup request. The items the snapshotter handles
+// along with the item are excluded from the backup, and the additional items it returns
+// are backed up. It returns the item to store in the backup.
+func (ib *itemBackupper) snapshotItem(
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	groupResource schema.GroupResource,
+	name, namespace string,
+	metadata metav1.Object,
+) (runtime.Unstructured, error) {
+	for _, snapshotter := range ib.backupRequest.ResolvedItemSnapshotters {
+		if !snapshotter.ShouldUse(groupResource, namespace, metadata, log) {
+			continue
+		}
+		log := log.WithField("itemSnapshotter", snapshotter.Name)
+
+		handledItems, err := snapshotter.AlsoHandles(&isv1.AlsoHandlesInput{
+			Item:   obj,
+			Backup: ib.backupRequest.Backup,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting items handled by item snapshotter %s (groupResource=%s, namespace=%s, name=%s)", snapshotter.Name, groupResource.String(), namespace, name)
+		}
+		ib.markHandled(handledItems)
+
+		log.Info("Snapshotting item")
+		output, err := snapshotter.SnapshotItem(context.Background(), &isv1.SnapshotItemInput{
+			Item:   obj,
+			Backup: ib.backupRequest.Backup,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error snapshotting item with item snapshotter %s (groupResource=%s, namespace=%s, name=%s)", snapshotter.Name, groupResource.String(), namespace, name)
 		}
+		ib.markHandled(output.HandledItems)
+
+		if output.UpdatedItem != nil {
+			obj = output.UpdatedItem
+		}
+
+		var location string
+		if ib.backupRequest.StorageLocation != nil {
+			location = ib.backupRequest.StorageLocation.Name
+		}
+		itemSnapshot := &volume.ItemSnapshot{
+			Spec: volume.ItemSnapshotSpec{
+				ItemSnapshotter: snapshotter.Name,
+				BackupName:      ib.backupRequest.Name,
+				BackupUID:       string(ib.backupRequest.UID),
+				Location:        location,
+				ResourceIdentifier: volume.ItemSnapshotResourceIdentifier(velero.ResourceIdentifier{
+					GroupResource: groupResource,
+					Namespace:     namespace,
+					Name:          name,
+				}),
+			},
+			Status: volume.ItemSnapshotStatus{
+				ProviderSnapshotID: output.SnapshotID,
+				Metadata:           output.SnapshotMetadata,
+				Phase:              isv1.SnapshotPhaseInProgress,
+			},
+		}
+		log.WithField("snapshotID", output.SnapshotID).Info("Snapshotted item")
+
+		ib.lock.Lock()
+		ib.backupRequest.ItemSnapshots = append(ib.backupRequest.ItemSnapshots, itemSnapshot)
+		ib.lock.Unlock()
+
+		if err := ib.backupAdditionalItems(log, output.AdditionalItems); err != nil {
+			return nil, err
+		}
+
+		// an item is only snapshotted once
+		break
 	}
 
 	return obj, nil
 }
 
+// markHandled records items handled by an item snapshot, so that they're excluded
+// from the backup.
+func (ib *itemBackupper) markHandled(items []velero.ResourceIdentifier) {
+	if len(items) == 0 {
+		return
+	}
+
+	ib.lock.Lock()
+	defer ib.lock.Unlock()
+
+	if ib.handledItems == nil {
+		ib.handledItems = make(map[velero.ResourceIdentifier]struct{})
+	}
+	for _, item := range items {
+		ib.handledItems[item] = struct{}{}
+	}
+}
+
+// isHandled returns whether the item is handled by an item snapshot.
+func (ib *itemBackupper) isHandled(item velero.ResourceIdentifier) bool {
+	ib.lock.Lock()
+	defer ib.lock.Unlock()
+
+	_, handled := ib.handledItems[item]
+	return handled
+}
+
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeSnapshotLocation) (velero.VolumeSnapshotter, error) {
+	ib.lock.Lock()
+	defer ib.lock.Unlock()
+
 	if bs, ok := ib.snapshotLocationVolumeSnapshotters[snapshotLocation.Name]; ok {
 		return bs, nil
 	}
@@ -397,6 +775,9 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	if boolptr.IsSetToFalse(ib.backupRequest.Spec.SnapshotVolumes) {
 		log.Info("Backup has volume snapshots disabled; skipping volume snapshot action.")
+		if metadata, err := meta.Accessor(obj); err == nil {
+			ib.dryRunSkipPV(metadata.GetName(), "volume snapshots are disabled")
+		}
 		return nil
 	}
 
@@ -412,6 +793,21 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	if pv.Spec.ClaimRef != nil {
 		if ib.resticSnapshotTracker.Has(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name) {
 			log.Info("Skipping snapshot of persistent volume because volume is being backed up with restic.")
+			ib.dryRunSkipPV(pv.Name, "backed up with a pod volume backup")
+			return nil
+		}
+	}
+
+	// Only take a snapshot if the PV doesn't match any of the backup's volume policies, or
+	// matches one whose action is to snapshot it.
+	if ib.backupRequest.ResPolicies != nil {
+		action, err := ib.backupRequest.ResPolicies.GetMatchAction(pv)
+		if err != nil {
+			return err
+		}
+		if action != nil && action.Type != resourcepolicies.Snapshot {
+			log.Infof("Skipping snapshot of persistent volume because it matches a volume policy with action %q.", action.Type)
+			ib.dryRunSkipPV(pv.Name, fmt.Sprintf("matches a volume policy with action %q", action.Type))
 			return nil
 		}
 	}
@@ -419,6 +815,7 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	// #4758 Do not take snapshot for CSI PV to avoid duplicated snapshotting, when CSI feature is enabled.
 	if features.IsEnabled(velerov1api.CSIFeatureFlag) && pv.Spec.CSI != nil {
 		log.Infof("Skipping snapshot of persistent volume %s, because it's handled by CSI plugin.", pv.Name)
+		ib.dryRunSkipPV(pv.Name, "handled by the CSI plugin")
 		return nil
 	}
 
@@ -471,11 +868,30 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	if volumeSnapshotter == nil {
 		log.Info("Persistent volume is not a supported volume type for snapshots, skipping.")
+		ib.dryRunSkipPV(pv.Name, "not supported by the volume snapshot locations")
 		return nil
 	}
 
 	log = log.WithField("volumeID", volumeID)
 
+	if ib.dryRunReport != nil {
+		log.Info("Dry run, not snapshotting persistent volume")
+		ib.dryRunReport.addVolume(DryRunVolume{
+			Name:     pv.Name,
+			Method:   DryRunVolumeMethodSnapshot,
+			Location: location,
+		})
+		return nil
+	}
+
+	if previous := ib.previousVolumeSnapshot(pv.Name, location, volumeID); previous != nil {
+		log.Warnf("Reusing volume snapshot %s taken by attempt %d of the backup, so the volume's data is older than the resources backed up by this attempt", previous.Status.ProviderSnapshotID, ib.backupRequest.ResumeFrom.Attempt)
+		ib.lock.Lock()
+		ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, previous)
+		ib.lock.Unlock()
+		return nil
+	}
+
 	// create tags from the backup's labels
 	tags := map[string]string{}
 	for k, v := range ib.backupRequest.GetLabels() {
@@ -499,10 +915,15 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
 		snapshot.Status.Phase = volume.SnapshotPhaseFailed
 	} else {
-		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
+		// the snapshot may not be usable yet, e.g. if its provider still copies the
+		// volume's data, in which case the backup waits for it to be uploaded.
+		snapshot.Status.Phase = volume.SnapshotPhaseUploading
 		snapshot.Status.ProviderSnapshotID = snapshotID
+		updateVolumeSnapshotProgress(log.WithField("snapshotID", snapshotID), volumeSnapshotter, snapshot)
 	}
+	ib.lock.Lock()
 	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	ib.lock.Unlock()
 
 	// nil errors are automatically removed
 	return kubeerrs.NewAggregate(errs)
@@ -572,3 +993,12 @@ func zoneFromPVNodeAffinity(res *corev1api.PersistentVolume, topologyKeys ...str
 
 	return "", ""
 }
+
+// dryRunSkipPV reports that a dry run wouldn't snapshot a persistent volume.
+func (ib *itemBackupper) dryRunSkipPV(name, reason string) {
+	ib.dryRunReport.addVolume(DryRunVolume{
+		Name:   name,
+		Method: DryRunVolumeMethodSkipped,
+		Reason: reason,
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"path"
+
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupItemActionGetter gets backup item action plugins by name.
+type BackupItemActionGetter interface {
+	GetBackupItemAction(name string) (velero.BackupItemAction, error)
+}
+
+// UpdateItemOperationsProgress asks the backup item actions for the progress of
+// the backup's item operations that are still in progress and records the phase
+// of the ones that have completed or failed. Failed operations are logged as
+// errors. Errors getting the progress of an operation are logged as warnings and
+// the operation is checked again later. It returns the number of item
+// operations that are still in progress.
+func UpdateItemOperationsProgress(log logrus.FieldLogger, backup *velerov1api.Backup, operations []*itemoperation.BackupOperation, getter BackupItemActionGetter) int {
+	inProgress := 0
+	for _, operation := range operations {
+		if operation.Status.Phase != velero.OperationPhaseInProgress {
+			continue
+		}
+
+		log := log.WithFields(logrus.Fields{
+			"backupItemAction": operation.Spec.BackupItemAction,
+			"item":             itemOperationResource(operation),
+		})
+
+		action, err := getter.GetBackupItemAction(operation.Spec.BackupItemAction)
+		if err != nil {
+			log.WithError(err).Error("Error getting backup item action of item operation")
+			operation.Status.Phase = velero.OperationPhaseFailed
+			operation.Status.Error = err.Error()
+			continue
+		}
+
+		if updateItemOperationProgress(log, action, backup, operation) {
+			inProgress++
+		}
+	}
+
+	return inProgress
+}
+
+// updateItemOperationProgress records the phase of an item operation that's in
+// progress if it has completed or failed, and returns whether it's still in
+// progress. The work of backup item actions that don't report their progress
+// is completed.
+func updateItemOperationProgress(log logrus.FieldLogger, action velero.BackupItemAction, backup *velerov1api.Backup, operation *itemoperation.BackupOperation) bool {
+	reporter, ok := action.(velero.BackupItemActionProgressReporter)
+	if !ok {
+		operation.Status.Phase = velero.OperationPhaseCompleted
+		return false
+	}
+
+	progress, err := reporter.Progress(operation.Spec.ResourceIdentifier, backup)
+	if err != nil {
+		log.WithError(err).Warn("Error getting progress of item operation, checking it again later")
+		return true
+	}
+
+	switch progress.Phase {
+	case velero.OperationPhaseCompleted:
+		log.Info("Item operation completed")
+		operation.Status.Phase = velero.OperationPhaseCompleted
+		return false
+	case velero.OperationPhaseFailed:
+		log.Errorf("Item operation failed: %s", progress.Err)
+		operation.Status.Phase = velero.OperationPhaseFailed
+		operation.Status.Error = progress.Err
+		return false
+	default:
+		log.Debug("Item operation in progress")
+		return true
+	}
+}
+
+// itemOperationResource returns the item of an item operation as it's logged.
+func itemOperationResource(operation *itemoperation.BackupOperation) string {
+	id := operation.Spec.ResourceIdentifier
+	return path.Join(id.GroupResource.String(), id.Namespace, id.Name)
+}
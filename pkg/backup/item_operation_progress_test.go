@@ -0,0 +1,137 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeBackupItemActionGetter map[string]velero.BackupItemAction
+
+func (g fakeBackupItemActionGetter) GetBackupItemAction(name string) (velero.BackupItemAction, error) {
+	action, ok := g[name]
+	if !ok {
+		return nil, errors.Errorf("backup item action %s not found", name)
+	}
+	return action, nil
+}
+
+// progressBackupItemAction is a recordResourcesAction that reports the progress
+// of its item operations.
+type progressBackupItemAction struct {
+	recordResourcesAction
+
+	phase       velero.OperationPhase
+	progressErr bool
+}
+
+func (a *progressBackupItemAction) Progress(item velero.ResourceIdentifier, backup *velerov1.Backup) (velero.OperationProgress, error) {
+	if a.progressErr {
+		return velero.OperationProgress{}, errors.New("error getting progress")
+	}
+	return velero.OperationProgress{Phase: a.phase, Err: "operation error"}, nil
+}
+
+func TestUpdateItemOperationsProgress(t *testing.T) {
+	operation := func(action string, phase velero.OperationPhase) *itemoperation.BackupOperation {
+		return &itemoperation.BackupOperation{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupItemAction:   action,
+				ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"},
+			},
+			Status: itemoperation.BackupOperationStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		operations     []*itemoperation.BackupOperation
+		action         velero.BackupItemAction
+		wantPhases     []velero.OperationPhase
+		wantInProgress int
+	}{
+		{
+			name:           "completed operations are recorded as completed",
+			operations:     []*itemoperation.BackupOperation{operation("velero.io/fake", velero.OperationPhaseInProgress)},
+			action:         &progressBackupItemAction{phase: velero.OperationPhaseCompleted},
+			wantPhases:     []velero.OperationPhase{velero.OperationPhaseCompleted},
+			wantInProgress: 0,
+		},
+		{
+			name:           "failed operations are recorded as failed",
+			operations:     []*itemoperation.BackupOperation{operation("velero.io/fake", velero.OperationPhaseInProgress)},
+			action:         &progressBackupItemAction{phase: velero.OperationPhaseFailed},
+			wantPhases:     []velero.OperationPhase{velero.OperationPhaseFailed},
+			wantInProgress: 0,
+		},
+		{
+			name:           "operations still in progress are counted",
+			operations:     []*itemoperation.BackupOperation{operation("velero.io/fake", velero.OperationPhaseInProgress)},
+			action:         &progressBackupItemAction{phase: velero.OperationPhaseInProgress},
+			wantPhases:     []velero.OperationPhase{velero.OperationPhaseInProgress},
+			wantInProgress: 1,
+		},
+		{
+			name:           "operations whose progress can't be gotten are left in progress",
+			operations:     []*itemoperation.BackupOperation{operation("velero.io/fake", velero.OperationPhaseInProgress)},
+			action:         &progressBackupItemAction{progressErr: true},
+			wantPhases:     []velero.OperationPhase{velero.OperationPhaseInProgress},
+			wantInProgress: 1,
+		},
+		{
+			name:           "operations of actions that don't report progress are completed",
+			operations:     []*itemoperation.BackupOperation{operation("velero.io/fake", velero.OperationPhaseInProgress)},
+			action:         new(recordResourcesAction),
+			wantPhases:     []velero.OperationPhase{velero.OperationPhaseCompleted},
+			wantInProgress: 0,
+		},
+		{
+			name:           "operations of an unknown action are failed",
+			operations:     []*itemoperation.BackupOperation{operation("velero.io/unknown", velero.OperationPhaseInProgress)},
+			action:         &progressBackupItemAction{phase: velero.OperationPhaseInProgress},
+			wantPhases:     []velero.OperationPhase{velero.OperationPhaseFailed},
+			wantInProgress: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := fakeBackupItemActionGetter{"velero.io/fake": tc.action}
+
+			inProgress := UpdateItemOperationsProgress(velerotest.NewLogger(), builder.ForBackup("velero", "backup-1").Result(), tc.operations, getter)
+
+			assert.Equal(t, tc.wantInProgress, inProgress)
+			var phases []velero.OperationPhase
+			for _, operation := range tc.operations {
+				phases = append(phases, operation.Status.Phase)
+			}
+			assert.Equal(t, tc.wantPhases, phases)
+		})
+	}
+}
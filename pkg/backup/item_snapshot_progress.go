@@ -0,0 +1,91 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// ItemSnapshotterGetter gets item snapshotter plugins by name.
+type ItemSnapshotterGetter interface {
+	GetItemSnapshotter(name string) (isv1.ItemSnapshotter, error)
+}
+
+// UpdateItemSnapshotsProgress asks the item snapshotters for the progress of the
+// backup's item snapshots that are still in progress and records the phase of
+// the ones that have completed or failed. Failed snapshots are logged as errors.
+// Errors getting the progress of a snapshot, e.g. because its plugin was
+// restarted, are logged as warnings and the snapshot is left in progress to be
+// checked again. It returns the number of item snapshots that are still in
+// progress.
+func UpdateItemSnapshotsProgress(log logrus.FieldLogger, backup *velerov1api.Backup, itemSnapshots []*volume.ItemSnapshot, getter ItemSnapshotterGetter) int {
+	inProgress := 0
+	for _, itemSnapshot := range itemSnapshots {
+		if itemSnapshot.Status.Phase != isv1.SnapshotPhaseInProgress {
+			continue
+		}
+
+		log := log.WithFields(logrus.Fields{
+			"itemSnapshotter": itemSnapshot.Spec.ItemSnapshotter,
+			"item":            itemSnapshot.Spec.ResourceIdentifier,
+			"snapshotID":      itemSnapshot.Status.ProviderSnapshotID,
+		})
+
+		snapshotter, err := getter.GetItemSnapshotter(itemSnapshot.Spec.ItemSnapshotter)
+		if err != nil {
+			log.WithError(err).Error("Error getting item snapshotter of item snapshot")
+			itemSnapshot.Status.Phase = isv1.SnapshotPhaseFailed
+			continue
+		}
+
+		itemID, err := volume.ParseItemSnapshotResourceIdentifier(itemSnapshot.Spec.ResourceIdentifier)
+		if err != nil {
+			log.WithError(err).Error("Error getting item of item snapshot")
+			itemSnapshot.Status.Phase = isv1.SnapshotPhaseFailed
+			continue
+		}
+
+		progress, err := snapshotter.Progress(&isv1.ProgressInput{
+			ItemID:     itemID,
+			SnapshotID: itemSnapshot.Status.ProviderSnapshotID,
+			Backup:     backup,
+		})
+		if err != nil {
+			log.WithError(err).Warn("Error getting progress of item snapshot, checking it again later")
+			inProgress++
+			continue
+		}
+
+		switch progress.Phase {
+		case isv1.SnapshotPhaseCompleted:
+			log.Info("Item snapshot completed")
+			itemSnapshot.Status.Phase = isv1.SnapshotPhaseCompleted
+		case isv1.SnapshotPhaseFailed:
+			log.Errorf("Item snapshot failed: %s", progress.Err)
+			itemSnapshot.Status.Phase = isv1.SnapshotPhaseFailed
+		default:
+			log.Debugf("Item snapshot in progress, %d of %d items completed", progress.ItemsCompleted, progress.ItemsToComplete)
+			inProgress++
+		}
+	}
+
+	return inProgress
+}
@@ -0,0 +1,126 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+type fakeItemSnapshotterGetter map[string]isv1.ItemSnapshotter
+
+func (g fakeItemSnapshotterGetter) GetItemSnapshotter(name string) (isv1.ItemSnapshotter, error) {
+	snapshotter, ok := g[name]
+	if !ok {
+		return nil, errors.Errorf("item snapshotter %s not found", name)
+	}
+	return snapshotter, nil
+}
+
+func TestUpdateItemSnapshotsProgress(t *testing.T) {
+	itemSnapshot := func(snapshotter string, phase isv1.SnapshotPhase) *volume.ItemSnapshot {
+		return &volume.ItemSnapshot{
+			Spec: volume.ItemSnapshotSpec{
+				ItemSnapshotter:    snapshotter,
+				ResourceIdentifier: "pods/ns-1/pod-1",
+			},
+			Status: volume.ItemSnapshotStatus{
+				ProviderSnapshotID: "pod-1-snapshot",
+				Phase:              phase,
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		itemSnapshots  []*volume.ItemSnapshot
+		progressPhase  isv1.SnapshotPhase
+		progressErr    bool
+		wantPhases     []isv1.SnapshotPhase
+		wantInProgress int
+	}{
+		{
+			name:           "completed snapshots are recorded as completed",
+			itemSnapshots:  []*volume.ItemSnapshot{itemSnapshot("velero.io/fake", isv1.SnapshotPhaseInProgress)},
+			progressPhase:  isv1.SnapshotPhaseCompleted,
+			wantPhases:     []isv1.SnapshotPhase{isv1.SnapshotPhaseCompleted},
+			wantInProgress: 0,
+		},
+		{
+			name:           "failed snapshots are recorded as failed",
+			itemSnapshots:  []*volume.ItemSnapshot{itemSnapshot("velero.io/fake", isv1.SnapshotPhaseInProgress)},
+			progressPhase:  isv1.SnapshotPhaseFailed,
+			wantPhases:     []isv1.SnapshotPhase{isv1.SnapshotPhaseFailed},
+			wantInProgress: 0,
+		},
+		{
+			name:           "snapshots still in progress are counted",
+			itemSnapshots:  []*volume.ItemSnapshot{itemSnapshot("velero.io/fake", isv1.SnapshotPhaseInProgress)},
+			progressPhase:  isv1.SnapshotPhaseInProgress,
+			wantPhases:     []isv1.SnapshotPhase{isv1.SnapshotPhaseInProgress},
+			wantInProgress: 1,
+		},
+		{
+			name: "snapshots that are no longer in progress are not checked again",
+			itemSnapshots: []*volume.ItemSnapshot{
+				itemSnapshot("velero.io/fake", isv1.SnapshotPhaseCompleted),
+				itemSnapshot("velero.io/fake", isv1.SnapshotPhaseFailed),
+			},
+			progressPhase:  isv1.SnapshotPhaseInProgress,
+			wantPhases:     []isv1.SnapshotPhase{isv1.SnapshotPhaseCompleted, isv1.SnapshotPhaseFailed},
+			wantInProgress: 0,
+		},
+		{
+			name:           "snapshots whose progress can't be gotten are left in progress",
+			itemSnapshots:  []*volume.ItemSnapshot{itemSnapshot("velero.io/fake", isv1.SnapshotPhaseInProgress)},
+			progressErr:    true,
+			wantPhases:     []isv1.SnapshotPhase{isv1.SnapshotPhaseInProgress},
+			wantInProgress: 1,
+		},
+		{
+			name:           "snapshots of an unknown item snapshotter are failed",
+			itemSnapshots:  []*volume.ItemSnapshot{itemSnapshot("velero.io/unknown", isv1.SnapshotPhaseInProgress)},
+			progressPhase:  isv1.SnapshotPhaseInProgress,
+			wantPhases:     []isv1.SnapshotPhase{isv1.SnapshotPhaseFailed},
+			wantInProgress: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := fakeItemSnapshotterGetter{
+				"velero.io/fake": &fakeItemSnapshotter{phase: tc.progressPhase, progressErr: tc.progressErr},
+			}
+
+			inProgress := UpdateItemSnapshotsProgress(velerotest.NewLogger(), builder.ForBackup("velero", "backup-1").Result(), tc.itemSnapshots, getter)
+
+			assert.Equal(t, tc.wantInProgress, inProgress)
+			var phases []isv1.SnapshotPhase
+			for _, itemSnapshot := range tc.itemSnapshots {
+				phases = append(phases, itemSnapshot.Status.Phase)
+			}
+			assert.Equal(t, tc.wantPhases, phases)
+		})
+	}
+}
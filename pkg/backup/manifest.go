@@ -0,0 +1,208 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
+)
+
+// ManifestEntry is the record of a file of the backup tarball in the backup's
+// manifest.
+type ManifestEntry struct {
+	// Path is the path of the file in the tarball.
+	Path string `json:"path"`
+
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+
+	// SHA256 is the hex-encoded SHA-256 digest of the file's contents.
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest lists the files of a backup tarball with their checksums, so that
+// the tarball can be checked for corruption before it's restored.
+type Manifest struct {
+	Entries []ManifestEntry `json:"entries"`
+}
+
+// manifestTarWriter is a tarWriter that records the files written to the
+// tarball in a manifest. Writes must be serialized by the caller.
+type manifestTarWriter struct {
+	tarWriter
+
+	entries []ManifestEntry
+	hash    hash.Hash
+}
+
+func newManifestTarWriter(tw tarWriter) *manifestTarWriter {
+	return &manifestTarWriter{tarWriter: tw}
+}
+
+func (w *manifestTarWriter) WriteHeader(hdr *tar.Header) error {
+	if err := w.tarWriter.WriteHeader(hdr); err != nil {
+		return err
+	}
+
+	w.finishEntry()
+	w.entries = append(w.entries, ManifestEntry{Path: hdr.Name})
+	w.hash = sha256.New()
+
+	return nil
+}
+
+func (w *manifestTarWriter) Write(p []byte) (int, error) {
+	n, err := w.tarWriter.Write(p)
+	if w.hash != nil {
+		w.hash.Write(p[:n])
+		w.entries[len(w.entries)-1].Size += int64(n)
+	}
+	return n, err
+}
+
+// finishEntry records the checksum of the file being written, if any.
+func (w *manifestTarWriter) finishEntry() {
+	if w.hash == nil {
+		return
+	}
+	w.entries[len(w.entries)-1].SHA256 = hex.EncodeToString(w.hash.Sum(nil))
+	w.hash = nil
+}
+
+// manifest returns the manifest of the files written so far, sorted by path.
+func (w *manifestTarWriter) manifest() *Manifest {
+	w.finishEntry()
+
+	entries := make([]ManifestEntry, len(w.entries))
+	copy(entries, w.entries)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+
+	return &Manifest{Entries: entries}
+}
+
+// VerifyContents checks the compressed backup tarball read from contents
+// against the backup's manifest. It returns a description of every file that's
+// missing, unexpected or doesn't match its checksum, or an error if the tarball
+// can't be read, which usually means it's corrupt as well.
+func VerifyContents(contents io.Reader, manifest *Manifest) ([]string, error) {
+	expected := make(map[string]ManifestEntry, len(manifest.Entries))
+	for _, entry := range manifest.Entries {
+		expected[entry.Path] = entry
+	}
+
+	r, err := compression.NewReader(contents)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading backup tarball")
+	}
+	defer r.Close()
+
+	var problems []string
+	seen := map[string]bool{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return problems, errors.Wrap(err, "error reading backup tarball")
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		h := sha256.New()
+		size, err := io.Copy(h, tr)
+		if err != nil {
+			return problems, errors.Wrapf(err, "error reading %s from backup tarball", hdr.Name)
+		}
+		seen[hdr.Name] = true
+
+		entry, ok := expected[hdr.Name]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s: not in the manifest", hdr.Name))
+		case entry.Size != size:
+			problems = append(problems, fmt.Sprintf("%s: size is %d, expected %d", hdr.Name, size, entry.Size))
+		case entry.SHA256 != hex.EncodeToString(h.Sum(nil)):
+			problems = append(problems, fmt.Sprintf("%s: checksum doesn't match", hdr.Name))
+		}
+	}
+
+	for _, entry := range manifest.Entries {
+		if !seen[entry.Path] {
+			problems = append(problems, fmt.Sprintf("%s: missing from the backup tarball", entry.Path))
+		}
+	}
+
+	return problems, nil
+}
+
+// NewManifest reads the compressed backup tarball from contents and returns its
+// manifest. It's used for tarballs that don't come with one, such as backups
+// imported from a local file.
+func NewManifest(contents io.Reader) (*Manifest, error) {
+	r, err := compression.NewReader(contents)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading backup tarball")
+	}
+	defer r.Close()
+
+	var entries []ManifestEntry
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading backup tarball")
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		h := sha256.New()
+		size, err := io.Copy(h, tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s from backup tarball", hdr.Name)
+		}
+
+		entries = append(entries, ManifestEntry{
+			Path:   hdr.Name,
+			Size:   size,
+			SHA256: hex.EncodeToString(h.Sum(nil)),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+
+	return &Manifest{Entries: entries}, nil
+}
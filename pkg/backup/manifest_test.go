@@ -0,0 +1,159 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTarball writes the given files, in order, to a gzip-compressed tarball
+// through a manifestTarWriter and returns the tarball and its manifest.
+func writeTarball(t *testing.T, files [][2]string) (*bytes.Buffer, *Manifest) {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	mtw := newManifestTarWriter(tw)
+
+	for _, file := range files {
+		require.NoError(t, mtw.WriteHeader(&tar.Header{
+			Name:     file[0],
+			Size:     int64(len(file[1])),
+			Typeflag: tar.TypeReg,
+			Mode:     0755,
+		}))
+		_, err := mtw.Write([]byte(file[1]))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf, mtw.manifest()
+}
+
+func TestManifestTarWriter(t *testing.T) {
+	_, manifest := writeTarball(t, [][2]string{
+		{"resources/pods/namespaces/ns-1/pod-1.json", "pod-1"},
+		{"metadata/version", "1.1.0\n"},
+	})
+
+	assert.Equal(t, &Manifest{
+		Entries: []ManifestEntry{
+			{
+				Path:   "metadata/version",
+				Size:   6,
+				SHA256: "1575e1af4a95f12f70b4ee6a6adce8160953d93ea17dc2611b90883ccc3ad3b8",
+			},
+			{
+				Path:   "resources/pods/namespaces/ns-1/pod-1.json",
+				Size:   5,
+				SHA256: "0f066824e0c3c4bd6d80f4c182769fa06e5da9ef0e1f44fcf590bb916f3e408f",
+			},
+		},
+	}, manifest)
+}
+
+func TestVerifyContents(t *testing.T) {
+	files := [][2]string{
+		{"metadata/version", "1.1.0\n"},
+		{"resources/pods/namespaces/ns-1/pod-1.json", "pod-1"},
+		{"resources/pods/namespaces/ns-1/pod-2.json", "pod-2"},
+	}
+	_, manifest := writeTarball(t, files)
+
+	tests := []struct {
+		name         string
+		files        [][2]string
+		wantProblems []string
+	}{
+		{
+			name:  "identical tarball is verified",
+			files: files,
+		},
+		{
+			name: "changed, missing and unexpected files are reported",
+			files: [][2]string{
+				{"metadata/version", "1.1.0\n"},
+				{"resources/pods/namespaces/ns-1/pod-1.json", "pod-X"},
+				{"resources/pods/namespaces/ns-1/pod-3.json", "pod-3"},
+			},
+			wantProblems: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json: checksum doesn't match",
+				"resources/pods/namespaces/ns-1/pod-3.json: not in the manifest",
+				"resources/pods/namespaces/ns-1/pod-2.json: missing from the backup tarball",
+			},
+		},
+		{
+			name: "file of a different size is reported",
+			files: [][2]string{
+				{"metadata/version", "1.1.0\n"},
+				{"resources/pods/namespaces/ns-1/pod-1.json", "pod-10"},
+				{"resources/pods/namespaces/ns-1/pod-2.json", "pod-2"},
+			},
+			wantProblems: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json: size is 6, expected 5",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			contents, _ := writeTarball(t, tc.files)
+
+			problems, err := VerifyContents(contents, manifest)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantProblems, problems)
+		})
+	}
+}
+
+func TestVerifyContentsOfCorruptTarball(t *testing.T) {
+	contents, manifest := writeTarball(t, [][2]string{
+		{"metadata/version", "1.1.0\n"},
+	})
+
+	// truncate the tarball
+	corrupt := bytes.NewReader(contents.Bytes()[:contents.Len()/2])
+
+	_, err := VerifyContents(corrupt, manifest)
+	assert.Error(t, err)
+}
+
+func TestNewManifest(t *testing.T) {
+	contents, manifest := writeTarball(t, [][2]string{
+		{"resources/pods/namespaces/ns-1/pod-1.json", "pod-1"},
+		{"metadata/version", "1.1.0\n"},
+	})
+
+	data := contents.Bytes()
+
+	got, err := NewManifest(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, manifest, got)
+
+	// truncate the tarball
+	_, err = NewManifest(bytes.NewReader(data[:len(data)/2]))
+	assert.Error(t, err)
+}
@@ -81,3 +81,24 @@ func (_m *ItemAction) Execute(item runtime.Unstructured, _a1 *v1.Backup) (runtim
 
 	return r0, r1, r2
 }
+
+// Progress provides a mock function with given fields: item, _a1
+func (_m *ItemAction) Progress(item velero.ResourceIdentifier, _a1 *v1.Backup) (velero.OperationProgress, error) {
+	ret := _m.Called(item, _a1)
+
+	var r0 velero.OperationProgress
+	if rf, ok := ret.Get(0).(func(velero.ResourceIdentifier, *v1.Backup) velero.OperationProgress); ok {
+		r0 = rf(item, _a1)
+	} else {
+		r0 = ret.Get(0).(velero.OperationProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(velero.ResourceIdentifier, *v1.Backup) error); ok {
+		r1 = rf(item, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -17,13 +17,16 @@ limitations under the License.
 package backup
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -40,6 +43,17 @@ type itemKey struct {
 type Request struct {
 	*velerov1api.Backup
 
+	// Context is canceled when the backup's cancellation is requested. A nil
+	// Context never is.
+	Context context.Context
+
+	// ResumeFrom is the checkpoint saved by the previous attempt of the backup
+	// if it was interrupted. The work it records is reused where possible.
+	ResumeFrom *Checkpoint
+	// CheckpointFunc, if set, is called periodically to save a checkpoint of
+	// the backup's progress.
+	CheckpointFunc CheckpointFunc
+
 	StorageLocation           *velerov1api.BackupStorageLocation
 	SnapshotLocations         []*velerov1api.VolumeSnapshotLocation
 	NamespaceIncludesExcludes *collections.IncludesExcludes
@@ -49,8 +63,36 @@ type Request struct {
 	ResolvedItemSnapshotters  []framework.ItemSnapshotterResolvedAction
 	VolumeSnapshots           []*volume.Snapshot
 	PodVolumeBackups          []*velerov1api.PodVolumeBackup
+	ItemSnapshots             []*volume.ItemSnapshot
+	ItemOperations            []*itemoperation.BackupOperation
 	BackedUpItems             map[itemKey]struct{}
 	CSISnapshots              []*snapshotv1api.VolumeSnapshot
+	ResPolicies               *resourcepolicies.ResourcePolicies
+	Manifest                  *Manifest
+	DryRunReport              *DryRunReport
+	HookExecutions            *hook.ExecutionRecorder
+}
+
+// runContext returns the request's context, or the background context if it
+// doesn't have one.
+func (r *Request) runContext() context.Context {
+	if r.Context == nil {
+		return context.Background()
+	}
+	return r.Context
+}
+
+// CompressionConfig returns the configuration the backup tarball is compressed
+// with: the backup's own if it has one, otherwise its storage location's.
+func (r *Request) CompressionConfig() velerov1api.CompressionConfig {
+	switch {
+	case r.Spec.Compression != nil:
+		return *r.Spec.Compression
+	case r.StorageLocation != nil && r.StorageLocation.Spec.Compression != nil:
+		return *r.StorageLocation.Spec.Compression
+	default:
+		return velerov1api.CompressionConfig{}
+	}
 }
 
 // BackupResourceList returns the list of backed up resources grouped by the API
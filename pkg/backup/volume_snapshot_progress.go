@@ -0,0 +1,111 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// UpdateVolumeSnapshotsProgress asks the volume snapshotters for the progress of
+// the volume snapshots whose data is still being uploaded and records the phase
+// of the ones that have completed or failed. Failed snapshots are logged as
+// errors. Errors getting the progress of a snapshot are logged as warnings and
+// the snapshot is checked again later. It returns the number of volume
+// snapshots that are still uploading.
+func UpdateVolumeSnapshotsProgress(log logrus.FieldLogger, snapshots []*volume.Snapshot, locations []*velerov1api.VolumeSnapshotLocation, getter VolumeSnapshotterGetter) int {
+	locationsByName := make(map[string]*velerov1api.VolumeSnapshotLocation)
+	for _, location := range locations {
+		locationsByName[location.Name] = location
+	}
+	snapshotters := make(map[string]velero.VolumeSnapshotter)
+
+	inProgress := 0
+	for _, snapshot := range snapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseUploading {
+			continue
+		}
+
+		log := log.WithFields(logrus.Fields{
+			"persistentVolume":       snapshot.Spec.PersistentVolumeName,
+			"volumeSnapshotLocation": snapshot.Spec.Location,
+			"snapshotID":             snapshot.Status.ProviderSnapshotID,
+		})
+
+		snapshotter, ok := snapshotters[snapshot.Spec.Location]
+		if !ok {
+			location, found := locationsByName[snapshot.Spec.Location]
+			if !found {
+				log.Error("Volume snapshot location of volume snapshot not found")
+				snapshot.Status.Phase = volume.SnapshotPhaseFailed
+				continue
+			}
+
+			var err error
+			if snapshotter, err = getter.GetVolumeSnapshotter(location.Spec.Provider); err == nil {
+				err = snapshotter.Init(location.Spec.Config)
+			}
+			if err != nil {
+				log.WithError(err).Error("Error getting volume snapshotter of volume snapshot")
+				snapshot.Status.Phase = volume.SnapshotPhaseFailed
+				continue
+			}
+			snapshotters[snapshot.Spec.Location] = snapshotter
+		}
+
+		if updateVolumeSnapshotProgress(log, snapshotter, snapshot) {
+			inProgress++
+		}
+	}
+
+	return inProgress
+}
+
+// updateVolumeSnapshotProgress records the phase of a volume snapshot whose data
+// is uploading if it has completed or failed, and returns whether it's still
+// uploading. The snapshots of volume snapshotters that don't report their
+// progress are completed.
+func updateVolumeSnapshotProgress(log logrus.FieldLogger, snapshotter velero.VolumeSnapshotter, snapshot *volume.Snapshot) bool {
+	reporter, ok := snapshotter.(velero.VolumeSnapshotProgressReporter)
+	if !ok {
+		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
+		return false
+	}
+
+	progress, err := reporter.SnapshotProgress(snapshot.Status.ProviderSnapshotID)
+	if err != nil {
+		log.WithError(err).Warn("Error getting progress of volume snapshot, checking it again later")
+		return true
+	}
+
+	switch progress.Phase {
+	case velero.OperationPhaseCompleted:
+		log.Info("Volume snapshot completed")
+		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
+		return false
+	case velero.OperationPhaseFailed:
+		log.Errorf("Volume snapshot failed: %s", progress.Err)
+		snapshot.Status.Phase = volume.SnapshotPhaseFailed
+		return false
+	default:
+		log.Debug("Volume snapshot still uploading")
+		return true
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// progressVolumeSnapshotter is a fakeVolumeSnapshotter that reports the progress
+// of its snapshots.
+type progressVolumeSnapshotter struct {
+	fakeVolumeSnapshotter
+
+	phase       velero.OperationPhase
+	progressErr bool
+}
+
+func (vs *progressVolumeSnapshotter) SnapshotProgress(snapshotID string) (velero.OperationProgress, error) {
+	if vs.progressErr {
+		return velero.OperationProgress{}, errors.New("error getting progress")
+	}
+	return velero.OperationProgress{Phase: vs.phase, Err: "snapshot error"}, nil
+}
+
+func TestUpdateVolumeSnapshotsProgress(t *testing.T) {
+	snapshot := func(location string, phase volume.SnapshotPhase) *volume.Snapshot {
+		return &volume.Snapshot{
+			Spec: volume.SnapshotSpec{
+				Location:             location,
+				PersistentVolumeName: "pv-1",
+			},
+			Status: volume.SnapshotStatus{
+				ProviderSnapshotID: "vol-1-snapshot",
+				Phase:              phase,
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		snapshots      []*volume.Snapshot
+		snapshotter    velero.VolumeSnapshotter
+		wantPhases     []volume.SnapshotPhase
+		wantInProgress int
+	}{
+		{
+			name:           "completed snapshots are recorded as completed",
+			snapshots:      []*volume.Snapshot{snapshot("default", volume.SnapshotPhaseUploading)},
+			snapshotter:    &progressVolumeSnapshotter{phase: velero.OperationPhaseCompleted},
+			wantPhases:     []volume.SnapshotPhase{volume.SnapshotPhaseCompleted},
+			wantInProgress: 0,
+		},
+		{
+			name:           "failed snapshots are recorded as failed",
+			snapshots:      []*volume.Snapshot{snapshot("default", volume.SnapshotPhaseUploading)},
+			snapshotter:    &progressVolumeSnapshotter{phase: velero.OperationPhaseFailed},
+			wantPhases:     []volume.SnapshotPhase{volume.SnapshotPhaseFailed},
+			wantInProgress: 0,
+		},
+		{
+			name:           "snapshots still uploading are counted",
+			snapshots:      []*volume.Snapshot{snapshot("default", volume.SnapshotPhaseUploading)},
+			snapshotter:    &progressVolumeSnapshotter{phase: velero.OperationPhaseInProgress},
+			wantPhases:     []volume.SnapshotPhase{volume.SnapshotPhaseUploading},
+			wantInProgress: 1,
+		},
+		{
+			name: "snapshots that aren't uploading are not checked",
+			snapshots: []*volume.Snapshot{
+				snapshot("default", volume.SnapshotPhaseCompleted),
+				snapshot("default", volume.SnapshotPhaseFailed),
+			},
+			snapshotter:    &progressVolumeSnapshotter{phase: velero.OperationPhaseInProgress},
+			wantPhases:     []volume.SnapshotPhase{volume.SnapshotPhaseCompleted, volume.SnapshotPhaseFailed},
+			wantInProgress: 0,
+		},
+		{
+			name:           "snapshots whose progress can't be gotten are left uploading",
+			snapshots:      []*volume.Snapshot{snapshot("default", volume.SnapshotPhaseUploading)},
+			snapshotter:    &progressVolumeSnapshotter{progressErr: true},
+			wantPhases:     []volume.SnapshotPhase{volume.SnapshotPhaseUploading},
+			wantInProgress: 1,
+		},
+		{
+			name:           "snapshots of volume snapshotters that don't report progress are completed",
+			snapshots:      []*volume.Snapshot{snapshot("default", volume.SnapshotPhaseUploading)},
+			snapshotter:    new(fakeVolumeSnapshotter),
+			wantPhases:     []volume.SnapshotPhase{volume.SnapshotPhaseCompleted},
+			wantInProgress: 0,
+		},
+		{
+			name:           "snapshots of an unknown volume snapshot location are failed",
+			snapshots:      []*volume.Snapshot{snapshot("unknown", volume.SnapshotPhaseUploading)},
+			snapshotter:    &progressVolumeSnapshotter{phase: velero.OperationPhaseInProgress},
+			wantPhases:     []volume.SnapshotPhase{volume.SnapshotPhaseFailed},
+			wantInProgress: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			locations := []*velerov1.VolumeSnapshotLocation{newSnapshotLocation("velero", "default", "default")}
+			getter := volumeSnapshotterGetter{"default": tc.snapshotter}
+
+			inProgress := UpdateVolumeSnapshotsProgress(velerotest.NewLogger(), tc.snapshots, locations, getter)
+
+			assert.Equal(t, tc.wantInProgress, inProgress)
+			var phases []volume.SnapshotPhase
+			for _, snapshot := range tc.snapshots {
+				phases = append(phases, snapshot.Status.Phase)
+			}
+			assert.Equal(t, tc.wantPhases, phases)
+		})
+	}
+}
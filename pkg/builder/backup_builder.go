@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -174,6 +175,24 @@ func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
 	return b
 }
 
+// UploaderType sets the type of the uploader used to back up the Backup's pod volumes.
+func (b *BackupBuilder) UploaderType(uploaderType velerov1api.UploaderType) *BackupBuilder {
+	b.object.Spec.UploaderType = uploaderType
+	return b
+}
+
+// ItemBackupWorkers sets the number of items of the same resource the Backup backs up concurrently.
+func (b *BackupBuilder) ItemBackupWorkers(workers int) *BackupBuilder {
+	b.object.Spec.ItemBackupWorkers = workers
+	return b
+}
+
+// Compression sets the algorithm and level the Backup's tarball is compressed with.
+func (b *BackupBuilder) Compression(algorithm velerov1api.CompressionAlgorithm, level int) *BackupBuilder {
+	b.object.Spec.Compression = &velerov1api.CompressionConfig{Algorithm: algorithm, Level: level}
+	return b
+}
+
 // Phase sets the Backup's phase.
 func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	b.object.Status.Phase = phase
@@ -222,8 +241,23 @@ func (b *BackupBuilder) Hooks(hooks velerov1api.BackupHooks) *BackupBuilder {
 	return b
 }
 
+// DryRun sets the Backup's DryRun flag.
+func (b *BackupBuilder) DryRun(val bool) *BackupBuilder {
+	b.object.Spec.DryRun = val
+	return b
+}
+
 // OrderedResources sets the Backup's OrderedResources
 func (b *BackupBuilder) OrderedResources(orders map[string]string) *BackupBuilder {
 	b.object.Spec.OrderedResources = orders
 	return b
 }
+
+// ResourcePolicyConfigMap sets the Backup's resource policy reference to the named ConfigMap.
+func (b *BackupBuilder) ResourcePolicyConfigMap(name string) *BackupBuilder {
+	b.object.Spec.ResourcePolicy = &corev1api.TypedLocalObjectReference{
+		Kind: "ConfigMap",
+		Name: name,
+	}
+	return b
+}
@@ -0,0 +1,88 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupReplicationBuilder builds BackupReplication objects.
+type BackupReplicationBuilder struct {
+	object *velerov1api.BackupReplication
+}
+
+// ForBackupReplication is the constructor for a BackupReplicationBuilder.
+func ForBackupReplication(ns, name string) *BackupReplicationBuilder {
+	return &BackupReplicationBuilder{
+		object: &velerov1api.BackupReplication{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "BackupReplication",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built BackupReplication.
+func (b *BackupReplicationBuilder) Result() *velerov1api.BackupReplication {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the BackupReplication's ObjectMeta.
+func (b *BackupReplicationBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupReplicationBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// SourceLocation sets the BackupReplication's source location.
+func (b *BackupReplicationBuilder) SourceLocation(location string) *BackupReplicationBuilder {
+	b.object.Spec.SourceLocation = location
+	return b
+}
+
+// DestinationLocation sets the BackupReplication's destination location.
+func (b *BackupReplicationBuilder) DestinationLocation(location string) *BackupReplicationBuilder {
+	b.object.Spec.DestinationLocation = location
+	return b
+}
+
+// LabelSelector sets the BackupReplication's label selector.
+func (b *BackupReplicationBuilder) LabelSelector(selector *metav1.LabelSelector) *BackupReplicationBuilder {
+	b.object.Spec.LabelSelector = selector
+	return b
+}
+
+// MaxRetries sets the BackupReplication's maximum number of retries.
+func (b *BackupReplicationBuilder) MaxRetries(retries int) *BackupReplicationBuilder {
+	b.object.Spec.MaxRetries = retries
+	return b
+}
+
+// Failures sets the BackupReplication's failures.
+func (b *BackupReplicationBuilder) Failures(failures ...velerov1api.BackupReplicationFailure) *BackupReplicationBuilder {
+	b.object.Status.Failures = failures
+	return b
+}
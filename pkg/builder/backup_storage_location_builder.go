@@ -128,3 +128,16 @@ func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeyS
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// Encryption sets the BackupStorageLocation's encryption key selector.
+func (b *BackupStorageLocationBuilder) Encryption(selector *corev1api.SecretKeySelector) *BackupStorageLocationBuilder {
+	b.object.Spec.Encryption = &velerov1api.EncryptionConfig{Key: *selector}
+	return b
+}
+
+// Compression sets the algorithm and level the tarballs of the backups stored in the
+// BackupStorageLocation are compressed with.
+func (b *BackupStorageLocationBuilder) Compression(algorithm velerov1api.CompressionAlgorithm, level int) *BackupStorageLocationBuilder {
+	b.object.Spec.Compression = &velerov1api.CompressionConfig{Algorithm: algorithm, Level: level}
+	return b
+}
@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ImportBackupRequestBuilder builds ImportBackupRequest objects.
+type ImportBackupRequestBuilder struct {
+	object *velerov1api.ImportBackupRequest
+}
+
+// ForImportBackupRequest is the constructor for an ImportBackupRequestBuilder.
+func ForImportBackupRequest(ns, name string) *ImportBackupRequestBuilder {
+	return &ImportBackupRequestBuilder{
+		object: &velerov1api.ImportBackupRequest{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "ImportBackupRequest",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built ImportBackupRequest.
+func (b *ImportBackupRequestBuilder) Result() *velerov1api.ImportBackupRequest {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the ImportBackupRequest's ObjectMeta.
+func (b *ImportBackupRequestBuilder) ObjectMeta(opts ...ObjectMetaOpt) *ImportBackupRequestBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// BackupName sets the name of the backup the ImportBackupRequest imports.
+func (b *ImportBackupRequestBuilder) BackupName(name string) *ImportBackupRequestBuilder {
+	b.object.Spec.BackupName = name
+	return b
+}
+
+// StorageLocation sets the ImportBackupRequest's storage location.
+func (b *ImportBackupRequestBuilder) StorageLocation(location string) *ImportBackupRequestBuilder {
+	b.object.Spec.StorageLocation = location
+	return b
+}
+
+// Tarball sets the ImportBackupRequest's tarball.
+func (b *ImportBackupRequestBuilder) Tarball(tarball string) *ImportBackupRequestBuilder {
+	b.object.Spec.Tarball = tarball
+	return b
+}
+
+// Upload sets whether the ImportBackupRequest's tarball is uploaded through its upload URL.
+func (b *ImportBackupRequestBuilder) Upload(upload bool) *ImportBackupRequestBuilder {
+	b.object.Spec.Upload = upload
+	return b
+}
+
+// TTL sets the ImportBackupRequest's TTL.
+func (b *ImportBackupRequestBuilder) TTL(ttl time.Duration) *ImportBackupRequestBuilder {
+	b.object.Spec.TTL.Duration = ttl
+	return b
+}
+
+// Phase sets the ImportBackupRequest's phase.
+func (b *ImportBackupRequestBuilder) Phase(phase velerov1api.ImportBackupRequestPhase) *ImportBackupRequestBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// UploadURL sets the ImportBackupRequest's upload URL.
+func (b *ImportBackupRequestBuilder) UploadURL(url string) *ImportBackupRequestBuilder {
+	b.object.Status.UploadURL = url
+	return b
+}
+
+// Expiration sets the expiration of the ImportBackupRequest's upload URL.
+func (b *ImportBackupRequestBuilder) Expiration(val time.Time) *ImportBackupRequestBuilder {
+	b.object.Status.Expiration = &metav1.Time{Time: val}
+	return b
+}
+
+// CompletionTimestamp sets the ImportBackupRequest's completion timestamp.
+func (b *ImportBackupRequestBuilder) CompletionTimestamp(val time.Time) *ImportBackupRequestBuilder {
+	b.object.Status.CompletionTimestamp = &metav1.Time{Time: val}
+	return b
+}
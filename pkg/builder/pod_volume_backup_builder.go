@@ -18,6 +18,7 @@ package builder
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -93,8 +94,26 @@ func (b *PodVolumeBackupBuilder) PodNamespace(ns string) *PodVolumeBackupBuilder
 	return b
 }
 
+// PodUID sets the UID of the pod associated with this PodVolumeBackup.
+func (b *PodVolumeBackupBuilder) PodUID(uid string) *PodVolumeBackupBuilder {
+	b.object.Spec.Pod.UID = types.UID(uid)
+	return b
+}
+
 // Volume sets the name of the volume associated with this PodVolumeBackup.
 func (b *PodVolumeBackupBuilder) Volume(volume string) *PodVolumeBackupBuilder {
 	b.object.Spec.Volume = volume
 	return b
 }
+
+// RepoIdentifier sets the PodVolumeBackup's repository identifier.
+func (b *PodVolumeBackupBuilder) RepoIdentifier(repoIdentifier string) *PodVolumeBackupBuilder {
+	b.object.Spec.RepoIdentifier = repoIdentifier
+	return b
+}
+
+// UploaderType sets the type of the uploader of this PodVolumeBackup.
+func (b *PodVolumeBackupBuilder) UploaderType(uploaderType velerov1api.UploaderType) *PodVolumeBackupBuilder {
+	b.object.Spec.UploaderType = uploaderType
+	return b
+}
@@ -19,6 +19,7 @@ package builder
 import (
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -142,6 +143,33 @@ func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
 	return b
 }
 
+// ExistingResourcePolicy sets the Restore's existing resource policy.
+func (b *RestoreBuilder) ExistingResourcePolicy(policy velerov1api.PolicyType) *RestoreBuilder {
+	b.object.Spec.ExistingResourcePolicy = policy
+	return b
+}
+
+// ResourceModifierConfigMap sets the Restore's resource modifier reference to the named ConfigMap.
+func (b *RestoreBuilder) ResourceModifierConfigMap(name string) *RestoreBuilder {
+	b.object.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{
+		Kind: "ConfigMap",
+		Name: name,
+	}
+	return b
+}
+
+// DryRun sets the Restore's dry run flag.
+func (b *RestoreBuilder) DryRun(val bool) *RestoreBuilder {
+	b.object.Spec.DryRun = val
+	return b
+}
+
+// ResourceHooks appends the hook specs to the Restore's hooks.
+func (b *RestoreBuilder) ResourceHooks(hooks ...velerov1api.RestoreResourceHookSpec) *RestoreBuilder {
+	b.object.Spec.Hooks.Resources = append(b.object.Spec.Hooks.Resources, hooks...)
+	return b
+}
+
 // StartTimestamp sets the Restore's start timestamp.
 func (b *RestoreBuilder) StartTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
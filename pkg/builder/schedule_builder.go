@@ -89,3 +89,40 @@ func (b *ScheduleBuilder) Template(spec velerov1api.BackupSpec) *ScheduleBuilder
 	b.object.Spec.Template = spec
 	return b
 }
+
+// Paused sets the Schedule's paused flag.
+func (b *ScheduleBuilder) Paused(val bool) *ScheduleBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
+
+// SkipImmediately sets the Schedule's skip immediately flag.
+func (b *ScheduleBuilder) SkipImmediately(val bool) *ScheduleBuilder {
+	b.object.Spec.SkipImmediately = val
+	return b
+}
+
+// MissedRunPolicy sets the Schedule's missed run policy.
+func (b *ScheduleBuilder) MissedRunPolicy(policy velerov1api.MissedRunPolicy) *ScheduleBuilder {
+	b.object.Spec.MissedRunPolicy = policy
+	return b
+}
+
+// StartingDeadline sets the Schedule's starting deadline.
+func (b *ScheduleBuilder) StartingDeadline(val time.Duration) *ScheduleBuilder {
+	b.object.Spec.StartingDeadline = metav1.Duration{Duration: val}
+	return b
+}
+
+// Retention sets the Schedule's retention policy.
+func (b *ScheduleBuilder) Retention(policy *velerov1api.RetentionPolicy) *ScheduleBuilder {
+	b.object.Spec.Retention = policy
+	return b
+}
+
+// LastSkippedTime sets the Schedule's last skipped time.
+func (b *ScheduleBuilder) LastSkippedTime(val string) *ScheduleBuilder {
+	t, _ := time.Parse("2006-01-02 15:04:05", val)
+	b.object.Status.LastSkipped = &metav1.Time{Time: t}
+	return b
+}
@@ -54,3 +54,11 @@ func (b *VolumeBuilder) CSISource(driver string) *VolumeBuilder {
 	}
 	return b
 }
+
+// HostPathSource sets the Volume's host path source.
+func (b *VolumeBuilder) HostPathSource(path string) *VolumeBuilder {
+	b.object.HostPath = &corev1api.HostPathVolumeSource{
+		Path: path,
+	}
+	return b
+}
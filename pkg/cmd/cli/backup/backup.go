@@ -35,7 +35,12 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
+		NewVerifyCommand(f),
+		NewInspectCommand(f),
+		NewDiffCommand(f),
+		NewImportCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewCancelCommand(f, "cancel"),
 	)
 
 	return c
@@ -0,0 +1,84 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewCancelCommand creates a new command that cancels backups in progress.
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s NAME [NAME...]", use),
+		Short: "Cancel backups",
+		Long: `Cancel new or in-progress backups.
+
+A canceled backup stops backing up items and pod volumes, and ends up in phase Canceled. Its tarball isn't
+uploaded, so it can't be restored, but the snapshots taken before it was canceled are recorded so that
+deleting the backup cleans them up.`,
+		Example: `  # Cancel a backup named "backup-1".
+  velero backup cancel backup-1
+
+  # Cancel backups named "backup-1" and "backup-2".
+  velero backup cancel backup-1 backup-2`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			veleroClient, err := f.Client()
+			cmd.CheckError(err)
+
+			patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, velerov1api.CancelRequestedAnnotation))
+
+			var errs []error
+			for _, name := range args {
+				backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					errs = append(errs, errors.WithStack(err))
+					continue
+				}
+
+				switch backup.Status.Phase {
+				case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress:
+				default:
+					errs = append(errs, errors.Errorf("backup %q is in phase %s and can't be canceled", name, backup.Status.Phase))
+					continue
+				}
+
+				if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+					errs = append(errs, errors.WithStack(err))
+					continue
+				}
+
+				fmt.Printf("Request to cancel backup %q submitted successfully.\nRun `velero backup describe %s` to check when it's canceled.\n", name, name)
+			}
+
+			cmd.CheckError(kubeerrs.NewAggregate(errs))
+		},
+	}
+
+	return c
+}
@@ -24,6 +24,7 @@ import (
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,7 +39,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	v1 "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -69,11 +72,18 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero backup create backup3 --snapshot-volumes=false -o yaml
 
   # Wait for a backup to complete before returning from the command.
-  velero backup create backup4 --wait`,
+  velero backup create backup4 --wait
+
+  # Create a backup whose volumes are backed up according to the volume policies in a configmap.
+  velero backup create backup5 --resource-policies-configmap policies-1
+
+  # Report the items, volumes and hooks a backup would back up, without backing anything up.
+  velero backup create backup6 --include-namespaces nginx --dry-run`,
 	}
 
 	o.BindFlags(c.Flags())
 	o.BindWait(c.Flags())
+	o.BindDryRun(c.Flags())
 	o.BindFromSchedule(c.Flags())
 	output.BindFlags(c.Flags())
 	output.ClearOutputFlagDefault(c)
@@ -98,6 +108,14 @@ type CreateOptions struct {
 	SnapshotLocations       []string
 	FromSchedule            string
 	OrderedResources        string
+	ResourcePolicyConfigMap string
+	UploaderType            string
+	ItemBackupWorkers       int
+	Compression             string
+	CompressionLevel        int
+	DryRun                  bool
+	InsecureSkipTLSVerify   bool
+	CACertFile              string
 
 	client veleroclient.Interface
 }
@@ -122,6 +140,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
 	flags.StringVar(&o.OrderedResources, "ordered-resources", "", "Mapping Kinds to an ordered list of specific resources of that Kind.  Resource names are separated by commas and their names are in format 'namespace/resourcename'. For cluster scope resource, simply use resource name. Key-value pairs in the mapping are separated by semi-colon.  Example: 'pods=ns1/pod1,ns1/pod2;persistentvolumeclaims=ns1/pvc4,ns1/pvc8'.  Optional.")
+	flags.StringVar(&o.ResourcePolicyConfigMap, "resource-policies-configmap", "", "Name of the configmap in the Velero namespace holding the volume policies used to decide how each volume is backed up.")
+	flags.StringVar(&o.UploaderType, "uploader-type", "", fmt.Sprintf("Type of the uploader used to back up pod volumes from the file system. Valid values are %q and %q. Optional, defaults to the Velero server's default uploader type.", velerov1api.UploaderTypeRestic, velerov1api.UploaderTypeKopia))
+	flags.IntVar(&o.ItemBackupWorkers, "item-backup-workers", 0, "Number of items of the same resource backed up concurrently. Optional, defaults to the Velero server's default number of item backup workers.")
+	flags.StringVar(&o.Compression, "compression", "", fmt.Sprintf("Algorithm the backup tarball is compressed with. Valid values are %q, %q and %q. Optional, defaults to the backup storage location's compression.", velerov1api.CompressionAlgorithmGzip, velerov1api.CompressionAlgorithmZstd, velerov1api.CompressionAlgorithmNone))
+	flags.IntVar(&o.CompressionLevel, "compression-level", 0, "Level the backup tarball is compressed with, from 1 to 9 for gzip and from 1 to 22 for zstd. Optional, defaults to the algorithm's default level.")
 	f := flags.VarPF(&o.SnapshotVolumes, "snapshot-volumes", "", "Take snapshots of PersistentVolumes as part of the backup.")
 	// this allows the user to just specify "--snapshot-volumes" as shorthand for "--snapshot-volumes=true"
 	// like a normal bool flag
@@ -140,6 +163,14 @@ func (o *CreateOptions) BindWait(flags *pflag.FlagSet) {
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
 }
 
+// BindDryRun binds the dry-run flags separately so they are not called by other
+// create commands that reuse CreateOptions's BindFlags method.
+func (o *CreateOptions) BindDryRun(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Report the items, volumes and hooks the backup would back up, without writing a backup tarball, taking snapshots or running hooks. Waits for the dry run to complete.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity when downloading the dry run report. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "Path to a certificate bundle to use when verifying TLS connections when downloading the dry run report.")
+}
+
 // BindFromSchedule binds the from-schedule flag separately so it is not called
 // by other create commands that reuse CreateOptions's BindFlags method.
 func (o *CreateOptions) BindFromSchedule(flags *pflag.FlagSet) {
@@ -166,6 +197,26 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return kubeerrs.NewAggregate(errs)
 	}
 
+	if o.UploaderType != "" {
+		if err := uploader.ValidateUploaderType(o.UploaderType); err != nil {
+			return err
+		}
+	}
+
+	if o.ItemBackupWorkers < 0 {
+		return errors.New("--item-backup-workers must not be negative")
+	}
+
+	if o.CompressionLevel != 0 && o.Compression == "" {
+		return errors.New("--compression-level requires --compression")
+	}
+
+	if config := o.CompressionConfig(); config != nil {
+		if err := compression.Validate(*config); err != nil {
+			return err
+		}
+	}
+
 	if o.StorageLocation != "" {
 		location := &velerov1api.BackupStorageLocation{}
 		if err := client.Get(context.Background(), kbclient.ObjectKey{
@@ -185,11 +236,28 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	return nil
 }
 
+// CompressionConfig returns the compression configuration of the backup, or nil
+// if the backup uses its storage location's.
+func (o *CreateOptions) CompressionConfig() *velerov1api.CompressionConfig {
+	if o.Compression == "" {
+		return nil
+	}
+
+	return &velerov1api.CompressionConfig{
+		Algorithm: velerov1api.CompressionAlgorithm(o.Compression),
+		Level:     o.CompressionLevel,
+	}
+}
+
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
 	// If an explicit name is specified, use that name
 	if len(args) > 0 {
 		o.Name = args[0]
 	}
+	// a dry run is waited for so that its report can be printed
+	if o.DryRun {
+		o.Wait = true
+	}
 	client, err := f.Client()
 	if err != nil {
 		return err
@@ -273,8 +341,14 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 					return nil
 				}
 
-				if backup.Status.Phase != velerov1api.BackupPhaseNew && backup.Status.Phase != velerov1api.BackupPhaseInProgress {
+				switch backup.Status.Phase {
+				case velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress,
+					velerov1api.BackupPhaseUploading, velerov1api.BackupPhaseUploadingPartialFailure:
+				default:
 					fmt.Printf("\nBackup completed with status: %s. You may check for more information using the commands `velero backup describe %s` and `velero backup logs %s`.\n", backup.Status.Phase, backup.Name, backup.Name)
+					if backup.Spec.DryRun {
+						return o.printDryRunReport(f, backup)
+					}
 					return nil
 				}
 			}
@@ -288,6 +362,31 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	return nil
 }
 
+// printDryRunReport prints the report of a completed dry-run backup.
+func (o *CreateOptions) printDryRunReport(f client.Factory, backup *velerov1api.Backup) error {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+	default:
+		return nil
+	}
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	caCertFile := o.CACertFile
+	if caCertFile == "" {
+		if config, err := client.LoadConfig(); err == nil {
+			caCertFile = config.CACertFile()
+		}
+	}
+
+	fmt.Println()
+	fmt.Print(output.DescribeBackupDryRunReport(context.Background(), kbClient, backup, o.InsecureSkipTLSVerify, caCertFile))
+	return nil
+}
+
 // ParseOrderedResources converts to map of Kinds to an ordered list of specific resources of that Kind.
 // Resource names in the list are in format 'namespace/resourcename' and separated by commas.
 // Key-value pairs in the mapping are separated by semi-colon.
@@ -340,6 +439,18 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			}
 			backupBuilder.OrderedResources(orders)
 		}
+		if o.ResourcePolicyConfigMap != "" {
+			backupBuilder.ResourcePolicyConfigMap(o.ResourcePolicyConfigMap)
+		}
+		if o.UploaderType != "" {
+			backupBuilder.UploaderType(velerov1api.UploaderType(o.UploaderType))
+		}
+		if o.ItemBackupWorkers > 0 {
+			backupBuilder.ItemBackupWorkers(o.ItemBackupWorkers)
+		}
+		if config := o.CompressionConfig(); config != nil {
+			backupBuilder.Compression(config.Algorithm, config.Level)
+		}
 
 		if o.SnapshotVolumes.Value != nil {
 			backupBuilder.SnapshotVolumes(*o.SnapshotVolumes.Value)
@@ -352,6 +463,10 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		}
 	}
 
+	if o.DryRun {
+		backupBuilder.DryRun(true)
+	}
+
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
 	return backup, nil
 }
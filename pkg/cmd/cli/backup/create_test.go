@@ -85,6 +85,18 @@ func TestCreateOptions_BuildBackupFromSchedule(t *testing.T) {
 		}, backup.GetAnnotations())
 	})
 
+	t.Run("dry run of a schedule's template", func(t *testing.T) {
+		o.DryRun = true
+		defer func() { o.DryRun = false }()
+
+		backup, err := o.BuildBackup(testNamespace)
+		assert.NoError(t, err)
+
+		expectedDryRunSpec := expectedBackupSpec.DeepCopy()
+		expectedDryRunSpec.DryRun = true
+		assert.Equal(t, *expectedDryRunSpec, backup.Spec)
+	})
+
 	t.Run("command line labels take precedence over schedule labels", func(t *testing.T) {
 		o.Labels.Set("velero.io/test=yes,custom-label=true")
 		backup, err := o.BuildBackup(testNamespace)
@@ -0,0 +1,507 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func NewDiffCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewDiffOptions()
+	o.CACertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "diff BACKUP_A BACKUP_B",
+		Short: "Compare the contents of two backups",
+		Long: `Compare the Kubernetes manifests in two backups, and report the items that were added
+to BACKUP_B, removed from it or changed in it, with the fields that changed. The persistent volume
+snapshots and pod volume backups of the backups are compared too.
+
+Each backup is either the name of a backup, whose tarball is downloaded from object storage, or the
+path to a backup tarball on the local disk. Volumes are only compared between backups, since local
+tarballs don't hold them. The metadata.resourceVersion and metadata.managedFields fields of items are
+ignored, since they change whenever an item is written.`,
+		Example: `  # Compare the items in namespace "app-1" of backups "nightly-20230101" and "nightly-20230102".
+  velero backup diff nightly-20230101 nightly-20230102 --in-namespace app-1`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Run(f, args[0], args[1], os.Stdout))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// DiffOptions are the options of the backup diff command.
+type DiffOptions struct {
+	InspectOptions
+	Namespace string
+}
+
+func NewDiffOptions() *DiffOptions {
+	return &DiffOptions{
+		InspectOptions: *NewInspectOptions(),
+	}
+}
+
+func (o *DiffOptions) BindFlags(flags *pflag.FlagSet) {
+	o.InspectOptions.BindFlags(flags)
+	flags.StringVar(&o.Namespace, "in-namespace", o.Namespace, "Only compare the items in this namespace, and the volumes of its pods and persistent volume claims.")
+}
+
+func (o *DiffOptions) Run(f client.Factory, nameA, nameB string, w io.Writer) error {
+	a, err := o.extract(f, nameA)
+	if err != nil {
+		return err
+	}
+	defer a.close()
+
+	b, err := o.extract(f, nameB)
+	if err != nil {
+		return err
+	}
+	defer b.close()
+
+	d := &backupDiff{}
+	if d.Items, err = diffBackupItems(a, b, o.Namespace); err != nil {
+		return err
+	}
+
+	if !a.local && !b.local {
+		d.volumesCompared = true
+
+		var snapshotsA, snapshotsB []*volume.Snapshot
+		if err := o.downloadJSON(f, nameA, velerov1api.DownloadTargetKindBackupVolumeSnapshots, &snapshotsA); err != nil {
+			return err
+		}
+		if err := o.downloadJSON(f, nameB, velerov1api.DownloadTargetKindBackupVolumeSnapshots, &snapshotsB); err != nil {
+			return err
+		}
+		d.VolumeSnapshots = diffVolumeSnapshots(snapshotsA, snapshotsB, func(pvName string) bool {
+			return o.Namespace == "" || pvClaimNamespace(a, pvName) == o.Namespace || pvClaimNamespace(b, pvName) == o.Namespace
+		})
+
+		var podVolumeBackupsA, podVolumeBackupsB []*velerov1api.PodVolumeBackup
+		if err := o.downloadJSON(f, nameA, velerov1api.DownloadTargetKindBackupPodVolumeBackups, &podVolumeBackupsA); err != nil {
+			return err
+		}
+		if err := o.downloadJSON(f, nameB, velerov1api.DownloadTargetKindBackupPodVolumeBackups, &podVolumeBackupsB); err != nil {
+			return err
+		}
+		d.PodVolumeBackups = diffPodVolumeBackups(podVolumeBackupsA, podVolumeBackupsB, o.Namespace)
+	}
+
+	printBackupDiff(w, nameA, nameB, d)
+	return nil
+}
+
+// downloadJSON downloads a JSON file of the named backup into obj. Files that
+// don't exist, because the backup was taken by an older version of Velero or
+// has nothing to record in them, leave obj empty.
+func (o *DiffOptions) downloadJSON(f client.Factory, name string, kind velerov1api.DownloadTargetKind, obj interface{}) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), name, kind, buf, o.Timeout, o.InsecureSkipTLSVerify, o.CACertFile)
+	if err == downloadrequest.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrapf(json.NewDecoder(buf).Decode(obj), "error decoding %s of backup %s", kind, name)
+}
+
+// diffChange is how an item or volume changed from one backup to the other.
+type diffChange string
+
+const (
+	diffChangeAdded   diffChange = "added"
+	diffChangeRemoved diffChange = "removed"
+	diffChangeChanged diffChange = "changed"
+)
+
+// fieldDiff is a field that changed, with its JSON-encoded values.
+type fieldDiff struct {
+	Path string
+	Old  string
+	New  string
+}
+
+// itemDiff is an item that changed.
+type itemDiff struct {
+	Resource  string
+	Namespace string
+	Name      string
+	Change    diffChange
+	Fields    []fieldDiff
+}
+
+// volumeDiff is a volume snapshot or pod volume backup that changed.
+type volumeDiff struct {
+	Name   string
+	Change diffChange
+	Fields []fieldDiff
+}
+
+// backupDiff is what changed from one backup to another.
+type backupDiff struct {
+	Items            []itemDiff
+	VolumeSnapshots  []volumeDiff
+	PodVolumeBackups []volumeDiff
+
+	// volumesCompared is whether volume snapshots and pod volume backups were
+	// compared, which needs both backups' metadata from object storage.
+	volumesCompared bool
+}
+
+// ignoredFields are the fields of items that change whenever an item is written.
+var ignoredFields = map[string]bool{
+	"metadata.resourceVersion": true,
+	"metadata.managedFields":   true,
+}
+
+// itemKey identifies an item in a backup.
+type itemKey struct {
+	resource, namespace, name string
+}
+
+// diffBackupItems compares the items of two backups, optionally only the ones in
+// the given namespace. The diffs are sorted by resource, namespace and name.
+func diffBackupItems(a, b *extractedBackup, namespace string) ([]itemDiff, error) {
+	keysA, keysB := backupItemKeys(a, namespace), backupItemKeys(b, namespace)
+
+	keys := make([]itemKey, 0, len(keysA)+len(keysB))
+	for key := range keysA {
+		keys = append(keys, key)
+	}
+	for key := range keysB {
+		if !keysA[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].resource != keys[j].resource {
+			return keys[i].resource < keys[j].resource
+		}
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		return keys[i].name < keys[j].name
+	})
+
+	var diffs []itemDiff
+	for _, key := range keys {
+		diff := itemDiff{Resource: key.resource, Namespace: key.namespace, Name: key.name}
+		switch {
+		case !keysA[key]:
+			diff.Change = diffChangeAdded
+		case !keysB[key]:
+			diff.Change = diffChangeRemoved
+		default:
+			objA, err := a.item(key.resource, key.namespace, key.name)
+			if err != nil {
+				return nil, err
+			}
+			objB, err := b.item(key.resource, key.namespace, key.name)
+			if err != nil {
+				return nil, err
+			}
+
+			diff.Fields = diffFields("", objA.Object, objB.Object)
+			if len(diff.Fields) == 0 {
+				continue
+			}
+			diff.Change = diffChangeChanged
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+// backupItemKeys returns the keys of the items in the backup, optionally only
+// the ones in the given namespace.
+func backupItemKeys(b *extractedBackup, namespace string) map[itemKey]bool {
+	keys := map[itemKey]bool{}
+	for resource, items := range b.resources {
+		for ns, names := range items.ItemsByNamespace {
+			if namespace != "" && ns != namespace {
+				continue
+			}
+			for _, name := range names {
+				keys[itemKey{resource: resource, namespace: ns, name: name}] = true
+			}
+		}
+	}
+	return keys
+}
+
+// diffFields returns the fields that differ between two decoded JSON values,
+// recursing into objects and into arrays of the same length.
+func diffFields(path string, old, new interface{}) []fieldDiff {
+	if ignoredFields[path] || reflect.DeepEqual(old, new) {
+		return nil
+	}
+
+	switch oldValue := old.(type) {
+	case map[string]interface{}:
+		newValue, ok := new.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(oldValue)+len(newValue))
+		for key := range oldValue {
+			keys = append(keys, key)
+		}
+		for key := range newValue {
+			if _, ok := oldValue[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		var diffs []fieldDiff
+		for _, key := range keys {
+			fieldPath := joinFieldPath(path, key)
+			if ignoredFields[fieldPath] {
+				continue
+			}
+
+			o, oldOK := oldValue[key]
+			n, newOK := newValue[key]
+			switch {
+			case !oldOK:
+				diffs = append(diffs, fieldDiff{Path: fieldPath, Old: "<none>", New: encodeFieldValue(n)})
+			case !newOK:
+				diffs = append(diffs, fieldDiff{Path: fieldPath, Old: encodeFieldValue(o), New: "<none>"})
+			default:
+				diffs = append(diffs, diffFields(fieldPath, o, n)...)
+			}
+		}
+		return diffs
+
+	case []interface{}:
+		newValue, ok := new.([]interface{})
+		if !ok || len(newValue) != len(oldValue) {
+			break
+		}
+
+		var diffs []fieldDiff
+		for i := range oldValue {
+			diffs = append(diffs, diffFields(fmt.Sprintf("%s[%d]", path, i), oldValue[i], newValue[i])...)
+		}
+		return diffs
+	}
+
+	return []fieldDiff{{Path: path, Old: encodeFieldValue(old), New: encodeFieldValue(new)}}
+}
+
+// joinFieldPath appends a key to a field path, quoting keys that contain dots,
+// like the keys of labels and annotations.
+func joinFieldPath(path, key string) string {
+	if strings.Contains(key, ".") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func encodeFieldValue(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// diffVolumeSnapshots compares the volume snapshots of two backups by persistent
+// volume, for the persistent volumes that include returns true for. Snapshot IDs
+// are not compared, since every backup takes new snapshots.
+func diffVolumeSnapshots(a, b []*volume.Snapshot, include func(pvName string) bool) []volumeDiff {
+	fields := func(snapshots []*volume.Snapshot) map[string]interface{} {
+		res := map[string]interface{}{}
+		for _, snapshot := range snapshots {
+			if !include(snapshot.Spec.PersistentVolumeName) {
+				continue
+			}
+			res[snapshot.Spec.PersistentVolumeName] = map[string]interface{}{
+				"location":         snapshot.Spec.Location,
+				"providerVolumeID": snapshot.Spec.ProviderVolumeID,
+				"volumeType":       snapshot.Spec.VolumeType,
+				"volumeAZ":         snapshot.Spec.VolumeAZ,
+				"volumeIOPS":       snapshot.Spec.VolumeIOPS,
+				"phase":            snapshot.Status.Phase,
+			}
+		}
+		return res
+	}
+
+	return diffVolumes(fields(a), fields(b))
+}
+
+// diffPodVolumeBackups compares the pod volume backups of two backups by pod and
+// volume, optionally only the ones of pods in the given namespace. Snapshot IDs are
+// not compared, since every backup takes new snapshots.
+func diffPodVolumeBackups(a, b []*velerov1api.PodVolumeBackup, namespace string) []volumeDiff {
+	fields := func(podVolumeBackups []*velerov1api.PodVolumeBackup) map[string]interface{} {
+		res := map[string]interface{}{}
+		for _, pvb := range podVolumeBackups {
+			if namespace != "" && pvb.Spec.Pod.Namespace != namespace {
+				continue
+			}
+			res[fmt.Sprintf("%s/%s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name, pvb.Spec.Volume)] = map[string]interface{}{
+				"backupStorageLocation": pvb.Spec.BackupStorageLocation,
+				"uploaderType":          pvb.Spec.UploaderType,
+				"phase":                 pvb.Status.Phase,
+				"totalBytes":            pvb.Status.Progress.TotalBytes,
+			}
+		}
+		return res
+	}
+
+	return diffVolumes(fields(a), fields(b))
+}
+
+// diffVolumes compares volumes by name, given the fields to compare of each one.
+func diffVolumes(a, b map[string]interface{}) []volumeDiff {
+	names := make([]string, 0, len(a)+len(b))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diffs []volumeDiff
+	for _, name := range names {
+		fieldsA, inA := a[name]
+		fieldsB, inB := b[name]
+		switch {
+		case !inA:
+			diffs = append(diffs, volumeDiff{Name: name, Change: diffChangeAdded})
+		case !inB:
+			diffs = append(diffs, volumeDiff{Name: name, Change: diffChangeRemoved})
+		default:
+			if fields := diffFields("", fieldsA, fieldsB); len(fields) > 0 {
+				diffs = append(diffs, volumeDiff{Name: name, Change: diffChangeChanged, Fields: fields})
+			}
+		}
+	}
+	return diffs
+}
+
+// pvClaimNamespace returns the namespace of the claim of a persistent volume in
+// the backup, or an empty string if it's not known.
+func pvClaimNamespace(b *extractedBackup, pvName string) string {
+	pv, err := b.item("persistentvolumes", "", pvName)
+	if err != nil {
+		return ""
+	}
+	namespace, _, _ := unstructured.NestedString(pv.Object, "spec", "claimRef", "namespace")
+	return namespace
+}
+
+var diffChangeSymbols = map[diffChange]string{
+	diffChangeAdded:   "+",
+	diffChangeRemoved: "-",
+	diffChangeChanged: "~",
+}
+
+func printBackupDiff(w io.Writer, nameA, nameB string, d *backupDiff) {
+	counts := map[diffChange]int{}
+	for _, item := range d.Items {
+		counts[item.Change]++
+	}
+	fmt.Fprintf(w, "Comparing %s to %s: %d items added, %d removed, %d changed.\n", nameA, nameB,
+		counts[diffChangeAdded], counts[diffChangeRemoved], counts[diffChangeChanged])
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Items:")
+	if len(d.Items) == 0 {
+		fmt.Fprintln(w, "  <no changes>")
+	}
+	for _, item := range d.Items {
+		name := item.Name
+		if item.Namespace != "" {
+			name = item.Namespace + "/" + item.Name
+		}
+		fmt.Fprintf(w, "  %s %s %s\n", diffChangeSymbols[item.Change], item.Resource, name)
+		printFieldDiffs(w, item.Fields)
+	}
+
+	printVolumeDiffs(w, "Volume Snapshots", d.VolumeSnapshots, d.volumesCompared)
+	printVolumeDiffs(w, "Pod Volume Backups", d.PodVolumeBackups, d.volumesCompared)
+}
+
+func printVolumeDiffs(w io.Writer, title string, diffs []volumeDiff, compared bool) {
+	fmt.Fprintln(w)
+	if !compared {
+		fmt.Fprintf(w, "%s: <not compared for local tarballs>\n", title)
+		return
+	}
+
+	fmt.Fprintf(w, "%s:\n", title)
+	if len(diffs) == 0 {
+		fmt.Fprintln(w, "  <no changes>")
+	}
+	for _, diff := range diffs {
+		fmt.Fprintf(w, "  %s %s\n", diffChangeSymbols[diff.Change], diff.Name)
+		printFieldDiffs(w, diff.Fields)
+	}
+}
+
+func printFieldDiffs(w io.Writer, fields []fieldDiff) {
+	for _, field := range fields {
+		fmt.Fprintf(w, "      %s: %s -> %s\n", field.Path, field.Old, field.New)
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestDiffFields(t *testing.T) {
+	tests := []struct {
+		name string
+		old  map[string]interface{}
+		new  map[string]interface{}
+		want []fieldDiff
+	}{
+		{
+			name: "identical objects have no diffs",
+			old:  map[string]interface{}{"spec": map[string]interface{}{"replicas": 1}},
+			new:  map[string]interface{}{"spec": map[string]interface{}{"replicas": 1}},
+		},
+		{
+			name: "changed, added and removed fields are reported by path",
+			old: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": 1, "paused": true},
+			},
+			new: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": 2, "strategy": "Recreate"},
+			},
+			want: []fieldDiff{
+				{Path: "spec.paused", Old: "true", New: "<none>"},
+				{Path: "spec.replicas", Old: "1", New: "2"},
+				{Path: "spec.strategy", Old: "<none>", New: `"Recreate"`},
+			},
+		},
+		{
+			name: "arrays of the same length are compared by index",
+			old: map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"image": "nginx:1.20"}},
+			},
+			new: map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"image": "nginx:1.21"}},
+			},
+			want: []fieldDiff{
+				{Path: "containers[0].image", Old: `"nginx:1.20"`, New: `"nginx:1.21"`},
+			},
+		},
+		{
+			name: "arrays of different lengths are reported as a whole",
+			old:  map[string]interface{}{"args": []interface{}{"a"}},
+			new:  map[string]interface{}{"args": []interface{}{"a", "b"}},
+			want: []fieldDiff{
+				{Path: "args", Old: `["a"]`, New: `["a","b"]`},
+			},
+		},
+		{
+			name: "keys with dots are quoted",
+			old:  map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"app.kubernetes.io/name": "a"}}},
+			new:  map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"app.kubernetes.io/name": "b"}}},
+			want: []fieldDiff{
+				{Path: `metadata.labels["app.kubernetes.io/name"]`, Old: `"a"`, New: `"b"`},
+			},
+		},
+		{
+			name: "resource version and managed fields are ignored",
+			old:  map[string]interface{}{"metadata": map[string]interface{}{"resourceVersion": "1", "managedFields": []interface{}{"a"}}},
+			new:  map[string]interface{}{"metadata": map[string]interface{}{"resourceVersion": "2"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, diffFields("", tc.old, tc.new))
+		})
+	}
+}
+
+func TestDiffBackupItems(t *testing.T) {
+	a, err := extractBackup(test.NewTarWriter(t).
+		AddItems("pods",
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "a")).Result(),
+			builder.ForPod("ns-2", "pod-3").Result(),
+		).
+		Done())
+	require.NoError(t, err)
+	defer a.close()
+
+	b, err := extractBackup(test.NewTarWriter(t).
+		AddItems("pods",
+			builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "b")).Result(),
+			builder.ForPod("ns-1", "pod-4").Result(),
+			builder.ForPod("ns-2", "pod-3").Result(),
+			builder.ForPod("ns-2", "pod-5").Result(),
+		).
+		Done())
+	require.NoError(t, err)
+	defer b.close()
+
+	diffs, err := diffBackupItems(a, b, "ns-1")
+	require.NoError(t, err)
+	assert.Equal(t, []itemDiff{
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Change: diffChangeRemoved},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-2", Change: diffChangeChanged, Fields: []fieldDiff{
+			{Path: "metadata.labels.app", Old: `"a"`, New: `"b"`},
+		}},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-4", Change: diffChangeAdded},
+	}, diffs)
+
+	diffs, err = diffBackupItems(a, b, "")
+	require.NoError(t, err)
+	assert.Len(t, diffs, 4)
+	assert.Equal(t, itemDiff{Resource: "pods", Namespace: "ns-2", Name: "pod-5", Change: diffChangeAdded}, diffs[3])
+}
+
+func TestDiffVolumes(t *testing.T) {
+	snapshot := func(pvName, volumeType, snapshotID string) *volume.Snapshot {
+		return &volume.Snapshot{
+			Spec:   volume.SnapshotSpec{PersistentVolumeName: pvName, VolumeType: volumeType},
+			Status: volume.SnapshotStatus{ProviderSnapshotID: snapshotID, Phase: volume.SnapshotPhaseCompleted},
+		}
+	}
+
+	snapshotDiffs := diffVolumeSnapshots(
+		[]*volume.Snapshot{snapshot("pv-1", "gp2", "snap-1"), snapshot("pv-2", "gp2", "snap-2"), snapshot("pv-3", "gp2", "snap-3")},
+		[]*volume.Snapshot{snapshot("pv-1", "gp3", "snap-4"), snapshot("pv-2", "gp2", "snap-5"), snapshot("pv-4", "gp2", "snap-6")},
+		func(string) bool { return true },
+	)
+	assert.Equal(t, []volumeDiff{
+		{Name: "pv-1", Change: diffChangeChanged, Fields: []fieldDiff{{Path: "volumeType", Old: `"gp2"`, New: `"gp3"`}}},
+		{Name: "pv-3", Change: diffChangeRemoved},
+		{Name: "pv-4", Change: diffChangeAdded},
+	}, snapshotDiffs)
+
+	podVolumeBackup := func(namespace, pod string, totalBytes int64) *velerov1api.PodVolumeBackup {
+		pvb := builder.ForPodVolumeBackup("velero", pod+"-data").
+			PodNamespace(namespace).
+			PodName(pod).
+			Volume("data").
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).
+			Result()
+		pvb.Status.Progress.TotalBytes = totalBytes
+		return pvb
+	}
+
+	podVolumeBackupDiffs := diffPodVolumeBackups(
+		[]*velerov1api.PodVolumeBackup{podVolumeBackup("ns-1", "pod-1", 100), podVolumeBackup("ns-2", "pod-2", 100)},
+		[]*velerov1api.PodVolumeBackup{podVolumeBackup("ns-1", "pod-1", 200), podVolumeBackup("ns-2", "pod-2", 200)},
+		"ns-1",
+	)
+	assert.Equal(t, []volumeDiff{
+		{Name: "ns-1/pod-1/data", Change: diffChangeChanged, Fields: []fieldDiff{{Path: "totalBytes", Old: "100", New: "200"}}},
+	}, podVolumeBackupDiffs)
+}
+
+func TestPrintBackupDiff(t *testing.T) {
+	d := &backupDiff{
+		Items: []itemDiff{
+			{Resource: "deployments.apps", Namespace: "ns-1", Name: "deploy-1", Change: diffChangeChanged, Fields: []fieldDiff{
+				{Path: "spec.replicas", Old: "1", New: "2"},
+			}},
+			{Resource: "persistentvolumes", Name: "pv-1", Change: diffChangeAdded},
+		},
+		VolumeSnapshots: []volumeDiff{
+			{Name: "pv-1", Change: diffChangeAdded},
+		},
+		volumesCompared: true,
+	}
+
+	buf := new(bytes.Buffer)
+	printBackupDiff(buf, "backup-1", "backup-2", d)
+	assert.Equal(t, `Comparing backup-1 to backup-2: 1 items added, 0 removed, 1 changed.
+
+Items:
+  ~ deployments.apps ns-1/deploy-1
+      spec.replicas: 1 -> 2
+  + persistentvolumes pv-1
+
+Volume Snapshots:
+  + pv-1
+
+Pod Volume Backups:
+  <no changes>
+`, buf.String())
+
+	d.volumesCompared = false
+	buf.Reset()
+	printBackupDiff(buf, "backup-1", "backup-2-data.tar.gz", d)
+	assert.Contains(t, buf.String(), "Volume Snapshots: <not compared for local tarballs>\n")
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 func NewDownloadCommand(f client.Factory) *cobra.Command {
@@ -76,7 +77,7 @@ func NewDownloadOptions() *DownloadOptions {
 }
 
 func (o *DownloadOptions) BindFlags(flags *pflag.FlagSet) {
-	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory, or to <NAME>-data.tar.zst or <NAME>-data.tar if the backup is compressed with zstd or isn't compressed.")
 	flags.BoolVar(&o.Force, "force", o.Force, "Forces the download and will overwrite file if it exists already.")
 	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
 	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
@@ -88,10 +89,21 @@ func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Fac
 	veleroClient, err := f.Client()
 	cmd.CheckError(err)
 
-	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{}); err != nil {
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{})
+	if err != nil {
 		return err
 	}
 
+	// name the tarball after the algorithm it's compressed with. Backups that
+	// don't record it are compressed with gzip.
+	if o.Output == "" {
+		path, err := os.Getwd()
+		if err != nil {
+			return errors.Wrapf(err, "error getting current directory")
+		}
+		o.Output = filepath.Join(path, fmt.Sprintf("%s-data%s", o.Name, compression.Extension(backup.Status.Compression)))
+	}
+
 	return nil
 }
 
@@ -103,14 +115,6 @@ func (o *DownloadOptions) Complete(args []string) error {
 		o.writeOptions = os.O_RDWR | os.O_CREATE | os.O_TRUNC
 	}
 
-	if o.Output == "" {
-		path, err := os.Getwd()
-		if err != nil {
-			return errors.Wrapf(err, "error getting current directory")
-		}
-		o.Output = filepath.Join(path, fmt.Sprintf("%s-data.tar.gz", o.Name))
-	}
-
 	return nil
 }
 
@@ -0,0 +1,352 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewImportCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewImportOptions()
+	o.caCertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "import",
+		Short: "Import a backup tarball into a backup storage location",
+		Long: `Import a backup tarball, such as one downloaded with 'velero backup download', into a backup
+storage location, from where it's synced into the cluster like any other backup.
+
+With --file, the command uploads a local tarball, e.g. from removable media, to the "imports"
+directory of the backup storage location through an upload URL issued by the Velero server. This
+requires an object store plugin that supports upload URLs. With --tarball, the tarball must have
+been copied to the imports directory, under the location's prefix if it has one, with the object
+store's own tools. The Velero server then validates the tarball, reconstructs the backup's metadata
+from its contents, uploads it as a backup of the location and deletes the tarball.
+
+Only the Kubernetes manifests of the backup are imported. Snapshots of persistent volumes and pod
+volume backups aren't part of the tarball, so they can't be restored from an imported backup.`,
+		Example: `  # Import the local tarball "backup-1-data.tar.gz" into the backup storage location "default" as
+  # backup "backup-1".
+  velero backup import --file /media/usb/backup-1-data.tar.gz --storage-location default
+
+  # Import it as backup "backup-2", to be garbage collected in 7 days.
+  velero backup import --file /media/usb/backup-1-data.tar.gz --storage-location default --name backup-2 --ttl 168h
+
+  # Import the tarball "backup-1-data.tar.gz" that was copied to the imports directory of the
+  # backup storage location "default".
+  velero backup import --tarball backup-1-data.tar.gz --storage-location default`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f))
+			cmd.CheckError(o.Validate(f))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// ImportOptions are the options of the backup import command.
+type ImportOptions struct {
+	File                  string
+	Tarball               string
+	StorageLocation       string
+	Name                  string
+	TTL                   time.Duration
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+
+	caCertFile string
+	client     kbclient.Client
+}
+
+func NewImportOptions() *ImportOptions {
+	return &ImportOptions{
+		TTL:     30 * 24 * time.Hour,
+		Timeout: 10 * time.Minute,
+	}
+}
+
+func (o *ImportOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.File, "file", o.File, "Path to the local backup tarball to upload and import.")
+	flags.StringVar(&o.Tarball, "tarball", o.Tarball, "Name of the backup tarball to import, which has been copied to the imports directory of the backup storage location. Can't be used with --file.")
+	flags.StringVar(&o.StorageLocation, "storage-location", o.StorageLocation, "Backup storage location to import the backup into. Defaults to the default backup storage location.")
+	flags.StringVar(&o.Name, "name", o.Name, "Name of the imported backup. Defaults to the name of the tarball without the '-data' suffix and its extension.")
+	flags.DurationVar(&o.TTL, "ttl", o.TTL, "How long before the imported backup can be garbage collected, counted from the import.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait for the Velero server to process the import, not counting the upload of the tarball.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity when uploading the tarball. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *ImportOptions) Complete(f client.Factory) error {
+	// uploaded tarballs are named after the local file in the imports directory
+	if o.File != "" && o.Tarball == "" {
+		o.Tarball = filepath.Base(o.File)
+	}
+	if o.Name == "" && o.Tarball != "" {
+		o.Name = backupNameFromFile(o.Tarball)
+	}
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = kbClient
+
+	return nil
+}
+
+func (o *ImportOptions) Validate(f client.Factory) error {
+	if o.File != "" {
+		if o.Tarball != filepath.Base(o.File) {
+			return errors.New("--file and --tarball can't be used together")
+		}
+		info, err := os.Stat(o.File)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !info.Mode().IsRegular() {
+			return errors.Errorf("%s is not a file", o.File)
+		}
+	}
+	if o.Tarball == "" {
+		return errors.New("either --file or --tarball is required")
+	}
+	if strings.Contains(o.Tarball, "/") {
+		return errors.New("--tarball must be the name of a file in the imports directory of the backup storage location")
+	}
+	if errs := validation.IsDNS1123Subdomain(o.Name); len(errs) > 0 {
+		return errors.Errorf("invalid backup name %q: %s", o.Name, strings.Join(errs, ", "))
+	}
+
+	location, err := getImportLocation(o.client, f.Namespace(), o.StorageLocation)
+	if err != nil {
+		return err
+	}
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("backup storage location %q is read-only", location.Name)
+	}
+	o.StorageLocation = location.Name
+
+	backup := &velerov1api.Backup{}
+	err = o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}, backup)
+	if err == nil {
+		return errors.Errorf("backup %q already exists", o.Name)
+	}
+	if !apierrors.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// getImportLocation returns the named backup storage location, or the default
+// one if name is empty.
+func getImportLocation(kbClient kbclient.Client, namespace, name string) (*velerov1api.BackupStorageLocation, error) {
+	if name != "" {
+		location := &velerov1api.BackupStorageLocation{}
+		if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: namespace, Name: name}, location); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return location, nil
+	}
+
+	locations := &velerov1api.BackupStorageLocationList{}
+	if err := kbClient.List(context.Background(), locations, &kbclient.ListOptions{Namespace: namespace}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for i := range locations.Items {
+		if locations.Items[i].Spec.Default {
+			return &locations.Items[i], nil
+		}
+	}
+	return nil, errors.New("there is no default backup storage location; use --storage-location")
+}
+
+func (o *ImportOptions) Run(f client.Factory) error {
+	request := builder.ForImportBackupRequest(f.Namespace(), "").
+		ObjectMeta(builder.WithGenerateName(o.Name + "-")).
+		BackupName(o.Name).
+		StorageLocation(o.StorageLocation).
+		Tarball(o.Tarball).
+		Upload(o.File != "").
+		TTL(o.TTL).
+		Result()
+
+	if err := o.client.Create(context.Background(), request); err != nil {
+		return errors.WithStack(err)
+	}
+	fmt.Printf("Import backup request %q submitted successfully.\n", request.Name)
+
+	if o.File != "" {
+		var err error
+		request, err = waitForImport(o.client, request, o.Timeout, velerov1api.ImportBackupRequestPhaseWaitingForUpload)
+		if err != nil {
+			return err
+		}
+
+		if request.Status.Phase == velerov1api.ImportBackupRequestPhaseWaitingForUpload {
+			fmt.Printf("Uploading %s...\n", o.File)
+			if err := uploadTarball(o.File, request.Status.UploadURL, o.InsecureSkipTLSVerify, o.caCertFile); err != nil {
+				return errors.Wrapf(err, "error uploading %s, import backup request %q fails once its upload URL expires", o.File, request.Name)
+			}
+		}
+	}
+
+	request, err := waitForImport(o.client, request, o.Timeout)
+	if err != nil {
+		return err
+	}
+
+	if request.Status.Phase == velerov1api.ImportBackupRequestPhaseFailed {
+		return errors.Errorf("backup %q couldn't be imported: %s", o.Name, strings.Join(request.Status.Errors, "; "))
+	}
+
+	fmt.Printf("Backup %q imported into backup storage location %q. It's available once the location is synced.\n", o.Name, o.StorageLocation)
+	fmt.Printf("Run `velero backup describe %s` for more details.\n", o.Name)
+	return nil
+}
+
+// waitForImport waits until the import backup request has been processed, or
+// has reached one of the given phases, and returns it.
+func waitForImport(kbClient kbclient.Client, request *velerov1api.ImportBackupRequest, timeout time.Duration, phases ...velerov1api.ImportBackupRequestPhase) (*velerov1api.ImportBackupRequest, error) {
+	key := kbclient.ObjectKey{Namespace: request.Namespace, Name: request.Name}
+	updated := &velerov1api.ImportBackupRequest{}
+
+	phases = append(phases, velerov1api.ImportBackupRequestPhaseCompleted, velerov1api.ImportBackupRequestPhaseFailed)
+	err := wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+		if err := kbClient.Get(context.Background(), key, updated); err != nil {
+			return false, errors.WithStack(err)
+		}
+		for _, phase := range phases {
+			if updated.Status.Phase == phase {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, errors.Errorf("timed out waiting for import backup request %q to be processed, check its status with 'kubectl -n %s get importbackuprequests %s'", request.Name, request.Namespace, request.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// uploadTarball uploads the local tarball at path to the upload URL of an
+// import backup request.
+func uploadTarball(path, url string, insecureSkipTLSVerify bool, caCertFile string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var caPool *x509.CertPool
+	if len(caCertFile) > 0 {
+		caCert, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return errors.Wrapf(err, "couldn't open cacert")
+		}
+		// bundle the passed in cert with the system cert pool
+		// if it's available, otherwise create a new pool just
+		// for this.
+		caPool, err = x509.SystemCertPool()
+		if err != nil {
+			caPool = x509.NewCertPool()
+		}
+		caPool.AppendCertsFromPEM(caCert)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: insecureSkipTLSVerify,
+		RootCAs:            caPool,
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	httpReq, err := http.NewRequest(http.MethodPut, url, file)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// signed upload URLs don't accept chunked uploads
+	httpReq.ContentLength = info.Size()
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrapf(err, "upload failed: unable to read response body")
+		}
+		return errors.Errorf("upload failed: %s: %s", resp.Status, string(body))
+	}
+
+	return nil
+}
+
+// backupNameFromFile returns the name of the backup whose tarball is at path,
+// as named by 'velero backup download'.
+func backupNameFromFile(path string) string {
+	name := filepath.Base(path)
+	for _, ext := range []string{".tar.gz", ".tar.zst", ".tgz", ".tar"} {
+		if strings.HasSuffix(name, ext) {
+			name = strings.TrimSuffix(name, ext)
+			break
+		}
+	}
+	return strings.TrimSuffix(name, "-data")
+}
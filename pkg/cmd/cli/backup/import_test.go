@@ -0,0 +1,157 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupNameFromFile(t *testing.T) {
+	tests := map[string]string{
+		"backup-1-data.tar.gz":          "backup-1",
+		"/media/usb/backup-1-data.tar":  "backup-1",
+		"backup-1-data.tar.zst":         "backup-1",
+		"nightly.tgz":                   "nightly",
+		"backup-1":                      "backup-1",
+		"/media/usb/backup-1-data.json": "backup-1-data.json",
+	}
+
+	for path, want := range tests {
+		t.Run(path, func(t *testing.T) {
+			assert.Equal(t, want, backupNameFromFile(path))
+		})
+	}
+}
+
+func TestGetImportLocation(t *testing.T) {
+	kbClient := test.NewFakeControllerRuntimeClient(t,
+		builder.ForBackupStorageLocation("velero", "location-1").Result(),
+		builder.ForBackupStorageLocation("velero", "location-2").Default(true).Result(),
+	)
+
+	location, err := getImportLocation(kbClient, "velero", "location-1")
+	require.NoError(t, err)
+	assert.Equal(t, "location-1", location.Name)
+
+	location, err = getImportLocation(kbClient, "velero", "")
+	require.NoError(t, err)
+	assert.Equal(t, "location-2", location.Name)
+
+	_, err = getImportLocation(kbClient, "velero", "location-3")
+	assert.Error(t, err)
+
+	_, err = getImportLocation(test.NewFakeControllerRuntimeClient(t), "velero", "")
+	assert.EqualError(t, err, "there is no default backup storage location; use --storage-location")
+}
+
+func TestWaitForImport(t *testing.T) {
+	request := builder.ForImportBackupRequest("velero", "backup-1-abcde").
+		Phase(velerov1api.ImportBackupRequestPhaseFailed).
+		Result()
+	request.Status.Errors = []string{"backup backup-1 already exists"}
+	kbClient := test.NewFakeControllerRuntimeClient(t, request)
+
+	updated, err := waitForImport(kbClient, request, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.ImportBackupRequestPhaseFailed, updated.Status.Phase)
+	assert.Equal(t, []string{"backup backup-1 already exists"}, updated.Status.Errors)
+
+	pending := builder.ForImportBackupRequest("velero", "backup-2-abcde").Result()
+	require.NoError(t, kbClient.Create(context.Background(), pending))
+
+	waiting := builder.ForImportBackupRequest("velero", "backup-3-abcde").
+		Upload(true).
+		Phase(velerov1api.ImportBackupRequestPhaseWaitingForUpload).
+		UploadURL("an-upload-url").
+		Result()
+	require.NoError(t, kbClient.Create(context.Background(), waiting))
+
+	updated, err = waitForImport(kbClient, waiting, time.Second, velerov1api.ImportBackupRequestPhaseWaitingForUpload)
+	require.NoError(t, err)
+	assert.Equal(t, "an-upload-url", updated.Status.UploadURL)
+
+	_, err = waitForImport(kbClient, pending, time.Second)
+	assert.EqualError(t, err, "timed out waiting for import backup request \"backup-2-abcde\" to be processed, check its status with 'kubectl -n velero get importbackuprequests backup-2-abcde'")
+}
+
+func TestUploadTarball(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backup-1-data.tar.gz")
+	require.NoError(t, ioutil.WriteFile(path, []byte("tarball"), 0600))
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr string
+	}{
+		{
+			name:   "upload succeeds",
+			status: http.StatusOK,
+		},
+		{
+			name:    "upload is rejected",
+			status:  http.StatusForbidden,
+			wantErr: "upload failed: 403 Forbidden: signature expired",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				method        string
+				contentLength int64
+				body          []byte
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method = r.Method
+				contentLength = r.ContentLength
+				body, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					w.Write([]byte("signature expired"))
+				}
+			}))
+			defer server.Close()
+
+			err := uploadTarball(path, server.URL, false, "")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, http.MethodPut, method)
+			assert.Equal(t, int64(len("tarball")), contentLength)
+			assert.Equal(t, "tarball", string(body))
+		})
+	}
+
+	assert.True(t, os.IsNotExist(errors.Cause(uploadTarball(filepath.Join(t.TempDir(), "missing.tar.gz"), "http://localhost", false, ""))))
+}
@@ -0,0 +1,395 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+func NewInspectCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewInspectOptions()
+	o.CACertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "inspect",
+		Short: "Inspect the contents of a backup",
+		Long: `Inspect the Kubernetes manifests in a backup without restoring it.
+
+The BACKUP argument of the subcommands is either the path to a backup tarball on the local
+disk, such as one downloaded with 'velero backup download', or the name of a backup whose
+tarball is downloaded from object storage. Inspecting a local tarball doesn't need a cluster.`,
+	}
+
+	c.AddCommand(
+		newInspectNamespacesCommand(f, o),
+		newInspectResourcesCommand(f, o),
+		newInspectItemsCommand(f, o),
+		newInspectGetCommand(f, o),
+	)
+
+	o.BindFlags(c.PersistentFlags())
+
+	return c
+}
+
+func newInspectNamespacesCommand(f client.Factory, o *InspectOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "namespaces BACKUP",
+		Short: "List the namespaces in a backup and their number of items",
+		Args:  cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.run(f, args[0], func(b *extractedBackup) error {
+				return printBackupNamespaces(os.Stdout, b)
+			}))
+		},
+	}
+}
+
+func newInspectResourcesCommand(f client.Factory, o *InspectOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resources BACKUP",
+		Short: "List the resources in a backup and their number of items",
+		Args:  cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.run(f, args[0], func(b *extractedBackup) error {
+				return printBackupResources(os.Stdout, b)
+			}))
+		},
+	}
+}
+
+func newInspectItemsCommand(f client.Factory, o *InspectOptions) *cobra.Command {
+	var resource, namespace string
+
+	c := &cobra.Command{
+		Use:   "items BACKUP",
+		Short: "List the items in a backup",
+		Example: `  # List all the items in the local tarball "backup-1-data.tar.gz".
+  velero backup inspect items backup-1-data.tar.gz
+
+  # List the deployments in namespace "app-1" in backup "backup-1".
+  velero backup inspect items backup-1 --resource deployments.apps --in-namespace app-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.run(f, args[0], func(b *extractedBackup) error {
+				return printBackupItems(os.Stdout, b, resource, namespace)
+			}))
+		},
+	}
+
+	c.Flags().StringVar(&resource, "resource", resource, "Only list the items of this resource, formatted as resource.group, such as deployments.apps.")
+	c.Flags().StringVar(&namespace, "in-namespace", namespace, "Only list the items in this namespace.")
+
+	return c
+}
+
+func newInspectGetCommand(f client.Factory, o *InspectOptions) *cobra.Command {
+	format := "yaml"
+
+	c := &cobra.Command{
+		Use:   "get BACKUP RESOURCE [NAMESPACE/]NAME",
+		Short: "Print the manifest of an item in a backup",
+		Example: `  # Print the manifest of deployment "app-1/nginx" in backup "backup-1".
+  velero backup inspect get backup-1 deployments.apps app-1/nginx
+
+  # Print the manifest of cluster-scoped persistent volume "pv-1" in the local tarball "backup-1-data.tar.gz" as JSON.
+  velero backup inspect get backup-1-data.tar.gz persistentvolumes pv-1 -o json`,
+		Args: cobra.ExactArgs(3),
+		Run: func(c *cobra.Command, args []string) {
+			if format != "yaml" && format != "json" {
+				cmd.CheckError(errors.Errorf("unsupported output format %q; valid values are 'json' and 'yaml'", format))
+			}
+
+			cmd.CheckError(o.run(f, args[0], func(b *extractedBackup) error {
+				namespace, name := "", args[2]
+				if parts := strings.SplitN(args[2], "/", 2); len(parts) == 2 {
+					namespace, name = parts[0], parts[1]
+				}
+
+				obj, err := b.item(args[1], namespace, name)
+				if err != nil {
+					return err
+				}
+
+				return encode.EncodeTo(obj, format, os.Stdout)
+			}))
+		},
+	}
+
+	c.Flags().StringVarP(&format, "output", "o", format, "Output display format. Valid formats are 'json' and 'yaml'.")
+
+	return c
+}
+
+// InspectOptions are the options of the backup inspect commands.
+type InspectOptions struct {
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CACertFile            string
+}
+
+func NewInspectOptions() *InspectOptions {
+	return &InspectOptions{
+		Timeout: time.Minute,
+	}
+}
+
+func (o *InspectOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to download the backup's tarball.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+// run extracts the backup and calls fn with it.
+func (o *InspectOptions) run(f client.Factory, backup string, fn func(*extractedBackup) error) error {
+	b, err := o.extract(f, backup)
+	if err != nil {
+		return err
+	}
+	defer b.close()
+
+	return fn(b)
+}
+
+// extract extracts the backup, either a local tarball or the tarball of a backup
+// downloaded from object storage.
+func (o *InspectOptions) extract(f client.Factory, backup string) (*extractedBackup, error) {
+	if info, err := os.Stat(backup); err == nil && !info.IsDir() {
+		b, err := extractBackupFile(backup)
+		if err != nil {
+			return nil, err
+		}
+		b.local = true
+		return b, nil
+	}
+
+	return o.downloadAndExtractBackup(f, backup)
+}
+
+// downloadAndExtractBackup downloads the tarball of the named backup to a temp file
+// and extracts it.
+func (o *InspectOptions) downloadAndExtractBackup(f client.Factory, name string) (*extractedBackup, error) {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := ioutil.TempFile("", fmt.Sprintf("%s-data-", name))
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp file")
+	}
+	defer func() {
+		contents.Close()
+		os.Remove(contents.Name())
+	}()
+
+	err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), name, velerov1api.DownloadTargetKindBackupContents, contents, o.Timeout, o.InsecureSkipTLSVerify, o.CACertFile)
+	if err == downloadrequest.ErrNotFound {
+		return nil, errors.Errorf("backup %q not found, and it's not a path to a backup tarball", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := contents.Seek(0, 0); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return extractBackup(contents)
+}
+
+// extractedBackup is a backup tarball extracted to a temp directory.
+type extractedBackup struct {
+	fs        filesystem.Interface
+	dir       string
+	resources map[string]*archive.ResourceItems
+
+	// local is whether the tarball was read from the local disk rather
+	// than downloaded from object storage.
+	local bool
+}
+
+// extractBackupFile extracts the backup tarball at path.
+func extractBackupFile(path string) (*extractedBackup, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	return extractBackup(file)
+}
+
+// extractBackup extracts a backup tarball, compressed with any of the
+// supported algorithms, to a temp directory and parses its contents.
+func extractBackup(tarball io.Reader) (*extractedBackup, error) {
+	log := logrus.New()
+	log.SetLevel(logrus.WarnLevel)
+
+	fs := filesystem.NewFileSystem()
+	dir, err := archive.NewExtractor(log, fs).UnzipAndExtractBackup(tarball)
+	if err != nil {
+		return nil, errors.Wrap(err, "error extracting backup tarball")
+	}
+
+	resources, err := archive.NewParser(log, fs).Parse(dir)
+	if err != nil {
+		fs.RemoveAll(dir)
+		return nil, errors.Wrap(err, "error parsing backup tarball")
+	}
+
+	return &extractedBackup{
+		fs:        fs,
+		dir:       dir,
+		resources: resources,
+	}, nil
+}
+
+// close removes the extracted backup.
+func (b *extractedBackup) close() error {
+	return b.fs.RemoveAll(b.dir)
+}
+
+// item returns the item of the given resource, namespace and name.
+func (b *extractedBackup) item(resource, namespace, name string) (*unstructured.Unstructured, error) {
+	if _, ok := b.resources[resource]; !ok {
+		return nil, errors.Errorf("backup has no items of resource %q", resource)
+	}
+
+	path := archive.GetItemFilePath(b.dir, resource, namespace, name)
+	if _, err := b.fs.Stat(path); os.IsNotExist(err) {
+		if namespace != "" {
+			return nil, errors.Errorf("backup has no %s %s/%s", resource, namespace, name)
+		}
+		return nil, errors.Errorf("backup has no %s %s", resource, name)
+	} else if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	obj, err := archive.Unmarshal(b.fs, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding %s", strings.TrimPrefix(path, b.dir+"/"))
+	}
+	return obj, nil
+}
+
+// sortedResources returns the names of the resources in the backup, sorted.
+func (b *extractedBackup) sortedResources() []string {
+	resources := make([]string, 0, len(b.resources))
+	for resource := range b.resources {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+func printBackupNamespaces(w io.Writer, b *extractedBackup) error {
+	counts := map[string]int{}
+	for _, resource := range b.resources {
+		for namespace, items := range resource.ItemsByNamespace {
+			if namespace != "" {
+				counts[namespace] += len(items)
+			}
+		}
+	}
+
+	namespaces := make([]string, 0, len(counts))
+	for namespace := range counts {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tITEMS")
+	for _, namespace := range namespaces {
+		fmt.Fprintf(tw, "%s\t%d\n", namespace, counts[namespace])
+	}
+	return tw.Flush()
+}
+
+func printBackupResources(w io.Writer, b *extractedBackup) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "RESOURCE\tITEMS")
+	for _, resource := range b.sortedResources() {
+		count := 0
+		for _, items := range b.resources[resource].ItemsByNamespace {
+			count += len(items)
+		}
+		fmt.Fprintf(tw, "%s\t%d\n", resource, count)
+	}
+	return tw.Flush()
+}
+
+// printBackupItems prints the items in the backup, optionally only the ones of
+// the given resource or in the given namespace.
+func printBackupItems(w io.Writer, b *extractedBackup, resource, namespace string) error {
+	if _, ok := b.resources[resource]; resource != "" && !ok {
+		return errors.Errorf("backup has no items of resource %q", resource)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "RESOURCE\tNAMESPACE\tNAME")
+	for _, r := range b.sortedResources() {
+		if resource != "" && r != resource {
+			continue
+		}
+
+		itemsByNamespace := b.resources[r].ItemsByNamespace
+		namespaces := make([]string, 0, len(itemsByNamespace))
+		for ns := range itemsByNamespace {
+			if namespace == "" || ns == namespace {
+				namespaces = append(namespaces, ns)
+			}
+		}
+		sort.Strings(namespaces)
+
+		for _, ns := range namespaces {
+			items := append([]string(nil), itemsByNamespace[ns]...)
+			sort.Strings(items)
+			for _, item := range items {
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", r, ns, item)
+			}
+		}
+	}
+	return tw.Flush()
+}
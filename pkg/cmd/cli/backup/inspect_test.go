@@ -0,0 +1,131 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestInspectBackup(t *testing.T) {
+	tarball := test.NewTarWriter(t).
+		AddItems("pods",
+			builder.ForPod("ns-2", "pod-3").Result(),
+			builder.ForPod("ns-1", "pod-2").Result(),
+			builder.ForPod("ns-1", "pod-1").Result(),
+		).
+		AddItems("deployments.apps", builder.ForDeployment("ns-1", "deploy-1").Result()).
+		AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+		Done()
+
+	b, err := extractBackup(tarball)
+	require.NoError(t, err)
+	defer b.close()
+
+	tests := []struct {
+		name    string
+		print   func(*bytes.Buffer) error
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "namespaces are listed with their number of items",
+			print: func(w *bytes.Buffer) error { return printBackupNamespaces(w, b) },
+			want: `NAMESPACE  ITEMS
+ns-1       3
+ns-2       1
+`,
+		},
+		{
+			name:  "resources are listed with their number of items",
+			print: func(w *bytes.Buffer) error { return printBackupResources(w, b) },
+			want: `RESOURCE           ITEMS
+deployments.apps   1
+persistentvolumes  1
+pods               3
+`,
+		},
+		{
+			name:  "all items are listed sorted by resource, namespace and name",
+			print: func(w *bytes.Buffer) error { return printBackupItems(w, b, "", "") },
+			want: `RESOURCE           NAMESPACE  NAME
+deployments.apps   ns-1       deploy-1
+persistentvolumes             pv-1
+pods               ns-1       pod-1
+pods               ns-1       pod-2
+pods               ns-2       pod-3
+`,
+		},
+		{
+			name:  "items are filtered by resource and namespace",
+			print: func(w *bytes.Buffer) error { return printBackupItems(w, b, "pods", "ns-1") },
+			want: `RESOURCE  NAMESPACE  NAME
+pods      ns-1       pod-1
+pods      ns-1       pod-2
+`,
+		},
+		{
+			name:    "listing the items of a resource that isn't in the backup fails",
+			print:   func(w *bytes.Buffer) error { return printBackupItems(w, b, "secrets", "") },
+			wantErr: `backup has no items of resource "secrets"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := tc.print(buf)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+func TestExtractedBackupItem(t *testing.T) {
+	b, err := extractBackup(test.NewTarWriter(t).
+		AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+		AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+		Done())
+	require.NoError(t, err)
+	defer b.close()
+
+	pod, err := b.item("pods", "ns-1", "pod-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Pod", pod.GetKind())
+	assert.Equal(t, "ns-1", pod.GetNamespace())
+	assert.Equal(t, "pod-1", pod.GetName())
+
+	pv, err := b.item("persistentvolumes", "", "pv-1")
+	require.NoError(t, err)
+	assert.Equal(t, "pv-1", pv.GetName())
+
+	_, err = b.item("pods", "ns-2", "pod-1")
+	assert.EqualError(t, err, "backup has no pods ns-2/pod-1")
+
+	_, err = b.item("secrets", "ns-1", "secret-1")
+	assert.EqualError(t, err, `backup has no items of resource "secrets"`)
+}
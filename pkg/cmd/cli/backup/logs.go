@@ -63,8 +63,9 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 			}
 
 			switch backup.Status.Phase {
-			case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
-				// terminal phases, do nothing.
+			case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed,
+				velerov1api.BackupPhaseUploading, velerov1api.BackupPhaseUploadingPartialFailure, velerov1api.BackupPhaseCanceled:
+				// phases in which the backup's log has been uploaded, do nothing.
 			default:
 				cmd.Exit("Logs for backup %q are not available until it's finished processing. Please wait "+
 					"until the backup has a phase of Completed or Failed and try again.", backupName)
@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+)
+
+func NewVerifyCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+
+	timeout := time.Minute
+	insecureSkipTLSVerify := false
+	caCertFile := config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "verify BACKUP",
+		Short: "Verify the contents of a backup against its manifest",
+		Long: `Download the tarball of a backup and verify the size and SHA-256 checksum of every file in it
+against the manifest recorded when the backup was taken. Contents of persistent volume snapshots are not verified.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			backupName := args[0]
+
+			veleroClient, err := f.Client()
+			cmd.CheckError(err)
+
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), backupName, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				cmd.Exit("Backup %q does not exist.", backupName)
+			} else if err != nil {
+				cmd.Exit("Error checking for backup %q: %v", backupName, err)
+			}
+
+			switch backup.Status.Phase {
+			case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+			default:
+				cmd.Exit("Backup %q can't be verified in phase %s. Only Completed and PartiallyFailed backups can be verified.", backupName, backup.Status.Phase)
+			}
+
+			manifestJSON := new(bytes.Buffer)
+			err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), backupName, velerov1api.DownloadTargetKindBackupManifest, manifestJSON, timeout, insecureSkipTLSVerify, caCertFile)
+			if err == downloadrequest.ErrNotFound {
+				cmd.Exit("Backup %q has no manifest. It was probably taken by a version of Velero that didn't record manifests.", backupName)
+			}
+			cmd.CheckError(err)
+
+			manifest := new(pkgbackup.Manifest)
+			if err := json.Unmarshal(manifestJSON.Bytes(), manifest); err != nil {
+				cmd.CheckError(errors.Wrap(err, "error decoding backup manifest"))
+			}
+
+			contents, err := ioutil.TempFile("", fmt.Sprintf("%s-data-", backupName))
+			cmd.CheckError(err)
+			defer func() {
+				contents.Close()
+				os.Remove(contents.Name())
+			}()
+
+			err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), backupName, velerov1api.DownloadTargetKindBackupContents, contents, timeout, insecureSkipTLSVerify, caCertFile)
+			cmd.CheckError(err)
+
+			_, err = contents.Seek(0, 0)
+			cmd.CheckError(err)
+
+			problems, err := pkgbackup.VerifyContents(contents, manifest)
+			for _, problem := range problems {
+				fmt.Printf("\t%s\n", problem)
+			}
+			if err != nil {
+				cmd.Exit("Backup %q is corrupt: %v", backupName, err)
+			}
+			if len(problems) > 0 {
+				cmd.Exit("Backup %q is corrupt: %d of %d files don't match the manifest.", backupName, len(problems), len(manifest.Entries))
+			}
+
+			fmt.Printf("Backup %q verified: all %d files match the manifest.\n", backupName, len(manifest.Entries))
+		},
+	}
+
+	c.Flags().DurationVar(&timeout, "timeout", timeout, "How long to wait to download the backup's manifest and contents.")
+	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	return c
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -65,6 +66,10 @@ type CreateOptions struct {
 	Provider                              string
 	Bucket                                string
 	Credential                            flag.Map
+	EncryptionKey                         flag.Map
+	AllowUnencrypted                      bool
+	Compression                           string
+	CompressionLevel                      int
 	DefaultBackupStorageLocation          bool
 	Prefix                                string
 	BackupSyncPeriod, ValidationFrequency time.Duration
@@ -76,9 +81,10 @@ type CreateOptions struct {
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Credential: flag.NewMap(),
-		Config:     flag.NewMap(),
-		Labels:     flag.NewMap(),
+		Credential:    flag.NewMap(),
+		EncryptionKey: flag.NewMap(),
+		Config:        flag.NewMap(),
+		Labels:        flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
@@ -91,6 +97,10 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "Name of the backup storage provider (e.g. aws, azure, gcp).")
 	flags.StringVar(&o.Bucket, "bucket", o.Bucket, "Name of the object storage bucket where backups should be stored.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.Var(&o.EncryptionKey, "encryption-key", "The key to encrypt the objects stored in this location with as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. The key must be 32 bytes long. Optional, one value only.")
+	flags.BoolVar(&o.AllowUnencrypted, "allow-unencrypted", o.AllowUnencrypted, "Allow reading the objects of this location that aren't encrypted, such as the ones stored before encryption was enabled. Requires --encryption-key. Optional.")
+	flags.StringVar(&o.Compression, "compression", o.Compression, fmt.Sprintf("Algorithm the tarballs of the backups stored in this location are compressed with, unless the backups set it themselves. Valid values are %q, %q and %q. Optional, defaults to gzip.", velerov1api.CompressionAlgorithmGzip, velerov1api.CompressionAlgorithmZstd, velerov1api.CompressionAlgorithmNone))
+	flags.IntVar(&o.CompressionLevel, "compression-level", o.CompressionLevel, "Level the tarballs of the backups stored in this location are compressed with, from 1 to 9 for gzip and from 1 to 22 for zstd. Optional, defaults to the algorithm's default level.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "Prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync. Default: 1 minute.")
@@ -126,6 +136,27 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if len(o.EncryptionKey.Data()) > 1 {
+		return errors.New("--encryption-key can only contain 1 key/value pair")
+	}
+
+	if o.AllowUnencrypted && len(o.EncryptionKey.Data()) == 0 {
+		return errors.New("--allow-unencrypted requires --encryption-key")
+	}
+
+	if o.CompressionLevel != 0 && o.Compression == "" {
+		return errors.New("--compression-level requires --compression")
+	}
+
+	if o.Compression != "" {
+		if err := compression.Validate(velerov1api.CompressionConfig{
+			Algorithm: velerov1api.CompressionAlgorithm(o.Compression),
+			Level:     o.CompressionLevel,
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -181,6 +212,21 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		break
 	}
 
+	if o.Compression != "" {
+		backupStorageLocation.Spec.Compression = &velerov1api.CompressionConfig{
+			Algorithm: velerov1api.CompressionAlgorithm(o.Compression),
+			Level:     o.CompressionLevel,
+		}
+	}
+
+	for secretName, secretKey := range o.EncryptionKey.Data() {
+		backupStorageLocation.Spec.Encryption = &velerov1api.EncryptionConfig{
+			Key:              *builder.ForSecretKeySelector(secretName, secretKey).Result(),
+			AllowUnencrypted: o.AllowUnencrypted,
+		}
+		break
+	}
+
 	return backupStorageLocation, nil
 }
 
@@ -23,6 +23,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 func TestBuildBackupStorageLocationSetsNamespace(t *testing.T) {
@@ -77,6 +79,31 @@ func TestBuildBackupStorageLocationSetsCredential(t *testing.T) {
 	}, bsl.Spec.Credential)
 }
 
+func TestBuildBackupStorageLocationSetsEncryptionKey(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Encryption)
+
+	setErr := o.EncryptionKey.Set("my-secret=key-from-secret")
+	assert.NoError(t, setErr)
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.EncryptionConfig{
+		Key: v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "my-secret"},
+			Key:                  "key-from-secret",
+		},
+	}, bsl.Spec.Encryption)
+
+	o.AllowUnencrypted = true
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.True(t, bsl.Spec.Encryption.AllowUnencrypted)
+}
+
 func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	o := NewCreateOptions()
 
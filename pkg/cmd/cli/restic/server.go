@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -196,6 +197,10 @@ func (s *resticServer) run() {
 	s.metrics.RegisterAllMetrics()
 	s.metrics.InitResticMetricsForNode(os.Getenv("NODE_NAME"))
 
+	if err := s.markInProgressPodVolumeBackupsFailed(os.Getenv("NODE_NAME")); err != nil {
+		s.logger.WithError(err).Error("Error marking in progress pod volume backups as failed")
+	}
+
 	s.logger.Info("Starting controllers")
 
 	credentialFileStore, err := credentials.NewNamespacedFileStore(
@@ -258,6 +263,36 @@ func (s *resticServer) run() {
 	}
 }
 
+// markInProgressPodVolumeBackupsFailed marks the pod volume backups of the node
+// that are still in progress as failed. Their restic processes ended when the
+// previous restic server on the node stopped, and the controller doesn't pick up
+// pod volume backups that are in progress, so a backup waiting for them would
+// otherwise wait until it times out.
+func (s *resticServer) markInProgressPodVolumeBackupsFailed(nodeName string) error {
+	pvbs, err := s.veleroClient.VeleroV1().PodVolumeBackups(s.namespace).List(s.ctx, metav1.ListOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for i := range pvbs.Items {
+		pvb := &pvbs.Items[i]
+		if pvb.Spec.Node != nodeName || pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseInProgress {
+			continue
+		}
+
+		pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseFailed
+		pvb.Status.Message = "the restic server was restarted while the pod volume backup was in progress"
+		pvb.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		if _, err := s.veleroClient.VeleroV1().PodVolumeBackups(s.namespace).UpdateStatus(s.ctx, pvb, metav1.UpdateOptions{}); err != nil {
+			s.logger.WithError(err).Errorf("Error marking pod volume backup %s/%s as failed", pvb.Namespace, pvb.Name)
+			continue
+		}
+		s.logger.Infof("Marked pod volume backup %s/%s, which was in progress, as failed", pvb.Namespace, pvb.Name)
+	}
+
+	return nil
+}
+
 // validatePodVolumesHostPath validates that the pod volumes path contains a
 // directory for each Pod running on this node
 func (s *resticServer) validatePodVolumesHostPath() error {
@@ -22,12 +22,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerofake "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -109,3 +112,43 @@ func Test_validatePodVolumesHostPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_markInProgressPodVolumeBackupsFailed(t *testing.T) {
+	pvbs := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "new").Node("node-1").Phase(velerov1api.PodVolumeBackupPhaseNew).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "in-progress").Node("node-1").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "in-progress-other-node").Node("node-2").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "completed").Node("node-1").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+	}
+
+	veleroClient := velerofake.NewSimpleClientset()
+	for _, pvb := range pvbs {
+		_, err := veleroClient.VeleroV1().PodVolumeBackups(pvb.Namespace).Create(context.TODO(), pvb, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	s := &resticServer{
+		veleroClient: veleroClient,
+		logger:       testutil.NewLogger(),
+		ctx:          context.TODO(),
+		namespace:    velerov1api.DefaultNamespace,
+	}
+
+	require.NoError(t, s.markInProgressPodVolumeBackupsFailed("node-1"))
+
+	wantPhases := map[string]velerov1api.PodVolumeBackupPhase{
+		"new":                    velerov1api.PodVolumeBackupPhaseNew,
+		"in-progress":            velerov1api.PodVolumeBackupPhaseFailed,
+		"in-progress-other-node": velerov1api.PodVolumeBackupPhaseInProgress,
+		"completed":              velerov1api.PodVolumeBackupPhaseCompleted,
+	}
+	for name, phase := range wantPhases {
+		pvb, err := veleroClient.VeleroV1().PodVolumeBackups(velerov1api.DefaultNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, phase, pvb.Status.Phase, name)
+		if phase == velerov1api.PodVolumeBackupPhaseFailed {
+			assert.NotEmpty(t, pvb.Status.Message)
+			assert.NotNil(t, pvb.Status.CompletionTimestamp)
+		}
+	}
+}
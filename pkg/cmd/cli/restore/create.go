@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -57,7 +58,16 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero restore create --from-schedule schedule-1 --allow-partially-failed
 
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
-  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes`,
+  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Create a restore from backup "backup-1" that updates in-cluster resources that differ from the backed-up version.
+  velero restore create --from-backup backup-1 --existing-resource-policy update
+
+  # Create a restore from backup "backup-1" that modifies items using the rules in configmap "modifiers-1".
+  velero restore create --from-backup backup-1 --resource-modifier-configmap modifiers-1
+
+  # Report what restoring backup "backup-1" would do to the cluster, without changing anything.
+  velero restore create --from-backup backup-1 --dry-run`,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -74,21 +84,26 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	BackupName              string
-	ScheduleName            string
-	RestoreName             string
-	RestoreVolumes          flag.OptionalBool
-	PreserveNodePorts       flag.OptionalBool
-	Labels                  flag.Map
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	NamespaceMappings       flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	AllowPartiallyFailed    flag.OptionalBool
+	BackupName                string
+	ScheduleName              string
+	RestoreName               string
+	RestoreVolumes            flag.OptionalBool
+	PreserveNodePorts         flag.OptionalBool
+	Labels                    flag.Map
+	IncludeNamespaces         flag.StringArray
+	ExcludeNamespaces         flag.StringArray
+	IncludeResources          flag.StringArray
+	ExcludeResources          flag.StringArray
+	NamespaceMappings         flag.Map
+	Selector                  flag.LabelSelector
+	IncludeClusterResources   flag.OptionalBool
+	Wait                      bool
+	AllowPartiallyFailed      flag.OptionalBool
+	ExistingResourcePolicy    string
+	ResourceModifierConfigMap string
+	DryRun                    bool
+	InsecureSkipTLSVerify     bool
+	CACertFile                string
 
 	client veleroclient.Interface
 }
@@ -114,6 +129,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
+	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", "", "Name of the configmap in the Velero namespace holding the rules used to modify items before they're restored.")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore policy for resources that already exist in the cluster and differ from the backed-up version. Valid values are 'none' and 'update'. Defaults to 'none'.")
 	f := flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
 	// this allows the user to just specify "--restore-volumes" as shorthand for "--restore-volumes=true"
 	// like a normal bool flag
@@ -131,6 +148,10 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = "true"
 
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
+
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Report whether each item of the backup would be created, already exists identically, already exists with differences or would be skipped, without changing anything in the cluster. Waits for the dry run to complete.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity when downloading the dry run plan. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "Path to a certificate bundle to use when verifying TLS connections when downloading the dry run plan.")
 }
 
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
@@ -145,6 +166,10 @@ func (o *CreateOptions) Complete(args []string, f client.Factory) error {
 		o.RestoreName = fmt.Sprintf("%s-%s", sourceName, time.Now().Format("20060102150405"))
 	}
 
+	if o.DryRun {
+		o.Wait = true
+	}
+
 	client, err := f.Client()
 	if err != nil {
 		return err
@@ -167,6 +192,12 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return err
 	}
 
+	switch api.PolicyType(o.ExistingResourcePolicy) {
+	case "", api.PolicyTypeNone, api.PolicyTypeUpdate:
+	default:
+		return errors.Errorf("invalid value for --existing-resource-policy %q, must be one of %q or %q", o.ExistingResourcePolicy, api.PolicyTypeNone, api.PolicyTypeUpdate)
+	}
+
 	if o.client == nil {
 		// This should never happen
 		return errors.New("Velero client is not set; unable to proceed")
@@ -269,9 +300,18 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy),
+			DryRun:                  o.DryRun,
 		},
 	}
 
+	if o.ResourceModifierConfigMap != "" {
+		restore.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{
+			Kind: "ConfigMap",
+			Name: o.ResourceModifierConfigMap,
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
@@ -339,6 +379,9 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 
 				if restore.Status.Phase != api.RestorePhaseNew && restore.Status.Phase != api.RestorePhaseInProgress {
 					fmt.Printf("\nRestore completed with status: %s. You may check for more information using the commands `velero restore describe %s` and `velero restore logs %s`.\n", restore.Status.Phase, restore.Name, restore.Name)
+					if restore.Spec.DryRun {
+						return o.printDryRunPlan(f, restore)
+					}
 					return nil
 				}
 			}
@@ -351,3 +394,28 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 
 	return nil
 }
+
+// printDryRunPlan prints the plan of a completed dry-run restore.
+func (o *CreateOptions) printDryRunPlan(f client.Factory, restore *api.Restore) error {
+	switch restore.Status.Phase {
+	case api.RestorePhaseCompleted, api.RestorePhasePartiallyFailed:
+	default:
+		return nil
+	}
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	caCertFile := o.CACertFile
+	if caCertFile == "" {
+		if config, err := client.LoadConfig(); err == nil {
+			caCertFile = config.CACertFile()
+		}
+	}
+
+	fmt.Println()
+	fmt.Print(output.DescribeRestoreDryRunPlan(context.Background(), kbClient, restore, o.InsecureSkipTLSVerify, caCertFile))
+	return nil
+}
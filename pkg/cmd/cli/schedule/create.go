@@ -19,10 +19,12 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -62,7 +64,16 @@ example: "@every 2h30m".`,
   velero create schedule NAME --schedule="@every 24h" --include-namespaces web
 
   # Create a weekly backup, each living for 90 days (2160 hours).
-  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s`,
+  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s
+
+  # Create a daily backup that doesn't run until it is unpaused.
+  velero create schedule NAME --schedule="@every 24h" --paused
+
+  # Create a daily backup at 3am that skips backups missed while the Velero server was down.
+  velero create schedule NAME --schedule="0 3 * * *" --missed-run-policy skip
+
+  # Create a daily backup, keeping the daily backups of the last 7 days, the weekly ones of the last 4 weeks and the monthly ones of the last 12 months.
+  velero create schedule NAME --schedule="0 3 * * *" --keep-daily 7 --keep-weekly 4 --keep-monthly 12`,
 		Args: cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -82,6 +93,14 @@ type CreateOptions struct {
 	BackupOptions              *backup.CreateOptions
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
+	Paused                     bool
+	SkipImmediately            bool
+	MissedRunPolicy            string
+	StartingDeadline           time.Duration
+	KeepLast                   int
+	KeepDaily                  int
+	KeepWeekly                 int
+	KeepMonthly                int
 
 	labelSelector *metav1.LabelSelector
 }
@@ -96,6 +115,14 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule. Notice: if set to true, when schedule is deleted, backups will be deleted too.")
+	flags.BoolVar(&o.Paused, "paused", o.Paused, "Specifies whether the newly created schedule is paused or not.")
+	flags.BoolVar(&o.SkipImmediately, "skip-immediately", o.SkipImmediately, "Specifies whether to skip a run that is due when the schedule is created or unpaused, and wait for the next scheduled time instead.")
+	flags.StringVar(&o.MissedRunPolicy, "missed-run-policy", o.MissedRunPolicy, fmt.Sprintf("What to do with runs missed e.g. while the Velero server was down. Valid values are %q, to run a single backup for them, and %q, to wait for the next scheduled time. Optional.", api.MissedRunPolicyRunOnce, api.MissedRunPolicySkip))
+	flags.DurationVar(&o.StartingDeadline, "starting-deadline", o.StartingDeadline, "How late a scheduled run may be triggered before it's considered missed and handled according to the missed run policy. Defaults to 5m. Optional.")
+	flags.IntVar(&o.KeepLast, "keep-last", o.KeepLast, "Number of most recent backups to keep. Setting any of the --keep flags replaces the TTL of the completed backups with the retention policy. Optional.")
+	flags.IntVar(&o.KeepDaily, "keep-daily", o.KeepDaily, "Number of days, including the current one, for which the most recent backup of each day is kept. Optional.")
+	flags.IntVar(&o.KeepWeekly, "keep-weekly", o.KeepWeekly, "Number of weeks, including the current one, for which the most recent backup of each week is kept. Optional.")
+	flags.IntVar(&o.KeepMonthly, "keep-monthly", o.KeepMonthly, "Number of months, including the current one, for which the most recent backup of each month is kept. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -103,6 +130,20 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	switch api.MissedRunPolicy(o.MissedRunPolicy) {
+	case "", api.MissedRunPolicyRunOnce, api.MissedRunPolicySkip:
+	default:
+		return errors.Errorf("invalid --missed-run-policy %q, must be %q or %q", o.MissedRunPolicy, api.MissedRunPolicyRunOnce, api.MissedRunPolicySkip)
+	}
+
+	if o.StartingDeadline < 0 {
+		return errors.New("--starting-deadline must not be negative")
+	}
+
+	if o.KeepLast < 0 || o.KeepDaily < 0 || o.KeepWeekly < 0 || o.KeepMonthly < 0 {
+		return errors.New("--keep-last, --keep-daily, --keep-weekly and --keep-monthly must not be negative")
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -145,12 +186,35 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
 				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
 				OrderedResources:        orders,
+				UploaderType:            api.UploaderType(o.BackupOptions.UploaderType),
+				ItemBackupWorkers:       o.BackupOptions.ItemBackupWorkers,
+				Compression:             o.BackupOptions.CompressionConfig(),
 			},
 			Schedule:                   o.Schedule,
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
+			Paused:                     o.Paused,
+			SkipImmediately:            o.SkipImmediately,
+			MissedRunPolicy:            api.MissedRunPolicy(o.MissedRunPolicy),
+			StartingDeadline:           metav1.Duration{Duration: o.StartingDeadline},
 		},
 	}
 
+	if o.KeepLast > 0 || o.KeepDaily > 0 || o.KeepWeekly > 0 || o.KeepMonthly > 0 {
+		schedule.Spec.Retention = &api.RetentionPolicy{
+			KeepLast:    o.KeepLast,
+			KeepDaily:   o.KeepDaily,
+			KeepWeekly:  o.KeepWeekly,
+			KeepMonthly: o.KeepMonthly,
+		}
+	}
+
+	if o.BackupOptions.ResourcePolicyConfigMap != "" {
+		schedule.Spec.Template.ResourcePolicy = &corev1api.TypedLocalObjectReference{
+			Kind: "ConfigMap",
+			Name: o.BackupOptions.ResourcePolicyConfigMap,
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, schedule); printed || err != nil {
 		return err
 	}
@@ -0,0 +1,148 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewPauseCommand creates and returns a new cobra command for pausing schedules.
+func NewPauseCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("Pause", "schedule")
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Pause schedules",
+		Example: `  # Pause a schedule named "schedule-1".
+  velero schedule pause schedule-1
+
+  # Pause schedules named "schedule-1" and "schedule-2".
+  velero schedule pause schedule-1 schedule-2
+
+  # Pause all schedules labelled with "foo=bar".
+  velero schedule pause --selector foo=bar
+
+  # Pause all schedules.
+  velero schedule pause --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runPause(f, o, true, false))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// runPause sets the paused flag of the selected schedules. If skipImmediately
+// is true, their skipImmediately flag is set as well.
+func runPause(f client.Factory, o *cli.SelectOptions, paused, skipImmediately bool) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	var (
+		schedules []*velerov1api.Schedule
+		errs      []error
+	)
+	switch {
+	case len(o.Names) > 0:
+		for _, name := range o.Names {
+			schedule, err := veleroClient.VeleroV1().Schedules(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			schedules = append(schedules, schedule)
+		}
+	default:
+		selector := labels.Everything().String()
+		if o.Selector.LabelSelector != nil {
+			selector = o.Selector.String()
+		}
+		res, err := veleroClient.VeleroV1().Schedules(f.Namespace()).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+		} else {
+			for i := range res.Items {
+				schedules = append(schedules, &res.Items[i])
+			}
+		}
+	}
+	if len(schedules) == 0 {
+		fmt.Println("No schedules found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	msg := "paused"
+	if !paused {
+		msg = "unpaused"
+	}
+	for _, schedule := range schedules {
+		if schedule.Spec.Paused == paused {
+			fmt.Printf("Schedule %s is already %s, skipping\n", schedule.Name, msg)
+			continue
+		}
+
+		original, err := json.Marshal(schedule)
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+		schedule.Spec.Paused = paused
+		if skipImmediately {
+			schedule.Spec.SkipImmediately = true
+		}
+		updated, err := json.Marshal(schedule)
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+		patchBytes, err := jsonpatch.CreateMergePatch(original, updated)
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+
+		if _, err := veleroClient.VeleroV1().Schedules(schedule.Namespace).Patch(context.TODO(), schedule.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update schedule %s", schedule.Name))
+			continue
+		}
+		fmt.Printf("Schedule %s %s successfully\n", schedule.Name, msg)
+	}
+	return kubeerrs.NewAggregate(errs)
+}
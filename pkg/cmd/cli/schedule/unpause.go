@@ -0,0 +1,62 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewUnpauseCommand creates and returns a new cobra command for unpausing schedules.
+func NewUnpauseCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("Unpause", "schedule")
+	skipImmediately := false
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Unpause schedules",
+		Example: `  # Unpause a schedule named "schedule-1".
+  velero schedule unpause schedule-1
+
+  # Unpause schedules named "schedule-1" and "schedule-2".
+  velero schedule unpause schedule-1 schedule-2
+
+  # Unpause all schedules labelled with "foo=bar".
+  velero schedule unpause --selector foo=bar
+
+  # Unpause all schedules.
+  velero schedule unpause --all
+
+  # Unpause a schedule named "schedule-1" without running a backup until its next scheduled time.
+  velero schedule unpause schedule-1 --skip-immediately`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runPause(f, o, false, skipImmediately))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	c.Flags().BoolVar(&skipImmediately, "skip-immediately", skipImmediately, "Set the schedules' skipImmediately flag, so that a run that's due when they're unpaused is skipped and they wait for their next scheduled time.")
+
+	return c
+}
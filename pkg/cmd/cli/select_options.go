@@ -0,0 +1,71 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+)
+
+// SelectOptions contains parameters used for selecting the objects a
+// command operates on: either specific names, all objects, or the
+// objects matching a label selector.
+type SelectOptions struct {
+	Names            []string
+	All              bool
+	Selector         flag.LabelSelector
+	verb             string
+	singularTypeName string
+}
+
+// NewSelectOptions returns SelectOptions for a command that applies verb,
+// e.g. "Pause", to objects of the given type.
+func NewSelectOptions(verb, singularTypeName string) *SelectOptions {
+	return &SelectOptions{
+		verb:             verb,
+		singularTypeName: singularTypeName,
+	}
+}
+
+// Complete fills in the correct values for all the options.
+func (o *SelectOptions) Complete(args []string) error {
+	o.Names = args
+	return nil
+}
+
+// Validate validates the fields of the SelectOptions struct.
+func (o *SelectOptions) Validate() error {
+	var (
+		hasNames    = len(o.Names) > 0
+		hasAll      = o.All
+		hasSelector = o.Selector.LabelSelector != nil
+	)
+	if !xor(hasNames, hasAll, hasSelector) {
+		return errors.New("you must specify exactly one of: specific " + o.singularTypeName + " name(s), the --all flag, or the --selector flag")
+	}
+
+	return nil
+}
+
+// BindFlags binds options for this command to flags.
+func (o *SelectOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.All, "all", o.All, o.verb+" all "+o.singularTypeName+"s")
+	flags.VarP(&o.Selector, "selector", "l", o.verb+" all "+o.singularTypeName+"s matching this label selector.")
+}
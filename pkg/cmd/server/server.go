@@ -69,6 +69,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
@@ -89,6 +90,7 @@ const (
 	defaultStoreValidationFrequency   = time.Minute
 	defaultPodVolumeOperationTimeout  = 240 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
+	defaultItemOperationTimeout       = 4 * time.Hour
 
 	// server's client default qps and burst
 	defaultClientQPS      float32 = 20.0
@@ -98,8 +100,13 @@ const (
 	defaultProfilerAddress = "localhost:6060"
 
 	defaultControllerWorkers = 1
+
+	// the default number of items of the same resource backed up concurrently
+	defaultItemBackupWorkers = 1
 	// the default TTL for a backup
 	defaultBackupTTL = 30 * 24 * time.Hour
+	// the default number of times a backup interrupted by a server restart is attempted
+	defaultBackupMaxAttempts = 3
 
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
@@ -122,6 +129,11 @@ type serverConfig struct {
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
 	defaultVolumesToRestic                                                  bool
+	uploaderType                                                            string
+	itemBackupWorkers                                                       int
+	concurrentBackups                                                       int
+	backupMaxAttempts                                                       int
+	itemOperationTimeout                                                    time.Duration
 }
 
 type controllerRunInfo struct {
@@ -151,6 +163,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
 			defaultVolumesToRestic:            restic.DefaultVolumesToRestic,
+			uploaderType:                      string(velerov1api.UploaderTypeRestic),
+			itemBackupWorkers:                 defaultItemBackupWorkers,
+			concurrentBackups:                 defaultControllerWorkers,
+			backupMaxAttempts:                 defaultBackupMaxAttempts,
+			itemOperationTimeout:              defaultItemOperationTimeout,
 		}
 	)
 
@@ -186,6 +203,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			if err := uploader.ValidateUploaderType(config.uploaderType); err != nil {
+				logger.Fatal(err)
+			}
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -215,6 +236,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "How often 'restic prune' is run for restic repositories by default.")
 	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
+	command.Flags().IntVar(&config.concurrentBackups, "concurrent-backups", config.concurrentBackups, "Maximum number of backups run concurrently. Backups including the same namespaces are never run concurrently.")
+	command.Flags().IntVar(&config.backupMaxAttempts, "backup-max-attempts", config.backupMaxAttempts, "Maximum number of times a backup is attempted when it's interrupted by a restart of the server. Interrupted backups are marked as failed if set to 1.")
+	command.Flags().DurationVar(&config.itemOperationTimeout, "item-operation-timeout", config.itemOperationTimeout, "How long the item snapshots of a backup may be uploaded after its items are backed up. The ones still in progress after that are failed.")
+	command.Flags().IntVar(&config.itemBackupWorkers, "item-backup-workers", config.itemBackupWorkers, "Number of items of the same resource backed up concurrently by backups that don't specify it. Items are backed up one at a time if set to 1.")
+	command.Flags().StringVar(&config.uploaderType, "uploader-type", config.uploaderType, fmt.Sprintf("The default type of the uploader used to back up pod volumes from the file system for backups that don't specify one. Valid values are %q and %q.", velerov1api.UploaderTypeRestic, velerov1api.UploaderTypeKopia))
 
 	return command
 }
@@ -258,6 +284,14 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, errors.New("client-page-size must not be negative")
 	}
 
+	if config.concurrentBackups <= 0 {
+		return nil, errors.New("concurrent-backups must be positive")
+	}
+
+	if config.itemBackupWorkers <= 0 {
+		return nil, errors.New("item-backup-workers must be positive")
+	}
+
 	kubeClient, err := f.KubeClient()
 	if err != nil {
 		return nil, err
@@ -625,6 +659,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.podVolumeOperationTimeout,
 			s.config.defaultVolumesToRestic,
 			s.config.clientPageSize,
+			s.config.itemBackupWorkers,
 		)
 		cmd.CheckError(err)
 
@@ -640,6 +675,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.mgr.GetClient(),
 			s.config.defaultBackupLocation,
 			s.config.defaultVolumesToRestic,
+			s.config.uploaderType,
 			s.config.defaultBackupTTL,
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
 			defaultVolumeSnapshotLocations,
@@ -649,10 +685,30 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			csiVSCLister,
 			csiVSClassLister,
 			backupStoreGetter,
+			s.config.backupMaxAttempts,
+			s.resticManager,
 		)
 
 		return controllerRunInfo{
 			controller: backupController,
+			numWorkers: s.config.concurrentBackups,
+		}
+	}
+
+	backupUploadControllerRunInfo := func() controllerRunInfo {
+		backupUploadController := controller.NewBackupUploadController(
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.veleroClient.VeleroV1(),
+			s.mgr.GetClient(),
+			newPluginManager,
+			backupStoreGetter,
+			s.metrics,
+			s.config.itemOperationTimeout,
+		)
+
+		return controllerRunInfo{
+			controller: backupUploadController,
 			numWorkers: defaultControllerWorkers,
 		}
 	}
@@ -664,6 +720,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests().Lister(),
 			s.veleroClient.VeleroV1(),
 			s.mgr.GetClient(),
+			s.mgr.GetEventRecorderFor("velero-gc"),
 		)
 
 		return controllerRunInfo{
@@ -758,6 +815,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	enabledControllers := map[string]func() controllerRunInfo{
 		controller.BackupSync:        backupSyncControllerRunInfo,
 		controller.Backup:            backupControllerRunInfo,
+		controller.BackupUpload:      backupUploadControllerRunInfo,
 		controller.GarbageCollection: gcControllerRunInfo,
 		controller.BackupDeletion:    deletionControllerRunInfo,
 		controller.Restore:           restoreControllerRunInfo,
@@ -767,11 +825,14 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	enabledRuntimeControllers := make(map[string]struct{})
 	enabledRuntimeControllers[controller.ServerStatusRequest] = struct{}{}
 	enabledRuntimeControllers[controller.DownloadRequest] = struct{}{}
+	enabledRuntimeControllers[controller.BackupReplication] = struct{}{}
+	enabledRuntimeControllers[controller.ImportBackupRequest] = struct{}{}
 
 	if s.config.restoreOnly {
-		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
+		s.logger.Info("Restore only mode - not starting the backup, backup upload, schedule, delete-backup, or GC controllers")
 		s.config.disabledControllers = append(s.config.disabledControllers,
 			controller.Backup,
+			controller.BackupUpload,
 			controller.Schedule,
 			controller.GarbageCollection,
 			controller.BackupDeletion,
@@ -829,7 +890,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocation)
 	}
 
-	if err := controller.NewScheduleReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.metrics).SetupWithManager(s.mgr); err != nil {
+	if err := controller.NewScheduleReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.metrics, s.mgr.GetEventRecorderFor("velero-schedule")).SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.Schedule)
 	}
 
@@ -861,6 +922,18 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.BackupReplication]; ok {
+		if err := controller.NewBackupReplicationReconciler(s.namespace, s.logger, s.mgr.GetClient(), newPluginManager, backupStoreGetter).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupReplication)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.ImportBackupRequest]; ok {
+		if err := controller.NewImportBackupRequestReconciler(s.logger, s.mgr.GetClient(), s.config.defaultBackupTTL, newPluginManager, backupStoreGetter).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.ImportBackupRequest)
+		}
+	}
+
 	// TODO(2.0): presuming all controllers and resources are converted to runtime-controller
 	// by v2.0, the block from this line and including the `s.mgr.Start() will be
 	// deprecated, since the manager auto-starts all the caches. Until then, we need to start the
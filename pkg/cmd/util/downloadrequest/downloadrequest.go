@@ -35,6 +35,8 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // ErrNotFound is exported for external packages to check for when a file is
@@ -145,10 +147,21 @@ func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name strin
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
+	var reader io.Reader = resp.Body
+	if created.Status.Encryption != nil {
+		key, err := kube.GetSecretKey(kbClient, namespace, &created.Status.Encryption.Key)
+		if err != nil {
+			return errors.Wrap(err, "unable to get encryption key")
+		}
+
+		if reader, err = encryption.NewDecryptingReader(reader, key, created.Status.Encryption.AllowUnencrypted); err != nil {
+			return err
+		}
+	}
+
 	if kind != velerov1api.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(reader)
 		if err != nil {
 			return err
 		}
@@ -31,7 +31,9 @@ import (
 	"github.com/fatih/color"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
@@ -61,17 +63,21 @@ func DescribeBackup(
 		}
 		phaseString := string(phase)
 		switch phase {
-		case velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
+		case velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseUploadingPartialFailure:
 			phaseString = color.RedString(phaseString)
 		case velerov1api.BackupPhaseCompleted:
 			phaseString = color.GreenString(phaseString)
+		case velerov1api.BackupPhaseCanceled:
+			phaseString = color.YellowString(phaseString)
 		case velerov1api.BackupPhaseDeleting:
 		case velerov1api.BackupPhaseInProgress:
+		case velerov1api.BackupPhaseUploading:
 		case velerov1api.BackupPhaseNew:
 		}
 
 		logsNote := ""
-		if backup.Status.Phase == velerov1api.BackupPhaseFailed || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed {
+		switch backup.Status.Phase {
+		case velerov1api.BackupPhaseFailed, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseUploadingPartialFailure:
 			logsNote = fmt.Sprintf(" (run `velero backup logs %s` for more information)", backup.Name)
 		}
 
@@ -162,6 +168,35 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
 
+	if spec.UploaderType != "" {
+		d.Println()
+		d.Printf("Uploader Type:\t%s\n", spec.UploaderType)
+	}
+
+	if spec.ItemBackupWorkers > 0 {
+		d.Println()
+		d.Printf("Item Backup Workers:\t%d\n", spec.ItemBackupWorkers)
+	}
+
+	if spec.Compression != nil {
+		d.Println()
+		if spec.Compression.Level > 0 {
+			d.Printf("Compression:\t%s (level %d)\n", spec.Compression.Algorithm, spec.Compression.Level)
+		} else {
+			d.Printf("Compression:\t%s\n", spec.Compression.Algorithm)
+		}
+	}
+
+	if spec.DryRun {
+		d.Println()
+		d.Printf("Dry Run:\ttrue\n")
+	}
+
+	if spec.ResourcePolicy != nil {
+		d.Println()
+		d.Printf("Resource Policies:\t%s/%s\n", spec.ResourcePolicy.Kind, spec.ResourcePolicy.Name)
+	}
+
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
 
@@ -250,6 +285,9 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	d.Printf("Backup Format Version:\t%s\n", status.FormatVersion)
+	if status.Compression != "" {
+		d.Printf("Compressed With:\t%s\n", status.Compression)
+	}
 
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
@@ -263,6 +301,13 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	} else {
 		d.Printf("Completed:\t%s\n", status.CompletionTimestamp.Time)
 	}
+	// backups are only attempted more than once when they're interrupted by a restart of the server
+	if status.Attempts > 1 {
+		d.Printf("Attempts:\t%d\n", status.Attempts)
+	}
+	if status.VolumeSnapshotsReused > 0 {
+		d.Printf("Volume Snapshots Reused:\t%d (taken by a previous attempt, older than the backed up resources)\n", status.VolumeSnapshotsReused)
+	}
 
 	d.Println()
 	// Expiration can't be 0, it is always set to a 30-day default. It can be nil
@@ -283,9 +328,20 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Println()
 	}
 
+	if status.HooksAttempted > 0 {
+		describeHookExecutions(ctx, kbClient, d, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupHookExecutions,
+			status.HooksAttempted, status.HooksFailed, details, insecureSkipTLSVerify, caCertPath)
+		d.Println()
+	}
+
 	if details {
 		describeBackupResourceList(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
 		d.Println()
+
+		if backup.Spec.DryRun {
+			describeBackupDryRunReport(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+			d.Println()
+		}
 	}
 
 	if status.VolumeSnapshotsAttempted > 0 {
@@ -352,6 +408,123 @@ func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d
 	}
 }
 
+// DescribeBackupDryRunReport describes what a dry-run backup would back up in
+// human-readable format.
+func DescribeBackupDryRunReport(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) string {
+	return Describe(func(d *Describer) {
+		describeBackupResourceList(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+		d.Println()
+		describeBackupDryRunReport(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+	})
+}
+
+func describeBackupDryRunReport(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupDryRunReport, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Dry Run Report:\t<dry run report not found>")
+		} else {
+			d.Printf("Dry Run Report:\t<error getting dry run report: %v>\n", err)
+		}
+		return
+	}
+
+	var report pkgbackup.DryRunReport
+	if err := json.NewDecoder(buf).Decode(&report); err != nil {
+		d.Printf("Dry Run Report:\t<error reading dry run report: %v>\n", err)
+		return
+	}
+
+	if len(report.Volumes) == 0 {
+		d.Println("Volumes:\t<none>")
+	} else {
+		d.Println("Volumes:")
+		for _, v := range report.Volumes {
+			name := v.Name
+			if v.Pod != "" {
+				name = fmt.Sprintf("%s/%s/%s", v.Namespace, v.Pod, v.Name)
+			}
+
+			switch v.Method {
+			case pkgbackup.DryRunVolumeMethodPodVolumeBackup:
+				d.Printf("\t%s:\t%s (uploader %s, location %s)\n", name, v.Method, v.Uploader, v.Location)
+			case pkgbackup.DryRunVolumeMethodSnapshot:
+				d.Printf("\t%s:\t%s (location %s)\n", name, v.Method, v.Location)
+			default:
+				d.Printf("\t%s:\t%s (%s)\n", name, v.Method, v.Reason)
+			}
+		}
+	}
+
+	d.Println()
+	if len(report.Hooks) == 0 {
+		d.Println("Hooks:\t<none>")
+		return
+	}
+	d.Println("Hooks:")
+	for _, h := range report.Hooks {
+		d.Printf("\t%s/%s:\t%s hook %s in container %s: %s\n", h.Namespace, h.Pod, h.Phase, h.Name, h.Container, strings.Join(h.Command, " "))
+	}
+}
+
+// describeHookExecutions describes the exec hooks executed by a backup or a restore. Without
+// details, only the number of hooks attempted and failed is described.
+func describeHookExecutions(ctx context.Context, kbClient kbclient.Client, d *Describer, namespace, name string, kind velerov1api.DownloadTargetKind,
+	attempted, failed int, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	if !details {
+		d.Printf("Hooks:\t%d attempted, %d failed (specify --details for more information)\n", attempted, failed)
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, namespace, name, kind, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Hook Executions:\t<hook executions not found>")
+		} else {
+			d.Printf("Hook Executions:\t<error getting hook executions: %v>\n", err)
+		}
+		return
+	}
+
+	var executions []hook.Execution
+	if err := json.NewDecoder(buf).Decode(&executions); err != nil {
+		d.Printf("Hook Executions:\t<error reading hook executions: %v>\n", err)
+		return
+	}
+
+	d.Printf("Hook Executions:\t%d attempted, %d failed\n", attempted, failed)
+	for _, e := range executions {
+		status := color.GreenString("succeeded")
+		if e.Error != "" {
+			status = color.RedString("failed")
+		}
+		d.Printf("\t%s/%s:\t%s hook %s (%s) %s\n", e.Namespace, e.Pod, e.Phase, e.Name, e.Source, status)
+		d.Printf("\t\tContainer:\t%s\n", e.Container)
+		d.Printf("\t\tCommand:\t%s\n", strings.Join(e.Command, " "))
+		if e.ExitCode != nil {
+			d.Printf("\t\tExit Code:\t%d\n", *e.ExitCode)
+		}
+		d.Printf("\t\tStarted:\t%s\n", e.Started)
+		d.Printf("\t\tDuration:\t%s\n", e.Duration.Duration)
+		if e.Error != "" {
+			d.Printf("\t\tError:\t%s\n", e.Error)
+		}
+		describeHookOutput(d, "Stdout", e.Stdout)
+		describeHookOutput(d, "Stderr", e.Stderr)
+	}
+}
+
+func describeHookOutput(d *Describer, name, output string) {
+	output = strings.TrimRight(output, "\n")
+	if output == "" {
+		return
+	}
+	d.Printf("\t\t%s:\n", name)
+	for _, line := range strings.Split(output, "\n") {
+		d.Printf("\t\t\t%s\n", line)
+	}
+}
+
 func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ string, iops *int64) {
 	d.Printf("\t%s:\n", pvName)
 	d.Printf("\t\tSnapshot ID:\t%s\n", snapshotID)
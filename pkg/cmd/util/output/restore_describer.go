@@ -50,6 +50,8 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			phaseString = color.GreenString(phaseString)
 		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
 			phaseString = color.RedString(phaseString)
+		case velerov1api.RestorePhaseCanceled:
+			phaseString = color.YellowString(phaseString)
 		}
 
 		resultsNote := ""
@@ -151,9 +153,113 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
+		d.Println()
+		s = string(velerov1api.PolicyTypeNone)
+		if restore.Spec.ExistingResourcePolicy != "" {
+			s = string(restore.Spec.ExistingResourcePolicy)
+		}
+		d.Printf("Existing Resource Policy:\t%s\n", s)
+
+		if restore.Spec.ResourceModifier != nil {
+			d.Println()
+			d.Printf("Resource Modifier:\t%s/%s\n", restore.Spec.ResourceModifier.Kind, restore.Spec.ResourceModifier.Name)
+		}
+
+		if restore.Spec.DryRun {
+			d.Println()
+			d.Printf("Dry Run:\ttrue\n")
+		}
+
+		if len(restore.Status.HookResults) > 0 {
+			d.Println()
+			describeRestoreHookResults(d, restore.Status.HookResults)
+		}
+
+		if restore.Status.HooksAttempted > 0 {
+			d.Println()
+			describeHookExecutions(ctx, kbClient, d, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreHookExecutions,
+				restore.Status.HooksAttempted, restore.Status.HooksFailed, details, insecureSkipTLSVerify, caCertFile)
+		}
+
+		if details {
+			d.Println()
+			describeRestoredResourceList(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+		}
+	})
+}
+
+// describeRestoreHookResults describes the outcome of the pre-restore hooks and of the hooks
+// with the Restore scope.
+func describeRestoreHookResults(d *Describer, results []velerov1api.RestoreHookResult) {
+	d.Println("Hook Results:")
+	for _, res := range results {
+		pod := res.Pod
+		if pod == "" {
+			pod = "<none>"
+		} else if res.Container != "" {
+			pod = fmt.Sprintf("%s (container %s)", pod, res.Container)
+		}
+
+		if res.Succeeded {
+			d.Printf("\t%s (%s):\t%s\t%s\n", res.Name, res.Phase, pod, color.GreenString("succeeded"))
+		} else {
+			d.Printf("\t%s (%s):\t%s\t%s: %s\n", res.Name, res.Phase, pod, color.RedString("failed"), res.Message)
+		}
+	}
+}
+
+// DescribeRestoreDryRunPlan describes what a dry-run restore would do with each
+// item of the backup in human-readable format.
+func DescribeRestoreDryRunPlan(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertFile string) string {
+	return Describe(func(d *Describer) {
+		describeRestoredResourceList(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 	})
 }
 
+func describeRestoredResourceList(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			// the restored resource list could be missing if the restore was run
+			// by an older version of Velero, or hasn't completed yet
+			d.Println("Resource List:\t<restored resource list not found>")
+		} else {
+			d.Printf("Resource List:\t<error getting restored resource list: %v>\n", err)
+		}
+		return
+	}
+
+	var resourceList []pkgrestore.RestoredItem
+	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
+		d.Printf("Resource List:\t<error reading restored resource list: %v>\n", err)
+		return
+	}
+
+	d.Println("Resource List:")
+
+	// the list is sorted by group resource, namespace and name
+	groupResource := ""
+	for _, item := range resourceList {
+		if item.GroupResource != groupResource {
+			groupResource = item.GroupResource
+			d.Printf("\t%s:\n", groupResource)
+		}
+
+		entry := item.Name
+		if item.Namespace != "" {
+			entry = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+		}
+		if item.Reason != "" {
+			d.Printf("\t\t- %s(%s): %s\n", entry, item.Action, item.Reason)
+		} else {
+			d.Printf("\t\t- %s(%s)\n", entry, item.Action)
+		}
+		if len(item.Diff) > 0 {
+			d.Printf("\t\t  diff: %s\n", item.Diff)
+		}
+	}
+}
+
 func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
 	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
 		return
@@ -40,6 +40,8 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 			phaseString = color.GreenString(phaseString)
 		case v1.SchedulePhaseFailedValidation:
 			phaseString = color.RedString(phaseString)
+		case v1.SchedulePhasePaused:
+			phaseString = color.YellowString(phaseString)
 		}
 		d.Printf("Phase:\t%s\n", phaseString)
 
@@ -61,7 +63,26 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 }
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
+	d.Printf("Paused:\t%t\n", spec.Paused)
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	d.Printf("Skip Immediately:\t%t\n", spec.SkipImmediately)
+	missedRunPolicy := spec.MissedRunPolicy
+	if missedRunPolicy == "" {
+		missedRunPolicy = v1.MissedRunPolicyRunOnce
+	}
+	d.Printf("Missed Run Policy:\t%s\n", missedRunPolicy)
+	if spec.StartingDeadline.Duration > 0 {
+		d.Printf("Starting Deadline:\t%s\n", spec.StartingDeadline.Duration)
+	}
+
+	if spec.Retention != nil {
+		d.Println()
+		d.Println("Retention:")
+		d.Printf("\tKeep Last:\t%d\n", spec.Retention.KeepLast)
+		d.Printf("\tKeep Daily:\t%d\n", spec.Retention.KeepDaily)
+		d.Printf("\tKeep Weekly:\t%d\n", spec.Retention.KeepWeekly)
+		d.Printf("\tKeep Monthly:\t%d\n", spec.Retention.KeepMonthly)
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -76,4 +97,11 @@ func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+
+	if status.LastSkipped != nil && !status.LastSkipped.Time.IsZero() {
+		d.Printf("Last Skipped:\t%v\n", status.LastSkipped.Time)
+	}
+	if status.MissedRuns > 0 {
+		d.Printf("Missed Runs:\t%d\n", status.MissedRuns)
+	}
 }
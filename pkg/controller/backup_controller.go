@@ -25,11 +25,13 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -44,6 +46,7 @@ import (
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	snapshotv1listers "github.com/kubernetes-csi/external-snapshotter/client/v4/listers/volumesnapshot/v1"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
@@ -57,8 +60,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -67,6 +74,10 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// overlappingBackupRequeueDelay is how long a backup overlapping the namespaces of a
+// backup in progress waits before being processed again.
+const overlappingBackupRequeueDelay = 30 * time.Second
+
 type backupController struct {
 	*genericController
 	discoveryHelper             discovery.Helper
@@ -80,6 +91,7 @@ type backupController struct {
 	backupTracker               BackupTracker
 	defaultBackupLocation       string
 	defaultVolumesToRestic      bool
+	uploaderType                string
 	defaultBackupTTL            time.Duration
 	snapshotLocationLister      velerov1listers.VolumeSnapshotLocationLister
 	defaultSnapshotLocations    map[string]string
@@ -89,6 +101,14 @@ type backupController struct {
 	volumeSnapshotLister        snapshotv1listers.VolumeSnapshotLister
 	volumeSnapshotContentLister snapshotv1listers.VolumeSnapshotContentLister
 	volumeSnapshotClassLister   snapshotv1listers.VolumeSnapshotClassLister
+	// maxAttempts is the number of times a backup interrupted by a restart of
+	// the server is attempted.
+	maxAttempts int
+
+	// cancelFuncs holds the functions canceling the backups in progress.
+	cancelFuncs cancelFuncs
+	// resticMgr forgets the pod volume backups of canceled backups.
+	resticMgr restic.RepositoryManager
 }
 
 func NewBackupController(
@@ -103,6 +123,7 @@ func NewBackupController(
 	kbClient kbclient.Client,
 	defaultBackupLocation string,
 	defaultVolumesToRestic bool,
+	uploaderType string,
 	defaultBackupTTL time.Duration,
 	volumeSnapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	defaultSnapshotLocations map[string]string,
@@ -112,6 +133,8 @@ func NewBackupController(
 	volumeSnapshotContentLister snapshotv1listers.VolumeSnapshotContentLister,
 	volumesnapshotClassLister snapshotv1listers.VolumeSnapshotClassLister,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	maxAttempts int,
+	resticMgr restic.RepositoryManager,
 ) Interface {
 	c := &backupController{
 		genericController:           newGenericController(Backup, logger),
@@ -126,6 +149,7 @@ func NewBackupController(
 		kbClient:                    kbClient,
 		defaultBackupLocation:       defaultBackupLocation,
 		defaultVolumesToRestic:      defaultVolumesToRestic,
+		uploaderType:                uploaderType,
 		defaultBackupTTL:            defaultBackupTTL,
 		snapshotLocationLister:      volumeSnapshotLocationLister,
 		defaultSnapshotLocations:    defaultSnapshotLocations,
@@ -135,6 +159,8 @@ func NewBackupController(
 		volumeSnapshotContentLister: volumeSnapshotContentLister,
 		volumeSnapshotClassLister:   volumesnapshotClassLister,
 		backupStoreGetter:           backupStoreGetter,
+		maxAttempts:                 maxAttempts,
+		resticMgr:                   resticMgr,
 	}
 
 	c.syncHandler = c.processBackup
@@ -163,6 +189,22 @@ func NewBackupController(
 				}
 				c.queue.Add(key)
 			},
+			UpdateFunc: func(_, obj interface{}) {
+				backup := obj.(*velerov1api.Backup)
+
+				if backup.Status.Phase != velerov1api.BackupPhaseInProgress || !cancelRequested(backup) {
+					return
+				}
+
+				key, err := cache.MetaNamespaceKeyFunc(backup)
+				if err != nil {
+					c.logger.WithError(err).WithField(Backup, backup).Error("Error creating queue key, backup not canceled")
+					return
+				}
+				if c.cancelFuncs.cancel(key) {
+					c.logger.WithField(Backup, key).Info("Canceling backup")
+				}
+			},
 		},
 	)
 
@@ -240,21 +282,48 @@ func (c *backupController) processBackup(key string) error {
 	// this key (even though it was a no-op).
 	switch original.Status.Phase {
 	case "", velerov1api.BackupPhaseNew:
+		// backups canceled before they're started have nothing to clean up
+		if cancelRequested(original) {
+			updated := original.DeepCopy()
+			updated.Status.Phase = velerov1api.BackupPhaseCanceled
+			updated.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+			if _, err := patchBackup(original, updated, c.client); err != nil {
+				return errors.Wrapf(err, "error updating Backup status to %s", updated.Status.Phase)
+			}
+			log.Info("Backup was canceled before it was started")
+			return nil
+		}
 	case velerov1api.BackupPhaseInProgress:
 		// A backup may stay in-progress forever because of
 		// 1) the controller restarts during the processing of a backup
 		// 2) the backup with in-progress status isn't updated to completed or failed status successfully
-		// So we try to mark such Backups as failed to avoid it
+		// So such backups are attempted again from their checkpoint, until they've used up their
+		// attempts and are marked as failed to avoid it. Backups whose cancellation was requested
+		// are marked as canceled instead.
+		attempts := backupAttempts(original)
+		if attempts < c.maxAttempts && !cancelRequested(original) {
+			log.Infof("Backup was interrupted during attempt %d, attempting it again", attempts)
+			break
+		}
+
 		updated := original.DeepCopy()
-		updated.Status.Phase = velerov1api.BackupPhaseFailed
-		updated.Status.FailureReason = fmt.Sprintf("got a Backup with unexpected status %q, this may be due to a restart of the controller during the backing up, mark it as %q",
-			velerov1api.BackupPhaseInProgress, updated.Status.Phase)
+		if cancelRequested(original) {
+			updated.Status.Phase = velerov1api.BackupPhaseCanceled
+			updated.Status.FailureReason = "the backup was interrupted by a restart of the controller while its cancellation was requested"
+		} else {
+			updated.Status.Phase = velerov1api.BackupPhaseFailed
+			updated.Status.FailureReason = fmt.Sprintf("got a Backup with unexpected status %q after %d attempts, this may be due to a restart of the controller during the backing up, mark it as %q",
+				velerov1api.BackupPhaseInProgress, attempts, updated.Status.Phase)
+		}
 		updated.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
 		_, err = patchBackup(original, updated, c.client)
 		if err != nil {
 			return errors.Wrapf(err, "error updating Backup status to %s", updated.Status.Phase)
 		}
 		log.Warn(updated.Status.FailureReason)
+		if updated.Status.Phase == velerov1api.BackupPhaseCanceled {
+			c.cleanUpCanceledBackup(updated, log)
+		}
 		return nil
 	default:
 		return nil
@@ -266,8 +335,27 @@ func (c *backupController) processBackup(key string) error {
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
 	} else {
+		// Backups run concurrently, but not two backups of the same namespace. Wait for the
+		// backup in progress to finish rather than backing up the namespace twice at once.
+		namespaces, err := c.getBackupNamespaces(request.Backup)
+		if err != nil {
+			return err
+		}
+		if overlapping, added := c.backupTracker.AddIfNotOverlapping(request.Namespace, request.Name, namespaces); !added {
+			log.Infof("Backup overlaps the namespaces of backup %s in progress, waiting for it to finish", overlapping)
+			c.queue.AddAfter(key, overlappingBackupRequeueDelay)
+			return nil
+		}
+		defer c.backupTracker.Delete(request.Namespace, request.Name)
+
+		// a backup attempted again keeps the start timestamp of its first attempt
+		if request.Status.Phase == velerov1api.BackupPhaseInProgress {
+			request.Status.Attempts = backupAttempts(request.Backup) + 1
+		} else {
+			request.Status.Attempts = 1
+			request.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
+		}
 		request.Status.Phase = velerov1api.BackupPhaseInProgress
-		request.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
 	}
 
 	// update status
@@ -284,14 +372,21 @@ func (c *backupController) processBackup(key string) error {
 		return nil
 	}
 
-	c.backupTracker.Add(request.Namespace, request.Name)
-	defer c.backupTracker.Delete(request.Namespace, request.Name)
-
-	log.Debug("Running backup")
+	log.WithField("backupsInProgress", c.backupTracker.InFlight()).Debug("Running backup")
 
 	backupScheduleName := request.GetLabels()[velerov1api.ScheduleNameLabel]
 	c.metrics.RegisterBackupAttempt(backupScheduleName)
 
+	// the backup's context is canceled when its cancellation is requested, which
+	// the informer may have seen before the backup's cancel func was registered.
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancelFuncs.register(key, cancel)
+	defer c.cancelFuncs.unregister(key)
+	if latest, err := c.lister.Backups(ns).Get(name); err == nil && cancelRequested(latest) {
+		cancel()
+	}
+	request.Context = ctx
+
 	// execution & upload of backup
 	if err := c.runBackup(request); err != nil {
 		// even though runBackup sets the backup's phase prior
@@ -314,16 +409,187 @@ func (c *backupController) processBackup(key string) error {
 		c.metrics.RegisterBackupFailed(backupScheduleName)
 	case velerov1api.BackupPhaseFailedValidation:
 		c.metrics.RegisterBackupValidationFailure(backupScheduleName)
+	case velerov1api.BackupPhaseCanceled:
+		c.metrics.RegisterBackupCanceled(backupScheduleName)
 	}
 
 	log.Debug("Updating backup's final status")
 	if _, err := patchBackup(original, request.Backup, c.client); err != nil {
 		log.WithError(err).Error("error updating backup's final status")
+		return nil
+	}
+
+	if request.Status.Phase == velerov1api.BackupPhaseCanceled {
+		// the deletion controller doesn't delete backups in progress
+		c.backupTracker.Delete(request.Namespace, request.Name)
+		c.cleanUpCanceledBackup(request.Backup, log)
 	}
 
 	return nil
 }
 
+// cleanUpCanceledBackup deletes the volume snapshots and the pod volume backups
+// taken by a canceled backup before it was canceled, and then the objects it
+// uploaded, since a canceled backup can't be restored. The backup itself is kept
+// in the Canceled phase until it expires. What can't be deleted is left for the
+// deletion of the backup once it expires.
+func (c *backupController) cleanUpCanceledBackup(backup *velerov1api.Backup, log logrus.FieldLogger) {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error getting backup storage location of canceled backup, not cleaning it up")
+		return
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Error("Error getting backup store of canceled backup, not cleaning it up")
+		return
+	}
+
+	snapshots, err := backupStore.GetBackupVolumeSnapshots(backup.Name)
+	if err != nil {
+		log.WithError(err).Error("Error getting volume snapshots of canceled backup, not cleaning it up")
+		return
+	}
+	// backups interrupted by a restart of the server only saved their snapshots
+	// in their checkpoint
+	fromCheckpoint := false
+	if len(snapshots) == 0 {
+		if checkpoint := getBackupCheckpoint(backupStore, backup.Name, log); checkpoint != nil {
+			snapshots = checkpoint.VolumeSnapshots
+			fromCheckpoint = true
+		}
+	}
+
+	var errs []error
+	var remaining []*volume.Snapshot
+	volumeSnapshotters := make(map[string]velero.VolumeSnapshotter)
+	for _, snapshot := range snapshots {
+		if snapshot.Status.ProviderSnapshotID == "" {
+			continue
+		}
+		log.WithField("providerSnapshotID", snapshot.Status.ProviderSnapshotID).Info("Removing snapshot taken by canceled backup")
+
+		volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
+		if !ok {
+			if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager); err != nil {
+				errs = append(errs, err)
+				remaining = append(remaining, snapshot)
+				continue
+			}
+			volumeSnapshotters[snapshot.Spec.Location] = volumeSnapshotter
+		}
+
+		if err := volumeSnapshotter.DeleteSnapshot(snapshot.Status.ProviderSnapshotID); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error deleting snapshot %s", snapshot.Status.ProviderSnapshotID))
+			remaining = append(remaining, snapshot)
+		}
+	}
+
+	errs = append(errs, c.deleteCanceledPodVolumeBackups(backup, log)...)
+
+	if len(errs) > 0 {
+		for _, err := range errs {
+			log.WithError(err).Error("Error cleaning up canceled backup")
+		}
+		// the deletion of the backup once it expires only finds the snapshots in
+		// the backup's list of volume snapshots
+		if fromCheckpoint && len(remaining) > 0 {
+			buf, encodeErrs := encodeToJSONGzip(remaining, "volume snapshots list")
+			if len(encodeErrs) == 0 {
+				encodeErrs = append(encodeErrs, backupStore.PutBackupVolumeSnapshots(backup.Name, buf))
+			}
+			if err := kerrors.NewAggregate(encodeErrs); err != nil {
+				log.WithError(err).Error("Error uploading volume snapshots of canceled backup, they have to be deleted manually")
+			}
+		}
+		log.Warn("Canceled backup was partially cleaned up, the rest is deleted when the backup expires")
+		return
+	}
+
+	if err := backupStore.DeleteBackup(backup.Name); err != nil {
+		log.WithError(err).Error("Error deleting objects uploaded by canceled backup, they're deleted when the backup expires")
+		return
+	}
+	log.Info("Canceled backup cleaned up")
+}
+
+// deleteCanceledPodVolumeBackups forgets the snapshots of the pod volume backups
+// completed by a canceled backup, and then deletes the pod volume backups so that
+// their snapshots aren't forgotten again once the backup expires.
+func (c *backupController) deleteCanceledPodVolumeBackups(backup *velerov1api.Backup, log logrus.FieldLogger) []error {
+	if c.resticMgr == nil {
+		return nil
+	}
+
+	podVolumeBackups := &velerov1api.PodVolumeBackupList{}
+	if err := c.kbClient.List(context.Background(), podVolumeBackups, kbclient.InNamespace(backup.Namespace), kbclient.MatchingLabels{
+		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+	}); err != nil {
+		return []error{errors.Wrap(err, "error listing pod volume backups")}
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), resticTimeout)
+	defer cancelFunc()
+
+	var errs []error
+	for i := range podVolumeBackups.Items {
+		pvb := &podVolumeBackups.Items[i]
+		if pvb.Status.SnapshotID != "" {
+			log.WithField("snapshotID", pvb.Status.SnapshotID).Info("Removing pod volume backup snapshot taken by canceled backup")
+			if err := c.resticMgr.Forget(ctx, restic.SnapshotIdentifier{
+				VolumeNamespace:       pvb.Spec.Pod.Namespace,
+				BackupStorageLocation: backup.Spec.StorageLocation,
+				SnapshotID:            pvb.Status.SnapshotID,
+				UploaderType:          pvb.Spec.UploaderType,
+			}); err != nil {
+				errs = append(errs, errors.Wrapf(err, "error forgetting snapshot %s of pod volume backup %s", pvb.Status.SnapshotID, pvb.Name))
+				continue
+			}
+		}
+
+		if err := c.kbClient.Delete(context.Background(), pvb); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "error deleting pod volume backup %s", pvb.Name))
+		}
+	}
+
+	return errs
+}
+
+// backupAttempts returns the number of times the backup has been attempted.
+// Backups started before attempts were recorded were attempted once.
+func backupAttempts(backup *velerov1api.Backup) int {
+	if backup.Status.Attempts == 0 {
+		return 1
+	}
+	return backup.Status.Attempts
+}
+
+// getBackupNamespaces returns the names of the existing namespaces included in the backup.
+func (c *backupController) getBackupNamespaces(backup *velerov1api.Backup) ([]string, error) {
+	namespaces := &corev1api.NamespaceList{}
+	if err := c.kbClient.List(context.Background(), namespaces); err != nil {
+		return nil, errors.Wrap(err, "error listing namespaces")
+	}
+
+	includesExcludes := collections.NewIncludesExcludes().Includes(backup.Spec.IncludedNamespaces...).Excludes(backup.Spec.ExcludedNamespaces...)
+
+	var res []string
+	for _, ns := range namespaces.Items {
+		if includesExcludes.ShouldInclude(ns.Name) {
+			res = append(res, ns.Name)
+		}
+	}
+
+	return res, nil
+}
+
 func patchBackup(original, updated *velerov1api.Backup, client velerov1client.BackupsGetter) (*velerov1api.Backup, error) {
 	origBytes, err := json.Marshal(original)
 	if err != nil {
@@ -371,6 +637,15 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Spec.DefaultVolumesToRestic = &c.defaultVolumesToRestic
 	}
 
+	if request.Spec.UploaderType == "" {
+		request.Spec.UploaderType = velerov1api.UploaderType(c.uploaderType)
+	}
+	if request.Spec.UploaderType != "" {
+		if err := uploader.ValidateUploaderType(string(request.Spec.UploaderType)); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+		}
+	}
+
 	// find which storage location to use
 	var serverSpecified bool
 	if request.Spec.StorageLocation == "" {
@@ -421,6 +696,15 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 	}
 	request.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(request.Spec.StorageLocation)
 
+	// validate the compression of the backup tarball, and record its algorithm
+	// so that the tarball can be named after it when it's downloaded.
+	compressionConfig := request.CompressionConfig()
+	if err := compression.Validate(compressionConfig); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid compression: %v", err))
+	} else {
+		request.Status.Compression = compression.Algorithm(compressionConfig)
+	}
+
 	// validate and get the backup's VolumeSnapshotLocations, and store the
 	// VolumeSnapshotLocation API objs on the request
 	if locs, errs := c.validateAndGetSnapshotLocations(request.Backup); len(errs) > 0 {
@@ -451,9 +735,36 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the volume policies, if specified
+	if policies, err := c.getResourcePolicies(request.Backup); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid resource policies: %v", err))
+	} else {
+		request.ResPolicies = policies
+	}
+
 	return request
 }
 
+// getResourcePolicies fetches and parses the volume policies from the ConfigMap
+// referenced by the backup. It returns nil if the backup doesn't reference any.
+func (c *backupController) getResourcePolicies(backup *velerov1api.Backup) (*resourcepolicies.ResourcePolicies, error) {
+	ref := backup.Spec.ResourcePolicy
+	if ref == nil {
+		return nil, nil
+	}
+
+	if !strings.EqualFold(ref.Kind, resourcepolicies.ConfigMapRefKind) {
+		return nil, errors.Errorf("unsupported kind %q, only %s is supported", ref.Kind, resourcepolicies.ConfigMapRefKind)
+	}
+
+	cm := &corev1api.ConfigMap{}
+	if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: ref.Name}, cm); err != nil {
+		return nil, errors.Wrapf(err, "error getting configmap %s/%s", backup.Namespace, ref.Name)
+	}
+
+	return resourcepolicies.GetResourcePoliciesFromConfig(cm)
+}
+
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
 // - each location name in .spec.volumeSnapshotLocations exists as a location
@@ -587,12 +898,23 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	pluginManager := c.newPluginManager(backupLog)
 	defer pluginManager.CleanupClients()
 
+	// the calls of the plugins run while backing up are stopped when the backup is canceled,
+	// while the backup's artifacts are still persisted with the plugin manager's plugins.
+	ctx := backup.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	runPluginManager := clientmgmt.WithContext(ctx, pluginManager)
+	if runPluginManager != pluginManager {
+		defer runPluginManager.CleanupClients()
+	}
+
 	backupLog.Info("Getting backup item actions")
-	actions, err := pluginManager.GetBackupItemActions()
+	actions, err := runPluginManager.GetBackupItemActions()
 	if err != nil {
 		return err
 	}
-	itemSnapshotters, err := pluginManager.GetItemSnapshotters()
+	itemSnapshotters, err := runPluginManager.GetItemSnapshotters()
 	if err != nil {
 		return err
 	}
@@ -604,6 +926,15 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	}
 
 	exists, err := backupStore.BackupExists(backup.StorageLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name)
+	if exists && err == nil && backup.Status.Attempts > 1 {
+		// the previous attempt was interrupted after it persisted the backup, whose status
+		// is then the one persisted with it.
+		if persisted, err := backupStore.GetBackupMetadata(backup.Name); err == nil {
+			backupLog.Info("Backup was persisted by its previous attempt, using its status")
+			backup.Status = persisted.Status
+			return nil
+		}
+	}
 	if exists || err != nil {
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
 		backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
@@ -613,13 +944,31 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	// a backup attempted again resumes from the checkpoint of its previous attempt
+	if backup.Status.Attempts > 1 {
+		backup.ResumeFrom = getBackupCheckpoint(backupStore, backup.Name, backupLog)
+	}
+	if !backup.Spec.DryRun {
+		backup.CheckpointFunc = func(checkpoint *pkgbackup.Checkpoint) error {
+			buf, errs := encodeToJSONGzip(checkpoint, "backup checkpoint")
+			if len(errs) > 0 {
+				return kerrors.NewAggregate(errs)
+			}
+			return backupStore.PutBackupCheckpoint(backup.Name, buf)
+		}
+	}
+
 	backupItemActionsResolver := framework.NewBackupItemActionResolver(actions)
 	itemSnapshottersResolver := framework.NewItemSnapshotterResolver(itemSnapshotters)
 
 	var fatalErrs []error
 	if err := c.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver,
-		itemSnapshottersResolver, pluginManager); err != nil {
-		fatalErrs = append(fatalErrs, err)
+		itemSnapshottersResolver, runPluginManager); err != nil {
+		if ctx.Err() != nil {
+			backupLog.Info("Backup was canceled")
+		} else {
+			fatalErrs = append(fatalErrs, err)
+		}
 	}
 
 	// Empty slices here so that they can be passed in to the persistBackup call later, regardless of whether or not CSI's enabled.
@@ -666,6 +1015,13 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		}
 	}
 
+	// Check on the item snapshots, volume snapshots and item operations once, so that
+	// backups whose uploads are done by now are completed right away rather than by
+	// the backup upload controller.
+	uploadsInProgress := pkgbackup.UpdateItemSnapshotsProgress(backupLog, backup.Backup, backup.ItemSnapshots, pluginManager) +
+		pkgbackup.UpdateVolumeSnapshotsProgress(backupLog, backup.VolumeSnapshots, backup.SnapshotLocations, pluginManager) +
+		pkgbackup.UpdateItemOperationsProgress(backupLog, backup.Backup, backup.ItemOperations, pluginManager)
+
 	// Mark completion timestamp before serializing and uploading.
 	// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
 	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
@@ -687,7 +1043,9 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
 
-	recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
+	if !backup.Spec.DryRun {
+		recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
+	}
 
 	if err := gzippedLogFile.Close(); err != nil {
 		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("error closing gzippedLogFile")
@@ -698,6 +1056,8 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	// artifacts to object storage so that the JSON representation of the
 	// backup in object storage has the terminal phase set.
 	switch {
+	case ctx.Err() != nil:
+		backup.Status.Phase = velerov1api.BackupPhaseCanceled
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
@@ -706,6 +1066,18 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		backup.Status.Phase = velerov1api.BackupPhaseCompleted
 	}
 
+	// Backups aren't usable until their snapshots and the work their item actions
+	// started are uploaded, so they're left Uploading for the backup upload
+	// controller to complete them.
+	if uploadsInProgress > 0 {
+		switch backup.Status.Phase {
+		case velerov1api.BackupPhaseCompleted:
+			backup.Status.Phase = velerov1api.BackupPhaseUploading
+		case velerov1api.BackupPhasePartiallyFailed:
+			backup.Status.Phase = velerov1api.BackupPhaseUploadingPartialFailure
+		}
+	}
+
 	// re-instantiate the backup store because credentials could have changed since the original
 	// instantiation, if this was a long-running backup
 	backupLog.Info("Setting up backup store to persist the backup")
@@ -718,7 +1090,24 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		fatalErrs = append(fatalErrs, errs...)
 	}
 
-	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
+	// the checkpoint is only needed to resume an attempt that was interrupted
+	// before getting here
+	if !backup.Spec.DryRun {
+		if err := backupStore.DeleteBackupCheckpoint(backup.Name); err != nil {
+			c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Warn("Error deleting backup checkpoint")
+		}
+	}
+
+	switch {
+	case backup.Status.Phase == velerov1api.BackupPhaseCanceled:
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup canceled")
+	case backup.Spec.DryRun:
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup dry run completed")
+	case uploadsInProgress > 0 && len(fatalErrs) == 0:
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Infof("Backup completed, waiting for %d snapshots and item operations to be uploaded", uploadsInProgress)
+	default:
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
+	}
 
 	// if we return a non-nil error, the calling function will update
 	// the backup's phase to Failed.
@@ -781,6 +1170,16 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	itemSnapshots, errs := encodeToJSONGzip(backup.ItemSnapshots, "item snapshots list")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
+	itemOperations, errs := encodeToJSONGzip(backup.ItemOperations, "item operations list")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	csiSnapshotJSON, errs := encodeToJSONGzip(csiVolumeSnapshots, "csi volume snapshots list")
 	if errs != nil {
 		persistErrs = append(persistErrs, errs...)
@@ -800,12 +1199,57 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	// the manifest is only set if the backup tarball was completely written
+	var backupManifest io.Reader
+	if backup.Manifest != nil {
+		manifestJSON, errs := encodeToJSONGzip(backup.Manifest, "backup manifest")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		} else {
+			backupManifest = manifestJSON
+		}
+	}
+
+	var dryRunReport io.Reader
+	if backup.DryRunReport != nil {
+		dryRunReportJSON, errs := encodeToJSONGzip(backup.DryRunReport, "dry run report")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		} else {
+			dryRunReport = dryRunReportJSON
+		}
+	}
+
+	var hookExecutions io.Reader
+	if backup.HookExecutions != nil {
+		hookExecutionsJSON, errs := encodeToJSONGzip(backup.HookExecutions.Executions(), "hook executions")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		} else {
+			hookExecutions = hookExecutionsJSON
+		}
+	}
+
+	// The metadata of backups that are still uploading is only persisted once
+	// they're completed, so that they're not synced into clusters before they're
+	// usable.
+	var backupMetadata io.Reader = backupJSON
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseUploading, velerov1api.BackupPhaseUploadingPartialFailure:
+		backupMetadata = nil
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
-		backupJSON = nil
+		backupMetadata = nil
 		backupContents = nil
 		nativeVolumeSnapshots = nil
+		itemSnapshots = nil
+		itemOperations = nil
 		backupResourceList = nil
+		backupManifest = nil
+		dryRunReport = nil
+		hookExecutions = nil
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
 		csiSnapshotClassesJSON = nil
@@ -813,16 +1257,37 @@ func persistBackup(backup *pkgbackup.Request,
 
 	backupInfo := persistence.BackupInfo{
 		Name:                      backup.Name,
-		Metadata:                  backupJSON,
+		Metadata:                  backupMetadata,
 		Contents:                  backupContents,
 		Log:                       backupLog,
 		PodVolumeBackups:          podVolumeBackups,
 		VolumeSnapshots:           nativeVolumeSnapshots,
+		ItemSnapshots:             itemSnapshots,
+		ItemOperations:            itemOperations,
 		BackupResourceList:        backupResourceList,
+		BackupManifest:            backupManifest,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 		CSIVolumeSnapshotClasses:  csiSnapshotClassesJSON,
+		DryRunReport:              dryRunReport,
+		HookExecutions:            hookExecutions,
+	}
+
+	// dry runs only report what they would back up, so their tarball isn't uploaded
+	if backup.Spec.DryRun {
+		backupInfo.Contents = nil
+		backupInfo.BackupManifest = nil
 	}
+
+	// canceled backups can't be restored, so only what's needed to delete them is
+	// uploaded: their log and the lists of the snapshots taken before they were canceled.
+	if backup.Status.Phase == velerov1api.BackupPhaseCanceled {
+		backupInfo.Metadata = nil
+		backupInfo.Contents = nil
+		backupInfo.BackupManifest = nil
+		backupInfo.BackupResourceList = nil
+	}
+
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
 	}
@@ -843,6 +1308,30 @@ func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	}
 }
 
+// getBackupCheckpoint returns the checkpoint saved by the previous attempt of the
+// backup, or nil if it didn't save any or it can't be read, in which case the
+// backup is attempted again from scratch.
+func getBackupCheckpoint(backupStore persistence.BackupStore, name string, log logrus.FieldLogger) *pkgbackup.Checkpoint {
+	rc, err := backupStore.GetBackupCheckpoint(name)
+	if err != nil {
+		log.WithError(err).Warn("Error getting checkpoint of the previous attempt of the backup, backing up from scratch")
+		return nil
+	}
+	if rc == nil {
+		log.Info("Previous attempt of the backup saved no checkpoint, backing up from scratch")
+		return nil
+	}
+	defer rc.Close()
+
+	checkpoint := new(pkgbackup.Checkpoint)
+	if err := decodeJSONGzip(rc, checkpoint); err != nil {
+		log.WithError(err).Warn("Error decoding checkpoint of the previous attempt of the backup, backing up from scratch")
+		return nil
+	}
+
+	return checkpoint
+}
+
 // encodeToJSONGzip takes arbitrary Go data and encodes it to GZip compressed JSON in a buffer, as well as a description of the data to put into an error should encoding fail.
 func encodeToJSONGzip(data interface{}, desc string) (*bytes.Buffer, []error) {
 	buf := new(bytes.Buffer)
@@ -865,3 +1354,14 @@ func encodeToJSONGzip(data interface{}, desc string) (*bytes.Buffer, []error) {
 
 	return buf, nil
 }
+
+// decodeJSONGzip decodes the gzipped JSON read from r into data.
+func decodeJSONGzip(r io.Reader, data interface{}) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	return errors.WithStack(json.NewDecoder(gzr).Decode(data))
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 
 	"sort"
 	"strings"
@@ -32,8 +33,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/version"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -50,9 +53,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 type fakeBackupper struct {
@@ -157,6 +162,33 @@ func TestMarkInProgressBackupAsFailed(t *testing.T) {
 	assert.Equal(t, velerov1api.BackupPhaseFailed, res.Status.Phase)
 }
 
+func TestMarkCanceledNewBackupAsCanceled(t *testing.T) {
+	backup := defaultBackup().
+		ObjectMeta(builder.WithAnnotations(velerov1api.CancelRequestedAnnotation, "true")).
+		Phase(velerov1api.BackupPhaseNew).
+		Result()
+	clientset := fake.NewSimpleClientset(backup)
+	sharedInformers := informers.NewSharedInformerFactory(clientset, 0)
+	logger := logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+
+	c := &backupController{
+		genericController: newGenericController("backup-test", logger),
+		client:            clientset.VeleroV1(),
+		lister:            sharedInformers.Velero().V1().Backups().Lister(),
+		clock:             &clock.RealClock{},
+	}
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+
+	err := c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name))
+	require.Nil(t, err)
+
+	res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCanceled, res.Status.Phase)
+	assert.NotNil(t, res.Status.CompletionTimestamp)
+	assert.Nil(t, res.Status.StartTimestamp)
+}
+
 func TestProcessBackupValidationFailures(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Result()
 
@@ -189,6 +221,24 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:           "non-existent resource policies configmap fails validation",
+			backup:         defaultBackup().ResourcePolicyConfigMap("policies-1").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"Invalid resource policies: error getting configmap velero/policies-1: configmaps \"policies-1\" not found"},
+		},
+		{
+			name:           "unsupported uploader type fails validation",
+			backup:         defaultBackup().UploaderType("rsync").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"invalid uploader type \"rsync\", valid uploader types are \"restic\" and \"kopia\""},
+		},
+		{
+			name:           "invalid compression level fails validation",
+			backup:         defaultBackup().Compression(velerov1api.CompressionAlgorithmGzip, 10).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"Invalid compression: invalid gzip compression level 10, must be between 0 and 9"},
+		},
 	}
 
 	for _, test := range tests {
@@ -242,6 +292,360 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 	}
 }
 
+func TestProcessBackupWaitsForOverlappingBackups(t *testing.T) {
+	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").Result()
+
+	tests := []struct {
+		name            string
+		backup          *velerov1api.Backup
+		inFlightBackups map[string][]string
+		wantWaiting     bool
+	}{
+		{
+			name:            "backup of a namespace backed up by a backup in progress waits",
+			backup:          defaultBackup().IncludedNamespaces("ns-1", "ns-2").Result(),
+			inFlightBackups: map[string][]string{"backup-2": {"ns-2"}},
+			wantWaiting:     true,
+		},
+		{
+			name:            "backup of all namespaces waits for any backup in progress",
+			backup:          defaultBackup().Result(),
+			inFlightBackups: map[string][]string{"backup-2": {"ns-3"}},
+			wantWaiting:     true,
+		},
+		{
+			name:            "backup excluding the namespaces of the backup in progress doesn't wait",
+			backup:          defaultBackup().ExcludedNamespaces("ns-3").Result(),
+			inFlightBackups: map[string][]string{"backup-2": {"ns-3"}},
+			wantWaiting:     false,
+		},
+		{
+			name:            "backup of other namespaces doesn't wait",
+			backup:          defaultBackup().IncludedNamespaces("ns-1").Result(),
+			inFlightBackups: map[string][]string{"backup-2": {"ns-2", "ns-3"}},
+			wantWaiting:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+			var (
+				clientset       = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+				backupper       = new(fakeBackupper)
+				backupTracker   = NewBackupTracker(metrics.NewServerMetrics())
+			)
+
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+				defaultBackupLocation,
+				builder.ForNamespace("ns-1").Result(),
+				builder.ForNamespace("ns-2").Result(),
+				builder.ForNamespace("ns-3").Result(),
+			)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               fakeClient,
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  defaultBackupLocation.Name,
+				backupTracker:          backupTracker,
+				metrics:                metrics.NewServerMetrics(),
+				clock:                  &clock.RealClock{},
+				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+				backupper:              backupper,
+				formatFlag:             formatFlag,
+			}
+
+			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("CleanupClients").Return(nil)
+			pluginManager.On("GetItemSnapshotters").Return(nil, nil)
+			backupper.On("BackupWithResolvers", mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolver{}, framework.ItemSnapshotterResolver{}, pluginManager).Return(nil)
+			backupStore.On("BackupExists", defaultBackupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(false, nil)
+			backupStore.On("PutBackup", mock.Anything).Return(nil)
+			backupStore.On("DeleteBackupCheckpoint", test.backup.Name).Return(nil)
+
+			for name, namespaces := range test.inFlightBackups {
+				_, added := backupTracker.AddIfNotOverlapping(velerov1api.DefaultNamespace, name, namespaces)
+				require.True(t, added)
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", test.backup.Namespace, test.backup.Name)))
+
+			res, err := clientset.VeleroV1().Backups(test.backup.Namespace).Get(context.TODO(), test.backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			if test.wantWaiting {
+				assert.Equal(t, velerov1api.BackupPhase(""), res.Status.Phase)
+			} else {
+				assert.Equal(t, velerov1api.BackupPhaseCompleted, res.Status.Phase)
+			}
+			// the backup is no longer in progress either way
+			assert.False(t, backupTracker.Contains(test.backup.Namespace, test.backup.Name))
+		})
+	}
+}
+
+func TestProcessBackupCanceledInProgress(t *testing.T) {
+	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").Result()
+	backup := defaultBackup().Result()
+
+	formatFlag := logging.FormatText
+	var (
+		clientset       = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+		pluginManager   = new(pluginmocks.Manager)
+		backupStore     = new(persistencemocks.BackupStore)
+		backupper       = new(fakeBackupper)
+		serverMetrics   = metrics.NewServerMetrics()
+	)
+
+	// the backup took a volume snapshot and a pod volume backup before it was canceled
+	snapshotLocation := builder.ForVolumeSnapshotLocation("velero", "vsl-1").Provider("provider-1").Result()
+	volumeSnapshotter := &velerotest.FakeVolumeSnapshotter{SnapshotsTaken: sets.NewString("snap-1")}
+	snapshots := []*volume.Snapshot{
+		{
+			Spec:   volume.SnapshotSpec{Location: snapshotLocation.Name},
+			Status: volume.SnapshotStatus{ProviderSnapshotID: "snap-1", Phase: volume.SnapshotPhaseCompleted},
+		},
+	}
+	podVolumeBackup := builder.ForPodVolumeBackup("velero", "pvb-1").
+		ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backup.Name)).
+		PodNamespace("ns-1").
+		SnapshotID("restic-snap-1").
+		Result()
+	resticMgr := new(fakeRepositoryManager)
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, defaultBackupLocation, podVolumeBackup)
+
+	apiServer := velerotest.NewAPIServer(t)
+	discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+	require.NoError(t, err)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		discoveryHelper:        discoveryHelper,
+		client:                 clientset.VeleroV1(),
+		lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+		kbClient:               fakeClient,
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		defaultBackupLocation:  defaultBackupLocation.Name,
+		backupTracker:          NewBackupTracker(serverMetrics),
+		metrics:                serverMetrics,
+		clock:                  &clock.RealClock{},
+		newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+		backupper:              backupper,
+		formatFlag:             formatFlag,
+		resticMgr:              resticMgr,
+	}
+
+	key := fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)
+
+	pluginManager.On("GetBackupItemActions").Return(nil, nil)
+	pluginManager.On("CleanupClients").Return(nil)
+	pluginManager.On("GetItemSnapshotters").Return(nil, nil)
+	// the backup is canceled while its items are backed up
+	backupper.On("BackupWithResolvers", mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolver{}, framework.ItemSnapshotterResolver{}, pluginManager).
+		Run(func(mock.Arguments) { c.cancelFuncs.cancel(key) }).
+		Return(errors.New("backup canceled: context canceled"))
+	backupStore.On("BackupExists", defaultBackupLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name).Return(false, nil)
+	backupStore.On("PutBackup", mock.MatchedBy(func(info persistence.BackupInfo) bool {
+		return info.Name == backup.Name && info.Metadata == nil && info.Contents == nil && info.Log != nil
+	})).Return(nil)
+	backupStore.On("DeleteBackupCheckpoint", backup.Name).Return(nil)
+	pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(volumeSnapshotter, nil)
+	backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return(snapshots, nil)
+	backupStore.On("DeleteBackup", backup.Name).Return(nil)
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(snapshotLocation))
+	require.NoError(t, c.processBackup(key))
+
+	res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCanceled, res.Status.Phase)
+	assert.Empty(t, res.Status.FailureReason)
+	assert.NotNil(t, res.Status.CompletionTimestamp)
+	backupStore.AssertExpectations(t)
+	assert.Empty(t, c.cancelFuncs.funcs)
+
+	// what the canceled backup took and uploaded is cleaned up right away, while
+	// the backup is kept to show how it ended
+	assert.False(t, c.backupTracker.Contains(backup.Namespace, backup.Name))
+	assert.Equal(t, 0, volumeSnapshotter.SnapshotsTaken.Len())
+	assert.Equal(t, []string{"restic-snap-1"}, resticMgr.forgotten)
+	err = fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: podVolumeBackup.Namespace, Name: podVolumeBackup.Name}, &velerov1api.PodVolumeBackup{})
+	assert.True(t, apierrors.IsNotFound(err))
+	deleteRequests := &velerov1api.DeleteBackupRequestList{}
+	require.NoError(t, fakeClient.List(context.Background(), deleteRequests))
+	assert.Empty(t, deleteRequests.Items)
+}
+
+// fakeRepositoryManager records the restic snapshots it forgets.
+type fakeRepositoryManager struct {
+	restic.RepositoryManager
+
+	forgotten []string
+}
+
+func (m *fakeRepositoryManager) Forget(_ context.Context, snapshot restic.SnapshotIdentifier) error {
+	m.forgotten = append(m.forgotten, snapshot.SnapshotID)
+	return nil
+}
+
+func TestRetryInterruptedBackup(t *testing.T) {
+	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").Result()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		attempts            int
+		cancelRequested     bool
+		expectedPhase       velerov1api.BackupPhase
+		expectedAttempts    int
+		expectedFailureText string
+	}{
+		{
+			name:             "backup interrupted during its first attempt is attempted again",
+			attempts:         1,
+			expectedPhase:    velerov1api.BackupPhaseCompleted,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "backup started before attempts were recorded is attempted again",
+			attempts:         0,
+			expectedPhase:    velerov1api.BackupPhaseCompleted,
+			expectedAttempts: 2,
+		},
+		{
+			name:                "backup that used up its attempts is marked as failed",
+			attempts:            3,
+			expectedPhase:       velerov1api.BackupPhaseFailed,
+			expectedAttempts:    3,
+			expectedFailureText: "after 3 attempts",
+		},
+		{
+			name:             "backup whose cancellation was requested is marked as canceled",
+			attempts:         1,
+			cancelRequested:  true,
+			expectedPhase:    velerov1api.BackupPhaseCanceled,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupBuilder := defaultBackup().StorageLocation(defaultBackupLocation.Name).Phase(velerov1api.BackupPhaseInProgress).StartTimestamp(startTime)
+			if test.cancelRequested {
+				backupBuilder.ObjectMeta(builder.WithAnnotations(velerov1api.CancelRequestedAnnotation, "true"))
+			}
+			backup := backupBuilder.Result()
+			backup.Status.Attempts = test.attempts
+
+			formatFlag := logging.FormatText
+			var (
+				clientset       = fake.NewSimpleClientset(backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+				backupper       = new(fakeBackupper)
+				serverMetrics   = metrics.NewServerMetrics()
+			)
+
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, defaultBackupLocation)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               fakeClient,
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  defaultBackupLocation.Name,
+				backupTracker:          NewBackupTracker(serverMetrics),
+				metrics:                serverMetrics,
+				clock:                  &clock.RealClock{},
+				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+				backupper:              backupper,
+				formatFlag:             formatFlag,
+				maxAttempts:            3,
+			}
+
+			// the previous attempt took a volume snapshot
+			snapshotLocation := builder.ForVolumeSnapshotLocation("velero", "vsl-1").Provider("provider-1").Result()
+			volumeSnapshotter := &velerotest.FakeVolumeSnapshotter{SnapshotsTaken: sets.NewString("snap-1")}
+			checkpoint, errs := encodeToJSONGzip(&pkgbackup.Checkpoint{
+				Attempt: 1,
+				VolumeSnapshots: []*volume.Snapshot{
+					{
+						Spec:   volume.SnapshotSpec{Location: snapshotLocation.Name},
+						Status: volume.SnapshotStatus{ProviderSnapshotID: "snap-1", Phase: volume.SnapshotPhaseCompleted},
+					},
+				},
+			}, "checkpoint")
+			require.Empty(t, errs)
+
+			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("CleanupClients").Return(nil)
+			pluginManager.On("GetItemSnapshotters").Return(nil, nil)
+			backupper.On("BackupWithResolvers", mock.Anything, mock.MatchedBy(func(req *pkgbackup.Request) bool {
+				return req.ResumeFrom != nil && req.ResumeFrom.Attempt == 1 && req.CheckpointFunc != nil
+			}), mock.Anything, framework.BackupItemActionResolver{}, framework.ItemSnapshotterResolver{}, pluginManager).Return(nil)
+			backupStore.On("BackupExists", defaultBackupLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name).Return(false, nil)
+			backupStore.On("GetBackupCheckpoint", backup.Name).Return(ioutil.NopCloser(checkpoint), nil)
+			backupStore.On("PutBackup", mock.Anything).Return(nil)
+			backupStore.On("DeleteBackupCheckpoint", backup.Name).Return(nil)
+			pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(volumeSnapshotter, nil)
+			backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return(nil, nil)
+			backupStore.On("DeleteBackup", backup.Name).Return(nil)
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(snapshotLocation))
+			require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)))
+
+			res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			assert.Equal(t, test.expectedAttempts, res.Status.Attempts)
+			assert.Contains(t, res.Status.FailureReason, test.expectedFailureText)
+			assert.True(t, startTime.Equal(res.Status.StartTimestamp.Time))
+			assert.NotNil(t, res.Status.CompletionTimestamp)
+
+			// the snapshots taken before a backup is canceled are deleted with the
+			// objects it uploaded, while the backup is kept
+			if test.expectedPhase == velerov1api.BackupPhaseCanceled {
+				assert.Equal(t, 0, volumeSnapshotter.SnapshotsTaken.Len())
+				backupStore.AssertCalled(t, "DeleteBackup", backup.Name)
+			} else {
+				backupStore.AssertNotCalled(t, "DeleteBackup", backup.Name)
+			}
+			deleteRequests := &velerov1api.DeleteBackupRequestList{}
+			require.NoError(t, fakeClient.List(context.Background(), deleteRequests))
+			assert.Empty(t, deleteRequests.Items)
+		})
+	}
+}
+
 func TestBackupLocationLabel(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -408,9 +812,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -444,9 +850,49 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
+				},
+			},
+		},
+		{
+			name:                   "backup for a location with zstd compression records it",
+			backup:                 defaultBackup().StorageLocation("zstd-loc").Result(),
+			backupLocation:         builder.ForBackupStorageLocation("velero", "zstd-loc").Bucket("store-1").Compression(velerov1api.CompressionAlgorithmZstd, 0).Result(),
+			defaultVolumesToRestic: false,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "zstd-loc",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:        "zstd-loc",
+					DefaultVolumesToRestic: boolptr.False(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhaseCompleted,
+					Version:             1,
+					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmZstd,
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -483,9 +929,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -520,9 +968,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					Expiration:          &metav1.Time{now.Add(10 * time.Minute)},
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -557,9 +1007,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -595,9 +1047,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -633,9 +1087,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -671,9 +1127,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -709,9 +1167,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseCompleted,
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -749,9 +1209,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					FailureReason:       "backup already exists in object storage",
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -787,9 +1249,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 					FailureReason:       "error checking if backup already exists in object storage: Backup already exists in object storage",
 					Version:             1,
 					FormatVersion:       "1.1.0",
+					Compression:         velerov1api.CompressionAlgorithmGzip,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
+					Attempts:            1,
 				},
 			},
 		},
@@ -841,7 +1305,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupLocation:  defaultBackupLocation.Name,
 				defaultVolumesToRestic: test.defaultVolumesToRestic,
-				backupTracker:          NewBackupTracker(),
+				backupTracker:          NewBackupTracker(metrics.NewServerMetrics()),
 				metrics:                metrics.NewServerMetrics(),
 				clock:                  clock.NewFakeClock(now),
 				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
@@ -866,6 +1330,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 					strings.Contains(buf.String(), `"completionTimestamp": "2006-01-02T22:04:05Z"`)
 			}
 			backupStore.On("PutBackup", mock.MatchedBy(hasNameAndCompletionTimestamp)).Return(nil)
+			backupStore.On("DeleteBackupCheckpoint", test.backup.Name).Return(nil)
 
 			// add the test's backup to the informer/lister store
 			require.NotNil(t, test.backup)
@@ -887,6 +1352,67 @@ func TestProcessBackupCompletions(t *testing.T) {
 	}
 }
 
+func TestPersistBackupDryRun(t *testing.T) {
+	backupContents, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer closeAndRemoveFile(backupContents, velerotest.NewLogger())
+
+	backupLog, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer closeAndRemoveFile(backupLog, velerotest.NewLogger())
+
+	backup := &pkgbackup.Request{
+		Backup:       defaultBackup().DryRun(true).Phase(velerov1api.BackupPhaseCompleted).Result(),
+		Manifest:     &pkgbackup.Manifest{},
+		DryRunReport: pkgbackup.NewDryRunReport(),
+	}
+
+	backupStore := new(persistencemocks.BackupStore)
+	backupStore.On("PutBackup", mock.MatchedBy(func(info persistence.BackupInfo) bool {
+		return info.Name == "backup-1" &&
+			info.Metadata != nil &&
+			info.Log != nil &&
+			info.Contents == nil &&
+			info.BackupManifest == nil &&
+			info.DryRunReport != nil
+	})).Return(nil)
+
+	errs := persistBackup(backup, backupContents, backupLog, backupStore, velerotest.NewLogger(), nil, nil, nil)
+	assert.Empty(t, errs)
+	backupStore.AssertExpectations(t)
+}
+
+func TestPersistBackupCanceled(t *testing.T) {
+	backupContents, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer closeAndRemoveFile(backupContents, velerotest.NewLogger())
+
+	backupLog, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer closeAndRemoveFile(backupLog, velerotest.NewLogger())
+
+	backup := &pkgbackup.Request{
+		Backup:   defaultBackup().Phase(velerov1api.BackupPhaseCanceled).Result(),
+		Manifest: &pkgbackup.Manifest{},
+	}
+
+	backupStore := new(persistencemocks.BackupStore)
+	backupStore.On("PutBackup", mock.MatchedBy(func(info persistence.BackupInfo) bool {
+		return info.Name == "backup-1" &&
+			info.Metadata == nil &&
+			info.Log != nil &&
+			info.Contents == nil &&
+			info.BackupManifest == nil &&
+			info.BackupResourceList == nil &&
+			info.PodVolumeBackups != nil &&
+			info.VolumeSnapshots != nil
+	})).Return(nil)
+
+	errs := persistBackup(backup, backupContents, backupLog, backupStore, velerotest.NewLogger(), nil, nil, nil)
+	assert.Empty(t, errs)
+	backupStore.AssertExpectations(t)
+}
+
 func TestValidateAndGetSnapshotLocations(t *testing.T) {
 	tests := []struct {
 		name                                string
@@ -37,6 +37,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/delete"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
@@ -47,9 +48,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -354,6 +357,13 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		}
 	}
 
+	if backupStore != nil {
+		log.Info("Removing item snapshots")
+		for _, err := range c.deleteItemSnapshots(backup, backupStore, pluginManager, log) {
+			errs = append(errs, err.Error())
+		}
+	}
+
 	log.Info("Removing restic snapshots")
 	if deleteErrs := c.deleteResticSnapshots(backup); len(deleteErrs) > 0 {
 		for _, err := range deleteErrs {
@@ -451,6 +461,68 @@ func volumeSnapshotterForSnapshotLocation(
 	return volumeSnapshotter, nil
 }
 
+// deleteItemSnapshots deletes the item snapshots taken by ItemSnapshotter plugins for the backup.
+func (c *backupDeletionController) deleteItemSnapshots(backup *velerov1api.Backup, backupStore persistence.BackupStore, pluginManager clientmgmt.Manager, log logrus.FieldLogger) []error {
+	itemSnapshots, err := backupStore.GetItemSnapshots(backup.Name)
+	if err != nil {
+		return []error{errors.Wrap(err, "error getting backup's item snapshots")}
+	}
+	if len(itemSnapshots) == 0 {
+		return nil
+	}
+
+	// the item snapshotters are passed the snapshotted items as they're stored in the backup
+	backupFile, err := downloadToTempFile(backup.Name, backupStore, log)
+	if err != nil {
+		return []error{errors.Wrap(err, "error downloading backup to delete its item snapshots")}
+	}
+	defer closeAndRemoveFile(backupFile, c.logger)
+
+	fs := filesystem.NewFileSystem()
+	dir, err := archive.NewExtractor(log, fs).UnzipAndExtractBackup(backupFile)
+	if err != nil {
+		return []error{errors.Wrap(err, "error extracting backup to delete its item snapshots")}
+	}
+	defer fs.RemoveAll(dir)
+
+	var errs []error
+	for _, itemSnapshot := range itemSnapshots {
+		log.WithFields(logrus.Fields{
+			"itemSnapshotter":    itemSnapshot.Spec.ItemSnapshotter,
+			"item":               itemSnapshot.Spec.ResourceIdentifier,
+			"providerSnapshotID": itemSnapshot.Status.ProviderSnapshotID,
+		}).Info("Removing item snapshot associated with backup")
+
+		itemSnapshotter, err := pluginManager.GetItemSnapshotter(itemSnapshot.Spec.ItemSnapshotter)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error getting item snapshotter %s", itemSnapshot.Spec.ItemSnapshotter))
+			continue
+		}
+
+		id, err := volume.ParseItemSnapshotResourceIdentifier(itemSnapshot.Spec.ResourceIdentifier)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		item, err := archive.Unmarshal(fs, archive.GetItemFilePath(dir, id.GroupResource.String(), id.Namespace, id.Name))
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error reading item %s from backup", itemSnapshot.Spec.ResourceIdentifier))
+			continue
+		}
+
+		if err := itemSnapshotter.DeleteSnapshot(context.TODO(), &isv1.DeleteSnapshotInput{
+			SnapshotID:       itemSnapshot.Status.ProviderSnapshotID,
+			ItemFromBackup:   item,
+			SnapshotMetadata: itemSnapshot.Status.Metadata,
+		}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error deleting item snapshot %s", itemSnapshot.Status.ProviderSnapshotID))
+		}
+	}
+
+	return errs
+}
+
 func (c *backupDeletionController) deleteExistingDeletionRequests(req *velerov1api.DeleteBackupRequest, log logrus.FieldLogger) []error {
 	log.Info("Removing existing deletion requests for backup")
 	selector := label.NewSelectorForBackup(req.Spec.BackupName)
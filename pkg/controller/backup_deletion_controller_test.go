@@ -531,6 +531,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-1").Return(nil)
@@ -692,6 +693,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-1").Return(nil)
@@ -833,6 +835,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 
 		err := td.controller.processRequest(td.req)
@@ -963,6 +966,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(nil, fmt.Errorf("error downloading tarball"))
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 
@@ -0,0 +1,306 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+const (
+	defaultBackupReplicationSyncPeriod = time.Minute
+	defaultBackupReplicationMaxRetries = 3
+)
+
+type backupReplicationReconciler struct {
+	client.Client
+	namespace         string
+	logger            logrus.FieldLogger
+	clock             clock.Clock
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+}
+
+func NewBackupReplicationReconciler(
+	namespace string,
+	logger logrus.FieldLogger,
+	client client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+) *backupReplicationReconciler {
+	return &backupReplicationReconciler{
+		Client:            client,
+		namespace:         namespace,
+		logger:            logger,
+		clock:             clock.RealClock{},
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+}
+
+func (r *backupReplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// replications are requeued after every sync, so the updates of their
+	// status don't need to trigger another one
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.BackupReplication{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupreplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupreplications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *backupReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backupReplication", req.String())
+
+	log.Debug("Getting backup replication")
+	replication := &velerov1api.BackupReplication{}
+	if err := r.Get(ctx, req.NamespacedName, replication); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find backup replication")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup replication %s", req.String())
+	}
+
+	patchHelper, err := patch.NewHelper(replication, r.Client)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error new patch helper for backup replication %s", req.String())
+	}
+
+	syncPeriod := defaultBackupReplicationSyncPeriod
+	if replication.Spec.SyncPeriod != nil && replication.Spec.SyncPeriod.Duration > 0 {
+		syncPeriod = replication.Spec.SyncPeriod.Duration
+	}
+
+	source, destination, errs, err := r.validate(ctx, replication)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(errs) > 0 {
+		// the locations may still be created, so the replication is
+		// validated again at its next sync
+		replication.Status.Phase = velerov1api.BackupReplicationPhaseFailedValidation
+		replication.Status.ValidationErrors = errs
+	} else {
+		replication.Status.Phase = velerov1api.BackupReplicationPhaseEnabled
+		replication.Status.ValidationErrors = nil
+
+		if err := r.replicate(ctx, log, replication, source, destination); err != nil {
+			log.WithError(err).Error("Error replicating backups")
+		}
+		replication.Status.LastSyncedTime = &metav1.Time{Time: r.clock.Now()}
+	}
+
+	if err := patchHelper.Patch(ctx, replication); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error updating backup replication %s", req.String())
+	}
+
+	return ctrl.Result{RequeueAfter: syncPeriod}, nil
+}
+
+// validate returns the source and destination locations of the replication, or
+// the validation errors of the replication.
+func (r *backupReplicationReconciler) validate(ctx context.Context, replication *velerov1api.BackupReplication) (*velerov1api.BackupStorageLocation, *velerov1api.BackupStorageLocation, []string, error) {
+	var errs []string
+
+	if replication.Spec.SourceLocation == "" {
+		errs = append(errs, "sourceLocation must be set")
+	}
+	if replication.Spec.DestinationLocation == "" {
+		errs = append(errs, "destinationLocation must be set")
+	}
+	if replication.Spec.SourceLocation != "" && replication.Spec.SourceLocation == replication.Spec.DestinationLocation {
+		errs = append(errs, "sourceLocation and destinationLocation must be different")
+	}
+	if replication.Spec.MaxRetries < 0 {
+		errs = append(errs, "maxRetries must not be negative")
+	}
+	if replication.Spec.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(replication.Spec.LabelSelector); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid labelSelector: %v", err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errs, nil
+	}
+
+	getLocation := func(name string) (*velerov1api.BackupStorageLocation, error) {
+		location := &velerov1api.BackupStorageLocation{}
+		err := r.Get(ctx, client.ObjectKey{Namespace: replication.Namespace, Name: name}, location)
+		if apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Sprintf("backup storage location %q not found", name))
+			return nil, nil
+		}
+		return location, errors.Wrapf(err, "error getting backup storage location %s", name)
+	}
+
+	source, err := getLocation(replication.Spec.SourceLocation)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	destination, err := getLocation(replication.Spec.DestinationLocation)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if destination != nil && destination.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		errs = append(errs, fmt.Sprintf("backup storage location %q is read-only", destination.Name))
+	}
+
+	return source, destination, errs, nil
+}
+
+// replicate copies the completed backups of the source location that the
+// destination location doesn't have yet, and records the ones that failed to
+// be copied in the replication's status.
+func (r *backupReplicationReconciler) replicate(ctx context.Context, log logrus.FieldLogger, replication *velerov1api.BackupReplication, source, destination *velerov1api.BackupStorageLocation) error {
+	selector := labels.Everything()
+	if replication.Spec.LabelSelector != nil {
+		// the selector was validated already
+		selector, _ = metav1.LabelSelectorAsSelector(replication.Spec.LabelSelector)
+	}
+
+	backups := &velerov1api.BackupList{}
+	if err := r.List(ctx, backups, &client.ListOptions{
+		Namespace:     replication.Namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{velerov1api.StorageLocationLabel: label.GetValidName(source.Name)}),
+	}); err != nil {
+		return errors.Wrap(err, "error listing backups")
+	}
+	sort.Slice(backups.Items, func(i, j int) bool {
+		return backups.Items[i].Name < backups.Items[j].Name
+	})
+
+	maxRetries := defaultBackupReplicationMaxRetries
+	if replication.Spec.MaxRetries > 0 {
+		maxRetries = replication.Spec.MaxRetries
+	}
+
+	failures := map[string]velerov1api.BackupReplicationFailure{}
+	for _, failure := range replication.Status.Failures {
+		failures[failure.Backup] = failure
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	sourceStore, err := r.backupStoreGetter.Get(source, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup store of backup storage location %s", source.Name)
+	}
+	destinationStore, err := r.backupStoreGetter.Get(destination, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup store of backup storage location %s", destination.Name)
+	}
+
+	// only the failures of the backups that still have to be copied are kept
+	remainingFailures := map[string]velerov1api.BackupReplicationFailure{}
+
+	var pending []string
+	for _, backup := range backups.Items {
+		if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+			continue
+		}
+		if backup.Spec.DryRun {
+			continue
+		}
+		if !selector.Matches(labels.Set(backup.Labels)) {
+			continue
+		}
+
+		exists, err := destinationStore.BackupExists(destination.Spec.ObjectStorage.Bucket, backup.Name)
+		if err != nil {
+			return errors.Wrapf(err, "error checking if backup %s exists in backup storage location %s", backup.Name, destination.Name)
+		}
+		if exists {
+			continue
+		}
+
+		if failure, ok := failures[backup.Name]; ok {
+			remainingFailures[backup.Name] = failure
+			if failure.Attempts >= maxRetries {
+				continue
+			}
+		}
+		pending = append(pending, backup.Name)
+	}
+
+	recordFailure := func(backup string, err error) {
+		log.WithError(err).WithField("backup", backup).Error("Error copying backup")
+		failure := remainingFailures[backup]
+		failure.Backup = backup
+		failure.Attempts++
+		failure.Message = err.Error()
+		failure.LastAttemptTimestamp = &metav1.Time{Time: r.clock.Now()}
+		remainingFailures[backup] = failure
+	}
+
+	if len(pending) > 0 {
+		// the data of the pod volume backups is copied before the backups, so
+		// that the backups can be restored as soon as they're in the destination
+		copied, err := persistence.CopyRepositoryFiles(sourceStore, destinationStore)
+		if err != nil {
+			for _, backup := range pending {
+				recordFailure(backup, errors.Wrap(err, "error copying pod volume backup repository data"))
+			}
+			pending = nil
+		} else if copied > 0 {
+			log.Infof("Copied %d pod volume backup repository files", copied)
+		}
+	}
+
+	for _, backup := range pending {
+		if err := persistence.CopyBackup(sourceStore, destinationStore, backup); err != nil {
+			recordFailure(backup, err)
+			continue
+		}
+
+		log.WithField("backup", backup).Info("Backup copied to the destination location")
+		delete(remainingFailures, backup)
+		replication.Status.ReplicatedBackups++
+	}
+
+	replication.Status.Failures = nil
+	for _, failure := range remainingFailures {
+		replication.Status.Failures = append(replication.Status.Failures, failure)
+	}
+	sort.Slice(replication.Status.Failures, func(i, j int) bool {
+		return replication.Status.Failures[i].Backup < replication.Status.Failures[j].Backup
+	})
+
+	return nil
+}
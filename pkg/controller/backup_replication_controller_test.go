@@ -0,0 +1,200 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupReplicationValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		replication *velerov1api.BackupReplication
+		wantErrors  []string
+	}{
+		{
+			name:        "locations must be set",
+			replication: builder.ForBackupReplication("velero", "replication-1").MaxRetries(-1).Result(),
+			wantErrors: []string{
+				"sourceLocation must be set",
+				"destinationLocation must be set",
+				"maxRetries must not be negative",
+			},
+		},
+		{
+			name:        "locations must be different",
+			replication: builder.ForBackupReplication("velero", "replication-1").SourceLocation("src").DestinationLocation("src").Result(),
+			wantErrors:  []string{"sourceLocation and destinationLocation must be different"},
+		},
+		{
+			name:        "locations must exist",
+			replication: builder.ForBackupReplication("velero", "replication-1").SourceLocation("src").DestinationLocation("missing").Result(),
+			wantErrors:  []string{`backup storage location "missing" not found`},
+		},
+		{
+			name:        "destination must not be read-only",
+			replication: builder.ForBackupReplication("velero", "replication-1").SourceLocation("src").DestinationLocation("read-only").Result(),
+			wantErrors:  []string{`backup storage location "read-only" is read-only`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t,
+				tc.replication,
+				builder.ForBackupStorageLocation("velero", "src").Bucket("src-bucket").Result(),
+				builder.ForBackupStorageLocation("velero", "read-only").Bucket("read-only-bucket").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			)
+			r := NewBackupReplicationReconciler("velero", velerotest.NewLogger(), client, nil, nil)
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "replication-1"}})
+			require.NoError(t, err)
+			assert.Equal(t, time.Minute, result.RequeueAfter)
+
+			replication := &velerov1api.BackupReplication{}
+			require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: "replication-1"}, replication))
+			assert.Equal(t, velerov1api.BackupReplicationPhaseFailedValidation, replication.Status.Phase)
+			assert.Equal(t, tc.wantErrors, replication.Status.ValidationErrors)
+			assert.Nil(t, replication.Status.LastSyncedTime)
+		})
+	}
+}
+
+func TestBackupReplicationReconcile(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	backup := func(name, location string, phase velerov1api.BackupPhase, app string) runtime.Object {
+		return builder.ForBackup("velero", name).
+			ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, location, "app", app)).
+			StorageLocation(location).
+			Phase(phase).
+			Result()
+	}
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackupReplication("velero", "replication-1").
+			SourceLocation("src").
+			DestinationLocation("dst").
+			LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}).
+			Failures(
+				velerov1api.BackupReplicationFailure{Backup: "backup-6", Attempts: 3, Message: "put failed", LastAttemptTimestamp: &earlier},
+				velerov1api.BackupReplicationFailure{Backup: "backup-7", Attempts: 1, Message: "backup was deleted", LastAttemptTimestamp: &earlier},
+			).
+			Result(),
+		builder.ForBackupStorageLocation("velero", "src").Bucket("src-bucket").Result(),
+		builder.ForBackupStorageLocation("velero", "dst").Bucket("dst-bucket").Result(),
+		// copied
+		backup("backup-1", "src", velerov1api.BackupPhaseCompleted, "db"),
+		// already in the destination
+		backup("backup-2", "src", velerov1api.BackupPhaseCompleted, "db"),
+		// not completed
+		backup("backup-3", "src", velerov1api.BackupPhaseInProgress, "db"),
+		// not selected
+		backup("backup-4", "src", velerov1api.BackupPhaseCompleted, "web"),
+		// fails to be copied
+		backup("backup-5", "src", velerov1api.BackupPhasePartiallyFailed, "db"),
+		// already failed the maximum number of times
+		backup("backup-6", "src", velerov1api.BackupPhaseCompleted, "db"),
+		// in another location
+		backup("backup-8", "other", velerov1api.BackupPhaseCompleted, "db"),
+		// dry run
+		builder.ForBackup("velero", "backup-9").
+			ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "src", "app", "db")).
+			StorageLocation("src").
+			Phase(velerov1api.BackupPhaseCompleted).
+			DryRun(true).
+			Result(),
+	)
+
+	src, dst := &persistencemocks.BackupStore{}, &persistencemocks.BackupStore{}
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	dst.On("BackupExists", "dst-bucket", "backup-1").Return(false, nil)
+	dst.On("BackupExists", "dst-bucket", "backup-2").Return(true, nil)
+	dst.On("BackupExists", "dst-bucket", "backup-5").Return(false, nil)
+	dst.On("BackupExists", "dst-bucket", "backup-6").Return(false, nil)
+
+	src.On("ListRepositoryFiles").Return([]string{"restic/ns-1/config"}, nil)
+	dst.On("ListRepositoryFiles").Return([]string{}, nil)
+	src.On("GetRepositoryFile", "restic/ns-1/config").Return(ioutil.NopCloser(strings.NewReader("config")), nil)
+	dst.On("PutRepositoryFile", "restic/ns-1/config", mock.Anything).Return(nil)
+
+	for _, name := range []string{"backup-1", "backup-5"} {
+		src.On("ListBackupFiles", name).Return([]string{"velero-backup.json", name + ".tar.gz"}, nil)
+	}
+	src.On("GetBackupFile", mock.Anything, mock.Anything).Return(ioutil.NopCloser(strings.NewReader("")), nil)
+	dst.On("PutBackupFile", "backup-1", mock.Anything, mock.Anything).Return(nil)
+	dst.On("PutBackupFile", "backup-5", mock.Anything, mock.Anything).Return(errors.New("put failed"))
+
+	r := NewBackupReplicationReconciler(
+		"velero",
+		velerotest.NewLogger(),
+		client,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"src": src, "dst": dst}),
+	)
+	r.clock = clock.NewFakeClock(now)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "replication-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, result.RequeueAfter)
+
+	// the metadata file is copied last
+	require.Len(t, dst.Calls, 9)
+	dst.AssertCalled(t, "PutBackupFile", "backup-1", "backup-1.tar.gz", mock.Anything)
+	dst.AssertCalled(t, "PutBackupFile", "backup-1", "velero-backup.json", mock.Anything)
+	assert.Equal(t, "velero-backup.json", dst.Calls[len(dst.Calls)-2].Arguments.String(1))
+	dst.AssertCalled(t, "PutRepositoryFile", "restic/ns-1/config", mock.Anything)
+	src.AssertNotCalled(t, "ListBackupFiles", "backup-6")
+
+	replication := &velerov1api.BackupReplication{}
+	require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: "replication-1"}, replication))
+	assert.Equal(t, velerov1api.BackupReplicationPhaseEnabled, replication.Status.Phase)
+	assert.Equal(t, now, replication.Status.LastSyncedTime.Time.UTC())
+	assert.Equal(t, 1, replication.Status.ReplicatedBackups)
+
+	require.Len(t, replication.Status.Failures, 2)
+	assert.Equal(t, "backup-5", replication.Status.Failures[0].Backup)
+	assert.Equal(t, 1, replication.Status.Failures[0].Attempts)
+	assert.Equal(t, "error putting backup file backup-5.tar.gz: put failed", replication.Status.Failures[0].Message)
+	assert.Equal(t, "backup-6", replication.Status.Failures[1].Backup)
+	assert.Equal(t, 3, replication.Status.Failures[1].Attempts)
+}
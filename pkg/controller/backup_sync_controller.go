@@ -217,6 +217,12 @@ func (c *backupSyncController) run() {
 				continue
 			}
 
+			// dry runs only report what they would back up, so they can't be restored
+			if backup.Spec.DryRun {
+				log.Debug("Skipping dry-run backup")
+				continue
+			}
+
 			backup.Namespace = c.namespace
 			backup.ResourceVersion = ""
 
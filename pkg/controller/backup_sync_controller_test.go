@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -327,6 +328,21 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				builder.ForPodVolumeBackup("ns-1", "pvb-2").Result(),
 			},
 		},
+		{
+			name:      "dry-run backups don't get synced",
+			namespace: "ns-1",
+			locations: defaultLocationsList("ns-1"),
+			cloudBuckets: map[string][]*cloudBackupData{
+				"bucket-1": {
+					&cloudBackupData{
+						backup: builder.ForBackup("ns-1", "backup-1").Result(),
+					},
+					&cloudBackupData{
+						backup: builder.ForBackup("ns-1", "backup-2").DryRun(true).Result(),
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -405,6 +421,12 @@ func TestBackupSyncControllerRun(t *testing.T) {
 
 				// process the cloud backups
 				for _, cloudBackupData := range backupDataSet {
+					if cloudBackupData.backup.Spec.DryRun {
+						_, err := client.VeleroV1().Backups(test.namespace).Get(context.TODO(), cloudBackupData.backup.Name, metav1.GetOptions{})
+						assert.True(t, apierrors.IsNotFound(err))
+						continue
+					}
+
 					obj, err := client.VeleroV1().Backups(test.namespace).Get(context.TODO(), cloudBackupData.backup.Name, metav1.GetOptions{})
 					require.NoError(t, err)
 
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -29,22 +30,30 @@ import (
 type BackupTracker interface {
 	// Add informs the tracker that a backup is in progress.
 	Add(ns, name string)
+	// AddIfNotOverlapping informs the tracker that a backup of the given namespaces
+	// is in progress, unless one of them is backed up by a backup already in progress.
+	// In that case the backup isn't tracked, and the namespace/name of the overlapping
+	// backup is returned along with false.
+	AddIfNotOverlapping(ns, name string, namespaces []string) (string, bool)
 	// Delete informs the tracker that a backup is no longer in progress.
 	Delete(ns, name string)
 	// Contains returns true if the tracker is tracking the backup.
 	Contains(ns, name string) bool
+	// InFlight returns the namespace/name of the backups in progress, sorted.
+	InFlight() []string
 }
 
 type backupTracker struct {
 	metrics *metrics.ServerMetrics
 	lock    sync.RWMutex
-	backups sets.String
+	// backups maps the backups in progress to the namespaces they back up.
+	backups map[string]sets.String
 }
 
 // NewBackupTracker returns a new BackupTracker.
 func NewBackupTracker(metrics *metrics.ServerMetrics) BackupTracker {
 	return &backupTracker{
-		backups: sets.NewString(),
+		backups: map[string]sets.String{},
 		metrics: metrics,
 	}
 }
@@ -53,23 +62,59 @@ func (bt *backupTracker) Add(ns, name string) {
 	bt.lock.Lock()
 	defer bt.lock.Unlock()
 
-	bt.backups.Insert(backupTrackerKey(ns, name))
-	bt.metrics.SetBackupActiveTotal(int64(bt.backups.Len()))
+	bt.add(ns, name, nil)
+}
+
+func (bt *backupTracker) AddIfNotOverlapping(ns, name string, namespaces []string) (string, bool) {
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+
+	for _, key := range bt.inFlight() {
+		if bt.backups[key].HasAny(namespaces...) {
+			return key, false
+		}
+	}
+
+	bt.add(ns, name, namespaces)
+	return "", true
+}
+
+func (bt *backupTracker) add(ns, name string, namespaces []string) {
+	bt.backups[backupTrackerKey(ns, name)] = sets.NewString(namespaces...)
+	bt.metrics.SetBackupActiveTotal(int64(len(bt.backups)))
 }
 
 func (bt *backupTracker) Delete(ns, name string) {
 	bt.lock.Lock()
 	defer bt.lock.Unlock()
 
-	bt.backups.Delete(backupTrackerKey(ns, name))
-	bt.metrics.SetBackupActiveTotal(int64(bt.backups.Len()))
+	delete(bt.backups, backupTrackerKey(ns, name))
+	bt.metrics.SetBackupActiveTotal(int64(len(bt.backups)))
 }
 
 func (bt *backupTracker) Contains(ns, name string) bool {
 	bt.lock.RLock()
 	defer bt.lock.RUnlock()
 
-	return bt.backups.Has(backupTrackerKey(ns, name))
+	_, ok := bt.backups[backupTrackerKey(ns, name)]
+	return ok
+}
+
+func (bt *backupTracker) InFlight() []string {
+	bt.lock.RLock()
+	defer bt.lock.RUnlock()
+
+	return bt.inFlight()
+}
+
+func (bt *backupTracker) inFlight() []string {
+	keys := make([]string, 0, len(bt.backups))
+	for key := range bt.backups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
 }
 
 func backupTrackerKey(ns, name string) string {
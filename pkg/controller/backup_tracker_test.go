@@ -45,3 +45,35 @@ func TestBackupTracker(t *testing.T) {
 	bt.Delete("ns2", "name2")
 	assert.False(t, bt.Contains("ns2", "name2"))
 }
+
+func TestBackupTrackerAddIfNotOverlapping(t *testing.T) {
+	bt := NewBackupTracker(metrics.NewServerMetrics())
+
+	overlapping, added := bt.AddIfNotOverlapping("velero", "backup-1", []string{"ns-1", "ns-2"})
+	assert.True(t, added)
+	assert.Empty(t, overlapping)
+
+	overlapping, added = bt.AddIfNotOverlapping("velero", "backup-2", []string{"ns-2", "ns-3"})
+	assert.False(t, added)
+	assert.Equal(t, "velero/backup-1", overlapping)
+	assert.False(t, bt.Contains("velero", "backup-2"))
+
+	_, added = bt.AddIfNotOverlapping("velero", "backup-3", []string{"ns-3"})
+	assert.True(t, added)
+
+	// a backup of no namespaces never overlaps
+	_, added = bt.AddIfNotOverlapping("velero", "backup-4", nil)
+	assert.True(t, added)
+
+	assert.Equal(t, []string{"velero/backup-1", "velero/backup-3", "velero/backup-4"}, bt.InFlight())
+
+	bt.Delete("velero", "backup-1")
+	_, added = bt.AddIfNotOverlapping("velero", "backup-2", []string{"ns-2", "ns-3"})
+	assert.False(t, added)
+
+	bt.Delete("velero", "backup-3")
+	_, added = bt.AddIfNotOverlapping("velero", "backup-2", []string{"ns-2", "ns-3"})
+	assert.True(t, added)
+
+	assert.Equal(t, []string{"velero/backup-2", "velero/backup-4"}, bt.InFlight())
+}
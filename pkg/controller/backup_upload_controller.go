@@ -0,0 +1,380 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// BackupUploadSyncPeriod is how often the progress of the uploads of backups
+// in the Uploading phases is checked.
+const BackupUploadSyncPeriod = time.Minute
+
+// backupUploadController checks on the item snapshots, volume snapshots and
+// item operations of backups in the Uploading and UploadingPartialFailure
+// phases, and completes the backups once all of them are uploaded.
+type backupUploadController struct {
+	*genericController
+
+	lister            velerov1listers.BackupLister
+	client            velerov1client.BackupsGetter
+	kbClient          kbclient.Client
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	metrics           *metrics.ServerMetrics
+	clock             clock.Clock
+	// operationTimeout is how long after a backup's items are backed up its
+	// snapshots and item operations may be uploaded before the ones still in
+	// progress are failed.
+	operationTimeout time.Duration
+}
+
+// NewBackupUploadController constructs a new backupUploadController.
+func NewBackupUploadController(
+	logger logrus.FieldLogger,
+	backupInformer velerov1informers.BackupInformer,
+	client velerov1client.BackupsGetter,
+	kbClient kbclient.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	metrics *metrics.ServerMetrics,
+	operationTimeout time.Duration,
+) Interface {
+	c := &backupUploadController{
+		genericController: newGenericController(BackupUpload, logger),
+		lister:            backupInformer.Lister(),
+		client:            client,
+		kbClient:          kbClient,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		metrics:           metrics,
+		clock:             clock.RealClock{},
+		operationTimeout:  operationTimeout,
+	}
+
+	c.syncHandler = c.processBackup
+	c.resyncPeriod = BackupUploadSyncPeriod
+	// the resync also picks up the backups that were uploading when the server
+	// was restarted, since it runs as soon as the controller starts.
+	c.resyncFunc = c.enqueueUploadingBackups
+
+	return c
+}
+
+// enqueueUploadingBackups enqueues all of the backups in the Uploading phases to
+// check on their progress.
+func (c *backupUploadController) enqueueUploadingBackups() {
+	backups, err := c.lister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing backups")
+		return
+	}
+
+	for _, backup := range backups {
+		if isUploading(backup) {
+			c.enqueue(backup)
+		}
+	}
+}
+
+func isUploading(backup *velerov1api.Backup) bool {
+	return backup.Status.Phase == velerov1api.BackupPhaseUploading ||
+		backup.Status.Phase == velerov1api.BackupPhaseUploadingPartialFailure
+}
+
+func (c *backupUploadController) processBackup(key string) error {
+	log := c.logger.WithField("key", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	original, err := c.lister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	// the backup may have been completed or deleted since it was enqueued
+	if !isUploading(original) {
+		return nil
+	}
+
+	backup := original.DeepCopy()
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	uploads, err := c.getBackupUploads(backupStore, backup)
+	if err != nil {
+		return err
+	}
+
+	failedBefore := uploads.failed()
+	inProgress := uploads.updateProgress(log, backup, pluginManager)
+	if inProgress > 0 && c.operationTimedOut(backup) {
+		log.Errorf("Failing %d snapshots and item operations that weren't uploaded within %s", inProgress, c.operationTimeout)
+		uploads.failInProgress()
+		inProgress = 0
+	}
+	failed := uploads.failed() - failedBefore
+	backup.Status.VolumeSnapshotsCompleted = uploads.volumeSnapshotsCompleted()
+
+	if failed > 0 {
+		backup.Status.Errors += failed
+		backup.Status.Phase = velerov1api.BackupPhaseUploadingPartialFailure
+	}
+
+	if inProgress > 0 {
+		log.Debugf("Waiting for %d snapshots and item operations to be uploaded", inProgress)
+
+		if failed == 0 {
+			return nil
+		}
+		if err := uploads.put(backupStore, backup.Name); err != nil {
+			return err
+		}
+		_, err := patchBackup(original, backup, c.client)
+		return err
+	}
+
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseUploading:
+		backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	case velerov1api.BackupPhaseUploadingPartialFailure:
+		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+	}
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+	// the snapshots and item operations are persisted with their final phase
+	// before the backup's metadata, which is only persisted once the backup is
+	// completed.
+	if err := uploads.put(backupStore, backup.Name); err != nil {
+		return err
+	}
+
+	backupJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup, "json", backupJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup")
+	}
+	if err := backupStore.PutBackupMetadata(backup.Name, backupJSON); err != nil {
+		return errors.Wrap(err, "error uploading backup metadata")
+	}
+
+	if _, err := patchBackup(original, backup, c.client); err != nil {
+		return err
+	}
+
+	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted:
+		c.metrics.RegisterBackupSuccess(backupScheduleName)
+	case velerov1api.BackupPhasePartiallyFailed:
+		c.metrics.RegisterBackupPartialFailure(backupScheduleName)
+	}
+
+	log.Infof("Backup's snapshots and item operations are uploaded, backup is %s", backup.Status.Phase)
+
+	return nil
+}
+
+// operationTimedOut returns whether the operation timeout has passed since the
+// backup's items were backed up.
+func (c *backupUploadController) operationTimedOut(backup *velerov1api.Backup) bool {
+	if backup.Status.CompletionTimestamp == nil {
+		return false
+	}
+	return c.clock.Since(backup.Status.CompletionTimestamp.Time) > c.operationTimeout
+}
+
+// backupUploads are the snapshots and item operations of a backup whose uploads
+// are checked on.
+type backupUploads struct {
+	itemSnapshots   []*volume.ItemSnapshot
+	volumeSnapshots []*volume.Snapshot
+	itemOperations  []*itemoperation.BackupOperation
+	// snapshotLocations are the volume snapshot locations of the backup's
+	// volume snapshots.
+	snapshotLocations []*velerov1api.VolumeSnapshotLocation
+}
+
+// getBackupUploads gets the snapshots and item operations of a backup from its
+// backup store.
+func (c *backupUploadController) getBackupUploads(backupStore persistence.BackupStore, backup *velerov1api.Backup) (*backupUploads, error) {
+	uploads := &backupUploads{}
+
+	var err error
+	if uploads.itemSnapshots, err = backupStore.GetItemSnapshots(backup.Name); err != nil {
+		return nil, errors.Wrap(err, "error getting item snapshots")
+	}
+	if uploads.volumeSnapshots, err = backupStore.GetBackupVolumeSnapshots(backup.Name); err != nil {
+		return nil, errors.Wrap(err, "error getting volume snapshots")
+	}
+	if uploads.itemOperations, err = backupStore.GetBackupItemOperations(backup.Name); err != nil {
+		return nil, errors.Wrap(err, "error getting item operations")
+	}
+
+	if len(uploads.volumeSnapshots) > 0 {
+		locations := &velerov1api.VolumeSnapshotLocationList{}
+		if err := c.kbClient.List(context.Background(), locations, kbclient.InNamespace(backup.Namespace)); err != nil {
+			return nil, errors.Wrap(err, "error listing volume snapshot locations")
+		}
+		for i := range locations.Items {
+			uploads.snapshotLocations = append(uploads.snapshotLocations, &locations.Items[i])
+		}
+	}
+
+	return uploads, nil
+}
+
+// updateProgress checks on the uploads that are in progress and returns how many
+// still are.
+func (u *backupUploads) updateProgress(log logrus.FieldLogger, backup *velerov1api.Backup, pluginManager clientmgmt.Manager) int {
+	return pkgbackup.UpdateItemSnapshotsProgress(log, backup, u.itemSnapshots, pluginManager) +
+		pkgbackup.UpdateVolumeSnapshotsProgress(log, u.volumeSnapshots, u.snapshotLocations, pluginManager) +
+		pkgbackup.UpdateItemOperationsProgress(log, backup, u.itemOperations, pluginManager)
+}
+
+// failInProgress fails the uploads that are still in progress.
+func (u *backupUploads) failInProgress() {
+	for _, itemSnapshot := range u.itemSnapshots {
+		if itemSnapshot.Status.Phase == isv1.SnapshotPhaseInProgress {
+			itemSnapshot.Status.Phase = isv1.SnapshotPhaseFailed
+		}
+	}
+	for _, snapshot := range u.volumeSnapshots {
+		if snapshot.Status.Phase == volume.SnapshotPhaseUploading {
+			snapshot.Status.Phase = volume.SnapshotPhaseFailed
+		}
+	}
+	for _, operation := range u.itemOperations {
+		if operation.Status.Phase == velero.OperationPhaseInProgress {
+			operation.Status.Phase = velero.OperationPhaseFailed
+			operation.Status.Error = "operation timed out"
+		}
+	}
+}
+
+// failed returns the number of uploads that failed.
+func (u *backupUploads) failed() int {
+	failed := 0
+	for _, itemSnapshot := range u.itemSnapshots {
+		if itemSnapshot.Status.Phase == isv1.SnapshotPhaseFailed {
+			failed++
+		}
+	}
+	for _, snapshot := range u.volumeSnapshots {
+		if snapshot.Status.Phase == volume.SnapshotPhaseFailed {
+			failed++
+		}
+	}
+	for _, operation := range u.itemOperations {
+		if operation.Status.Phase == velero.OperationPhaseFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// volumeSnapshotsCompleted returns the number of volume snapshots that completed.
+func (u *backupUploads) volumeSnapshotsCompleted() int {
+	completed := 0
+	for _, snapshot := range u.volumeSnapshots {
+		if snapshot.Status.Phase == volume.SnapshotPhaseCompleted {
+			completed++
+		}
+	}
+	return completed
+}
+
+// put persists the snapshots and item operations of a backup to its backup store.
+func (u *backupUploads) put(backupStore persistence.BackupStore, backupName string) error {
+	if len(u.itemSnapshots) > 0 {
+		itemSnapshotsJSON, errs := encodeToJSONGzip(u.itemSnapshots, "item snapshots list")
+		if errs != nil {
+			return kerrors.NewAggregate(errs)
+		}
+		if err := backupStore.PutItemSnapshots(backupName, itemSnapshotsJSON); err != nil {
+			return errors.Wrap(err, "error uploading item snapshots")
+		}
+	}
+
+	if len(u.volumeSnapshots) > 0 {
+		volumeSnapshotsJSON, errs := encodeToJSONGzip(u.volumeSnapshots, "native volumesnapshots list")
+		if errs != nil {
+			return kerrors.NewAggregate(errs)
+		}
+		if err := backupStore.PutBackupVolumeSnapshots(backupName, volumeSnapshotsJSON); err != nil {
+			return errors.Wrap(err, "error uploading volume snapshots")
+		}
+	}
+
+	if len(u.itemOperations) > 0 {
+		itemOperationsJSON, errs := encodeToJSONGzip(u.itemOperations, "item operations list")
+		if errs != nil {
+			return kerrors.NewAggregate(errs)
+		}
+		if err := backupStore.PutBackupItemOperations(backupName, itemOperationsJSON); err != nil {
+			return errors.Wrap(err, "error uploading item operations")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	backupmocks "github.com/vmware-tanzu/velero/pkg/backup/mocks"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
+	ismocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1/mocks"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestBackupUploadControllerProcessBackup(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	itemSnapshot := func(name string) *volume.ItemSnapshot {
+		return &volume.ItemSnapshot{
+			Spec: volume.ItemSnapshotSpec{
+				ItemSnapshotter:    "velero.io/fake",
+				BackupName:         "backup-1",
+				ResourceIdentifier: "pods/ns-1/" + name,
+			},
+			Status: volume.ItemSnapshotStatus{
+				ProviderSnapshotID: name + "-snapshot",
+				Phase:              isv1.SnapshotPhaseInProgress,
+			},
+		}
+	}
+
+	volumeSnapshot := &volume.Snapshot{
+		Spec: volume.SnapshotSpec{
+			BackupName:           "backup-1",
+			Location:             "vsl-1",
+			PersistentVolumeName: "pv-1",
+		},
+		Status: volume.SnapshotStatus{
+			ProviderSnapshotID: "pv-1-snapshot",
+			Phase:              volume.SnapshotPhaseUploading,
+		},
+	}
+
+	itemOperation := &itemoperation.BackupOperation{
+		Spec: itemoperation.BackupOperationSpec{
+			BackupName:         "backup-1",
+			BackupItemAction:   "velero.io/fake-action",
+			ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-3"},
+		},
+		Status: itemoperation.BackupOperationStatus{
+			Phase: velero.OperationPhaseInProgress,
+		},
+	}
+
+	tests := []struct {
+		name                         string
+		backup                       *velerov1api.Backup
+		progress                     map[string]isv1.SnapshotPhase
+		volumeSnapshotProgress       velero.OperationPhase
+		itemOperationProgress        velero.OperationPhase
+		wantPhase                    velerov1api.BackupPhase
+		wantErrors                   int
+		wantVolumeSnapshotsCompleted int
+		wantCompleted                bool
+		wantItemSnapshot             bool
+	}{
+		{
+			name:             "backup is completed once all item snapshots are uploaded",
+			backup:           defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).Result(),
+			progress:         map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseCompleted},
+			wantPhase:        velerov1api.BackupPhaseCompleted,
+			wantCompleted:    true,
+			wantItemSnapshot: true,
+		},
+		{
+			name:      "backup keeps uploading while item snapshots are in progress",
+			backup:    defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).Result(),
+			progress:  map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseInProgress},
+			wantPhase: velerov1api.BackupPhaseUploading,
+		},
+		{
+			name:             "failed item snapshots make an uploading backup partially failed",
+			backup:           defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).Result(),
+			progress:         map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseFailed, "pod-2-snapshot": isv1.SnapshotPhaseInProgress},
+			wantPhase:        velerov1api.BackupPhaseUploadingPartialFailure,
+			wantErrors:       1,
+			wantItemSnapshot: true,
+		},
+		{
+			name:             "backup with partial failures is partially failed once all item snapshots are uploaded",
+			backup:           defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploadingPartialFailure).Result(),
+			progress:         map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseCompleted},
+			wantPhase:        velerov1api.BackupPhasePartiallyFailed,
+			wantCompleted:    true,
+			wantItemSnapshot: true,
+		},
+		{
+			name:             "item snapshots still in progress after the operation timeout are failed",
+			backup:           defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).CompletionTimestamp(now.Add(-2 * time.Hour)).Result(),
+			progress:         map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseInProgress},
+			wantPhase:        velerov1api.BackupPhasePartiallyFailed,
+			wantErrors:       1,
+			wantCompleted:    true,
+			wantItemSnapshot: true,
+		},
+		{
+			name:      "item snapshots in progress within the operation timeout are waited for",
+			backup:    defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).CompletionTimestamp(now.Add(-30 * time.Minute)).Result(),
+			progress:  map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseInProgress},
+			wantPhase: velerov1api.BackupPhaseUploading,
+		},
+		{
+			name:                         "backup is completed once its volume snapshots and item operations are uploaded",
+			backup:                       defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).Result(),
+			progress:                     map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseCompleted},
+			volumeSnapshotProgress:       velero.OperationPhaseCompleted,
+			itemOperationProgress:        velero.OperationPhaseCompleted,
+			wantPhase:                    velerov1api.BackupPhaseCompleted,
+			wantVolumeSnapshotsCompleted: 1,
+			wantCompleted:                true,
+			wantItemSnapshot:             true,
+		},
+		{
+			name:                   "backup keeps uploading while a volume snapshot is uploading",
+			backup:                 defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).Result(),
+			progress:               map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseCompleted},
+			volumeSnapshotProgress: velero.OperationPhaseInProgress,
+			itemOperationProgress:  velero.OperationPhaseCompleted,
+			wantPhase:              velerov1api.BackupPhaseUploading,
+		},
+		{
+			name:                         "failed item operations make an uploading backup partially failed",
+			backup:                       defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).Result(),
+			progress:                     map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseCompleted},
+			volumeSnapshotProgress:       velero.OperationPhaseCompleted,
+			itemOperationProgress:        velero.OperationPhaseFailed,
+			wantPhase:                    velerov1api.BackupPhasePartiallyFailed,
+			wantErrors:                   1,
+			wantVolumeSnapshotsCompleted: 1,
+			wantCompleted:                true,
+			wantItemSnapshot:             true,
+		},
+		{
+			name:                   "volume snapshots still uploading after the operation timeout are failed",
+			backup:                 defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseUploading).CompletionTimestamp(now.Add(-2 * time.Hour)).Result(),
+			progress:               map[string]isv1.SnapshotPhase{"pod-1-snapshot": isv1.SnapshotPhaseCompleted, "pod-2-snapshot": isv1.SnapshotPhaseCompleted},
+			volumeSnapshotProgress: velero.OperationPhaseInProgress,
+			itemOperationProgress:  velero.OperationPhaseCompleted,
+			wantPhase:              velerov1api.BackupPhasePartiallyFailed,
+			wantErrors:             1,
+			wantCompleted:          true,
+			wantItemSnapshot:       true,
+		},
+		{
+			name:      "completed backup is not processed",
+			backup:    defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			wantPhase: velerov1api.BackupPhaseCompleted,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				client            = fake.NewSimpleClientset(tc.backup)
+				sharedInformers   = informers.NewSharedInformerFactory(client, 0)
+				pluginManager     = new(pluginmocks.Manager)
+				backupStore       = new(persistencemocks.BackupStore)
+				snapshotter       = new(ismocks.ItemSnapshotter)
+				volumeSnapshotter = new(providermocks.VolumeSnapshotter)
+				action            = new(backupmocks.ItemAction)
+				fakeClient        = velerotest.NewFakeControllerRuntimeClient(t,
+					builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+					builder.ForVolumeSnapshotLocation("velero", "vsl-1").Provider("fake-provider").Result(),
+				)
+			)
+			defer backupStore.AssertExpectations(t)
+
+			c := NewBackupUploadController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				fakeClient,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+				metrics.NewServerMetrics(),
+				time.Hour,
+			).(*backupUploadController)
+			c.clock = clock.NewFakeClock(now)
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(tc.backup))
+
+			pluginManager.On("CleanupClients").Return()
+			pluginManager.On("GetItemSnapshotter", "velero.io/fake").Return(snapshotter, nil)
+			for snapshotID, phase := range tc.progress {
+				snapshotID := snapshotID
+				snapshotter.On("Progress", mock.MatchedBy(func(input *isv1.ProgressInput) bool {
+					return input.SnapshotID == snapshotID
+				})).Return(&isv1.ProgressOutput{Phase: phase}, nil)
+			}
+			if tc.progress != nil {
+				backupStore.On("GetItemSnapshots", "backup-1").Return([]*volume.ItemSnapshot{itemSnapshot("pod-1"), itemSnapshot("pod-2")}, nil)
+
+				var volumeSnapshots []*volume.Snapshot
+				if tc.volumeSnapshotProgress != "" {
+					snapshot := *volumeSnapshot
+					volumeSnapshots = append(volumeSnapshots, &snapshot)
+					pluginManager.On("GetVolumeSnapshotter", "fake-provider").Return(volumeSnapshotter, nil)
+					volumeSnapshotter.On("Init", mock.Anything).Return(nil)
+					volumeSnapshotter.On("SnapshotProgress", "pv-1-snapshot").Return(velero.OperationProgress{Phase: tc.volumeSnapshotProgress}, nil)
+				}
+				backupStore.On("GetBackupVolumeSnapshots", "backup-1").Return(volumeSnapshots, nil)
+
+				var itemOperations []*itemoperation.BackupOperation
+				if tc.itemOperationProgress != "" {
+					operation := *itemOperation
+					itemOperations = append(itemOperations, &operation)
+					pluginManager.On("GetBackupItemAction", "velero.io/fake-action").Return(action, nil)
+					action.On("Progress", itemOperation.Spec.ResourceIdentifier, mock.Anything).Return(velero.OperationProgress{Phase: tc.itemOperationProgress}, nil)
+				}
+				backupStore.On("GetBackupItemOperations", "backup-1").Return(itemOperations, nil)
+			}
+			if tc.wantItemSnapshot {
+				backupStore.On("PutItemSnapshots", "backup-1", mock.Anything).Return(nil)
+				if tc.volumeSnapshotProgress != "" {
+					backupStore.On("PutBackupVolumeSnapshots", "backup-1", mock.Anything).Return(nil)
+				}
+				if tc.itemOperationProgress != "" {
+					backupStore.On("PutBackupItemOperations", "backup-1", mock.Anything).Return(nil)
+				}
+			}
+			if tc.wantCompleted {
+				backupStore.On("PutBackupMetadata", "backup-1", mock.Anything).Return(nil)
+			}
+
+			require.NoError(t, c.processBackup("velero/backup-1"))
+
+			res, err := client.VeleroV1().Backups("velero").Get(context.TODO(), "backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPhase, res.Status.Phase)
+			assert.Equal(t, tc.wantErrors, res.Status.Errors)
+			assert.Equal(t, tc.wantVolumeSnapshotsCompleted, res.Status.VolumeSnapshotsCompleted)
+			if tc.wantCompleted {
+				require.NotNil(t, res.Status.CompletionTimestamp)
+				assert.True(t, now.Equal(res.Status.CompletionTimestamp.Time))
+			}
+		})
+	}
+}
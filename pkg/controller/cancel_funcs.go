@@ -0,0 +1,74 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// cancelRequested returns whether the cancellation of the backup or restore
+// was requested.
+func cancelRequested(obj metav1.Object) bool {
+	_, ok := obj.GetAnnotations()[velerov1api.CancelRequestedAnnotation]
+	return ok
+}
+
+// cancelFuncs holds the functions canceling the backups or restores in
+// progress by their keys. Its zero value is ready to use.
+type cancelFuncs struct {
+	lock  sync.Mutex
+	funcs map[string]context.CancelFunc
+}
+
+// register records the function canceling the backup or restore with the
+// given key.
+func (c *cancelFuncs) register(key string, cancel context.CancelFunc) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.funcs == nil {
+		c.funcs = map[string]context.CancelFunc{}
+	}
+	c.funcs[key] = cancel
+}
+
+// unregister releases the context of the backup or restore with the given
+// key once it's done, and forgets its cancel function.
+func (c *cancelFuncs) unregister(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if cancel, ok := c.funcs[key]; ok {
+		cancel()
+		delete(c.funcs, key)
+	}
+}
+
+// cancel cancels the backup or restore with the given key, returning
+// whether it's in progress.
+func (c *cancelFuncs) cancel(key string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	cancel, ok := c.funcs[key]
+	if ok {
+		cancel()
+	}
+	return ok
+}
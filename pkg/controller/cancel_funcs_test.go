@@ -0,0 +1,53 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestCancelRequested(t *testing.T) {
+	assert.False(t, cancelRequested(builder.ForBackup("velero", "backup-1").Result()))
+	assert.True(t, cancelRequested(builder.ForBackup("velero", "backup-1").
+		ObjectMeta(builder.WithAnnotations(velerov1api.CancelRequestedAnnotation, "true")).Result()))
+	assert.True(t, cancelRequested(builder.ForRestore("velero", "restore-1").
+		ObjectMeta(builder.WithAnnotations(velerov1api.CancelRequestedAnnotation, "")).Result()))
+}
+
+func TestCancelFuncs(t *testing.T) {
+	var c cancelFuncs
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	c.register("velero/backup-1", cancel1)
+	c.register("velero/backup-2", cancel2)
+
+	assert.True(t, c.cancel("velero/backup-1"))
+	assert.False(t, c.cancel("velero/backup-3"))
+	assert.Error(t, ctx1.Err())
+	assert.NoError(t, ctx2.Err())
+
+	c.unregister("velero/backup-2")
+	assert.Error(t, ctx2.Err())
+	assert.False(t, c.cancel("velero/backup-2"))
+}
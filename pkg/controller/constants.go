@@ -19,10 +19,13 @@ package controller
 const (
 	Backup                = "backup"
 	BackupDeletion        = "backup-deletion"
+	BackupReplication     = "backup-replication"
 	BackupStorageLocation = "backup-storage-location"
 	BackupSync            = "backup-sync"
+	BackupUpload          = "backup-upload"
 	DownloadRequest       = "download-request"
 	GarbageCollection     = "gc"
+	ImportBackupRequest   = "import-backup-request"
 	PodVolumeBackup       = "pod-volume-backup"
 	PodVolumeRestore      = "pod-volume-restore"
 	ResticRepo            = "restic-repo"
@@ -35,9 +38,12 @@ const (
 var DisableableControllers = []string{
 	Backup,
 	BackupDeletion,
+	BackupReplication,
 	BackupSync,
+	BackupUpload,
 	DownloadRequest,
 	GarbageCollection,
+	ImportBackupRequest,
 	ResticRepo,
 	Restore,
 	Schedule,
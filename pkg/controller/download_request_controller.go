@@ -113,7 +113,8 @@ func (r *DownloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		downloadRequest.Status.Expiration = &metav1.Time{Time: r.Clock.Now().Add(persistence.DownloadURLTTL)}
 
 		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreLog ||
-			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResults {
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResults ||
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResourceList {
 			restore := &velerov1api.Restore{}
 			if err := r.Client.Get(ctx, kbclient.ObjectKey{
 				Namespace: downloadRequest.Namespace,
@@ -153,6 +154,9 @@ func (r *DownloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{Requeue: true}, errors.WithStack(err)
 		}
 
+		// objects downloaded from the URL are encrypted if the location encrypts them,
+		// so the requester needs to know which key to decrypt them with.
+		downloadRequest.Status.Encryption = location.Spec.Encryption.DeepCopy()
 		downloadRequest.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
 
 		// Update the expiration again to extend the time we wait (the TTL) to start after successfully processing the URL.
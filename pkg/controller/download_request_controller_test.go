@@ -239,6 +239,14 @@ var _ = Describe("Download Request Reconciler", func() {
 			expectGetsURL:   true,
 			expectedRequeue: ctrl.Result{Requeue: true},
 		}),
+		Entry("restore resource list request with phase '' gets a url", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindRestoreResourceList, "a-backup-20170912150214").Result(),
+			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "a-backup-20170912150214").Phase(velerov1api.RestorePhaseCompleted).Backup("a-backup").Result(),
+			backup:          defaultBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectGetsURL:   true,
+			expectedRequeue: ctrl.Result{Requeue: true},
+		}),
 		Entry("request with phase 'Processed' and not expired is not deleted", request{
 			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase(velerov1api.DownloadRequestPhaseProcessed).Target(velerov1api.DownloadTargetKindBackupLog, "a-backup-20170912150214").Result(),
 			backup:          defaultBackup(),
@@ -18,15 +18,20 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -54,6 +59,7 @@ type gcController struct {
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
 	kbClient                  client.Client
+	recorder                  record.EventRecorder
 
 	clock clock.Clock
 }
@@ -65,6 +71,7 @@ func NewGCController(
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister,
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
 	kbClient client.Client,
+	recorder record.EventRecorder,
 ) Interface {
 	c := &gcController{
 		genericController:         newGenericController(GarbageCollection, logger),
@@ -73,6 +80,7 @@ func NewGCController(
 		deleteBackupRequestLister: deleteBackupRequestLister,
 		deleteBackupRequestClient: deleteBackupRequestClient,
 		kbClient:                  kbClient,
+		recorder:                  recorder,
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -131,12 +139,25 @@ func (c *gcController) processQueueItem(key string) error {
 
 	now := c.clock.Now()
 
-	if backup.Status.Expiration == nil || backup.Status.Expiration.After(now) {
-		log.Debug("Backup has not expired yet, skipping")
-		return nil
+	// the retention policy of the backup's schedule, if any, replaces the
+	// backup's expiration
+	retained, applies, err := c.applyRetentionPolicy(backup, now, log)
+	if err != nil {
+		return err
+	}
+	if applies {
+		if retained {
+			log.Debug("Backup is kept by the retention policy of its schedule, skipping")
+			return nil
+		}
+		log.Info("Backup has expired according to the retention policy of its schedule")
+	} else {
+		if backup.Status.Expiration == nil || backup.Status.Expiration.After(now) {
+			log.Debug("Backup has not expired yet, skipping")
+			return nil
+		}
+		log.Info("Backup has expired")
 	}
-
-	log.Info("Backup has expired")
 
 	if backup.Labels == nil {
 		backup.Labels = make(map[string]string)
@@ -203,3 +224,158 @@ func (c *gcController) processQueueItem(key string) error {
 
 	return nil
 }
+
+// applyRetentionPolicy returns whether the backup is kept by the retention
+// policy of its schedule, and whether such a policy applies to the backup at
+// all. When the reason why the backup is kept or expired changes, it's recorded
+// in an annotation and an event of the backup.
+func (c *gcController) applyRetentionPolicy(backup *velerov1api.Backup, now time.Time, log logrus.FieldLogger) (bool, bool, error) {
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" || !countsForRetention(backup) {
+		return false, false, nil
+	}
+
+	schedule := &velerov1api.Schedule{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{Namespace: backup.Namespace, Name: scheduleName}, schedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, false, nil
+		}
+		return false, false, errors.Wrapf(err, "error getting schedule %s", scheduleName)
+	}
+	// a policy that keeps nothing, or that of a schedule that failed
+	// validation, would expire every backup of the schedule at once
+	if schedule.Spec.Retention == nil || isEmptyRetentionPolicy(schedule.Spec.Retention) ||
+		schedule.Status.Phase == velerov1api.SchedulePhaseFailedValidation {
+		return false, false, nil
+	}
+
+	scheduleBackups, err := c.backupLister.Backups(backup.Namespace).List(labels.SelectorFromSet(labels.Set{
+		velerov1api.ScheduleNameLabel: scheduleName,
+	}))
+	if err != nil {
+		return false, false, errors.Wrap(err, "error listing backups of schedule")
+	}
+	var counted []*velerov1api.Backup
+	for _, scheduleBackup := range scheduleBackups {
+		if countsForRetention(scheduleBackup) {
+			counted = append(counted, scheduleBackup)
+		}
+	}
+
+	reasons, retained := retentionReasons(schedule.Spec.Retention, counted, now)[backup.Name]
+	reason, message := "Expired", fmt.Sprintf("Expired by the retention policy of schedule %s", schedule.Name)
+	if retained {
+		reason, message = "Retained", fmt.Sprintf("Kept by the retention policy of schedule %s: %s", schedule.Name, strings.Join(reasons, ", "))
+	}
+
+	if backup.Annotations[velerov1api.RetentionAnnotation] != message {
+		if backup.Annotations == nil {
+			backup.Annotations = make(map[string]string)
+		}
+		backup.Annotations[velerov1api.RetentionAnnotation] = message
+		if err := c.kbClient.Update(context.Background(), backup); err != nil {
+			log.WithError(err).Error("error updating backup annotations")
+		}
+		c.recorder.Event(backup, corev1api.EventTypeNormal, reason, message)
+	}
+
+	return retained, true, nil
+}
+
+// countsForRetention returns whether the backup is one of the backups of its
+// schedule that the retention policy applies to. The other ones expire
+// according to their TTL.
+func countsForRetention(backup *velerov1api.Backup) bool {
+	return backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed
+}
+
+// isEmptyRetentionPolicy returns whether the policy doesn't keep any backup.
+func isEmptyRetentionPolicy(policy *velerov1api.RetentionPolicy) bool {
+	return policy.KeepLast == 0 && policy.KeepDaily == 0 && policy.KeepWeekly == 0 && policy.KeepMonthly == 0
+}
+
+// retentionReasons returns the reasons why the policy keeps each of the
+// backups it keeps. The backups that aren't in the returned map are expired.
+func retentionReasons(policy *velerov1api.RetentionPolicy, backups []*velerov1api.Backup, now time.Time) map[string][]string {
+	sorted := append([]*velerov1api.Backup{}, backups...)
+	sort.Slice(sorted, func(i, j int) bool {
+		ti, tj := backupTime(sorted[i]), backupTime(sorted[j])
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return sorted[i].Name > sorted[j].Name
+	})
+
+	reasons := make(map[string][]string)
+	for i := 0; i < policy.KeepLast && i < len(sorted); i++ {
+		reasons[sorted[i].Name] = append(reasons[sorted[i].Name], fmt.Sprintf("one of the last %d backups", policy.KeepLast))
+	}
+
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	thisWeek := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	periods := []struct {
+		name  string
+		count int
+		// start is the start of the oldest period in which a backup is kept
+		start time.Time
+		key   func(time.Time) string
+	}{
+		{
+			name:  "daily",
+			count: policy.KeepDaily,
+			start: today.AddDate(0, 0, 1-policy.KeepDaily),
+			key:   func(t time.Time) string { return t.Format("2006-01-02") },
+		},
+		{
+			name:  "weekly",
+			count: policy.KeepWeekly,
+			start: thisWeek.AddDate(0, 0, 7*(1-policy.KeepWeekly)),
+			key: func(t time.Time) string {
+				year, week := t.ISOWeek()
+				return fmt.Sprintf("%d-W%02d", year, week)
+			},
+		},
+		{
+			name:  "monthly",
+			count: policy.KeepMonthly,
+			start: thisMonth.AddDate(0, 1-policy.KeepMonthly, 0),
+			key:   func(t time.Time) string { return t.Format("2006-01") },
+		},
+	}
+
+	for _, period := range periods {
+		if period.count <= 0 {
+			continue
+		}
+
+		// the backups are sorted from the most recent one, so the first
+		// backup of each period is the one kept
+		kept := make(map[string]bool)
+		for _, backup := range sorted {
+			t := backupTime(backup).UTC()
+			if t.Before(period.start) {
+				break
+			}
+			key := period.key(t)
+			if kept[key] {
+				continue
+			}
+			kept[key] = true
+			reasons[backup.Name] = append(reasons[backup.Name], fmt.Sprintf("%s backup of %s", period.name, key))
+		}
+	}
+
+	return reasons
+}
+
+// backupTime returns the time a backup was taken at, which is its creation
+// time if it wasn't started.
+func backupTime(backup *velerov1api.Backup) time.Time {
+	if backup.Status.StartTimestamp != nil {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
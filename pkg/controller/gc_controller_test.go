@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/watch"
 	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -53,6 +54,7 @@ func TestGCControllerEnqueueAllBackups(t *testing.T) {
 			sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 			client.VeleroV1(),
 			nil,
+			nil,
 		).(*gcController)
 	)
 
@@ -114,6 +116,7 @@ func TestGCControllerHasUpdateFunc(t *testing.T) {
 		sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 		client.VeleroV1(),
 		nil,
+		nil,
 	).(*gcController)
 
 	keys := make(chan string)
@@ -262,6 +265,7 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 				client.VeleroV1(),
 				fakeClient,
+				record.NewFakeRecorder(10),
 			).(*gcController)
 			controller.clock = fakeClock
 
@@ -298,3 +302,184 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 		})
 	}
 }
+
+func TestGCControllerProcessQueueItemWithRetention(t *testing.T) {
+	now := time.Date(2022, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	scheduleBackup := func(name string, phase velerov1api.BackupPhase, start time.Time) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "nightly")).
+			StorageLocation("default").
+			Phase(phase).
+			StartTimestamp(start).
+			Expiration(now.Add(time.Hour)).
+			Result()
+	}
+
+	tests := []struct {
+		name           string
+		schedule       *velerov1api.Schedule
+		backup         *velerov1api.Backup
+		expectDeletion bool
+		expectReason   string
+		expectMessage  string
+	}{
+		{
+			name:          "backup kept by the retention policy is not deleted",
+			backup:        scheduleBackup("nightly-3", velerov1api.BackupPhaseCompleted, now.Add(-time.Hour)),
+			expectReason:  "Retained",
+			expectMessage: "Kept by the retention policy of schedule nightly: one of the last 2 backups",
+		},
+		{
+			name:           "unexpired backup not kept by the retention policy is deleted",
+			backup:         scheduleBackup("nightly-1", velerov1api.BackupPhaseCompleted, now.Add(-72*time.Hour)),
+			expectDeletion: true,
+			expectReason:   "Expired",
+			expectMessage:  "Expired by the retention policy of schedule nightly",
+		},
+		{
+			name:   "failed backup is not counted and expires according to its TTL",
+			backup: scheduleBackup("nightly-4", velerov1api.BackupPhaseFailed, now.Add(-30*time.Minute)),
+		},
+		{
+			name:     "empty retention policy is ignored and backups expire according to their TTL",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "nightly").Retention(&velerov1api.RetentionPolicy{}).Result(),
+			backup:   scheduleBackup("nightly-1", velerov1api.BackupPhaseCompleted, now.Add(-72*time.Hour)),
+		},
+		{
+			name: "retention policy of a schedule that failed validation is ignored and backups expire according to their TTL",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "nightly").
+				Retention(&velerov1api.RetentionPolicy{KeepLast: 2}).
+				Phase(velerov1api.SchedulePhaseFailedValidation).
+				Result(),
+			backup: scheduleBackup("nightly-1", velerov1api.BackupPhaseCompleted, now.Add(-72*time.Hour)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				recorder        = record.NewFakeRecorder(10)
+				backups         = []*velerov1api.Backup{
+					scheduleBackup("nightly-1", velerov1api.BackupPhaseCompleted, now.Add(-72*time.Hour)),
+					scheduleBackup("nightly-2", velerov1api.BackupPhasePartiallyFailed, now.Add(-48*time.Hour)),
+					scheduleBackup("nightly-3", velerov1api.BackupPhaseCompleted, now.Add(-time.Hour)),
+					scheduleBackup("nightly-4", velerov1api.BackupPhaseFailed, now.Add(-30*time.Minute)),
+				}
+			)
+
+			schedule := test.schedule
+			if schedule == nil {
+				schedule = builder.ForSchedule(velerov1api.DefaultNamespace, "nightly").Retention(&velerov1api.RetentionPolicy{KeepLast: 2}).Result()
+			}
+
+			objs := []runtime.Object{
+				builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+				schedule,
+			}
+			for _, backup := range backups {
+				objs = append(objs, backup)
+			}
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+
+			// the lister's backups have the resource version of the client's ones,
+			// so that they can be updated
+			for _, backup := range backups {
+				stored := &velerov1api.Backup{}
+				require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKeyFromObject(backup), stored))
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(stored))
+			}
+
+			controller := NewGCController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
+				client.VeleroV1(),
+				fakeClient,
+				recorder,
+			).(*gcController)
+			controller.clock = clock.NewFakeClock(now)
+
+			require.NoError(t, controller.processQueueItem(kube.NamespaceAndName(test.backup)))
+
+			if test.expectDeletion {
+				require.Len(t, client.Actions(), 1)
+				assert.Equal(t, "deletebackuprequests", client.Actions()[0].GetResource().Resource)
+			} else {
+				assert.Len(t, client.Actions(), 0)
+			}
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: test.backup.Namespace, Name: test.backup.Name}, backup))
+			if test.expectReason == "" {
+				assert.Empty(t, recorder.Events)
+				assert.NotContains(t, backup.Annotations, velerov1api.RetentionAnnotation)
+				return
+			}
+			require.Len(t, recorder.Events, 1)
+			assert.Equal(t, fmt.Sprintf("Normal %s %s", test.expectReason, test.expectMessage), <-recorder.Events)
+			assert.Equal(t, test.expectMessage, backup.Annotations[velerov1api.RetentionAnnotation])
+		})
+	}
+}
+
+func TestRetentionReasons(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2022, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	var backups []*velerov1api.Backup
+	add := func(start time.Time) {
+		backups = append(backups, builder.ForBackup(velerov1api.DefaultNamespace, "nightly-"+start.Format("20060102150405")).StartTimestamp(start).Result())
+	}
+	for day := time.Date(2022, 1, 1, 3, 0, 0, 0, time.UTC); day.Before(now); day = day.AddDate(0, 0, 1) {
+		add(day)
+	}
+	add(time.Date(2022, 6, 15, 1, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		policy *velerov1api.RetentionPolicy
+		want   map[string][]string
+	}{
+		{
+			name:   "keep last",
+			policy: &velerov1api.RetentionPolicy{KeepLast: 2},
+			want: map[string][]string{
+				"nightly-20220615030000": {"one of the last 2 backups"},
+				"nightly-20220615010000": {"one of the last 2 backups"},
+			},
+		},
+		{
+			name:   "grandfather-father-son",
+			policy: &velerov1api.RetentionPolicy{KeepDaily: 3, KeepWeekly: 2, KeepMonthly: 3},
+			want: map[string][]string{
+				"nightly-20220615030000": {"daily backup of 2022-06-15", "weekly backup of 2022-W24", "monthly backup of 2022-06"},
+				"nightly-20220614030000": {"daily backup of 2022-06-14"},
+				"nightly-20220613030000": {"daily backup of 2022-06-13"},
+				"nightly-20220612030000": {"weekly backup of 2022-W23"},
+				"nightly-20220531030000": {"monthly backup of 2022-05"},
+				"nightly-20220430030000": {"monthly backup of 2022-04"},
+			},
+		},
+		{
+			name:   "periods without backups aren't filled with older backups",
+			policy: &velerov1api.RetentionPolicy{KeepMonthly: 12},
+			want: map[string][]string{
+				"nightly-20220615030000": {"monthly backup of 2022-06"},
+				"nightly-20220531030000": {"monthly backup of 2022-05"},
+				"nightly-20220430030000": {"monthly backup of 2022-04"},
+				"nightly-20220331030000": {"monthly backup of 2022-03"},
+				"nightly-20220228030000": {"monthly backup of 2022-02"},
+				"nightly-20220131030000": {"monthly backup of 2022-01"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, retentionReasons(test.policy, backups, now))
+		})
+	}
+}
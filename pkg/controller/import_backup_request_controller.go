@@ -0,0 +1,448 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+// importBackupRequestMaxAge is how long processed ImportBackupRequests are
+// kept before they're deleted.
+const importBackupRequestMaxAge = 24 * time.Hour
+
+const (
+	// importUploadPollInterval is how often an ImportBackupRequest waiting for
+	// its tarball to be uploaded checks whether it has been.
+	importUploadPollInterval = 10 * time.Second
+
+	// importUploadGracePeriod is how long an ImportBackupRequest waits for the
+	// upload of its tarball after the upload URL expired, since uploads started
+	// before then may still be running.
+	importUploadGracePeriod = time.Hour
+)
+
+type importBackupRequestReconciler struct {
+	client.Client
+	logger            logrus.FieldLogger
+	clock             clock.Clock
+	defaultBackupTTL  time.Duration
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+}
+
+func NewImportBackupRequestReconciler(
+	logger logrus.FieldLogger,
+	client client.Client,
+	defaultBackupTTL time.Duration,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+) *importBackupRequestReconciler {
+	return &importBackupRequestReconciler{
+		Client:            client,
+		logger:            logger,
+		clock:             clock.RealClock{},
+		defaultBackupTTL:  defaultBackupTTL,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+}
+
+func (r *importBackupRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.ImportBackupRequest{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=importbackuprequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=importbackuprequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *importBackupRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("importBackupRequest", req.String())
+
+	log.Debug("Getting import backup request")
+	request := &velerov1api.ImportBackupRequest{}
+	if err := r.Get(ctx, req.NamespacedName, request); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find import backup request")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting import backup request %s", req.String())
+	}
+
+	switch request.Status.Phase {
+	case velerov1api.ImportBackupRequestPhaseCompleted, velerov1api.ImportBackupRequestPhaseFailed:
+		return r.deleteIfExpired(ctx, log, request)
+	}
+
+	patchHelper, err := patch.NewHelper(request, r.Client)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error new patch helper for import backup request %s", req.String())
+	}
+
+	// the tarballs uploaded for the request are only imported by it
+	uploaded := request.Spec.Upload && (request.Status.Phase == velerov1api.ImportBackupRequestPhaseWaitingForUpload ||
+		request.Status.Phase == velerov1api.ImportBackupRequestPhaseInProgress)
+
+	var importErr error
+	switch {
+	case request.Status.Phase == velerov1api.ImportBackupRequestPhaseInProgress:
+		// the server was restarted while the backup was imported, and the
+		// tarball is left in the imports directory to be imported again
+		importErr = errors.New("the import was interrupted, create another request to import the backup again")
+	case request.Spec.Upload && request.Status.Phase != velerov1api.ImportBackupRequestPhaseWaitingForUpload:
+		log.WithField("backup", request.Spec.BackupName).Info("Issuing upload URL for backup tarball to import")
+		if importErr = r.issueUploadURL(ctx, log, request); importErr == nil {
+			request.Status.Phase = velerov1api.ImportBackupRequestPhaseWaitingForUpload
+			if err := patchHelper.Patch(ctx, request); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "error updating import backup request %s", req.String())
+			}
+			return ctrl.Result{RequeueAfter: importUploadPollInterval}, nil
+		}
+	default:
+		if request.Spec.Upload {
+			uploaded, err := r.tarballUploaded(ctx, log, request)
+			if err != nil {
+				importErr = err
+				break
+			}
+			if !uploaded {
+				return ctrl.Result{RequeueAfter: importUploadPollInterval}, nil
+			}
+		}
+
+		request.Status.Phase = velerov1api.ImportBackupRequestPhaseInProgress
+		request.Status.UploadURL = ""
+		if err := patchHelper.Patch(ctx, request); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating import backup request %s", req.String())
+		}
+
+		log.WithField("backup", request.Spec.BackupName).Info("Importing backup")
+		importErr = r.importBackup(ctx, log, request)
+	}
+
+	if importErr != nil {
+		log.WithError(importErr).Error("Error importing backup")
+		request.Status.Phase = velerov1api.ImportBackupRequestPhaseFailed
+		request.Status.UploadURL = ""
+		request.Status.Errors = []string{importErr.Error()}
+		if uploaded {
+			r.deleteUploadedTarball(ctx, log, request)
+		}
+	} else {
+		log.Info("Backup imported")
+		request.Status.Phase = velerov1api.ImportBackupRequestPhaseCompleted
+	}
+	request.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	if err := patchHelper.Patch(ctx, request); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error updating import backup request %s", req.String())
+	}
+
+	return ctrl.Result{RequeueAfter: importBackupRequestMaxAge}, nil
+}
+
+// deleteIfExpired deletes a processed request once it's older than
+// importBackupRequestMaxAge, or requeues it until then.
+func (r *importBackupRequestReconciler) deleteIfExpired(ctx context.Context, log logrus.FieldLogger, request *velerov1api.ImportBackupRequest) (ctrl.Result, error) {
+	processed := request.CreationTimestamp.Time
+	if request.Status.CompletionTimestamp != nil {
+		processed = request.Status.CompletionTimestamp.Time
+	}
+
+	if age := r.clock.Since(processed); age < importBackupRequestMaxAge {
+		return ctrl.Result{RequeueAfter: importBackupRequestMaxAge - age}, nil
+	}
+
+	log.Debug("Deleting expired import backup request")
+	if err := r.Delete(ctx, request); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, errors.Wrapf(err, "error deleting import backup request %s/%s", request.Namespace, request.Name)
+	}
+	return ctrl.Result{}, nil
+}
+
+// validateRequest checks that the request's tarball can be imported as its
+// backup, and returns the backup storage location it's imported into.
+func (r *importBackupRequestReconciler) validateRequest(ctx context.Context, request *velerov1api.ImportBackupRequest) (*velerov1api.BackupStorageLocation, error) {
+	tarball := request.Spec.Tarball
+	if tarball == "" || strings.Contains(tarball, "/") {
+		return nil, errors.Errorf("invalid tarball %q, it must be the name of a file in the imports directory of the backup storage location", tarball)
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: request.Spec.StorageLocation}, location); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", request.Spec.StorageLocation)
+	}
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return nil, errors.Errorf("backup storage location %s is read-only", location.Name)
+	}
+
+	err := r.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: request.Spec.BackupName}, &velerov1api.Backup{})
+	if err == nil {
+		return nil, errors.Errorf("backup %s already exists", request.Spec.BackupName)
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "error getting backup %s", request.Spec.BackupName)
+	}
+
+	return location, nil
+}
+
+// issueUploadURL sets the URL that the tarball of the request is uploaded to
+// the imports directory of its backup storage location with.
+func (r *importBackupRequestReconciler) issueUploadURL(ctx context.Context, log logrus.FieldLogger, request *velerov1api.ImportBackupRequest) error {
+	location, err := r.validateRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup store of backup storage location %s", location.Name)
+	}
+
+	// the tarball of another import isn't overwritten
+	exists, err := backupStore.ImportTarballExists(request.Spec.Tarball)
+	if err != nil {
+		return errors.Wrapf(err, "error checking if tarball %s exists", request.Spec.Tarball)
+	}
+	if exists {
+		return errors.Errorf("tarball %s is already in the imports directory of backup storage location %s", request.Spec.Tarball, location.Name)
+	}
+
+	url, err := backupStore.GetImportUploadURL(request.Spec.Tarball)
+	if err != nil {
+		return errors.Wrapf(err, "error creating upload URL of tarball %s", request.Spec.Tarball)
+	}
+
+	request.Status.UploadURL = url
+	request.Status.Expiration = &metav1.Time{Time: r.clock.Now().Add(persistence.ImportUploadURLTTL)}
+	return nil
+}
+
+// tarballUploaded returns whether the tarball of the request has been uploaded
+// through its upload URL. Uploads started before the URL expired are waited for
+// importUploadGracePeriod more.
+func (r *importBackupRequestReconciler) tarballUploaded(ctx context.Context, log logrus.FieldLogger, request *velerov1api.ImportBackupRequest) (bool, error) {
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.getBackupStore(ctx, log, request, pluginManager)
+	if err != nil {
+		return false, err
+	}
+
+	exists, err := backupStore.ImportTarballExists(request.Spec.Tarball)
+	if err != nil {
+		return false, errors.Wrapf(err, "error checking if tarball %s was uploaded", request.Spec.Tarball)
+	}
+	if exists {
+		return true, nil
+	}
+
+	if request.Status.Expiration != nil && r.clock.Now().After(request.Status.Expiration.Add(importUploadGracePeriod)) {
+		return false, errors.Errorf("tarball %s wasn't uploaded before its upload URL expired", request.Spec.Tarball)
+	}
+	log.Debug("Waiting for backup tarball to be uploaded")
+	return false, nil
+}
+
+// deleteUploadedTarball deletes the tarball uploaded for a request that failed,
+// if it was uploaded. Errors are only logged since the request failed already.
+func (r *importBackupRequestReconciler) deleteUploadedTarball(ctx context.Context, log logrus.FieldLogger, request *velerov1api.ImportBackupRequest) {
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.getBackupStore(ctx, log, request, pluginManager)
+	if err != nil {
+		log.WithError(err).Warnf("Error deleting uploaded tarball %s", request.Spec.Tarball)
+		return
+	}
+
+	exists, err := backupStore.ImportTarballExists(request.Spec.Tarball)
+	if err == nil && exists {
+		err = backupStore.DeleteImportTarball(request.Spec.Tarball)
+	}
+	if err != nil {
+		log.WithError(err).Warnf("Error deleting uploaded tarball %s", request.Spec.Tarball)
+	}
+}
+
+// getBackupStore returns the backup store of the request's backup storage location.
+func (r *importBackupRequestReconciler) getBackupStore(ctx context.Context, log logrus.FieldLogger, request *velerov1api.ImportBackupRequest, pluginManager clientmgmt.Manager) (persistence.BackupStore, error) {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: request.Spec.StorageLocation}, location); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", request.Spec.StorageLocation)
+	}
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting backup store of backup storage location %s", location.Name)
+	}
+	return backupStore, nil
+}
+
+// importBackup imports the tarball of the request into its backup storage
+// location, from where the backup is synced into the cluster.
+func (r *importBackupRequestReconciler) importBackup(ctx context.Context, log logrus.FieldLogger, request *velerov1api.ImportBackupRequest) error {
+	location, err := r.validateRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup store of backup storage location %s", location.Name)
+	}
+
+	exists, err := backupStore.BackupExists(location.Spec.ObjectStorage.Bucket, request.Spec.BackupName)
+	if err != nil {
+		return errors.Wrapf(err, "error checking if backup %s exists in backup storage location %s", request.Spec.BackupName, location.Name)
+	}
+	if exists {
+		return errors.Errorf("backup %s already exists in backup storage location %s", request.Spec.BackupName, location.Name)
+	}
+
+	tarball := request.Spec.Tarball
+
+	// the tarball is read several times, so it's downloaded to a temp file
+	contents, err := downloadImportTarball(backupStore, tarball)
+	if err != nil {
+		return err
+	}
+	defer closeAndRemoveFile(contents, log)
+
+	ttl := request.Spec.TTL.Duration
+	if ttl == 0 {
+		ttl = r.defaultBackupTTL
+	}
+
+	backup, manifest, resourceList, err := pkgbackup.NewImportedBackup(contents, request.Namespace, request.Spec.BackupName, location.Name, ttl, r.clock.Now(), log)
+	if err != nil {
+		return err
+	}
+
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return errors.WithStack(err)
+	}
+	info, err := newImportedBackupInfo(backup, contents, manifest, resourceList)
+	if err != nil {
+		return err
+	}
+	if err := backupStore.PutBackup(info); err != nil {
+		return errors.Wrap(err, "error uploading backup")
+	}
+
+	// the backup is imported, so failing to clean up the tarball doesn't fail the request
+	if err := backupStore.DeleteImportTarball(tarball); err != nil {
+		log.WithError(err).Warnf("Error deleting imported tarball %s", tarball)
+	}
+
+	return nil
+}
+
+// downloadImportTarball downloads a tarball to import to a temp file.
+func downloadImportTarball(backupStore persistence.BackupStore, tarball string) (*os.File, error) {
+	rc, err := backupStore.GetImportTarball(tarball)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting tarball %s", tarball)
+	}
+	defer rc.Close()
+
+	file, err := ioutil.TempFile("", "import-")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp file")
+	}
+	if _, err := io.Copy(file, rc); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, errors.Wrapf(err, "error downloading tarball %s", tarball)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, errors.WithStack(err)
+	}
+
+	return file, nil
+}
+
+// newImportedBackupInfo returns the files of the imported backup to upload.
+func newImportedBackupInfo(backup *velerov1api.Backup, contents io.Reader, manifest *pkgbackup.Manifest, resourceList map[string][]string) (persistence.BackupInfo, error) {
+	metadata := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup, "json", metadata); err != nil {
+		return persistence.BackupInfo{}, errors.Wrap(err, "error encoding backup")
+	}
+
+	manifestJSON, errs := encodeToJSONGzip(manifest, "backup manifest")
+	if errs != nil {
+		return persistence.BackupInfo{}, kubeerrs.NewAggregate(errs)
+	}
+
+	resourceListJSON, errs := encodeToJSONGzip(resourceList, "backup resources list")
+	if errs != nil {
+		return persistence.BackupInfo{}, kubeerrs.NewAggregate(errs)
+	}
+
+	log := new(bytes.Buffer)
+	gzw := gzip.NewWriter(log)
+	logger := logrus.New()
+	logger.Out = gzw
+	logger.WithField("backup", backup.Namespace+"/"+backup.Name).Info("Backup imported from a tarball")
+	if err := gzw.Close(); err != nil {
+		return persistence.BackupInfo{}, errors.WithStack(err)
+	}
+
+	return persistence.BackupInfo{
+		Name:               backup.Name,
+		Metadata:           metadata,
+		Contents:           contents,
+		Log:                log,
+		BackupResourceList: resourceListJSON,
+		BackupManifest:     manifestJSON,
+	}, nil
+}
@@ -0,0 +1,352 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestImportBackupRequestReconcile(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tarball := velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1.1.0\n")).
+		AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+		Done().Bytes()
+
+	request := func(phase velerov1api.ImportBackupRequestPhase, tarball string) *velerov1api.ImportBackupRequest {
+		return builder.ForImportBackupRequest("velero", "import-1").
+			BackupName("backup-1").
+			StorageLocation("default").
+			Tarball(tarball).
+			TTL(time.Hour).
+			Phase(phase).
+			Result()
+	}
+
+	tests := []struct {
+		name          string
+		request       *velerov1api.ImportBackupRequest
+		objects       []runtime.Object
+		contents      []byte
+		expectedPhase velerov1api.ImportBackupRequestPhase
+		expectedError string
+	}{
+		{
+			name:          "the tarball is imported",
+			request:       request("", "backup-1-data.tar.gz"),
+			objects:       []runtime.Object{builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Result()},
+			contents:      tarball,
+			expectedPhase: velerov1api.ImportBackupRequestPhaseCompleted,
+		},
+		{
+			name:          "a tarball outside of the imports directory is rejected",
+			request:       request(velerov1api.ImportBackupRequestPhaseNew, "../backups/backup-1/backup-1.tar.gz"),
+			objects:       []runtime.Object{builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Result()},
+			expectedPhase: velerov1api.ImportBackupRequestPhaseFailed,
+			expectedError: `invalid tarball "../backups/backup-1/backup-1.tar.gz", it must be the name of a file in the imports directory of the backup storage location`,
+		},
+		{
+			name:          "a read-only location is rejected",
+			request:       request("", "backup-1-data.tar.gz"),
+			objects:       []runtime.Object{builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result()},
+			expectedPhase: velerov1api.ImportBackupRequestPhaseFailed,
+			expectedError: "backup storage location default is read-only",
+		},
+		{
+			name:    "an existing backup is rejected",
+			request: request("", "backup-1-data.tar.gz"),
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Result(),
+				builder.ForBackup("velero", "backup-1").Result(),
+			},
+			expectedPhase: velerov1api.ImportBackupRequestPhaseFailed,
+			expectedError: "backup backup-1 already exists",
+		},
+		{
+			name:          "an invalid tarball is rejected",
+			request:       request("", "backup-1-data.tar.gz"),
+			objects:       []runtime.Object{builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Result()},
+			contents:      []byte("not a tarball"),
+			expectedPhase: velerov1api.ImportBackupRequestPhaseFailed,
+			expectedError: "error extracting backup tarball",
+		},
+		{
+			name:          "an interrupted import fails",
+			request:       request(velerov1api.ImportBackupRequestPhaseInProgress, "backup-1-data.tar.gz"),
+			expectedPhase: velerov1api.ImportBackupRequestPhaseFailed,
+			expectedError: "the import was interrupted, create another request to import the backup again",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, append(tc.objects, tc.request)...)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			var uploaded *velerov1api.Backup
+			backupStore := &persistencemocks.BackupStore{}
+			if tc.contents != nil {
+				backupStore.On("BackupExists", "bucket", "backup-1").Return(false, nil)
+				backupStore.On("GetImportTarball", "backup-1-data.tar.gz").Return(ioutil.NopCloser(bytes.NewReader(tc.contents)), nil)
+				backupStore.On("PutBackup", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+					uploaded = new(velerov1api.Backup)
+					require.NoError(t, json.NewDecoder(args.Get(0).(persistence.BackupInfo).Metadata).Decode(uploaded))
+				})
+				backupStore.On("DeleteImportTarball", "backup-1-data.tar.gz").Return(nil)
+			}
+
+			r := NewImportBackupRequestReconciler(
+				velerotest.NewLogger(),
+				client,
+				24*time.Hour,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+			)
+			r.clock = clock.NewFakeClock(now)
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "import-1"}})
+			require.NoError(t, err)
+			assert.Equal(t, importBackupRequestMaxAge, result.RequeueAfter)
+
+			res := &velerov1api.ImportBackupRequest{}
+			require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: "import-1"}, res))
+			assert.Equal(t, tc.expectedPhase, res.Status.Phase)
+			assert.Equal(t, now, res.Status.CompletionTimestamp.Time.UTC())
+
+			if tc.expectedError != "" {
+				require.Len(t, res.Status.Errors, 1)
+				assert.Contains(t, res.Status.Errors[0], tc.expectedError)
+				backupStore.AssertNotCalled(t, "PutBackup", mock.Anything)
+				return
+			}
+
+			assert.Empty(t, res.Status.Errors)
+			require.NotNil(t, uploaded)
+			assert.Equal(t, "backup-1", uploaded.Name)
+			assert.Equal(t, "default", uploaded.Spec.StorageLocation)
+			assert.Equal(t, velerov1api.BackupPhaseCompleted, uploaded.Status.Phase)
+			assert.Equal(t, now.Add(time.Hour), uploaded.Status.Expiration.Time.UTC())
+			backupStore.AssertCalled(t, "DeleteImportTarball", "backup-1-data.tar.gz")
+		})
+	}
+}
+
+func TestImportBackupRequestUpload(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tarball := velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1.1.0\n")).
+		AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+		Done().Bytes()
+
+	request := func(phase velerov1api.ImportBackupRequestPhase, expiration time.Time) *velerov1api.ImportBackupRequest {
+		b := builder.ForImportBackupRequest("velero", "import-1").
+			BackupName("backup-1").
+			StorageLocation("default").
+			Tarball("backup-1-data.tar.gz").
+			Upload(true).
+			Phase(phase)
+		if !expiration.IsZero() {
+			b.UploadURL("upload-url").Expiration(expiration)
+		}
+		return b.Result()
+	}
+
+	tests := []struct {
+		name               string
+		request            *velerov1api.ImportBackupRequest
+		tarballExists      bool
+		uploadURLErr       error
+		contents           []byte
+		expectedPhase      velerov1api.ImportBackupRequestPhase
+		expectedUploadURL  string
+		expectedRequeue    time.Duration
+		expectedError      string
+		expectedDeletion   bool
+		expectedExpiration time.Time
+	}{
+		{
+			name:               "an upload URL is issued for a new request",
+			request:            request("", time.Time{}),
+			expectedPhase:      velerov1api.ImportBackupRequestPhaseWaitingForUpload,
+			expectedUploadURL:  "upload-url",
+			expectedRequeue:    importUploadPollInterval,
+			expectedExpiration: now.Add(persistence.ImportUploadURLTTL),
+		},
+		{
+			name:            "a tarball already in the imports directory isn't overwritten",
+			request:         request("", time.Time{}),
+			tarballExists:   true,
+			expectedPhase:   velerov1api.ImportBackupRequestPhaseFailed,
+			expectedRequeue: importBackupRequestMaxAge,
+			expectedError:   "tarball backup-1-data.tar.gz is already in the imports directory of backup storage location default",
+		},
+		{
+			name:            "an object store that can't create upload URLs fails the request",
+			request:         request(velerov1api.ImportBackupRequestPhaseNew, time.Time{}),
+			uploadURLErr:    velero.ErrSignedUploadURLNotSupported,
+			expectedPhase:   velerov1api.ImportBackupRequestPhaseFailed,
+			expectedRequeue: importBackupRequestMaxAge,
+			expectedError:   velero.ErrSignedUploadURLNotSupported.Error(),
+		},
+		{
+			name:              "the request waits for the tarball to be uploaded",
+			request:           request(velerov1api.ImportBackupRequestPhaseWaitingForUpload, now.Add(30*time.Minute)),
+			expectedPhase:     velerov1api.ImportBackupRequestPhaseWaitingForUpload,
+			expectedUploadURL: "upload-url",
+			expectedRequeue:   importUploadPollInterval,
+		},
+		{
+			name:             "the uploaded tarball is imported",
+			request:          request(velerov1api.ImportBackupRequestPhaseWaitingForUpload, now.Add(30*time.Minute)),
+			tarballExists:    true,
+			contents:         tarball,
+			expectedPhase:    velerov1api.ImportBackupRequestPhaseCompleted,
+			expectedRequeue:  importBackupRequestMaxAge,
+			expectedDeletion: true,
+		},
+		{
+			name:            "a tarball that isn't uploaded in time fails the request",
+			request:         request(velerov1api.ImportBackupRequestPhaseWaitingForUpload, now.Add(-2*time.Hour)),
+			expectedPhase:   velerov1api.ImportBackupRequestPhaseFailed,
+			expectedRequeue: importBackupRequestMaxAge,
+			expectedError:   "tarball backup-1-data.tar.gz wasn't uploaded before its upload URL expired",
+		},
+		{
+			name:             "an uploaded tarball that can't be imported is deleted",
+			request:          request(velerov1api.ImportBackupRequestPhaseWaitingForUpload, now.Add(30*time.Minute)),
+			tarballExists:    true,
+			contents:         []byte("not a tarball"),
+			expectedPhase:    velerov1api.ImportBackupRequestPhaseFailed,
+			expectedRequeue:  importBackupRequestMaxAge,
+			expectedError:    "error extracting backup tarball",
+			expectedDeletion: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Result(),
+				tc.request,
+			)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("ImportTarballExists", "backup-1-data.tar.gz").Return(tc.tarballExists, nil)
+			backupStore.On("GetImportUploadURL", "backup-1-data.tar.gz").Return("upload-url", tc.uploadURLErr)
+			backupStore.On("BackupExists", "bucket", "backup-1").Return(false, nil)
+			backupStore.On("GetImportTarball", "backup-1-data.tar.gz").Return(ioutil.NopCloser(bytes.NewReader(tc.contents)), nil)
+			backupStore.On("PutBackup", mock.Anything).Return(nil)
+			backupStore.On("DeleteImportTarball", "backup-1-data.tar.gz").Return(nil)
+
+			r := NewImportBackupRequestReconciler(
+				velerotest.NewLogger(),
+				client,
+				24*time.Hour,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+			)
+			r.clock = clock.NewFakeClock(now)
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "import-1"}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRequeue, result.RequeueAfter)
+
+			res := &velerov1api.ImportBackupRequest{}
+			require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: "import-1"}, res))
+			assert.Equal(t, tc.expectedPhase, res.Status.Phase)
+			assert.Equal(t, tc.expectedUploadURL, res.Status.UploadURL)
+			if !tc.expectedExpiration.IsZero() {
+				require.NotNil(t, res.Status.Expiration)
+				assert.Equal(t, tc.expectedExpiration, res.Status.Expiration.Time.UTC())
+			}
+
+			if tc.expectedError != "" {
+				require.Len(t, res.Status.Errors, 1)
+				assert.Contains(t, res.Status.Errors[0], tc.expectedError)
+				backupStore.AssertNotCalled(t, "PutBackup", mock.Anything)
+			} else {
+				assert.Empty(t, res.Status.Errors)
+			}
+
+			if tc.expectedDeletion {
+				backupStore.AssertCalled(t, "DeleteImportTarball", "backup-1-data.tar.gz")
+			} else {
+				backupStore.AssertNotCalled(t, "DeleteImportTarball", "backup-1-data.tar.gz")
+			}
+		})
+	}
+}
+
+func TestImportBackupRequestExpiration(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForImportBackupRequest("velero", "expired").
+			Phase(velerov1api.ImportBackupRequestPhaseCompleted).
+			CompletionTimestamp(now.Add(-25*time.Hour)).
+			Result(),
+		builder.ForImportBackupRequest("velero", "recent").
+			Phase(velerov1api.ImportBackupRequestPhaseFailed).
+			CompletionTimestamp(now.Add(-time.Hour)).
+			Result(),
+	)
+
+	r := NewImportBackupRequestReconciler(velerotest.NewLogger(), client, 24*time.Hour, nil, nil)
+	r.clock = clock.NewFakeClock(now)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "expired"}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	err = client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: "expired"}, &velerov1api.ImportBackupRequest{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	result, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "recent"}})
+	require.NoError(t, err)
+	assert.Equal(t, 23*time.Hour, result.RequeueAfter)
+	require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: "recent"}, &velerov1api.ImportBackupRequest{}))
+}
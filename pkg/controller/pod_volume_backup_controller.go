@@ -19,8 +19,6 @@ package controller
 import (
 	"context"
 	"fmt"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/wait"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -39,10 +38,20 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
+// backupOpName is the name of the operation recorded in the pod volume
+// operation latency metrics.
+const backupOpName = "backup"
+
+// backupCancelCheckInterval is how often a pod volume backup in progress checks
+// whether its backup was canceled.
+const backupCancelCheckInterval = 5 * time.Second
+
 // BackupExecuter runs backups.
 type BackupExecuter interface {
 	RunBackup(*restic.Command, logrus.FieldLogger, func(velerov1api.PodVolumeOperationProgress)) (string, string, error)
@@ -119,6 +128,14 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	r.Metrics.RegisterPodVolumeBackupEnqueue(r.NodeName)
 
+	backupName := ownerBackupName(&pvb)
+	if canceled, err := r.backupCanceled(ctx, pvb.Namespace, backupName); err != nil {
+		log.WithError(err).Warn("Error checking whether the backup was canceled")
+	} else if canceled {
+		log.Info("Backup was canceled, not processing")
+		return r.updateStatusToFailed(ctx, &pvb, nil, fmt.Sprintf("backup %s was canceled", backupName))
+	}
+
 	// Update status to InProgress.
 	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseInProgress
 	pvb.Status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
@@ -132,12 +149,10 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return r.updateStatusToFailed(ctx, &pvb, err, fmt.Sprintf("getting pod %s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name))
 	}
 
-	var resticDetails resticDetails
-	resticCmd, err := r.buildResticCommand(ctx, log, &pvb, &pod, &resticDetails)
+	path, err := r.getVolumePath(log, &pvb, &pod)
 	if err != nil {
-		return r.updateStatusToFailed(ctx, &pvb, err, "building Restic command")
+		return r.updateStatusToFailed(ctx, &pvb, err, "identifying volume path")
 	}
-	defer os.Remove(resticDetails.credsFile)
 
 	backupLocation := &velerov1api.BackupStorageLocation{}
 	if err := r.Client.Get(context.Background(), client.ObjectKey{
@@ -147,46 +162,56 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup storage location")
 	}
 
-	// #4820: restrieve insecureSkipTLSVerify from BSL configuration for
-	// AWS plugin. If nothing is return, that means insecureSkipTLSVerify
-	// is not enable for Restic command.
-	skipTLSRet := restic.GetInsecureSkipTLSVerifyFromBSL(backupLocation, log)
-	if len(skipTLSRet) > 0 {
-		resticCmd.ExtraFlags = append(resticCmd.ExtraFlags, skipTLSRet)
+	uploaderType := uploader.GetUploaderType(pvb.Spec.UploaderType)
+	uploaderProv, err := provider.NewUploaderProvider(ctx, uploaderType, pvb.Spec.RepoIdentifier, backupLocation, r.CredsFileStore, r.FileSystem, r.ResticExec, log)
+	if err != nil {
+		return r.updateStatusToFailed(ctx, &pvb, err, fmt.Sprintf("creating %s uploader", uploaderType))
 	}
+	defer func() {
+		if err := uploaderProv.Close(ctx); err != nil {
+			log.WithError(err).Warn("Error closing uploader")
+		}
+	}()
 
-	var stdout, stderr string
-
-	var emptySnapshot bool
-	stdout, stderr, err = r.ResticExec.RunBackup(resticCmd, log, r.updateBackupProgressFunc(&pvb, log))
-	if err != nil {
-		if strings.Contains(stderr, "snapshot is empty") {
-			emptySnapshot = true
+	// If this is a PVC, look for the most recent completed PodVolumeBackup for
+	// it and get its snapshot ID to use as the parent snapshot. Without this, if
+	// the pod using the PVC (and therefore the directory path under /host_pods/)
+	// has changed since the PVC's last backup, the uploader will not be able to
+	// identify a suitable parent snapshot to use, and will have to do a full
+	// rescan of the contents of the PVC.
+	var parentSnapshotID string
+	if pvcUID, ok := pvb.Labels[velerov1api.PVCUIDLabel]; ok {
+		parentSnapshotID = r.getParentSnapshot(ctx, log, pvb.Namespace, pvcUID, pvb.Spec.BackupStorageLocation, uploaderType)
+		if parentSnapshotID == "" {
+			log.Info("No parent snapshot found for PVC, not using a parent snapshot for this backup")
 		} else {
-			return r.updateStatusToFailed(ctx, &pvb, err, fmt.Sprintf("running Restic backup, stderr=%s", stderr))
+			log.WithField("parentSnapshotID", parentSnapshotID).Info("Using parent snapshot for this backup")
 		}
 	}
-	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
-
-	var snapshotID string
-	if !emptySnapshot {
-		cmd := restic.GetSnapshotCommand(pvb.Spec.RepoIdentifier, resticDetails.credsFile, pvb.Spec.Tags)
-		cmd.Env = resticDetails.envs
-		cmd.CACertFile = resticDetails.caCertFile
 
-		// #4820: also apply the insecureTLS flag to Restic snapshots command
-		if len(skipTLSRet) > 0 {
-			cmd.ExtraFlags = append(cmd.ExtraFlags, skipTLSRet)
+	// the upload is stopped, and the restic process killed, if the backup is canceled
+	// while the pod volume is backed up.
+	runCtx, cancelRun := context.WithCancel(ctx)
+	defer cancelRun()
+	go wait.UntilWithContext(runCtx, func(ctx context.Context) {
+		if canceled, err := r.backupCanceled(ctx, pvb.Namespace, backupName); err != nil {
+			log.WithError(err).Warn("Error checking whether the backup was canceled")
+		} else if canceled {
+			log.Info("Backup was canceled, stopping the pod volume backup")
+			cancelRun()
 		}
+	}, backupCancelCheckInterval)
 
-		snapshotID, err = r.ResticExec.GetSnapshotID(cmd)
-		if err != nil {
-			return r.updateStatusToFailed(ctx, &pvb, err, "getting snapshot id")
-		}
+	snapshotID, emptySnapshot, err := uploaderProv.RunBackup(runCtx, path, pvb.Spec.Tags, parentSnapshotID, r.updateBackupProgressFunc(&pvb, log))
+	if err != nil && runCtx.Err() != nil && ctx.Err() == nil {
+		return r.updateStatusToFailed(ctx, &pvb, nil, fmt.Sprintf("backup %s was canceled", backupName))
+	}
+	if err != nil {
+		return r.updateStatusToFailed(ctx, &pvb, err, fmt.Sprintf("running %s backup: %v", uploaderType, err))
 	}
 
 	// Update status to Completed with path & snapshot ID.
-	pvb.Status.Path = resticDetails.path
+	pvb.Status.Path = path
 	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseCompleted
 	pvb.Status.SnapshotID = snapshotID
 	pvb.Status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
@@ -196,9 +221,9 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	latencyDuration := pvb.Status.CompletionTimestamp.Time.Sub(pvb.Status.StartTimestamp.Time)
 	latencySeconds := float64(latencyDuration / time.Second)
-	backupName := fmt.Sprintf("%s/%s", req.Namespace, pvb.OwnerReferences[0].Name)
-	r.Metrics.ObserveResticOpLatency(r.NodeName, req.Name, resticCmd.Command, backupName, latencySeconds)
-	r.Metrics.RegisterResticOpLatencyGauge(r.NodeName, req.Name, resticCmd.Command, backupName, latencySeconds)
+	backupName = fmt.Sprintf("%s/%s", req.Namespace, backupName)
+	r.Metrics.ObserveResticOpLatency(r.NodeName, req.Name, backupOpName, backupName, latencySeconds)
+	r.Metrics.RegisterResticOpLatencyGauge(r.NodeName, req.Name, backupOpName, backupName, latencySeconds)
 	r.Metrics.RegisterPodVolumeBackupDequeue(r.NodeName)
 
 	log.Info("PodVolumeBackup completed")
@@ -226,9 +251,10 @@ func (r *PodVolumeBackupReconciler) singlePathMatch(path string) (string, error)
 }
 
 // getParentSnapshot finds the most recent completed PodVolumeBackup for the
-// specified PVC and returns its Restic snapshot ID. Any errors encountered are
-// logged but not returned since they do not prevent a backup from proceeding.
-func (r *PodVolumeBackupReconciler) getParentSnapshot(ctx context.Context, log logrus.FieldLogger, pvbNamespace, pvcUID, bsl string) string {
+// specified PVC taken with the given uploader and returns its snapshot ID. Any
+// errors encountered are logged but not returned since they do not prevent a
+// backup from proceeding.
+func (r *PodVolumeBackupReconciler) getParentSnapshot(ctx context.Context, log logrus.FieldLogger, pvbNamespace, pvcUID, bsl string, uploaderType velerov1api.UploaderType) string {
 	log = log.WithField("pvcUID", pvcUID)
 	log.Infof("Looking for most recent completed PodVolumeBackup for this PVC")
 
@@ -262,6 +288,11 @@ func (r *PodVolumeBackupReconciler) getParentSnapshot(ctx context.Context, log l
 			continue
 		}
 
+		if uploader.GetUploaderType(pvb.Spec.UploaderType) != uploaderType {
+			// Snapshots of one uploader can't be used as parents by another one.
+			continue
+		}
+
 		if mostRecentPVB == nil || pvb.Status.StartTimestamp.After(mostRecentPVB.Status.StartTimestamp.Time) {
 			mostRecentPVB = &pvb
 		}
@@ -295,16 +326,39 @@ func (r *PodVolumeBackupReconciler) updateStatusToFailed(ctx context.Context, pv
 	return ctrl.Result{}, errors.Wrap(err, msg)
 }
 
-type resticDetails struct {
-	credsFile, caCertFile string
-	envs                  []string
-	path                  string
+// backupCanceled returns whether the cancellation of the backup was requested.
+func (r *PodVolumeBackupReconciler) backupCanceled(ctx context.Context, namespace, backupName string) (bool, error) {
+	if backupName == "" {
+		return false, nil
+	}
+
+	backup := &velerov1api.Backup{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: backupName}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "error getting backup %s", backupName)
+	}
+
+	return cancelRequested(backup), nil
+}
+
+// ownerBackupName returns the name of the backup the pod volume backup is part of.
+func ownerBackupName(pvb *velerov1api.PodVolumeBackup) string {
+	for _, owner := range pvb.OwnerReferences {
+		if owner.Kind == "" || owner.Kind == "Backup" {
+			return owner.Name
+		}
+	}
+	return ""
 }
 
-func (r *PodVolumeBackupReconciler) buildResticCommand(ctx context.Context, log *logrus.Entry, pvb *velerov1api.PodVolumeBackup, pod *corev1.Pod, details *resticDetails) (*restic.Command, error) {
+// getVolumePath returns the path of the pod volume to back up, as mounted in
+// the daemonset pod.
+func (r *PodVolumeBackupReconciler) getVolumePath(log logrus.FieldLogger, pvb *velerov1api.PodVolumeBackup, pod *corev1.Pod) (string, error) {
 	volDir, err := kube.GetVolumeDirectory(log, pod, pvb.Spec.Volume, r.PvcLister, r.PvLister, r.Client)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting volume directory name")
+		return "", errors.Wrap(err, "getting volume directory name")
 	}
 
 	pathGlob := fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(pvb.Spec.Pod.UID), volDir)
@@ -312,62 +366,9 @@ func (r *PodVolumeBackupReconciler) buildResticCommand(ctx context.Context, log
 
 	path, err := r.singlePathMatch(pathGlob)
 	if err != nil {
-		return nil, errors.Wrap(err, "identifying unique volume path on host")
+		return "", errors.Wrap(err, "identifying unique volume path on host")
 	}
 	log.WithField("path", path).Debugf("Found path matching glob")
 
-	// Temporary credentials.
-	details.credsFile, err = r.CredsFileStore.Path(restic.RepoKeySelector())
-	if err != nil {
-		return nil, errors.Wrap(err, "creating temporary Restic credentials file")
-	}
-
-	cmd := restic.BackupCommand(pvb.Spec.RepoIdentifier, details.credsFile, path, pvb.Spec.Tags)
-
-	backupLocation := &velerov1api.BackupStorageLocation{}
-	if err := r.Client.Get(context.Background(), client.ObjectKey{
-		Namespace: pvb.Namespace,
-		Name:      pvb.Spec.BackupStorageLocation,
-	}, backupLocation); err != nil {
-		return nil, errors.Wrap(err, "getting backup storage location")
-	}
-
-	// If there's a caCert on the ObjectStorage, write it to disk so that it can
-	// be passed to Restic.
-	if backupLocation.Spec.ObjectStorage != nil &&
-		backupLocation.Spec.ObjectStorage.CACert != nil {
-
-		details.caCertFile, err = restic.TempCACertFile(backupLocation.Spec.ObjectStorage.CACert, pvb.Spec.BackupStorageLocation, r.FileSystem)
-		if err != nil {
-			log.WithError(err).Error("creating temporary caCert file")
-		}
-		defer os.Remove(details.caCertFile)
-
-	}
-	cmd.CACertFile = details.caCertFile
-
-	details.envs, err = restic.CmdEnv(backupLocation, r.CredsFileStore)
-	if err != nil {
-		return nil, errors.Wrap(err, "setting Restic command environment")
-	}
-	cmd.Env = details.envs
-
-	// If this is a PVC, look for the most recent completed PodVolumeBackup for
-	// it and get its Restic snapshot ID to use as the value of the `--parent`
-	// flag. Without this, if the pod using the PVC (and therefore the directory
-	// path under /host_pods/) has changed since the PVC's last backup, Restic
-	// will not be able to identify a suitable parent snapshot to use, and will
-	// have to do a full rescan of the contents of the PVC.
-	if pvcUID, ok := pvb.Labels[velerov1api.PVCUIDLabel]; ok {
-		parentSnapshotID := r.getParentSnapshot(ctx, log, pvb.Namespace, pvcUID, pvb.Spec.BackupStorageLocation)
-		if parentSnapshotID == "" {
-			log.Info("No parent snapshot found for PVC, not using --parent flag for this backup")
-		} else {
-			log.WithField("parentSnapshotID", parentSnapshotID).
-				Info("Setting --parent flag for this backup")
-			cmd.ExtraFlags = append(cmd.ExtraFlags, fmt.Sprintf("--parent=%s", parentSnapshotID))
-		}
-	}
-
-	return cmd, nil
+	return path, nil
 }
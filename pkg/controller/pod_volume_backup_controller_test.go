@@ -19,11 +19,16 @@ package controller
 import (
 	"context"
 	"fmt"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -38,6 +43,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restic/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -291,3 +297,89 @@ type fakeCredsFileStore struct{}
 func (f fakeCredsFileStore) Path(selector *corev1.SecretKeySelector) (string, error) {
 	return "/fake/path", nil
 }
+
+// cancelingResticBackupExec requests the cancellation of the backup when it starts backing up
+// a pod volume, then waits for the restic process to be killed.
+type cancelingResticBackupExec struct {
+	mocks.FakeResticBackupExec
+	client kbclient.Client
+	backup *velerov1api.Backup
+}
+
+func (e cancelingResticBackupExec) RunBackup(cmd *restic.Command, log logrus.FieldLogger, updateFn func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
+	updated := e.backup.DeepCopy()
+	updated.Annotations = map[string]string{velerov1api.CancelRequestedAnnotation: "true"}
+	if err := e.client.Patch(context.Background(), updated, kbclient.MergeFrom(e.backup)); err != nil {
+		return "", "", err
+	}
+
+	select {
+	case <-cmd.Context.Done():
+		return "", "", cmd.Context.Err()
+	case <-time.After(2 * backupCancelCheckInterval):
+		return "", "", errors.New("restic process wasn't killed")
+	}
+}
+
+func TestPodVolumeBackupReconcilerCanceledBackup(t *testing.T) {
+	tests := []struct {
+		name        string
+		canceled    bool
+		cancelOnRun bool
+	}{
+		{
+			name:     "pvb of a canceled backup isn't run",
+			canceled: true,
+		},
+		{
+			name:        "pvb in progress is stopped when its backup is canceled",
+			cancelOnRun: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			require.NoError(t, velerov1api.AddToScheme(scheme.Scheme))
+
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, name).Result()
+			if test.canceled {
+				backup.Annotations = map[string]string{velerov1api.CancelRequestedAnnotation: "true"}
+			}
+			fakeClient := fake.NewFakeClientWithScheme(scheme.Scheme,
+				pvbBuilder().Phase(velerov1api.PodVolumeBackupPhaseNew).Node("test_node").Result(),
+				podBuilder().Result(),
+				bslBuilder().Result(),
+				backup,
+			)
+
+			fakeFS := velerotest.NewFakeFileSystem()
+			_, err := fakeFS.Create(fmt.Sprintf("/host_pods/%s/volumes/*/%s", "", "pvb-1-volume"))
+			require.NoError(t, err)
+
+			var resticExec BackupExecuter = mocks.FakeResticBackupExec{}
+			if test.cancelOnRun {
+				resticExec = cancelingResticBackupExec{client: fakeClient, backup: backup}
+			}
+
+			r := PodVolumeBackupReconciler{
+				Client:         fakeClient,
+				Clock:          clock.NewFakeClock(time.Now()),
+				Metrics:        metrics.NewResticServerMetrics(),
+				CredsFileStore: fakeCredsFileStore{},
+				NodeName:       "test_node",
+				FileSystem:     fakeFS,
+				ResticExec:     resticExec,
+				Log:            velerotest.NewLogger(),
+			}
+
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: name}})
+			require.NoError(t, err)
+
+			pvb := &velerov1api.PodVolumeBackup{}
+			require.NoError(t, fakeClient.Get(ctx, kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, pvb))
+			assert.Equal(t, velerov1api.PodVolumeBackupPhaseFailed, pvb.Status.Phase)
+			assert.Equal(t, "backup pvb-1 was canceled", pvb.Status.Message)
+		})
+	}
+}
@@ -26,11 +26,13 @@ import (
 	"io/ioutil"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,6 +40,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
@@ -48,6 +52,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -95,6 +100,9 @@ type restoreController struct {
 
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
+
+	// cancelFuncs holds the functions canceling the restores in progress.
+	cancelFuncs cancelFuncs
 }
 
 func NewRestoreController(
@@ -160,6 +168,22 @@ func NewRestoreController(
 				}
 				c.queue.Add(key)
 			},
+			UpdateFunc: func(_, obj interface{}) {
+				restore := obj.(*api.Restore)
+
+				if restore.Status.Phase != api.RestorePhaseInProgress || !cancelRequested(restore) {
+					return
+				}
+
+				key, err := cache.MetaNamespaceKeyFunc(restore)
+				if err != nil {
+					c.logger.WithError(errors.WithStack(err)).WithField("restore", restore).Error("Error creating queue key, restore not canceled")
+					return
+				}
+				if c.cancelFuncs.cancel(key) {
+					c.logger.WithField("restore", key).Info("Canceling restore")
+				}
+			},
 		},
 	)
 
@@ -201,6 +225,17 @@ func (c *restoreController) processQueueItem(key string) error {
 	// is ("" | New)
 	switch restore.Status.Phase {
 	case "", api.RestorePhaseNew:
+		// restores canceled before they're started have nothing to clean up
+		if cancelRequested(restore) {
+			updated := restore.DeepCopy()
+			updated.Status.Phase = api.RestorePhaseCanceled
+			updated.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+			if _, err := patchRestore(restore, updated, c.restoreClient); err != nil {
+				return errors.Wrapf(err, "error updating Restore status to %s", updated.Status.Phase)
+			}
+			log.Info("Restore was canceled before it was started")
+			return nil
+		}
 	case api.RestorePhaseInProgress:
 		// A restore may stay in-progress forever because of
 		// 1) the controller restarts during the processing of a restore
@@ -269,7 +304,21 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		return nil
 	}
 
-	if err := c.runValidatedRestore(restore, info); err != nil {
+	// the restore's context is canceled when its cancellation is requested, which
+	// the informer may have seen before the restore's cancel func was registered.
+	key := kubeutil.NamespaceAndName(restore)
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancelFuncs.register(key, cancel)
+	defer c.cancelFuncs.unregister(key)
+	if latest, err := c.restoreLister.Restores(restore.Namespace).Get(restore.Name); err == nil && cancelRequested(latest) {
+		cancel()
+	}
+
+	if err := c.runValidatedRestore(ctx, restore, info); ctx.Err() != nil {
+		c.logger.Debug("Restore canceled")
+		restore.Status.Phase = api.RestorePhaseCanceled
+		c.metrics.RegisterRestoreCanceled(backupScheduleName)
+	} else if err != nil {
 		c.logger.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
@@ -297,6 +346,34 @@ type backupInfo struct {
 	backup      *api.Backup
 	location    *velerov1api.BackupStorageLocation
 	backupStore persistence.BackupStore
+
+	// resourceModifiers holds the resource modifier rules referenced
+	// by the restore, if any.
+	resourceModifiers *resourcemodifiers.ResourceModifiers
+}
+
+// validateRestoreHooks returns the errors of the restore hook specs: hooks with the Restore scope
+// must select the pod they're executed in, and only exec hooks can be executed once per restore.
+func validateRestoreHooks(hooks *api.RestoreHooks) []error {
+	var errs []error
+	for _, rh := range hooks.Resources {
+		switch rh.Scope {
+		case "", api.RestoreHookScopePod:
+		case api.RestoreHookScopeRestore:
+			if rh.Pod == nil || rh.Pod.Namespace == "" || rh.Pod.LabelSelector == nil {
+				errs = append(errs, errors.Errorf("hook %s with scope %s must set pod.namespace and pod.labelSelector", rh.Name, rh.Scope))
+			}
+			for _, h := range rh.PostHooks {
+				if h.Exec == nil {
+					errs = append(errs, errors.Errorf("hook %s with scope %s only supports exec post hooks", rh.Name, rh.Scope))
+					break
+				}
+			}
+		default:
+			errs = append(errs, errors.Errorf("hook %s has invalid scope %q, must be one of %q or %q", rh.Name, rh.Scope, api.RestoreHookScopePod, api.RestoreHookScopeRestore))
+		}
+	}
+	return errs
 }
 
 func (c *restoreController) validateAndComplete(restore *api.Restore, pluginManager clientmgmt.Manager) backupInfo {
@@ -326,6 +403,22 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate existing resource policy
+	if !isValidExistingResourcePolicy(restore.Spec.ExistingResourcePolicy) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid existing resource policy %q, must be one of %q or %q", restore.Spec.ExistingResourcePolicy, api.PolicyTypeNone, api.PolicyTypeUpdate))
+	}
+
+	// validate the restore hooks
+	for _, err := range validateRestoreHooks(&restore.Spec.Hooks) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid restore hooks: %v", err))
+	}
+
+	// validate the resource modifier rules, if specified
+	resourceModifiers, err := c.getResourceModifiers(restore)
+	if err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid resource modifiers: %v", err))
+	}
+
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
@@ -362,14 +455,63 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		return backupInfo{}
 	}
 
+	// backups aren't usable until their snapshots are uploaded
+	switch info.backup.Status.Phase {
+	case api.BackupPhaseUploading, api.BackupPhaseUploadingPartialFailure:
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s is in phase %s and can't be restored until its snapshots are uploaded", info.backup.Name, info.backup.Status.Phase))
+		return backupInfo{}
+	case api.BackupPhaseCanceled:
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s was canceled and can't be restored", info.backup.Name))
+		return backupInfo{}
+	}
+
+	// dry-run backups don't have a tarball to restore from
+	if info.backup.Spec.DryRun {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s is a dry run and can't be restored", info.backup.Name))
+		return backupInfo{}
+	}
+
 	// Fill in the ScheduleName so it's easier to consume for metrics.
 	if restore.Spec.ScheduleName == "" {
 		restore.Spec.ScheduleName = info.backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	}
 
+	info.resourceModifiers = resourceModifiers
+
 	return info
 }
 
+// getResourceModifiers fetches and parses the resource modifier rules from the ConfigMap
+// referenced by the restore. It returns nil if the restore doesn't reference any.
+func (c *restoreController) getResourceModifiers(restore *api.Restore) (*resourcemodifiers.ResourceModifiers, error) {
+	ref := restore.Spec.ResourceModifier
+	if ref == nil {
+		return nil, nil
+	}
+
+	if !strings.EqualFold(ref.Kind, resourcemodifiers.ConfigMapRefKind) {
+		return nil, errors.Errorf("unsupported kind %q, only %s is supported", ref.Kind, resourcemodifiers.ConfigMapRefKind)
+	}
+
+	cm := &corev1api.ConfigMap{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{Namespace: c.namespace, Name: ref.Name}, cm); err != nil {
+		return nil, errors.Wrapf(err, "error getting configmap %s/%s", c.namespace, ref.Name)
+	}
+
+	return resourcemodifiers.GetResourceModifiersFromConfig(cm)
+}
+
+// isValidExistingResourcePolicy returns true if the policy is empty or one of the
+// supported existing resource policies.
+func isValidExistingResourcePolicy(policy api.PolicyType) bool {
+	switch policy {
+	case "", api.PolicyTypeNone, api.PolicyTypeUpdate:
+		return true
+	default:
+		return false
+	}
+}
+
 // backupXorScheduleProvided returns true if exactly one of BackupName and
 // ScheduleName are non-empty for the restore, or false otherwise.
 func backupXorScheduleProvided(restore *api.Restore) bool {
@@ -401,7 +543,7 @@ func mostRecentCompletedBackup(backups []*api.Backup) *api.Backup {
 	})
 
 	for _, backup := range backups {
-		if backup.Status.Phase == api.BackupPhaseCompleted {
+		if backup.Status.Phase == api.BackupPhaseCompleted && !backup.Spec.DryRun {
 			return backup
 		}
 	}
@@ -441,7 +583,7 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
-func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) error {
+func (c *restoreController) runValidatedRestore(ctx context.Context, restore *api.Restore, info backupInfo) error {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := newRestoreLogger(restore, c.logger, c.restoreLogLevel, c.logFormat)
@@ -453,13 +595,20 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	pluginManager := c.newPluginManager(restoreLog)
 	defer pluginManager.CleanupClients()
 
-	actions, err := pluginManager.GetRestoreItemActions()
+	// the calls of the plugins run while restoring are stopped when the restore is canceled,
+	// while its log and results are still persisted with the plugin manager's plugins.
+	runPluginManager := clientmgmt.WithContext(ctx, pluginManager)
+	if runPluginManager != pluginManager {
+		defer runPluginManager.CleanupClients()
+	}
+
+	actions, err := runPluginManager.GetRestoreItemActions()
 	if err != nil {
 		return errors.Wrap(err, "error getting restore item actions")
 	}
 	actionsResolver := framework.NewRestoreItemActionResolver(actions)
 
-	itemSnapshotters, err := pluginManager.GetItemSnapshotters()
+	itemSnapshotters, err := runPluginManager.GetItemSnapshotters()
 	if err != nil {
 		return errors.Wrap(err, "error getting item snapshotters")
 	}
@@ -483,6 +632,11 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
+	itemSnapshots, err := info.backupStore.GetItemSnapshots(restore.Spec.BackupName)
+	if err != nil {
+		return errors.Wrap(err, "error fetching item snapshots metadata")
+	}
+
 	restoreLog.Info("starting restore")
 
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
@@ -490,15 +644,20 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
 	}
 	restoreReq := pkgrestore.Request{
-		Log:              restoreLog,
-		Restore:          restore,
-		Backup:           info.backup,
-		PodVolumeBackups: podVolumeBackups,
-		VolumeSnapshots:  volumeSnapshots,
-		BackupReader:     backupFile,
+		Log:               restoreLog,
+		Restore:           restore,
+		Backup:            info.backup,
+		PodVolumeBackups:  podVolumeBackups,
+		VolumeSnapshots:   volumeSnapshots,
+		ItemSnapshots:     itemSnapshots,
+		BackupReader:      backupFile,
+		ResourceModifiers: info.resourceModifiers,
+		RestoredItems:     make(map[velero.ResourceIdentifier]pkgrestore.ItemStatus),
+		HookExecutions:    hook.NewExecutionRecorder(),
+		Context:           ctx,
 	}
 	restoreWarnings, restoreErrors := c.restorer.RestoreWithResolvers(restoreReq, actionsResolver, snapshotItemResolver,
-		c.snapshotLocationLister, pluginManager)
+		c.snapshotLocationLister, runPluginManager)
 
 	// log errors and warnings to the restore log
 	for _, msg := range restoreErrors.Velero {
@@ -562,9 +721,52 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	if err := putRestoredResourceList(restore, restoreReq.RestoredResourceList(), info.backupStore); err != nil {
+		c.logger.WithError(err).Error("Error uploading restored resource list to backup storage")
+	}
+
+	// dry runs don't execute hooks
+	if !restore.Spec.DryRun {
+		if err := putHookExecutions(restore, restoreReq.HookExecutions.Executions(), info.backupStore); err != nil {
+			c.logger.WithError(err).Error("Error uploading restore hook executions to backup storage")
+		}
+	}
+
 	return nil
 }
 
+func putHookExecutions(restore *api.Restore, executions []hook.Execution, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(executions); err != nil {
+		return errors.Wrap(err, "error encoding hook executions to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreHookExecutions(restore.Name, buf)
+}
+
+func putRestoredResourceList(restore *api.Restore, list []pkgrestore.RestoredItem, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(list); err != nil {
+		return errors.Wrap(err, "error encoding restored resource list to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoredResourceList(restore.Name, buf)
+}
+
 func putResults(restore *api.Restore, results map[string]pkgrestore.Result, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -247,6 +247,36 @@ func TestMarkInProgressRestoreAsFailed(t *testing.T) {
 	assert.Equal(t, velerov1api.RestorePhaseFailed, res.Status.Phase)
 }
 
+func TestMarkCanceledNewRestoreAsCanceled(t *testing.T) {
+	var (
+		restore = builder.ForRestore("velero", "bar").
+			ObjectMeta(builder.WithAnnotations(velerov1api.CancelRequestedAnnotation, "true")).
+			Phase(velerov1api.RestorePhaseNew).
+			Result()
+		client          = fake.NewSimpleClientset(restore)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		logger          = velerotest.NewLogger()
+	)
+
+	c := restoreController{
+		genericController: newGenericController("restore-test", logger),
+		restoreClient:     client.VeleroV1(),
+		restoreLister:     sharedInformers.Velero().V1().Restores().Lister(),
+		clock:             clock.NewFakeClock(time.Now()),
+	}
+
+	err := sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore)
+	require.Nil(t, err)
+
+	err = c.processQueueItem(fmt.Sprintf("%s/%s", restore.Namespace, restore.Name))
+	require.Nil(t, err)
+
+	res, err := c.restoreClient.Restores(restore.Namespace).Get(context.Background(), restore.Name, metav1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, velerov1api.RestorePhaseCanceled, res.Status.Phase)
+	assert.NotNil(t, res.Status.CompletionTimestamp)
+}
+
 func TestProcessQueueItem(t *testing.T) {
 
 	defaultStorageLocation := builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
@@ -274,6 +304,7 @@ func TestProcessQueueItem(t *testing.T) {
 		backupStoreGetBackupMetadataErr error
 		backupStoreGetBackupContentsErr error
 		putRestoreLogErr                error
+		cancelDuringRestore             bool
 		expectedFinalPhase              string
 	}{
 		{
@@ -294,6 +325,55 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:                     "restore with invalid existing resource policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ExistingResourcePolicy("recreate").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid existing resource policy \"recreate\", must be one of \"none\" or \"update\""},
+		},
+		{
+			name:                     "restore with missing resource modifier configmap fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourceModifierConfigMap("modifiers-1").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid resource modifiers: error getting configmap velero/modifiers-1: configmaps \"modifiers-1\" not found"},
+		},
+		{
+			name:     "restore with invalid hooks fails validation",
+			location: defaultStorageLocation,
+			restore: NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourceHooks(
+				velerov1api.RestoreResourceHookSpec{
+					Name:  "hook-1",
+					Scope: velerov1api.RestoreHookScopeRestore,
+					Pod: &velerov1api.RestoreHookPod{
+						Namespace:     "ns-1",
+						LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					},
+					PostHooks: []velerov1api.RestoreResourceHook{{Init: &velerov1api.InitRestoreHook{}}},
+				},
+				velerov1api.RestoreResourceHookSpec{
+					Name:  "hook-2",
+					Scope: velerov1api.RestoreHookScopeRestore,
+				},
+				velerov1api.RestoreResourceHookSpec{
+					Name:  "hook-3",
+					Scope: "Namespace",
+				},
+			).Result(),
+			backup:        defaultBackup().StorageLocation("default").Result(),
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				"Invalid restore hooks: hook hook-1 with scope Restore only supports exec post hooks",
+				"Invalid restore hooks: hook hook-2 with scope Restore must set pod.namespace and pod.labelSelector",
+				"Invalid restore hooks: hook hook-3 has invalid scope \"Namespace\", must be one of \"Pod\" or \"Restore\"",
+			},
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -327,6 +407,33 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedValidationErrors:        []string{"Error retrieving backup: backup.velero.io \"backup-1\" not found"},
 			backupStoreGetBackupMetadataErr: errors.New("no backup here"),
 		},
+		{
+			name:                     "restore of a backup that's still uploading fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Phase(velerov1api.BackupPhaseUploading).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Backup backup-1 is in phase Uploading and can't be restored until its snapshots are uploaded"},
+		},
+		{
+			name:                     "restore of a canceled backup fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Phase(velerov1api.BackupPhaseCanceled).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Backup backup-1 was canceled and can't be restored"},
+		},
+		{
+			name:                     "restore of a dry-run backup fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                   defaultBackup().StorageLocation("default").DryRun(true).Phase(velerov1api.BackupPhaseCompleted).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Backup backup-1 is a dry run and can't be restored"},
+		},
 		{
 			name:                  "restorer throwing an error causes the restore to fail",
 			location:              defaultStorageLocation,
@@ -341,6 +448,19 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedRestoreErrors: 1,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:                  "restore canceled while it's executed is canceled",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                defaultBackup().StorageLocation("default").Result(),
+			cancelDuringRestore:   true,
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedFinalPhase:    string(velerov1api.RestorePhaseCanceled),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
+		},
 		{
 			name:                  "valid restore gets executed",
 			location:              defaultStorageLocation,
@@ -529,12 +649,22 @@ func TestProcessQueueItem(t *testing.T) {
 				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 
 				restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(warnings, errors)
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(mock.Arguments) {
+						if test.cancelDuringRestore {
+							c.cancelFuncs.cancel(test.restore.Namespace + "/" + test.restore.Name)
+						}
+					}).
+					Return(warnings, errors)
 
 				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
 
+				backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
+
+				backupStore.On("PutRestoreHookExecutions", test.restore.Name, mock.Anything).Return(nil)
+
 				volumeSnapshots := []*volume.Snapshot{
 					{
 						Spec: volume.SnapshotSpec{
@@ -544,6 +674,7 @@ func TestProcessQueueItem(t *testing.T) {
 					},
 				}
 				backupStore.On("GetBackupVolumeSnapshots", test.backup.Name).Return(volumeSnapshots, nil)
+				backupStore.On("GetItemSnapshots", test.backup.Name).Return(nil, nil)
 			}
 
 			var (
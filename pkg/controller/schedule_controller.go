@@ -24,9 +24,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/robfig/cron"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +42,16 @@ import (
 
 const (
 	scheduleSyncPeriod = time.Minute
+
+	// defaultStartingDeadline is how late a scheduled run may be
+	// triggered before it's considered missed, for the schedules that
+	// don't set their starting deadline.
+	defaultStartingDeadline = 5 * time.Minute
+
+	// maxMissedRuns is the number of missed runs after which they're no
+	// longer counted, so that a schedule that hasn't run for a long time
+	// doesn't have to go through all of its runs.
+	maxMissedRuns = 1000
 )
 
 type scheduleReconciler struct {
@@ -48,6 +60,7 @@ type scheduleReconciler struct {
 	logger    logrus.FieldLogger
 	clock     clock.Clock
 	metrics   *metrics.ServerMetrics
+	recorder  record.EventRecorder
 }
 
 func NewScheduleReconciler(
@@ -55,6 +68,7 @@ func NewScheduleReconciler(
 	logger logrus.FieldLogger,
 	client client.Client,
 	metrics *metrics.ServerMetrics,
+	recorder record.EventRecorder,
 ) *scheduleReconciler {
 	return &scheduleReconciler{
 		Client:    client,
@@ -62,6 +76,7 @@ func NewScheduleReconciler(
 		logger:    logger,
 		clock:     clock.RealClock{},
 		metrics:   metrics,
+		recorder:  recorder,
 	}
 }
 
@@ -76,6 +91,7 @@ func (c *scheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=schedules/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=create
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := c.logger.WithField("schedule", req.String())
@@ -92,8 +108,9 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 	if schedule.Status.Phase != "" &&
 		schedule.Status.Phase != velerov1.SchedulePhaseNew &&
-		schedule.Status.Phase != velerov1.SchedulePhaseEnabled {
-		log.Debugf("the schedule phase is %s, isn't %s or %s, skip", schedule.Status.Phase, velerov1.SchedulePhaseNew, velerov1.SchedulePhaseEnabled)
+		schedule.Status.Phase != velerov1.SchedulePhaseEnabled &&
+		schedule.Status.Phase != velerov1.SchedulePhasePaused {
+		log.Debugf("the schedule phase is %s, isn't %s, %s or %s, skip", schedule.Status.Phase, velerov1.SchedulePhaseNew, velerov1.SchedulePhaseEnabled, velerov1.SchedulePhasePaused)
 		return ctrl.Result{}, nil
 	}
 
@@ -109,15 +126,30 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	errs = append(errs, validateMissedRunPolicy(schedule)...)
+	errs = append(errs, validateStartingDeadline(schedule)...)
+	errs = append(errs, validateRetentionPolicy(schedule)...)
 	if len(errs) > 0 {
 		schedule.Status.Phase = velerov1.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
+	} else if schedule.Spec.Paused {
+		schedule.Status.Phase = velerov1.SchedulePhasePaused
 	} else {
 		schedule.Status.Phase = velerov1.SchedulePhaseEnabled
 	}
 
+	// a schedule that was just created or unpaused skips any run that's
+	// due if requested, so that it first runs at its next scheduled time
+	skipped := false
+	if schedule.Spec.SkipImmediately && currentPhase != velerov1.SchedulePhaseEnabled && schedule.Status.Phase == velerov1.SchedulePhaseEnabled {
+		schedule.Status.LastSkipped = &metav1.Time{Time: c.clock.Now()}
+		skipped = true
+		log.Info("Schedule was created or unpaused with skipImmediately, skipping any due run")
+		c.recorder.Event(schedule, corev1api.EventTypeNormal, "BackupSkipped", "Schedule was created or unpaused with skipImmediately, waiting for the next scheduled time")
+	}
+
 	// update status if it's changed
-	if currentPhase != schedule.Status.Phase {
+	if currentPhase != schedule.Status.Phase || skipped {
 		if err = patchHelper.Patch(ctx, schedule); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "error updating phase of schedule %s to %s", req.String(), schedule.Status.Phase)
 		}
@@ -176,6 +208,50 @@ func parseCronSchedule(itm *velerov1.Schedule, logger logrus.FieldLogger) (cron.
 	return schedule, nil
 }
 
+func validateMissedRunPolicy(itm *velerov1.Schedule) []string {
+	switch itm.Spec.MissedRunPolicy {
+	case "", velerov1.MissedRunPolicyRunOnce, velerov1.MissedRunPolicySkip:
+		return nil
+	default:
+		return []string{fmt.Sprintf("invalid missed run policy %q, must be %q or %q", itm.Spec.MissedRunPolicy, velerov1.MissedRunPolicyRunOnce, velerov1.MissedRunPolicySkip)}
+	}
+}
+
+func validateStartingDeadline(itm *velerov1.Schedule) []string {
+	if itm.Spec.StartingDeadline.Duration < 0 {
+		return []string{fmt.Sprintf("invalid starting deadline %s, must not be negative", itm.Spec.StartingDeadline.Duration)}
+	}
+	return nil
+}
+
+func validateRetentionPolicy(itm *velerov1.Schedule) []string {
+	policy := itm.Spec.Retention
+	if policy == nil {
+		return nil
+	}
+
+	var errs []string
+	for _, rule := range []struct {
+		name  string
+		count int
+	}{
+		{"keepLast", policy.KeepLast},
+		{"keepDaily", policy.KeepDaily},
+		{"keepWeekly", policy.KeepWeekly},
+		{"keepMonthly", policy.KeepMonthly},
+	} {
+		if rule.count < 0 {
+			errs = append(errs, fmt.Sprintf("invalid retention policy: %s must not be negative", rule.name))
+		}
+	}
+
+	if len(errs) == 0 && policy.KeepLast+policy.KeepDaily+policy.KeepWeekly+policy.KeepMonthly == 0 {
+		errs = append(errs, "invalid retention policy: at least one of keepLast, keepDaily, keepWeekly and keepMonthly must be set")
+	}
+
+	return errs
+}
+
 func (c *scheduleReconciler) submitBackupIfDue(ctx context.Context, item *velerov1.Schedule, cronSchedule cron.Schedule) error {
 	var (
 		now                = c.clock.Now()
@@ -188,22 +264,42 @@ func (c *scheduleReconciler) submitBackupIfDue(ctx context.Context, item *velero
 		return nil
 	}
 
-	// Don't attempt to "catch up" if there are any missed or failed runs - simply
-	// trigger a Backup if it's time.
+	patchHelper, err := patch.NewHelper(item, c.Client)
+	if err != nil {
+		return errors.Wrap(err, "error creating patch helper")
+	}
+
+	// Missed runs are never run one by one: depending on the schedule's missed
+	// run policy, they're either collapsed into a single Backup or skipped.
 	//
 	// It might also make sense in the future to explicitly check for currently-running
 	// backups so that we don't overlap runs (for disk snapshots in particular, this can
 	// lead to performance issues).
+	missedRuns := getMissedRuns(cronSchedule, nextRunTime, now, getStartingDeadline(item))
+	item.Status.MissedRuns = missedRuns
+	if missedRuns > 0 {
+		log = log.WithField("missedRuns", missedRuns)
+
+		if item.Spec.MissedRunPolicy == velerov1.MissedRunPolicySkip {
+			log.WithField("nextRunTime", nextRunTime).Info("Schedule missed runs, skipping them as the missed run policy is skip")
+			c.recorder.Eventf(item, corev1api.EventTypeWarning, "BackupSkipped", "Skipped %d missed run(s), waiting for the next scheduled time", missedRuns)
+
+			item.Status.LastSkipped = &metav1.Time{Time: now}
+			if err := patchHelper.Patch(ctx, item); err != nil {
+				return errors.Wrapf(err, "error updating Schedule's LastSkipped time to %v", item.Status.LastSkipped)
+			}
+			return nil
+		}
+
+		c.recorder.Eventf(item, corev1api.EventTypeWarning, "MissedRuns", "Missed %d run(s), running a single backup for them", missedRuns)
+	}
+
 	log.WithField("nextRunTime", nextRunTime).Info("Schedule is due, submitting Backup")
 	backup := getBackup(item, now)
 	if err := c.Create(ctx, backup); err != nil {
 		return errors.Wrap(err, "error creating Backup")
 	}
 
-	patchHelper, err := patch.NewHelper(item, c.Client)
-	if err != nil {
-		return errors.Wrap(err, "error creating patch helper")
-	}
 	item.Status.LastBackup = &metav1.Time{Time: now}
 
 	if err := patchHelper.Patch(ctx, item); err != nil {
@@ -221,11 +317,45 @@ func getNextRunTime(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asO
 		lastBackupTime = schedule.CreationTimestamp.Time
 	}
 
+	// a skipped run counts as a run so that the schedule waits for the next one
+	if schedule.Status.LastSkipped != nil && schedule.Status.LastSkipped.After(lastBackupTime) {
+		lastBackupTime = schedule.Status.LastSkipped.Time
+	}
+
 	nextRunTime := cronSchedule.Next(lastBackupTime)
 
 	return asOf.After(nextRunTime), nextRunTime
 }
 
+// getStartingDeadline returns how late the schedule's runs may be triggered
+// before they're considered missed.
+func getStartingDeadline(schedule *velerov1.Schedule) time.Duration {
+	if schedule.Spec.StartingDeadline.Duration > 0 {
+		return schedule.Spec.StartingDeadline.Duration
+	}
+	return defaultStartingDeadline
+}
+
+// getMissedRuns returns the number of scheduled runs, starting at the due
+// nextRunTime, that were missed as of asOf. A run is missed if the following
+// run is due as well, or if it's more than startingDeadline late. At most
+// maxMissedRuns are counted.
+func getMissedRuns(cronSchedule cron.Schedule, nextRunTime, asOf time.Time, startingDeadline time.Duration) int {
+	missedRuns := 0
+	for runTime := nextRunTime; missedRuns < maxMissedRuns; missedRuns++ {
+		following := cronSchedule.Next(runTime)
+		if following.After(asOf) {
+			if asOf.Sub(runTime) > startingDeadline {
+				missedRuns++
+			}
+			return missedRuns
+		}
+		runTime = following
+	}
+
+	return missedRuns
+}
+
 func getBackup(item *velerov1.Schedule, timestamp time.Time) *velerov1.Backup {
 	name := item.TimestampedName(timestamp)
 	backup := builder.
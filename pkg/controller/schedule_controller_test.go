@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -53,6 +54,8 @@ func TestReconcileOfSchedule(t *testing.T) {
 		expectedValidationErrors []string
 		expectedBackupCreate     *velerov1api.Backup
 		expectedLastBackup       string
+		expectedLastSkipped      string
+		expectedMissedRuns       int
 	}{
 		{
 			name:        "missing schedule triggers no backup",
@@ -96,6 +99,117 @@ func TestReconcileOfSchedule(t *testing.T) {
 			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
 			expectedLastBackup:   "2017-01-01 12:00:00",
 		},
+		{
+			name:          "paused schedule with phase New gets validated and paused and triggers no backup",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Paused(true).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedPhase: string(velerov1api.SchedulePhasePaused),
+		},
+		{
+			name:          "paused schedule with phase Enabled gets paused and triggers no backup",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Paused(true).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedPhase: string(velerov1api.SchedulePhasePaused),
+		},
+		{
+			name:                     "paused schedule gets re-validated and failed if invalid",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhasePaused).Paused(true).Result(),
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"Schedule must be a non-empty valid Cron expression"},
+		},
+		{
+			name:                 "unpaused schedule with phase Paused gets enabled and triggers a backup if due",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhasePaused).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+		},
+		{
+			name:                "schedule with phase New and skipImmediately skips the due run",
+			schedule:            newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").SkipImmediately(true).Result(),
+			fakeClockTime:       "2017-01-01 12:00:00",
+			expectedPhase:       string(velerov1api.SchedulePhaseEnabled),
+			expectedLastSkipped: "2017-01-01 12:00:00",
+		},
+		{
+			name:                "unpaused schedule with skipImmediately skips the due run",
+			schedule:            newScheduleBuilder(velerov1api.SchedulePhasePaused).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:00:00").SkipImmediately(true).Result(),
+			fakeClockTime:       "2017-01-01 12:00:00",
+			expectedPhase:       string(velerov1api.SchedulePhaseEnabled),
+			expectedLastSkipped: "2017-01-01 12:00:00",
+		},
+		{
+			name:                 "enabled schedule with skipImmediately triggers a backup when due",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:55:00").SkipImmediately(true).Result(),
+			fakeClockTime:        "2017-01-01 12:00:01",
+			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120001").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:01",
+		},
+		{
+			name:          "schedule that skipped a run waits for the next one",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:00:00").LastSkippedTime("2017-01-01 11:58:00").Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedPhase: string(velerov1api.SchedulePhaseEnabled),
+		},
+		{
+			name:                 "schedule with missed runs and missed run policy runOnce triggers a single backup",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:00:00").MissedRunPolicy(velerov1api.MissedRunPolicyRunOnce).Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedMissedRuns:   11,
+		},
+		{
+			name:                "schedule with missed runs and missed run policy skip skips them",
+			schedule:            newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:00:00").MissedRunPolicy(velerov1api.MissedRunPolicySkip).Result(),
+			fakeClockTime:       "2017-01-01 12:00:00",
+			expectedPhase:       string(velerov1api.SchedulePhaseEnabled),
+			expectedLastSkipped: "2017-01-01 12:00:00",
+			expectedMissedRuns:  11,
+		},
+		{
+			name:                 "schedule without missed runs and missed run policy skip triggers a backup",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:55:00").MissedRunPolicy(velerov1api.MissedRunPolicySkip).Result(),
+			fakeClockTime:        "2017-01-01 12:00:01",
+			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120001").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:01",
+		},
+		{
+			name:                 "schedule late by less than its starting deadline and missed run policy skip triggers a backup",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("0 11 * * *").LastBackupTime("2016-12-31 11:00:00").MissedRunPolicy(velerov1api.MissedRunPolicySkip).StartingDeadline(time.Hour).Result(),
+			fakeClockTime:        "2017-01-01 11:06:00",
+			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101110600").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 11:06:00",
+		},
+		{
+			name:                     "schedule with negative starting deadline fails validation",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").StartingDeadline(-time.Minute).Result(),
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid starting deadline -1m0s, must not be negative"},
+		},
+		{
+			name:                     "schedule with invalid missed run policy fails validation",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").MissedRunPolicy("catchUp").Result(),
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid missed run policy "catchUp", must be "runOnce" or "skip"`},
+		},
+		{
+			name:                     "schedule with negative retention fails validation",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Retention(&velerov1api.RetentionPolicy{KeepLast: 3, KeepDaily: -1}).Result(),
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid retention policy: keepDaily must not be negative"},
+		},
+		{
+			name:                     "schedule with empty retention fails validation",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Retention(&velerov1api.RetentionPolicy{}).Result(),
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid retention policy: at least one of keepLast, keepDaily, keepWeekly and keepMonthly must be set"},
+		},
 		{
 			name:                 "schedule that's already run gets LastBackup updated",
 			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Result(),
@@ -114,7 +228,7 @@ func TestReconcileOfSchedule(t *testing.T) {
 				err      error
 			)
 
-			reconciler := NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics())
+			reconciler := NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics(), record.NewFakeRecorder(10))
 
 			if test.fakeClockTime != "" {
 				testTime, err = time.Parse("2006-01-02 15:04:05", test.fakeClockTime)
@@ -143,6 +257,15 @@ func TestReconcileOfSchedule(t *testing.T) {
 				require.Nil(t, err)
 				assert.Equal(t, parseTime(test.expectedLastBackup).Unix(), schedule.Status.LastBackup.Unix())
 			}
+			if len(test.expectedLastSkipped) > 0 {
+				require.Nil(t, err)
+				require.NotNil(t, schedule.Status.LastSkipped)
+				assert.Equal(t, parseTime(test.expectedLastSkipped).Unix(), schedule.Status.LastSkipped.Unix())
+			}
+			if test.expectedMissedRuns > 0 {
+				require.Nil(t, err)
+				assert.Equal(t, test.expectedMissedRuns, schedule.Status.MissedRuns)
+			}
 
 			backups := &velerov1api.BackupList{}
 			require.Nil(t, client.List(ctx, backups))
@@ -247,6 +370,102 @@ func TestGetNextRunTime(t *testing.T) {
 	}
 }
 
+func TestGetNextRunTimeAfterSkippedRun(t *testing.T) {
+	now := time.Date(2017, 8, 10, 12, 0, 0, 0, time.UTC)
+
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("@every 5m").LastBackupTime("2017-08-10 11:00:00").LastSkippedTime("2017-08-10 11:58:00").Result()
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// the skipped run counts as a run
+	due, next := getNextRunTime(s, c, now)
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2017, 8, 10, 12, 3, 0, 0, time.UTC), next)
+
+	// a later backup takes precedence over the skipped run
+	s.Status.LastBackup = &metav1.Time{Time: time.Date(2017, 8, 10, 11, 59, 0, 0, time.UTC)}
+	due, next = getNextRunTime(s, c, now)
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2017, 8, 10, 12, 4, 0, 0, time.UTC), next)
+}
+
+func TestGetMissedRuns(t *testing.T) {
+	everyFiveMinutes, err := cron.ParseStandard("@every 5m")
+	require.NoError(t, err)
+	dailyAtNine, err := cron.ParseStandard("0 9 * * *")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		cronSchedule     cron.Schedule
+		nextRunTime      time.Time
+		asOf             time.Time
+		startingDeadline time.Duration
+		expected         int
+	}{
+		{
+			name:         "run that's just due isn't missed",
+			cronSchedule: everyFiveMinutes,
+			nextRunTime:  time.Date(2017, 8, 10, 12, 0, 0, 0, time.UTC),
+			asOf:         time.Date(2017, 8, 10, 12, 1, 0, 0, time.UTC),
+			expected:     0,
+		},
+		{
+			name:         "run whose following run is due is missed",
+			cronSchedule: everyFiveMinutes,
+			nextRunTime:  time.Date(2017, 8, 10, 12, 0, 0, 0, time.UTC),
+			asOf:         time.Date(2017, 8, 10, 12, 6, 0, 0, time.UTC),
+			expected:     1,
+		},
+		{
+			name:         "run that's late by less than the tolerance isn't missed",
+			cronSchedule: dailyAtNine,
+			nextRunTime:  time.Date(2017, 8, 10, 9, 0, 0, 0, time.UTC),
+			asOf:         time.Date(2017, 8, 10, 9, 4, 0, 0, time.UTC),
+			expected:     0,
+		},
+		{
+			name:         "run that's late by more than the tolerance is missed",
+			cronSchedule: dailyAtNine,
+			nextRunTime:  time.Date(2017, 8, 10, 9, 0, 0, 0, time.UTC),
+			asOf:         time.Date(2017, 8, 10, 9, 6, 0, 0, time.UTC),
+			expected:     1,
+		},
+		{
+			name:             "run that's late by less than a longer starting deadline isn't missed",
+			cronSchedule:     dailyAtNine,
+			nextRunTime:      time.Date(2017, 8, 10, 9, 0, 0, 0, time.UTC),
+			asOf:             time.Date(2017, 8, 10, 9, 30, 0, 0, time.UTC),
+			startingDeadline: time.Hour,
+			expected:         0,
+		},
+		{
+			name:         "all runs of a long outage are missed",
+			cronSchedule: dailyAtNine,
+			nextRunTime:  time.Date(2017, 8, 10, 9, 0, 0, 0, time.UTC),
+			asOf:         time.Date(2017, 8, 13, 10, 0, 0, 0, time.UTC),
+			expected:     4,
+		},
+		{
+			name:         "missed runs are counted up to a maximum",
+			cronSchedule: everyFiveMinutes,
+			nextRunTime:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			asOf:         time.Date(2017, 8, 10, 12, 0, 0, 0, time.UTC),
+			expected:     maxMissedRuns,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			startingDeadline := test.startingDeadline
+			if startingDeadline == 0 {
+				startingDeadline = defaultStartingDeadline
+			}
+			assert.Equal(t, test.expected, getMissedRuns(test.cronSchedule, test.nextRunTime, test.asOf, startingDeadline))
+		})
+	}
+}
+
 func TestParseCronSchedule(t *testing.T) {
 	// From https://github.com/vmware-tanzu/velero/issues/30, where we originally were using cron.Parse(),
 	// which treats the first field as seconds, and not minutes. We want to use cron.ParseStandard()
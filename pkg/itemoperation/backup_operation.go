@@ -0,0 +1,57 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package itemoperation contains the records of the work plugins keep doing
+// in the background for the items of a backup after their calls returned.
+package itemoperation
+
+import (
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupOperation stores information about work a BackupItemAction started
+// for an item during a Velero backup that was still in progress when the
+// action returned.
+type BackupOperation struct {
+	Spec BackupOperationSpec `json:"spec"`
+
+	Status BackupOperationStatus `json:"status"`
+}
+
+type BackupOperationSpec struct {
+	// BackupName is the name of the Velero backup this operation
+	// is associated with.
+	BackupName string `json:"backupName"`
+
+	// BackupUID is the UID of the Velero backup this operation
+	// is associated with.
+	BackupUID string `json:"backupUID"`
+
+	// BackupItemAction is the name of the BackupItemAction plugin that
+	// started the operation.
+	BackupItemAction string `json:"backupItemAction"`
+
+	// ResourceIdentifier is the item the operation was started for.
+	ResourceIdentifier velero.ResourceIdentifier `json:"resourceIdentifier"`
+}
+
+type BackupOperationStatus struct {
+	// Phase is the current state of the operation.
+	Phase velero.OperationPhase `json:"phase,omitempty"`
+
+	// Error is the reason the operation failed, if it did.
+	Error string `json:"error,omitempty"`
+}
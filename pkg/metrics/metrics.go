@@ -39,6 +39,7 @@ const (
 	backupPartialFailureTotal     = "backup_partial_failure_total"
 	backupFailureTotal            = "backup_failure_total"
 	backupValidationFailureTotal  = "backup_validation_failure_total"
+	backupCanceledTotal           = "backup_canceled_total"
 	backupDurationSeconds         = "backup_duration_seconds"
 	backupDeletionAttemptTotal    = "backup_deletion_attempt_total"
 	backupDeletionSuccessTotal    = "backup_deletion_success_total"
@@ -52,6 +53,7 @@ const (
 	restoreSuccessTotal           = "restore_success_total"
 	restorePartialFailureTotal    = "restore_partial_failure_total"
 	restoreFailedTotal            = "restore_failed_total"
+	restoreCanceledTotal          = "restore_canceled_total"
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
@@ -147,6 +149,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupCanceledTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupCanceledTotal,
+					Help:      "Total number of canceled backups",
+				},
+				[]string{scheduleLabel},
+			),
 			backupDeletionAttemptTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
 					Namespace: metricNamespace,
@@ -253,6 +263,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			restoreCanceledTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      restoreCanceledTotal,
+					Help:      "Total number of canceled restores",
+				},
+				[]string{scheduleLabel},
+			),
 			volumeSnapshotAttemptTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
 					Namespace: metricNamespace,
@@ -379,6 +397,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupValidationFailureTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
+	if c, ok := m.metrics[backupCanceledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
 	if c, ok := m.metrics[backupDeletionAttemptTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
@@ -409,6 +430,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
+	if c, ok := m.metrics[restoreCanceledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
 	if c, ok := m.metrics[volumeSnapshotSuccessTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
@@ -531,6 +555,13 @@ func (m *ServerMetrics) RegisterBackupValidationFailure(backupSchedule string) {
 	}
 }
 
+// RegisterBackupCanceled records a canceled backup.
+func (m *ServerMetrics) RegisterBackupCanceled(backupSchedule string) {
+	if c, ok := m.metrics[backupCanceledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
 // RegisterBackupDuration records the number of seconds a backup took.
 func (m *ServerMetrics) RegisterBackupDuration(backupSchedule string, seconds float64) {
 	if c, ok := m.metrics[backupDurationSeconds].(*prometheus.HistogramVec); ok {
@@ -622,6 +653,13 @@ func (m *ServerMetrics) RegisterRestoreValidationFailed(backupSchedule string) {
 	}
 }
 
+// RegisterRestoreCanceled records a restore that was canceled.
+func (m *ServerMetrics) RegisterRestoreCanceled(backupSchedule string) {
+	if c, ok := m.metrics[restoreCanceledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
 // RegisterVolumeSnapshotAttempts records an attempt to snapshot a volume.
 func (m *ServerMetrics) RegisterVolumeSnapshotAttempts(backupSchedule string, volumeSnapshotsAttempted int) {
 	if c, ok := m.metrics[volumeSnapshotAttemptTotal].(*prometheus.CounterVec); ok {
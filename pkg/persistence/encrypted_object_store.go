@@ -0,0 +1,79 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
+)
+
+// encryptedObjectStore is a velero.ObjectStore that encrypts the objects it puts
+// and decrypts the objects it gets with the key of a backup storage location.
+// Objects downloaded from signed URLs are still encrypted.
+type encryptedObjectStore struct {
+	velero.ObjectStore
+
+	key              []byte
+	allowUnencrypted bool
+}
+
+func newEncryptedObjectStore(objectStore velero.ObjectStore, key []byte, allowUnencrypted bool) *encryptedObjectStore {
+	return &encryptedObjectStore{
+		ObjectStore:      objectStore,
+		key:              key,
+		allowUnencrypted: allowUnencrypted,
+	}
+}
+
+func (s *encryptedObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	encrypted, err := encryption.NewEncryptingReader(body, s.key)
+	if err != nil {
+		return errors.Wrapf(err, "error encrypting %s", key)
+	}
+
+	return s.ObjectStore.PutObject(bucket, key, encrypted)
+}
+
+func (s *encryptedObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	body, err := s.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := encryption.NewDecryptingReader(body, s.key, s.allowUnencrypted)
+	if err != nil {
+		body.Close()
+		return nil, errors.Wrapf(err, "error decrypting %s", key)
+	}
+
+	return &decryptedObject{Reader: decrypted, body: body}, nil
+}
+
+// decryptedObject reads an object's decrypted contents and closes its body.
+type decryptedObject struct {
+	io.Reader
+
+	body io.ReadCloser
+}
+
+func (o *decryptedObject) Close() error {
+	return o.body.Close()
+}
@@ -167,6 +167,14 @@ func (o *inMemoryObjectStore) CreateSignedURL(bucket, key string, ttl time.Durat
 	return "a-url", nil
 }
 
+func (o *inMemoryObjectStore) CreateSignedUploadURL(bucket, key string, ttl time.Duration) (string, error) {
+	if _, ok := o.Data[bucket]; !ok {
+		return "", errors.New("bucket not found")
+	}
+
+	return "an-upload-url", nil
+}
+
 //
 // Test Helper Methods
 //
@@ -24,6 +24,7 @@ import (
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	itemoperation "github.com/vmware-tanzu/velero/pkg/itemoperation"
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
 	volume "github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -105,6 +106,43 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	ret := _m.Called(name)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) DeleteBackupCheckpoint(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBackupMetadata provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	ret := _m.Called(name)
@@ -246,6 +284,76 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupMetadata provides a mock function with given fields: backup, backupMetadata
+func (_m *BackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
+	ret := _m.Called(backup, backupMetadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, backupMetadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupCheckpoint provides a mock function with given fields: backup, checkpoint
+func (_m *BackupStore) PutBackupCheckpoint(backup string, checkpoint io.Reader) error {
+	ret := _m.Called(backup, checkpoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutItemSnapshots provides a mock function with given fields: backup, itemSnapshots
+func (_m *BackupStore) PutItemSnapshots(backup string, itemSnapshots io.Reader) error {
+	ret := _m.Called(backup, itemSnapshots)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, itemSnapshots)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupVolumeSnapshots provides a mock function with given fields: backup, volumeSnapshots
+func (_m *BackupStore) PutBackupVolumeSnapshots(backup string, volumeSnapshots io.Reader) error {
+	ret := _m.Called(backup, volumeSnapshots)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, volumeSnapshots)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupItemOperations provides a mock function with given fields: backup, itemOperations
+func (_m *BackupStore) PutBackupItemOperations(backup string, itemOperations io.Reader) error {
+	ret := _m.Called(backup, itemOperations)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, itemOperations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -274,6 +382,34 @@ func (_m *BackupStore) PutRestoreResults(backup string, restore string, results
 	return r0
 }
 
+// PutRestoredResourceList provides a mock function with given fields: restore, results
+func (_m *BackupStore) PutRestoredResourceList(restore string, results io.Reader) error {
+	ret := _m.Called(restore, results)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, results)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreHookExecutions provides a mock function with given fields: restore, executions
+func (_m *BackupStore) PutRestoreHookExecutions(restore string, executions io.Reader) error {
+	ret := _m.Called(restore, executions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, executions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 func (_m *BackupStore) GetCSIVolumeSnapshots(backup string) ([]*snapshotv1api.VolumeSnapshot, error) {
 	panic("Not implemented")
 	return nil, nil
@@ -289,6 +425,247 @@ func (_m *BackupStore) GetCSIVolumeSnapshotClasses(backup string) ([]*snapshotv1
 	return nil, nil
 }
 
+// GetItemSnapshots provides a mock function with given fields: name
 func (_m *BackupStore) GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error) {
-	panic("implement me")
+	ret := _m.Called(name)
+
+	var r0 []*volume.ItemSnapshot
+	if rf, ok := ret.Get(0).(func(string) []*volume.ItemSnapshot); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*volume.ItemSnapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupItemOperations provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error) {
+	ret := _m.Called(name)
+
+	var r0 []*itemoperation.BackupOperation
+	if rf, ok := ret.Get(0).(func(string) []*itemoperation.BackupOperation); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*itemoperation.BackupOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackupFiles provides a mock function with given fields: name
+func (_m *BackupStore) ListBackupFiles(name string) ([]string, error) {
+	ret := _m.Called(name)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupFile provides a mock function with given fields: backup, file
+func (_m *BackupStore) GetBackupFile(backup string, file string) (io.ReadCloser, error) {
+	ret := _m.Called(backup, file)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string, string) io.ReadCloser); ok {
+		r0 = rf(backup, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(backup, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutBackupFile provides a mock function with given fields: backup, file, contents
+func (_m *BackupStore) PutBackupFile(backup string, file string, contents io.Reader) error {
+	ret := _m.Called(backup, file, contents)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, file, contents)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRepositoryFiles provides a mock function with given fields:
+func (_m *BackupStore) ListRepositoryFiles() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRepositoryFile provides a mock function with given fields: key
+func (_m *BackupStore) GetRepositoryFile(key string) (io.ReadCloser, error) {
+	ret := _m.Called(key)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRepositoryFile provides a mock function with given fields: key, contents
+func (_m *BackupStore) PutRepositoryFile(key string, contents io.Reader) error {
+	ret := _m.Called(key, contents)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(key, contents)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetImportTarball provides a mock function with given fields: tarball
+func (_m *BackupStore) GetImportTarball(tarball string) (io.ReadCloser, error) {
+	ret := _m.Called(tarball)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(tarball)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tarball)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTarballExists provides a mock function with given fields: tarball
+func (_m *BackupStore) ImportTarballExists(tarball string) (bool, error) {
+	ret := _m.Called(tarball)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(tarball)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tarball)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImportUploadURL provides a mock function with given fields: tarball
+func (_m *BackupStore) GetImportUploadURL(tarball string) (string, error) {
+	ret := _m.Called(tarball)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(tarball)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tarball)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteImportTarball provides a mock function with given fields: tarball
+func (_m *BackupStore) DeleteImportTarball(tarball string) error {
+	ret := _m.Called(tarball)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(tarball)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
@@ -33,7 +33,9 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -45,7 +47,11 @@ type BackupInfo struct {
 	PodVolumeBackups,
 	VolumeSnapshots,
 	ItemSnapshots,
+	ItemOperations,
 	BackupResourceList,
+	BackupManifest,
+	DryRunReport,
+	HookExecutions,
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents,
 	CSIVolumeSnapshotClasses io.Reader
@@ -59,22 +65,72 @@ type BackupStore interface {
 	ListBackups() ([]string, error)
 
 	PutBackup(info BackupInfo) error
+	PutBackupMetadata(backup string, backupMetadata io.Reader) error
+	PutItemSnapshots(backup string, itemSnapshots io.Reader) error
+	PutBackupVolumeSnapshots(backup string, volumeSnapshots io.Reader) error
+	PutBackupItemOperations(backup string, itemOperations io.Reader) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
+	GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
 	GetBackupContents(name string) (io.ReadCloser, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1api.VolumeSnapshotContent, error)
 	GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error)
 
+	// PutBackupCheckpoint writes the checkpoint of an in-progress backup.
+	PutBackupCheckpoint(backup string, checkpoint io.Reader) error
+	// GetBackupCheckpoint returns the checkpoint of a backup, or nil if the
+	// backup has no checkpoint.
+	GetBackupCheckpoint(name string) (io.ReadCloser, error)
+	// DeleteBackupCheckpoint deletes the checkpoint of a backup once it's no
+	// longer needed.
+	DeleteBackupCheckpoint(name string) error
+
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
 
+	// ListBackupFiles returns the names of the files of a backup, except for
+	// its checkpoint, which is only used by the backup's own attempts.
+	ListBackupFiles(name string) ([]string, error)
+	// GetBackupFile returns the contents of a file of a backup.
+	GetBackupFile(backup, file string) (io.ReadCloser, error)
+	// PutBackupFile writes a file of a backup.
+	PutBackupFile(backup, file string, contents io.Reader) error
+
+	// ListRepositoryFiles returns the keys, relative to the backup store's
+	// prefix, of the files of the pod volume backup repositories in the store.
+	ListRepositoryFiles() ([]string, error)
+	// GetRepositoryFile returns the contents of a file of a pod volume backup
+	// repository. Repository files are read and written as is, even in backup
+	// stores that are encrypted, since the repositories encrypt them already.
+	GetRepositoryFile(key string) (io.ReadCloser, error)
+	// PutRepositoryFile writes a file of a pod volume backup repository.
+	PutRepositoryFile(key string, contents io.Reader) error
+
+	// GetImportTarball returns the contents of a backup tarball copied to the
+	// store's imports directory to be imported. Tarballs to import are read
+	// as is, even in backup stores that are encrypted, since they're copied
+	// there by users.
+	GetImportTarball(tarball string) (io.ReadCloser, error)
+	// ImportTarballExists checks if a backup tarball is in the store's imports
+	// directory.
+	ImportTarballExists(tarball string) (bool, error)
+	// GetImportUploadURL returns a URL that a backup tarball to import can be
+	// uploaded to the store's imports directory with, which expires after
+	// ImportUploadURLTTL.
+	GetImportUploadURL(tarball string) (string, error)
+	// DeleteImportTarball deletes a backup tarball from the store's imports
+	// directory.
+	DeleteImportTarball(tarball string) error
+
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
+	PutRestoredResourceList(restore string, list io.Reader) error
+	PutRestoreHookExecutions(restore string, executions io.Reader) error
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
@@ -83,6 +139,10 @@ type BackupStore interface {
 // DownloadURLTTL is how long a download URL is valid for.
 const DownloadURLTTL = 10 * time.Minute
 
+// ImportUploadURLTTL is how long the URL to upload a backup tarball to import
+// is valid for.
+const ImportUploadURLTTL = time.Hour
+
 type objectBackupStore struct {
 	objectStore velero.ObjectStore
 	bucket      string
@@ -166,6 +226,25 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		return nil, err
 	}
 
+	// If the BSL specifies an encryption key, encrypt and decrypt the objects
+	// stored in it with the key.
+	if location.Spec.Encryption != nil {
+		keyFile, err := b.credentialStore.Path(&location.Spec.Encryption.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get encryption key")
+		}
+
+		key, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read encryption key")
+		}
+		if len(key) != encryption.KeySize {
+			return nil, errors.Errorf("encryption key must be %d bytes long, not %d", encryption.KeySize, len(key))
+		}
+
+		objectStore = newEncryptedObjectStore(objectStore, key, location.Spec.Encryption.AllowUnencrypted)
+	}
+
 	log := logger.WithFields(logrus.Fields(map[string]interface{}{
 		"bucket": bucket,
 		"prefix": prefix,
@@ -251,7 +330,11 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getPodVolumeBackupsKey(info.Name):          info.PodVolumeBackups,
 		s.layout.getBackupVolumeSnapshotsKey(info.Name):     info.VolumeSnapshots,
 		s.layout.getItemSnapshotsKey(info.Name):             info.ItemSnapshots,
+		s.layout.getBackupItemOperationsKey(info.Name):      info.ItemOperations,
 		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
+		s.layout.getBackupManifestKey(info.Name):            info.BackupManifest,
+		s.layout.getBackupDryRunReportKey(info.Name):        info.DryRunReport,
+		s.layout.getBackupHookExecutionsKey(info.Name):      info.HookExecutions,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
 		s.layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
@@ -274,6 +357,44 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	return nil
 }
 
+func (s *objectBackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupMetadataKey(backup), backupMetadata)
+}
+
+func (s *objectBackupStore) PutItemSnapshots(backup string, itemSnapshots io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getItemSnapshotsKey(backup), itemSnapshots)
+}
+
+func (s *objectBackupStore) PutBackupVolumeSnapshots(backup string, volumeSnapshots io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupVolumeSnapshotsKey(backup), volumeSnapshots)
+}
+
+func (s *objectBackupStore) PutBackupItemOperations(backup string, itemOperations io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupItemOperationsKey(backup), itemOperations)
+}
+
+func (s *objectBackupStore) PutBackupCheckpoint(backup string, checkpoint io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupCheckpointKey(backup), checkpoint)
+}
+
+func (s *objectBackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(name))
+}
+
+func (s *objectBackupStore) DeleteBackupCheckpoint(name string) error {
+	// backups that finish quickly never save a checkpoint
+	key := s.layout.getBackupCheckpointKey(name)
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exists {
+		return nil
+	}
+
+	return errors.WithStack(s.objectStore.DeleteObject(s.bucket, key))
+}
+
 func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
 	metadataKey := s.layout.getBackupMetadataKey(name)
 
@@ -344,6 +465,25 @@ func (s *objectBackupStore) GetItemSnapshots(name string) ([]*volume.ItemSnapsho
 	return itemSnapshots, nil
 }
 
+func (s *objectBackupStore) GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error) {
+	// backups without item operations don't have the file
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var itemOperations []*itemoperation.BackupOperation
+	if err := decode(res, &itemOperations); err != nil {
+		return nil, err
+	}
+
+	return itemOperations, nil
+}
+
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
 func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
@@ -477,6 +617,82 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) ListBackupFiles(name string) ([]string, error) {
+	dir := s.layout.getBackupDir(name)
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	files := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key == s.layout.getBackupCheckpointKey(name) {
+			continue
+		}
+		files = append(files, strings.TrimPrefix(key, dir))
+	}
+	return files, nil
+}
+
+func (s *objectBackupStore) GetBackupFile(backup, file string) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupDir(backup)+file)
+}
+
+func (s *objectBackupStore) PutBackupFile(backup, file string, contents io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupDir(backup)+file, contents)
+}
+
+func (s *objectBackupStore) ListRepositoryFiles() ([]string, error) {
+	var files []string
+	for _, dir := range []string{s.layout.GetResticDir(), s.layout.GetKopiaDir()} {
+		keys, err := s.unencryptedObjectStore().ListObjects(s.bucket, dir)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, key := range keys {
+			files = append(files, strings.TrimPrefix(key, s.layout.rootPrefix))
+		}
+	}
+	return files, nil
+}
+
+func (s *objectBackupStore) GetRepositoryFile(key string) (io.ReadCloser, error) {
+	return s.unencryptedObjectStore().GetObject(s.bucket, s.layout.rootPrefix+key)
+}
+
+func (s *objectBackupStore) PutRepositoryFile(key string, contents io.Reader) error {
+	return s.unencryptedObjectStore().PutObject(s.bucket, s.layout.rootPrefix+key, contents)
+}
+
+func (s *objectBackupStore) GetImportTarball(tarball string) (io.ReadCloser, error) {
+	return s.unencryptedObjectStore().GetObject(s.bucket, s.layout.getImportTarballKey(tarball))
+}
+
+func (s *objectBackupStore) ImportTarballExists(tarball string) (bool, error) {
+	return s.unencryptedObjectStore().ObjectExists(s.bucket, s.layout.getImportTarballKey(tarball))
+}
+
+func (s *objectBackupStore) GetImportUploadURL(tarball string) (string, error) {
+	creator, ok := s.unencryptedObjectStore().(velero.SignedUploadURLCreator)
+	if !ok {
+		return "", velero.ErrSignedUploadURLNotSupported
+	}
+	return creator.CreateSignedUploadURL(s.bucket, s.layout.getImportTarballKey(tarball), ImportUploadURLTTL)
+}
+
+func (s *objectBackupStore) DeleteImportTarball(tarball string) error {
+	return s.unencryptedObjectStore().DeleteObject(s.bucket, s.layout.getImportTarballKey(tarball))
+}
+
+// unencryptedObjectStore returns the object store of the backup store without
+// the encryption of the backup storage location, if any.
+func (s *objectBackupStore) unencryptedObjectStore() velero.ObjectStore {
+	if encrypted, ok := s.objectStore.(*encryptedObjectStore); ok {
+		return encrypted.ObjectStore
+	}
+	return s.objectStore
+}
+
 func (s *objectBackupStore) DeleteRestore(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
@@ -504,6 +720,14 @@ func (s *objectBackupStore) PutRestoreResults(backup string, restore string, res
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
 }
 
+func (s *objectBackupStore) PutRestoredResourceList(restore string, list io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResourceListKey(restore), list)
+}
+
+func (s *objectBackupStore) PutRestoreHookExecutions(restore string, executions io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreHookExecutionsKey(restore), executions)
+}
+
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
@@ -516,10 +740,22 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getItemSnapshotsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResourceList:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupManifest:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupManifestKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupDryRunReport:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupDryRunReportKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupPodVolumeBackups:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getPodVolumeBackupsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupHookExecutions:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupHookExecutionsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResults:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreResourceList:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreHookExecutions:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreHookExecutionsKey(target.Name), DownloadURLTTL)
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
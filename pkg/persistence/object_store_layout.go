@@ -38,8 +38,10 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 		"backups":  path.Join(prefix, "backups") + "/",
 		"restores": path.Join(prefix, "restores") + "/",
 		"restic":   path.Join(prefix, "restic") + "/",
+		"kopia":    path.Join(prefix, "kopia") + "/",
 		"metadata": path.Join(prefix, "metadata") + "/",
 		"plugins":  path.Join(prefix, "plugins") + "/",
+		"imports":  path.Join(prefix, "imports") + "/",
 	}
 
 	return &ObjectStoreLayout{
@@ -55,21 +57,36 @@ func (l *ObjectStoreLayout) GetResticDir() string {
 	return l.subdirs["restic"]
 }
 
+// GetKopiaDir returns the full prefix representing the kopia
+// directory within an object storage bucket containing a backup
+// store.
+func (l *ObjectStoreLayout) GetKopiaDir() string {
+	return l.subdirs["kopia"]
+}
+
 func (l *ObjectStoreLayout) isValidSubdir(name string) bool {
 	_, ok := l.subdirs[name]
 	return ok
 }
 
+// backupMetadataFile is the name of the file holding a backup's metadata in
+// the backup's directory.
+const backupMetadataFile = "velero-backup.json"
+
 func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
 
+func (l *ObjectStoreLayout) getImportTarballKey(tarball string) string {
+	return path.Join(l.subdirs["imports"], tarball)
+}
+
 func (l *ObjectStoreLayout) getRestoreDir(restore string) string {
 	return path.Join(l.subdirs["restores"], restore) + "/"
 }
 
 func (l *ObjectStoreLayout) getBackupMetadataKey(backup string) string {
-	return path.Join(l.subdirs["backups"], backup, "velero-backup.json")
+	return path.Join(l.subdirs["backups"], backup, backupMetadataFile)
 }
 
 func (l *ObjectStoreLayout) getBackupContentsKey(backup string) string {
@@ -92,10 +109,30 @@ func (l *ObjectStoreLayout) getItemSnapshotsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-itemsnapshots.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupItemOperationsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-itemoperations.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupManifestKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-manifest.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupDryRunReportKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-dry-run-report.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupHookExecutionsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-hook-executions.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupCheckpointKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-checkpoint.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-logs.gz", restore))
 }
@@ -104,6 +141,14 @@ func (l *ObjectStoreLayout) getRestoreResultsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-results.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreResourceListKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-resource-list.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestoreHookExecutionsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-hook-executions.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
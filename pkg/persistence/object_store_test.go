@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"sort"
 	"strings"
 	"testing"
@@ -40,6 +41,7 @@ import (
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -147,6 +149,13 @@ func TestIsValid(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "backup store with imports directory is valid",
+			storageData: map[string][]byte{
+				"imports/backup-1-data.tar.gz": {},
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -225,6 +234,7 @@ func TestPutBackup(t *testing.T) {
 		snapshots       io.Reader
 		itemSnapshots   io.Reader
 		resourceList    io.Reader
+		manifest        io.Reader
 		expectedErr     string
 		expectedKeys    []string
 	}{
@@ -237,6 +247,7 @@ func TestPutBackup(t *testing.T) {
 			snapshots:       newStringReadSeeker("snapshots"),
 			itemSnapshots:   newStringReadSeeker("itemSnapshots"),
 			resourceList:    newStringReadSeeker("resourceList"),
+			manifest:        newStringReadSeeker("manifest"),
 			expectedErr:     "",
 			expectedKeys: []string{
 				"backups/backup-1/velero-backup.json",
@@ -246,6 +257,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-itemsnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
+				"backups/backup-1/backup-1-manifest.json.gz",
 			},
 		},
 		{
@@ -258,6 +270,7 @@ func TestPutBackup(t *testing.T) {
 			snapshots:       newStringReadSeeker("snapshots"),
 			itemSnapshots:   newStringReadSeeker("itemSnapshots"),
 			resourceList:    newStringReadSeeker("resourceList"),
+			manifest:        newStringReadSeeker("manifest"),
 			expectedErr:     "",
 			expectedKeys: []string{
 				"prefix-1/backups/backup-1/velero-backup.json",
@@ -267,6 +280,7 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"prefix-1/backups/backup-1/backup-1-itemsnapshots.json.gz",
 				"prefix-1/backups/backup-1/backup-1-resource-list.json.gz",
+				"prefix-1/backups/backup-1/backup-1-manifest.json.gz",
 			},
 		},
 		{
@@ -343,6 +357,7 @@ func TestPutBackup(t *testing.T) {
 				VolumeSnapshots:    tc.snapshots,
 				ItemSnapshots:      tc.itemSnapshots,
 				BackupResourceList: tc.resourceList,
+				BackupManifest:     tc.manifest,
 			}
 			err := harness.PutBackup(backupInfo)
 
@@ -498,6 +513,35 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestBackupCheckpoint(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// checkpoint file not found should not error
+	rc, err := harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, rc)
+
+	require.NoError(t, harness.PutBackupCheckpoint("test-backup", newStringReadSeeker("foo")))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-checkpoint.json.gz")
+
+	rc, err = harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	require.NotNil(t, rc)
+
+	data, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(data))
+
+	// the checkpoint isn't one of the backup's files
+	require.NoError(t, harness.PutBackupFile("test-backup", "velero-backup.json", newStringReadSeeker("bar")))
+	files, err := harness.ListBackupFiles("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"velero-backup.json"}, files)
+
+	require.NoError(t, harness.DeleteBackupCheckpoint("test-backup"))
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-checkpoint.json.gz")
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -561,11 +605,15 @@ func TestGetDownloadURL(t *testing.T) {
 			name:       "backup",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindBackupContents:        "backups/my-backup/my-backup.tar.gz",
-				velerov1api.DownloadTargetKindBackupLog:             "backups/my-backup/my-backup-logs.gz",
-				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupItemSnapshots:   "backups/my-backup/my-backup-itemsnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupContents:         "backups/my-backup/my-backup.tar.gz",
+				velerov1api.DownloadTargetKindBackupLog:              "backups/my-backup/my-backup-logs.gz",
+				velerov1api.DownloadTargetKindBackupVolumeSnapshots:  "backups/my-backup/my-backup-volumesnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupItemSnapshots:    "backups/my-backup/my-backup-itemsnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupResourceList:     "backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupManifest:         "backups/my-backup/my-backup-manifest.json.gz",
+				velerov1api.DownloadTargetKindBackupDryRunReport:     "backups/my-backup/my-backup-dry-run-report.json.gz",
+				velerov1api.DownloadTargetKindBackupPodVolumeBackups: "backups/my-backup/my-backup-podvolumebackups.json.gz",
+				velerov1api.DownloadTargetKindBackupHookExecutions:   "backups/my-backup/my-backup-hook-executions.json.gz",
 			},
 		},
 		{
@@ -573,33 +621,45 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup",
 			prefix:     "velero-backups/",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindBackupContents:        "velero-backups/backups/my-backup/my-backup.tar.gz",
-				velerov1api.DownloadTargetKindBackupLog:             "velero-backups/backups/my-backup/my-backup-logs.gz",
-				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "velero-backups/backups/my-backup/my-backup-volumesnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupItemSnapshots:   "velero-backups/backups/my-backup/my-backup-itemsnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupResourceList:    "velero-backups/backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupContents:         "velero-backups/backups/my-backup/my-backup.tar.gz",
+				velerov1api.DownloadTargetKindBackupLog:              "velero-backups/backups/my-backup/my-backup-logs.gz",
+				velerov1api.DownloadTargetKindBackupVolumeSnapshots:  "velero-backups/backups/my-backup/my-backup-volumesnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupItemSnapshots:    "velero-backups/backups/my-backup/my-backup-itemsnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupResourceList:     "velero-backups/backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupManifest:         "velero-backups/backups/my-backup/my-backup-manifest.json.gz",
+				velerov1api.DownloadTargetKindBackupDryRunReport:     "velero-backups/backups/my-backup/my-backup-dry-run-report.json.gz",
+				velerov1api.DownloadTargetKindBackupPodVolumeBackups: "velero-backups/backups/my-backup/my-backup-podvolumebackups.json.gz",
+				velerov1api.DownloadTargetKindBackupHookExecutions:   "velero-backups/backups/my-backup/my-backup-hook-executions.json.gz",
 			},
 		},
 		{
 			name:       "backup with multiple dashes",
 			targetName: "b-cool-20170913154901-20170913154902",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindBackupContents:        "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902.tar.gz",
-				velerov1api.DownloadTargetKindBackupLog:             "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-logs.gz",
-				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-volumesnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupItemSnapshots:   "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-itemsnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupResourceList:    "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupContents:         "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902.tar.gz",
+				velerov1api.DownloadTargetKindBackupLog:              "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-logs.gz",
+				velerov1api.DownloadTargetKindBackupVolumeSnapshots:  "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-volumesnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupItemSnapshots:    "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-itemsnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupResourceList:     "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupManifest:         "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-manifest.json.gz",
+				velerov1api.DownloadTargetKindBackupDryRunReport:     "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-dry-run-report.json.gz",
+				velerov1api.DownloadTargetKindBackupPodVolumeBackups: "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-podvolumebackups.json.gz",
+				velerov1api.DownloadTargetKindBackupHookExecutions:   "backups/b-cool-20170913154901-20170913154902/b-cool-20170913154901-20170913154902-hook-executions.json.gz",
 			},
 		},
 		{
 			name:       "scheduled backup",
 			targetName: "my-backup-20170913154901",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindBackupContents:        "backups/my-backup-20170913154901/my-backup-20170913154901.tar.gz",
-				velerov1api.DownloadTargetKindBackupLog:             "backups/my-backup-20170913154901/my-backup-20170913154901-logs.gz",
-				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup-20170913154901/my-backup-20170913154901-volumesnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupItemSnapshots:   "backups/my-backup-20170913154901/my-backup-20170913154901-itemsnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup-20170913154901/my-backup-20170913154901-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupContents:         "backups/my-backup-20170913154901/my-backup-20170913154901.tar.gz",
+				velerov1api.DownloadTargetKindBackupLog:              "backups/my-backup-20170913154901/my-backup-20170913154901-logs.gz",
+				velerov1api.DownloadTargetKindBackupVolumeSnapshots:  "backups/my-backup-20170913154901/my-backup-20170913154901-volumesnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupItemSnapshots:    "backups/my-backup-20170913154901/my-backup-20170913154901-itemsnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupResourceList:     "backups/my-backup-20170913154901/my-backup-20170913154901-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupManifest:         "backups/my-backup-20170913154901/my-backup-20170913154901-manifest.json.gz",
+				velerov1api.DownloadTargetKindBackupDryRunReport:     "backups/my-backup-20170913154901/my-backup-20170913154901-dry-run-report.json.gz",
+				velerov1api.DownloadTargetKindBackupPodVolumeBackups: "backups/my-backup-20170913154901/my-backup-20170913154901-podvolumebackups.json.gz",
+				velerov1api.DownloadTargetKindBackupHookExecutions:   "backups/my-backup-20170913154901/my-backup-20170913154901-hook-executions.json.gz",
 			},
 		},
 		{
@@ -607,19 +667,25 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup-20170913154901",
 			prefix:     "velero-backups/",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindBackupContents:        "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901.tar.gz",
-				velerov1api.DownloadTargetKindBackupLog:             "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-logs.gz",
-				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-volumesnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupItemSnapshots:   "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-itemsnapshots.json.gz",
-				velerov1api.DownloadTargetKindBackupResourceList:    "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupContents:         "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901.tar.gz",
+				velerov1api.DownloadTargetKindBackupLog:              "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-logs.gz",
+				velerov1api.DownloadTargetKindBackupVolumeSnapshots:  "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-volumesnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupItemSnapshots:    "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-itemsnapshots.json.gz",
+				velerov1api.DownloadTargetKindBackupResourceList:     "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupManifest:         "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-manifest.json.gz",
+				velerov1api.DownloadTargetKindBackupDryRunReport:     "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-dry-run-report.json.gz",
+				velerov1api.DownloadTargetKindBackupPodVolumeBackups: "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-podvolumebackups.json.gz",
+				velerov1api.DownloadTargetKindBackupHookExecutions:   "velero-backups/backups/my-backup-20170913154901/my-backup-20170913154901-hook-executions.json.gz",
 			},
 		},
 		{
 			name:       "restore",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:            "restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:        "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreResourceList:   "restores/my-backup/restore-my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreHookExecutions: "restores/my-backup/restore-my-backup-hook-executions.json.gz",
 			},
 		},
 		{
@@ -627,16 +693,20 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup",
 			prefix:     "velero-backups/",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:            "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:        "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreResourceList:   "velero-backups/restores/my-backup/restore-my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreHookExecutions: "velero-backups/restores/my-backup/restore-my-backup-hook-executions.json.gz",
 			},
 		},
 		{
 			name:       "restore with multiple dashes",
 			targetName: "b-cool-20170913154901-20170913154902",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:            "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:        "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-results.gz",
+				velerov1api.DownloadTargetKindRestoreResourceList:   "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreHookExecutions: "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-hook-executions.json.gz",
 			},
 		},
 	}
@@ -826,6 +896,104 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 	}
 }
 
+// writeKeyFile writes an encryption key filled with b to a temporary file and
+// returns the file's path.
+func writeKeyFile(t *testing.T, b byte) string {
+	t.Helper()
+
+	keyFile, err := ioutil.TempFile("", "encryption-key")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Remove(keyFile.Name()) })
+
+	_, err = keyFile.Write(bytes.Repeat([]byte{b}, encryption.KeySize))
+	require.NoError(t, err)
+	require.NoError(t, keyFile.Close())
+
+	return keyFile.Name()
+}
+
+func TestNewObjectBackupStoreGetterEncryption(t *testing.T) {
+
+	location := builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Encryption(
+		builder.ForSecretKeySelector("encryption-key", "key").Result(),
+	).Result()
+	objStore := newInMemoryObjectStore("bucket")
+	getter := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(writeKeyFile(t, 1), nil))
+
+	store, err := getter.Get(location, objectStoreGetter{"provider-1": objStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	require.NoError(t, store.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: bytes.NewReader(encodeToBytes(backup)),
+		Contents: strings.NewReader("contents"),
+	}))
+
+	// the objects in the bucket are encrypted
+	assert.NotEqual(t, encodeToBytes(backup), objStore.Data["bucket"]["backups/backup-1/velero-backup.json"])
+	assert.NotContains(t, string(objStore.Data["bucket"]["backups/backup-1/backup-1.tar.gz"]), "contents")
+
+	// and they're decrypted when they're read back
+	res, err := store.GetBackupMetadata("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-1", res.Name)
+
+	contents, err := store.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	defer contents.Close()
+	contentsBytes, err := ioutil.ReadAll(contents)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(contentsBytes))
+
+	// a store with a different key can't read them
+	otherStore, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(writeKeyFile(t, 2), nil)).
+		Get(location, objectStoreGetter{"provider-1": objStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	_, err = otherStore.GetBackupMetadata("backup-1")
+	assert.Error(t, err)
+
+	// objects that aren't encrypted can't be read, unless the location allows them
+	objStore.Data["bucket"]["backups/backup-2/velero-backup.json"] = encodeToBytes(builder.ForBackup("velero", "backup-2").Result())
+	_, err = store.GetBackupMetadata("backup-2")
+	assert.Error(t, err)
+
+	location.Spec.Encryption.AllowUnencrypted = true
+	migratingStore, err := getter.Get(location, objectStoreGetter{"provider-1": objStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	res, err = migratingStore.GetBackupMetadata("backup-2")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-2", res.Name)
+	res, err = migratingStore.GetBackupMetadata("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-1", res.Name)
+}
+
+func TestImportTarball(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("bucket", "prefix")
+	// tarballs to import are copied to the store by users, so they aren't encrypted
+	harness.objectBackupStore.objectStore = newEncryptedObjectStore(harness.objectStore, bytes.Repeat([]byte{1}, encryption.KeySize), false)
+	harness.objectStore.Data["bucket"]["prefix/imports/backup-1-data.tar.gz"] = []byte("contents")
+
+	url, err := harness.GetImportUploadURL("backup-1-data.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "an-upload-url", url)
+
+	exists, err := harness.ImportTarballExists("backup-1-data.tar.gz")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	rc, err := harness.GetImportTarball("backup-1-data.tar.gz")
+	require.NoError(t, err)
+	defer rc.Close()
+	contents, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(contents))
+
+	require.NoError(t, harness.DeleteImportTarball("backup-1-data.tar.gz"))
+	assert.Empty(t, harness.objectStore.Data["bucket"])
+}
+
 func encodeToBytes(obj runtime.Object) []byte {
 	res, err := encode.Encode(obj, "json")
 	if err != nil {
@@ -0,0 +1,186 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CopyBackup copies all the files of a backup, including its metadata, log and
+// volume snapshot lists, from one backup store to another. The metadata file is
+// copied last, so that the backup isn't synced from the destination before all
+// its files are there.
+func CopyBackup(src, dst BackupStore, backup string) error {
+	files, err := src.ListBackupFiles(backup)
+	if err != nil {
+		return errors.Wrap(err, "error listing backup files")
+	}
+
+	hasMetadata := false
+	for _, file := range files {
+		if file == backupMetadataFile {
+			hasMetadata = true
+			continue
+		}
+		if err := copyBackupFile(src, dst, backup, file); err != nil {
+			return err
+		}
+	}
+	if !hasMetadata {
+		return errors.Errorf("backup %s has no metadata file", backup)
+	}
+
+	return copyBackupFile(src, dst, backup, backupMetadataFile)
+}
+
+func copyBackupFile(src, dst BackupStore, backup, file string) error {
+	contents, err := src.GetBackupFile(backup, file)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup file %s", file)
+	}
+	defer contents.Close()
+
+	return errors.Wrapf(dst.PutBackupFile(backup, file, contents), "error putting backup file %s", file)
+}
+
+// CopyRepositoryFiles copies the files of the pod volume backup repositories
+// of one backup store that another one is missing, and returns how many it
+// copied. Repository files are immutable once written, so files that the
+// destination already has aren't copied again, except for the mutable ones
+// such as kopia's maintenance schedule, which are copied when they differ.
+// Repository locks are never copied, since they only matter to the repository
+// they were taken in. Nothing is copied if a repository of the source backup
+// store is a different repository than the one at the same path in the
+// destination, so that the destination's isn't corrupted.
+func CopyRepositoryFiles(src, dst BackupStore) (int, error) {
+	srcFiles, err := src.ListRepositoryFiles()
+	if err != nil {
+		return 0, errors.Wrap(err, "error listing repository files")
+	}
+	dstFiles, err := dst.ListRepositoryFiles()
+	if err != nil {
+		return 0, errors.Wrap(err, "error listing repository files")
+	}
+
+	existing := make(map[string]bool, len(dstFiles))
+	for _, key := range dstFiles {
+		existing[key] = true
+	}
+
+	for _, key := range srcFiles {
+		if isRepositoryConfig(key) && existing[key] {
+			same, err := sameRepositoryFile(src, dst, key)
+			if err != nil {
+				return 0, err
+			}
+			if !same {
+				return 0, errors.Errorf("repository %s of the destination is a different repository than the source's", path.Dir(key))
+			}
+		}
+	}
+
+	copied := 0
+	for _, key := range srcFiles {
+		if isRepositoryLock(key) {
+			continue
+		}
+		if existing[key] {
+			if !isMutableRepositoryFile(key) {
+				continue
+			}
+			same, err := sameRepositoryFile(src, dst, key)
+			if err != nil {
+				return copied, err
+			}
+			if same {
+				continue
+			}
+		}
+		if err := copyRepositoryFile(src, dst, key); err != nil {
+			return copied, err
+		}
+		copied++
+	}
+
+	return copied, nil
+}
+
+func copyRepositoryFile(src, dst BackupStore, key string) error {
+	contents, err := src.GetRepositoryFile(key)
+	if err != nil {
+		return errors.Wrapf(err, "error getting repository file %s", key)
+	}
+	defer contents.Close()
+
+	return errors.Wrapf(dst.PutRepositoryFile(key, contents), "error putting repository file %s", key)
+}
+
+// sameRepositoryFile returns whether the repository file with the given key
+// has the same contents in both backup stores.
+func sameRepositoryFile(src, dst BackupStore, key string) (bool, error) {
+	srcContents, err := readRepositoryFile(src, key)
+	if err != nil {
+		return false, err
+	}
+	dstContents, err := readRepositoryFile(dst, key)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(srcContents, dstContents), nil
+}
+
+func readRepositoryFile(store BackupStore, key string) ([]byte, error) {
+	contents, err := store.GetRepositoryFile(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting repository file %s", key)
+	}
+	defer contents.Close()
+
+	data, err := ioutil.ReadAll(contents)
+	return data, errors.Wrapf(err, "error reading repository file %s", key)
+}
+
+// isRepositoryConfig returns whether the repository file with the given key
+// identifies its repository, i.e. is restic's config or kopia's format blob.
+func isRepositoryConfig(key string) bool {
+	switch path.Base(key) {
+	case "config", "kopia.repository":
+		return strings.Count(path.Dir(key), "/") == 1
+	}
+	return false
+}
+
+// isMutableRepositoryFile returns whether the repository file with the given
+// key can be rewritten once written, like kopia's maintenance schedule.
+func isMutableRepositoryFile(key string) bool {
+	switch path.Base(key) {
+	case "kopia.maintenance", "kopia.blobcfg":
+		return true
+	}
+	return false
+}
+
+// isRepositoryLock returns whether the repository file with the given key is
+// a restic lock.
+func isRepositoryLock(key string) bool {
+	return strings.Contains(key, "/locks/")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
+)
+
+func TestCopyBackup(t *testing.T) {
+	src := newObjectBackupStoreTestHarness("src-bucket", "src-prefix")
+	src.objectStore.Data["src-bucket"] = BucketData{
+		"src-prefix/backups/backup-1/velero-backup.json":               []byte("metadata"),
+		"src-prefix/backups/backup-1/backup-1.tar.gz":                  []byte("contents"),
+		"src-prefix/backups/backup-1/backup-1-logs.gz":                 []byte("log"),
+		"src-prefix/backups/backup-1/backup-1-volumesnapshots.json.gz": []byte("snapshots"),
+		"src-prefix/backups/backup-2/velero-backup.json":               []byte("other metadata"),
+		"src-prefix/backups/backup-3/backup-3.tar.gz":                  []byte("contents"),
+	}
+
+	// the destination is encrypted, so the copied files are encrypted with its key
+	key := bytes.Repeat([]byte{1}, encryption.KeySize)
+	dst := newObjectBackupStoreTestHarness("dst-bucket", "")
+	dst.objectBackupStore.objectStore = newEncryptedObjectStore(dst.objectStore, key, false)
+
+	require.NoError(t, CopyBackup(src, dst, "backup-1"))
+
+	assert.Len(t, dst.objectStore.Data["dst-bucket"], 4)
+	for file, want := range map[string]string{
+		"velero-backup.json":               "metadata",
+		"backup-1.tar.gz":                  "contents",
+		"backup-1-logs.gz":                 "log",
+		"backup-1-volumesnapshots.json.gz": "snapshots",
+	} {
+		assert.NotEqual(t, want, string(dst.objectStore.Data["dst-bucket"]["backups/backup-1/"+file]))
+
+		contents, err := dst.GetBackupFile("backup-1", file)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(contents)
+		require.NoError(t, err)
+		assert.Equal(t, want, string(data))
+	}
+
+	assert.EqualError(t, CopyBackup(src, dst, "backup-3"), "backup backup-3 has no metadata file")
+	_, err := dst.GetBackupFile("backup-3", "velero-backup.json")
+	assert.Error(t, err)
+}
+
+func TestCopyRepositoryFiles(t *testing.T) {
+	src := newObjectBackupStoreTestHarness("src-bucket", "src-prefix")
+	src.objectStore.Data["src-bucket"] = BucketData{
+		"src-prefix/restic/ns-1/config":               []byte("config"),
+		"src-prefix/restic/ns-1/data/00/0001":         []byte("data-1"),
+		"src-prefix/restic/ns-1/data/00/0002":         []byte("data-2"),
+		"src-prefix/restic/ns-1/locks/0003":           []byte("lock"),
+		"src-prefix/kopia/ns-2/kopia.repository":      []byte("repository"),
+		"src-prefix/kopia/ns-2/kopia.maintenance":     []byte("maintenance-1"),
+		"src-prefix/backups/backup-1/backup-1.tar.gz": []byte("contents"),
+	}
+
+	// repository files aren't encrypted by the backup store, even when it's encrypted
+	dst := newObjectBackupStoreTestHarness("dst-bucket", "dst-prefix")
+	dst.objectBackupStore.objectStore = newEncryptedObjectStore(dst.objectStore, bytes.Repeat([]byte{1}, encryption.KeySize), false)
+	dst.objectStore.Data["dst-bucket"]["dst-prefix/restic/ns-1/config"] = []byte("config")
+
+	copied, err := CopyRepositoryFiles(src, dst)
+	require.NoError(t, err)
+	assert.Equal(t, 4, copied)
+	assert.Equal(t, BucketData{
+		"dst-prefix/restic/ns-1/config":           []byte("config"),
+		"dst-prefix/restic/ns-1/data/00/0001":     []byte("data-1"),
+		"dst-prefix/restic/ns-1/data/00/0002":     []byte("data-2"),
+		"dst-prefix/kopia/ns-2/kopia.repository":  []byte("repository"),
+		"dst-prefix/kopia/ns-2/kopia.maintenance": []byte("maintenance-1"),
+	}, dst.objectStore.Data["dst-bucket"])
+
+	copied, err = CopyRepositoryFiles(src, dst)
+	require.NoError(t, err)
+	assert.Equal(t, 0, copied)
+
+	// mutable files are copied again when they change
+	src.objectStore.Data["src-bucket"]["src-prefix/kopia/ns-2/kopia.maintenance"] = []byte("maintenance-2")
+	copied, err = CopyRepositoryFiles(src, dst)
+	require.NoError(t, err)
+	assert.Equal(t, 1, copied)
+	assert.Equal(t, []byte("maintenance-2"), dst.objectStore.Data["dst-bucket"]["dst-prefix/kopia/ns-2/kopia.maintenance"])
+}
+
+func TestCopyRepositoryFilesDifferentRepository(t *testing.T) {
+	src := newObjectBackupStoreTestHarness("src-bucket", "")
+	src.objectStore.Data["src-bucket"] = BucketData{
+		"restic/ns-1/config":       []byte("config-1"),
+		"restic/ns-1/data/00/0001": []byte("data-1"),
+	}
+
+	dst := newObjectBackupStoreTestHarness("dst-bucket", "")
+	dst.objectStore.Data["dst-bucket"] = BucketData{
+		"restic/ns-1/config":       []byte("config-2"),
+		"restic/ns-1/data/00/0002": []byte("data-2"),
+	}
+
+	copied, err := CopyRepositoryFiles(src, dst)
+	assert.EqualError(t, err, "repository restic/ns-1 of the destination is a different repository than the source's")
+	assert.Equal(t, 0, copied)
+	assert.Equal(t, BucketData{
+		"restic/ns-1/config":       []byte("config-2"),
+		"restic/ns-1/data/00/0002": []byte("data-2"),
+	}, dst.objectStore.Data["dst-bucket"])
+}
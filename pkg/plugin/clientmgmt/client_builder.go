@@ -18,6 +18,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"os"
 	"os/exec"
 
@@ -31,6 +32,8 @@ import (
 
 // clientBuilder builds go-plugin Clients.
 type clientBuilder struct {
+	// ctx is the context the plugin clients make their calls with.
+	ctx          context.Context
 	commandName  string
 	commandArgs  []string
 	clientLogger logrus.FieldLogger
@@ -39,8 +42,9 @@ type clientBuilder struct {
 
 // newClientBuilder returns a new clientBuilder with commandName to name. If the command matches the currently running
 // process (i.e. velero), this also sets commandArgs to the internal Velero command to run plugins.
-func newClientBuilder(command string, logger logrus.FieldLogger, logLevel logrus.Level) *clientBuilder {
+func newClientBuilder(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) *clientBuilder {
 	b := &clientBuilder{
+		ctx:          ctx,
 		commandName:  command,
 		clientLogger: logger,
 		pluginLogger: newLogrusAdapter(logger, logLevel),
@@ -67,13 +71,13 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger), framework.ClientContext(b.ctx)),
+			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger), framework.ClientContext(b.ctx)),
+			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger), framework.ClientContext(b.ctx)),
 			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindItemSnapshotter):   framework.NewItemSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger), framework.ClientContext(b.ctx)),
+			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger), framework.ClientContext(b.ctx)),
+			string(framework.PluginKindItemSnapshotter):   framework.NewItemSnapshotterPlugin(framework.ClientLogger(b.clientLogger), framework.ClientContext(b.ctx)),
 		},
 		Logger: b.pluginLogger,
 		Cmd:    exec.Command(b.commandName, b.commandArgs...),
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"strings"
 	"sync"
 
@@ -66,6 +67,8 @@ type Manager interface {
 
 // manager implements Manager.
 type manager struct {
+	// ctx is the context the plugins make their calls with.
+	ctx      context.Context
 	logger   logrus.FieldLogger
 	logLevel logrus.Level
 	registry Registry
@@ -79,7 +82,23 @@ type manager struct {
 
 // NewManager constructs a manager for getting plugins.
 func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry) Manager {
+	return newManager(context.Background(), logger, level, registry)
+}
+
+// WithContext returns a manager for the same plugins as m whose plugins make their calls
+// with ctx, so that the calls are canceled when ctx is done. Managers not constructed by
+// NewManager are returned as is.
+func WithContext(ctx context.Context, m Manager) Manager {
+	mgr, ok := m.(*manager)
+	if !ok {
+		return m
+	}
+	return newManager(ctx, mgr.logger, mgr.logLevel, mgr.registry)
+}
+
+func newManager(ctx context.Context, logger logrus.FieldLogger, level logrus.Level, registry Registry) *manager {
 	return &manager{
+		ctx:      ctx,
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
@@ -127,7 +146,7 @@ func (m *manager) getRestartableProcess(kind framework.PluginKind, name string)
 
 	logger.Debug("creating new restartable plugin process")
 
-	restartableProcess, err = m.restartableProcessFactory.newRestartableProcess(info.Command, m.logger, m.logLevel)
+	restartableProcess, err = m.restartableProcessFactory.newRestartableProcess(m.ctx, info.Command, m.logger, m.logLevel)
 	if err != nil {
 		return nil, err
 	}
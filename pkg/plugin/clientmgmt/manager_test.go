@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -68,12 +69,38 @@ func TestNewManager(t *testing.T) {
 	assert.Empty(t, m.restartableProcesses)
 }
 
+func TestWithContext(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := WithContext(ctx, NewManager(logger, logLevel, registry)).(*manager)
+	assert.Equal(t, ctx, m.ctx)
+	assert.Equal(t, logger, m.logger)
+	assert.Equal(t, logLevel, m.logLevel)
+	assert.Equal(t, registry, m.registry)
+	assert.Empty(t, m.restartableProcesses)
+
+	// managers not constructed by NewManager are returned as is
+	other := &otherManager{}
+	assert.Same(t, other, WithContext(ctx, other))
+}
+
+type otherManager struct {
+	Manager
+}
+
 type mockRestartableProcessFactory struct {
 	mock.Mock
 }
 
-func (f *mockRestartableProcessFactory) newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	args := f.Called(command, logger, logLevel)
+func (f *mockRestartableProcessFactory) newRestartableProcess(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+	args := f.Called(ctx, command, logger, logLevel)
 	var rp RestartableProcess
 	if args.Get(0) != nil {
 		rp = args.Get(0).(RestartableProcess)
@@ -135,7 +162,7 @@ func TestGetRestartableProcess(t *testing.T) {
 		Name:    pluginName,
 	}
 	registry.On("Get", pluginKind, pluginName).Return(podID, nil)
-	factory.On("newRestartableProcess", podID.Command, logger, logLevel).Return(nil, errors.Errorf("factory")).Once()
+	factory.On("newRestartableProcess", context.Background(), podID.Command, logger, logLevel).Return(nil, errors.Errorf("factory")).Once()
 	rp, err = m.getRestartableProcess(pluginKind, pluginName)
 	assert.Nil(t, rp)
 	assert.EqualError(t, err, "factory")
@@ -143,7 +170,7 @@ func TestGetRestartableProcess(t *testing.T) {
 	// Test 3: registry ok, factory ok
 	restartableProcess := &mockRestartableProcess{}
 	defer restartableProcess.AssertExpectations(t)
-	factory.On("newRestartableProcess", podID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+	factory.On("newRestartableProcess", context.Background(), podID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
 	rp, err = m.getRestartableProcess(pluginKind, pluginName)
 	require.NoError(t, err)
 	assert.Equal(t, restartableProcess, rp)
@@ -273,13 +300,13 @@ func getPluginTest(
 	defer restartableProcess.AssertExpectations(t)
 
 	// Test 1: error getting restartable process
-	factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+	factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 	actual, err := getPluginFunc(m, pluginName)
 	assert.Nil(t, actual)
 	assert.EqualError(t, err, "newRestartableProcess")
 
 	// Test 2: happy path
-	factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+	factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
 
 	expected := expectedResultFunc(name, restartableProcess)
 	if reinitializable {
@@ -356,13 +383,13 @@ func TestGetBackupItemActions(t *testing.T) {
 
 				if tc.newRestartableProcessError != nil {
 					// Test 1: error getting restartable process
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 					break
 				}
 
 				// Test 2: happy path
 				if i == 0 {
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+					factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
 				}
 
 				expectedActions = append(expectedActions, expected)
@@ -448,13 +475,13 @@ func TestGetRestoreItemActions(t *testing.T) {
 
 				if tc.newRestartableProcessError != nil {
 					// Test 1: error getting restartable process
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 					break
 				}
 
 				// Test 2: happy path
 				if i == 0 {
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+					factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
 				}
 
 				expectedActions = append(expectedActions, expected)
@@ -547,13 +574,13 @@ func TestGetDeleteItemActions(t *testing.T) {
 
 				if tc.newRestartableProcessError != nil {
 					// Test 1: error getting restartable process
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 					break
 				}
 
 				// Test 2: happy path
 				if i == 0 {
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+					factory.On("newRestartableProcess", context.Background(), pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
 				}
 
 				expectedActions = append(expectedActions, expected)
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"strings"
 
 	plugin "github.com/hashicorp/go-plugin"
@@ -38,7 +39,7 @@ func newProcessFactory() ProcessFactory {
 }
 
 func (pf *processFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	return newProcess(command, logger, logLevel)
+	return newProcess(context.Background(), command, logger, logLevel)
 }
 
 type Process interface {
@@ -52,8 +53,8 @@ type process struct {
 	protocolClient plugin.ClientProtocol
 }
 
-func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel)
+func newProcess(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+	builder := newClientBuilder(ctx, command, logger.WithField("cmd", command), logLevel)
 
 	// This creates a new go-plugin Client that has its own unique exec.Cmd for launching the plugin process.
 	client := builder.client()
@@ -43,6 +43,11 @@ func newRestartableBackupItemAction(name string, sharedPluginProcess Restartable
 	return r
 }
 
+// Name returns the name of the backup item action plugin.
+func (r *restartableBackupItemAction) Name() string {
+	return r.key.name
+}
+
 // getBackupItemAction returns the backup item action for this restartableBackupItemAction. It does *not* restart the
 // plugin process.
 func (r *restartableBackupItemAction) getBackupItemAction() (velero.BackupItemAction, error) {
@@ -87,3 +92,19 @@ func (r *restartableBackupItemAction) Execute(item runtime.Unstructured, backup
 
 	return delegate.Execute(item, backup)
 }
+
+// Progress restarts the plugin's process if needed, then delegates the call if the delegate
+// reports the progress of its work. Otherwise the work is completed.
+func (r *restartableBackupItemAction) Progress(item velero.ResourceIdentifier, backup *api.Backup) (velero.OperationProgress, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+
+	reporter, ok := delegate.(velero.BackupItemActionProgressReporter)
+	if !ok {
+		return velero.OperationProgress{Phase: velero.OperationPhaseCompleted}, nil
+	}
+
+	return reporter.Progress(item, backup)
+}
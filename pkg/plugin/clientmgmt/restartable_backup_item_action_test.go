@@ -144,5 +144,11 @@ func TestRestartableBackupItemActionDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{nil, ([]velero.ResourceIdentifier)(nil), errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{pvToReturn, additionalItems, errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "Progress",
+			inputs:                  []interface{}{additionalItems[0], b},
+			expectedErrorOutputs:    []interface{}{velero.OperationProgress{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.OperationProgress{Phase: velero.OperationPhaseInProgress}, errors.Errorf("delegate error")},
+		},
 	)
 }
@@ -41,6 +41,11 @@ func newRestartableItemSnapshotter(name string, sharedPluginProcess RestartableP
 	return r
 }
 
+// Name returns the name of the item snapshotter plugin.
+func (r *restartableItemSnapshotter) Name() string {
+	return r.key.name
+}
+
 // getItemSnapshotter returns the item snapshotter for this restartableItemSnapshotter. It does *not* restart the
 // plugin process.
 func (r *restartableItemSnapshotter) getItemSnapshotter() (isv1.ItemSnapshotter, error) {
@@ -173,3 +173,17 @@ func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl
 	}
 	return delegate.CreateSignedURL(bucket, key, ttl)
 }
+
+// CreateSignedUploadURL restarts the plugin's process if needed, then delegates the call if the
+// delegate supports it.
+func (r *restartableObjectStore) CreateSignedUploadURL(bucket string, key string, ttl time.Duration) (string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", err
+	}
+	creator, ok := delegate.(velero.SignedUploadURLCreator)
+	if !ok {
+		return "", velero.ErrSignedUploadURLNotSupported
+	}
+	return creator.CreateSignedUploadURL(bucket, key, ttl)
+}
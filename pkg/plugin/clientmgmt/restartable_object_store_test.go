@@ -232,5 +232,11 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{"", errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{"signedURL", errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "CreateSignedUploadURL",
+			inputs:                  []interface{}{"bucket", "key", 30 * time.Minute},
+			expectedErrorOutputs:    []interface{}{"", errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{"signedUploadURL", errors.Errorf("delegate error")},
+		},
 	)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -24,7 +25,7 @@ import (
 )
 
 type RestartableProcessFactory interface {
-	newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error)
+	newRestartableProcess(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error)
 }
 
 type restartableProcessFactory struct {
@@ -34,8 +35,8 @@ func newRestartableProcessFactory() RestartableProcessFactory {
 	return &restartableProcessFactory{}
 }
 
-func (rpf *restartableProcessFactory) newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+func (rpf *restartableProcessFactory) newRestartableProcess(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+	return newRestartableProcess(ctx, command, logger, logLevel)
 }
 
 type RestartableProcess interface {
@@ -50,6 +51,8 @@ type RestartableProcess interface {
 // to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
 // the original configuration data.
 type restartableProcess struct {
+	// ctx is the context the plugins of the process make their calls with.
+	ctx      context.Context
 	command  string
 	logger   logrus.FieldLogger
 	logLevel logrus.Level
@@ -69,8 +72,9 @@ type reinitializer interface {
 }
 
 // newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+func newRestartableProcess(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
 	p := &restartableProcess{
+		ctx:            ctx,
 		command:        command,
 		logger:         logger,
 		logLevel:       logLevel,
@@ -109,7 +113,7 @@ func (p *restartableProcess) resetLH() error {
 		return errors.Errorf("unable to restart plugin process: exceeded maximum number of reset failures")
 	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
+	process, err := newProcess(p.ctx, p.command, p.logger, p.logLevel)
 	if err != nil {
 		p.resetFailures++
 		return err
@@ -159,3 +159,17 @@ func (r *restartableVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
 	}
 	return delegate.DeleteSnapshot(snapshotID)
 }
+
+// SnapshotProgress restarts the plugin's process if needed, then delegates the call if the
+// delegate reports the progress of its snapshots. Otherwise the snapshot is completed.
+func (r *restartableVolumeSnapshotter) SnapshotProgress(snapshotID string) (velero.OperationProgress, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+	reporter, ok := delegate.(velero.VolumeSnapshotProgressReporter)
+	if !ok {
+		return velero.OperationProgress{Phase: velero.OperationPhaseCompleted}, nil
+	}
+	return reporter.SnapshotProgress(snapshotID)
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -243,5 +244,11 @@ func TestRestartableVolumeSnapshotterDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "SnapshotProgress",
+			inputs:                  []interface{}{"snapshotID"},
+			expectedErrorOutputs:    []interface{}{velero.OperationProgress{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.OperationProgress{Phase: velero.OperationPhaseInProgress}, errors.Errorf("delegate error")},
+		},
 	)
 }
@@ -100,6 +100,10 @@ func resolveAction(helper discovery.Helper, action velero.Applicable) (resources
 type BackupItemResolvedAction struct {
 	velero.BackupItemAction
 	resolvedAction
+
+	// Name is the name of the BackupItemAction plugin, recorded with the work it
+	// leaves in progress so that the same plugin can be asked about its progress.
+	Name string
 }
 
 func NewBackupItemActionResolver(actions []velero.BackupItemAction) BackupItemActionResolver {
@@ -149,6 +153,9 @@ func (recv BackupItemActionResolver) ResolveActions(helper discovery.Helper) ([]
 				Selector:                  selector,
 			},
 		}
+		if named, ok := action.(namedPlugin); ok {
+			res.Name = named.Name()
+		}
 		resolved = append(resolved, res)
 	}
 	return resolved, nil
@@ -215,6 +222,15 @@ func (recv DeleteItemActionResolver) ResolveActions(helper discovery.Helper) ([]
 type ItemSnapshotterResolvedAction struct {
 	isv1.ItemSnapshotter
 	resolvedAction
+
+	// Name is the name of the ItemSnapshotter plugin, recorded with the snapshots it
+	// takes so that the same plugin can be used to restore and delete them.
+	Name string
+}
+
+// namedPlugin is a plugin that knows its name.
+type namedPlugin interface {
+	Name() string
 }
 
 type ItemSnapshotterResolver struct {
@@ -236,6 +252,9 @@ func (recv ItemSnapshotterResolver) ResolveActions(helper discovery.Helper) ([]I
 				Selector:                  selector,
 			},
 		}
+		if named, ok := action.(namedPlugin); ok {
+			res.Name = named.Name()
+		}
 		resolved = append(resolved, res)
 	}
 	return resolved, nil
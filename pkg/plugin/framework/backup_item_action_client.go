@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -57,7 +56,7 @@ func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error
 		Plugin: c.plugin,
 	}
 
-	res, err := c.grpcClient.AppliesTo(context.Background(), req)
+	res, err := c.grpcClient.AppliesTo(c.callContext(), req)
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -92,7 +91,7 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 		Backup: backupJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	res, err := c.grpcClient.Execute(c.callContext(), req)
 	if err != nil {
 		return nil, nil, fromGRPCError(err)
 	}
@@ -119,3 +118,28 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 
 	return &updatedItem, additionalItems, nil
 }
+
+// Progress returns the progress of the work Execute started for the item. The work of a
+// plugin built against a version of Velero without progress reporting is always completed.
+func (c *BackupItemActionGRPCClient) Progress(item velero.ResourceIdentifier, backup *api.Backup) (velero.OperationProgress, error) {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return velero.OperationProgress{}, errors.WithStack(err)
+	}
+
+	req := &proto.BackupItemActionProgressRequest{
+		Plugin: c.plugin,
+		Item:   resourceIdentifierToProto(item),
+		Backup: backupJSON,
+	}
+
+	res, err := c.grpcClient.Progress(c.callContext(), req)
+	if err != nil {
+		if isUnimplemented(err) {
+			return velero.OperationProgress{Phase: velero.OperationPhaseCompleted}, nil
+		}
+		return velero.OperationProgress{}, fromGRPCError(err)
+	}
+
+	return protoToOperationProgress(res), nil
+}
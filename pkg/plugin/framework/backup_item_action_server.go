@@ -126,6 +126,38 @@ func (s *BackupItemActionGRPCServer) Execute(ctx context.Context, req *proto.Exe
 	return res, nil
 }
 
+// Progress returns the progress of the work Execute started for the item. The work of a
+// BackupItemAction that doesn't report its progress is always completed.
+func (s *BackupItemActionGRPCServer) Progress(ctx context.Context, req *proto.BackupItemActionProgressRequest) (response *proto.OperationProgress, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	reporter, ok := impl.(velero.BackupItemActionProgressReporter)
+	if !ok {
+		return operationProgressToProto(velero.OperationProgress{Phase: velero.OperationPhaseCompleted}), nil
+	}
+
+	var backup api.Backup
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	progress, err := reporter.Progress(protoToResourceIdentifier(req.Item), &backup)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return operationProgressToProto(progress), nil
+}
+
 func backupResourceIdentifierToProto(id velero.ResourceIdentifier) *proto.ResourceIdentifier {
 	return &proto.ResourceIdentifier{
 		Group:     id.Group,
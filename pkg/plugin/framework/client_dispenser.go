@@ -17,6 +17,8 @@ limitations under the License.
 package framework
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
@@ -25,6 +27,16 @@ import (
 type clientBase struct {
 	plugin string
 	logger logrus.FieldLogger
+	// ctx is the context the client makes its calls with.
+	ctx context.Context
+}
+
+// callContext returns the context the client makes its calls with.
+func (c *clientBase) callContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
 }
 
 type ClientDispenser interface {
@@ -34,6 +46,8 @@ type ClientDispenser interface {
 // clientDispenser supports the initialization and retrieval of multiple implementations for a single plugin kind, such as
 // "aws" and "azure" implementations of the object store plugin.
 type clientDispenser struct {
+	// ctx is the context the clients make their calls with.
+	ctx context.Context
 	// logger is the log the plugin should use.
 	logger logrus.FieldLogger
 	// clienConn is shared among all implementations for this client.
@@ -47,8 +61,9 @@ type clientDispenser struct {
 type clientInitFunc func(base *clientBase, clientConn *grpc.ClientConn) interface{}
 
 // newClientDispenser creates a new clientDispenser.
-func newClientDispenser(logger logrus.FieldLogger, clientConn *grpc.ClientConn, initFunc clientInitFunc) *clientDispenser {
+func newClientDispenser(ctx context.Context, logger logrus.FieldLogger, clientConn *grpc.ClientConn, initFunc clientInitFunc) *clientDispenser {
 	return &clientDispenser{
+		ctx:        ctx,
 		clientConn: clientConn,
 		logger:     logger,
 		initFunc:   initFunc,
@@ -66,6 +81,7 @@ func (cd *clientDispenser) ClientFor(name string) interface{} {
 	base := &clientBase{
 		plugin: name,
 		logger: cd.logger,
+		ctx:    cd.ctx,
 	}
 	// Initialize the plugin (e.g. newBackupItemActionGRPCClient())
 	client := cd.initFunc(base, cd.clientConn)
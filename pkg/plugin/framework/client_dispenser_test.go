@@ -16,6 +16,7 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,7 +41,7 @@ func TestNewClientDispenser(t *testing.T) {
 		return c
 	}
 
-	cd := newClientDispenser(logger, clientConn, initFunc)
+	cd := newClientDispenser(context.Background(), logger, clientConn, initFunc)
 	assert.Equal(t, clientConn, cd.clientConn)
 	assert.NotNil(t, cd.clients)
 	assert.Empty(t, cd.clients)
@@ -59,7 +60,9 @@ func TestClientFor(t *testing.T) {
 		return c
 	}
 
-	cd := newClientDispenser(logger, clientConn, initFunc)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cd := newClientDispenser(ctx, logger, clientConn, initFunc)
 
 	actual := cd.ClientFor("pod")
 	require.IsType(t, &fakeClient{}, actual)
@@ -69,8 +72,10 @@ func TestClientFor(t *testing.T) {
 	expectedBase := &clientBase{
 		plugin: "pod",
 		logger: logger,
+		ctx:    ctx,
 	}
 	assert.Equal(t, expectedBase, typed.base)
+	assert.Equal(t, ctx, typed.base.callContext())
 	assert.Equal(t, clientConn, typed.clientConn)
 
 	// Make sure we reuse a previous client
@@ -34,7 +34,7 @@ type DeleteItemActionPlugin struct {
 
 // GRPCClient returns a RestoreItemAction gRPC client.
 func (p *DeleteItemActionPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
-	return newClientDispenser(p.clientLogger, clientConn, newDeleteItemActionGRPCClient), nil
+	return newClientDispenser(p.clientContext, p.clientLogger, clientConn, newDeleteItemActionGRPCClient), nil
 }
 
 // GRPCServer registers a DeleteItemAction gRPC server.
@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -51,7 +50,7 @@ func newDeleteItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn
 }
 
 func (c *DeleteItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
+	res, err := c.grpcClient.AppliesTo(c.callContext(), &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -87,7 +86,7 @@ func (c *DeleteItemActionGRPCClient) Execute(input *velero.DeleteItemActionExecu
 	}
 
 	// First return item is just an empty struct no matter what.
-	if _, err = c.grpcClient.Execute(context.Background(), req); err != nil {
+	if _, err = c.grpcClient.Execute(c.callContext(), req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -58,7 +58,7 @@ func (recv ItemSnapshotterGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	_, err := recv.grpcClient.Init(context.Background(), req)
+	_, err := recv.grpcClient.Init(recv.callContext(), req)
 	return err
 }
 
@@ -67,7 +67,7 @@ func (recv ItemSnapshotterGRPCClient) AppliesTo() (velero.ResourceSelector, erro
 		Plugin: recv.plugin,
 	}
 
-	res, err := recv.grpcClient.AppliesTo(context.Background(), req)
+	res, err := recv.grpcClient.AppliesTo(recv.callContext(), req)
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -100,7 +100,7 @@ func (recv ItemSnapshotterGRPCClient) AlsoHandles(input *isv1.AlsoHandlesInput)
 		Item:   itemJSON,
 		Backup: backupJSON,
 	}
-	res, err := recv.grpcClient.AlsoHandles(context.Background(), req)
+	res, err := recv.grpcClient.AlsoHandles(recv.callContext(), req)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -159,7 +159,7 @@ func (recv ItemSnapshotterGRPCClient) Progress(input *isv1.ProgressInput) (*isv1
 		Backup:     backupJSON,
 	}
 
-	res, err := recv.grpcClient.Progress(context.Background(), req)
+	res, err := recv.grpcClient.Progress(recv.callContext(), req)
 
 	if err != nil {
 		return nil, errors.WithStack(err)
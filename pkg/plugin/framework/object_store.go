@@ -34,7 +34,7 @@ type ObjectStorePlugin struct {
 
 // GRPCClient returns an ObjectStore gRPC client.
 func (p *ObjectStorePlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
-	return newClientDispenser(p.clientLogger, clientConn, newObjectStoreGRPCClient), nil
+	return newClientDispenser(p.clientContext, p.clientLogger, clientConn, newObjectStoreGRPCClient), nil
 
 }
 
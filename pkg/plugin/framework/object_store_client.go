@@ -21,10 +21,10 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -59,7 +59,7 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+	if _, err := c.grpcClient.Init(c.callContext(), req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -69,7 +69,7 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 // PutObject creates a new object using the data in body within the specified
 // object storage bucket with the given key.
 func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) error {
-	stream, err := c.grpcClient.PutObject(context.Background())
+	stream, err := c.grpcClient.PutObject(c.callContext())
 	if err != nil {
 		return fromGRPCError(err)
 	}
@@ -104,7 +104,7 @@ func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
 		Key:    key,
 	}
 
-	res, err := c.grpcClient.ObjectExists(context.Background(), req)
+	res, err := c.grpcClient.ObjectExists(c.callContext(), req)
 	if err != nil {
 		return false, err
 	}
@@ -121,7 +121,7 @@ func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, er
 		Key:    key,
 	}
 
-	stream, err := c.grpcClient.GetObject(context.Background(), req)
+	stream, err := c.grpcClient.GetObject(c.callContext(), req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -162,7 +162,7 @@ func (c *ObjectStoreGRPCClient) ListCommonPrefixes(bucket, prefix, delimiter str
 		Delimiter: delimiter,
 	}
 
-	res, err := c.grpcClient.ListCommonPrefixes(context.Background(), req)
+	res, err := c.grpcClient.ListCommonPrefixes(c.callContext(), req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -178,7 +178,7 @@ func (c *ObjectStoreGRPCClient) ListObjects(bucket, prefix string) ([]string, er
 		Prefix: prefix,
 	}
 
-	res, err := c.grpcClient.ListObjects(context.Background(), req)
+	res, err := c.grpcClient.ListObjects(c.callContext(), req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -195,7 +195,7 @@ func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
 		Key:    key,
 	}
 
-	if _, err := c.grpcClient.DeleteObject(context.Background(), req); err != nil {
+	if _, err := c.grpcClient.DeleteObject(c.callContext(), req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -211,10 +211,31 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 		Ttl:    int64(ttl),
 	}
 
-	res, err := c.grpcClient.CreateSignedURL(context.Background(), req)
+	res, err := c.grpcClient.CreateSignedURL(c.callContext(), req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
 
 	return res.Url, nil
 }
+
+// CreateSignedUploadURL creates a pre-signed URL to upload the object with the given key to the
+// bucket that expires after ttl.
+func (c *ObjectStoreGRPCClient) CreateSignedUploadURL(bucket, key string, ttl time.Duration) (string, error) {
+	req := &proto.CreateSignedURLRequest{
+		Plugin: c.plugin,
+		Bucket: bucket,
+		Key:    key,
+		Ttl:    int64(ttl),
+	}
+
+	res, err := c.grpcClient.CreateSignedUploadURL(c.callContext(), req)
+	if err != nil {
+		if isUnimplemented(err) {
+			return "", velero.ErrSignedUploadURLNotSupported
+		}
+		return "", fromGRPCError(err)
+	}
+
+	return res.Url, nil
+}
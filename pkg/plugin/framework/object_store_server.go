@@ -22,6 +22,8 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -270,3 +272,30 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 
 	return &proto.CreateSignedURLResponse{Url: url}, nil
 }
+
+// CreateSignedUploadURL creates a pre-signed URL to upload the object with the given key to the
+// bucket that expires after ttl, if the ObjectStore supports it.
+func (s *ObjectStoreGRPCServer) CreateSignedUploadURL(ctx context.Context, req *proto.CreateSignedURLRequest) (response *proto.CreateSignedURLResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	creator, ok := impl.(velero.SignedUploadURLCreator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, velero.ErrSignedUploadURLNotSupported.Error())
+	}
+
+	url, err := creator.CreateSignedUploadURL(req.Bucket, req.Key, time.Duration(req.Ttl))
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.CreateSignedURLResponse{Url: url}, nil
+}
@@ -17,11 +17,14 @@ limitations under the License.
 package framework
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 )
 
 type pluginBase struct {
-	clientLogger logrus.FieldLogger
+	clientLogger  logrus.FieldLogger
+	clientContext context.Context
 	*serverMux
 }
 
@@ -41,6 +44,14 @@ func ClientLogger(logger logrus.FieldLogger) PluginOption {
 	}
 }
 
+// ClientContext sets the context the plugin's clients make their calls with, so that
+// the calls are canceled when the context is done.
+func ClientContext(ctx context.Context) PluginOption {
+	return func(base *pluginBase) {
+		base.clientContext = ctx
+	}
+}
+
 func serverLogger(logger logrus.FieldLogger) PluginOption {
 	return func(base *pluginBase) {
 		base.serverMux = newServerMux(logger)
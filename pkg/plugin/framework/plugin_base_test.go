@@ -16,6 +16,7 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,6 +32,15 @@ func TestClientLogger(t *testing.T) {
 	assert.Equal(t, logger, base.clientLogger)
 }
 
+func TestClientContext(t *testing.T) {
+	base := &pluginBase{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := ClientContext(ctx)
+	f(base)
+	assert.Equal(t, ctx, base.clientContext)
+}
+
 func TestServerLogger(t *testing.T) {
 	base := &pluginBase{}
 	logger := test.NewLogger()
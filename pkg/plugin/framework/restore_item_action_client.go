@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -53,7 +52,7 @@ func newRestoreItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientCon
 }
 
 func (c *RestoreItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
+	res, err := c.grpcClient.AppliesTo(c.callContext(), &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -94,7 +93,7 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 		Restore:        restoreJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	res, err := c.grpcClient.Execute(c.callContext(), req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
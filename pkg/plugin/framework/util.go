@@ -17,6 +17,8 @@ limitations under the License.
 package framework
 
 import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -56,3 +58,23 @@ func resourceIdentifierToProto(id velero.ResourceIdentifier) *proto.ResourceIden
 		Name:      id.Name,
 	}
 }
+
+func operationProgressToProto(progress velero.OperationProgress) *proto.OperationProgress {
+	return &proto.OperationProgress{
+		Phase: string(progress.Phase),
+		Err:   progress.Err,
+	}
+}
+
+func protoToOperationProgress(progress *proto.OperationProgress) velero.OperationProgress {
+	return velero.OperationProgress{
+		Phase: velero.OperationPhase(progress.Phase),
+		Err:   progress.Err,
+	}
+}
+
+// isUnimplemented returns whether err is the error of a gRPC call to a method the plugin
+// server doesn't have, e.g. because it was built against an older version of Velero.
+func isUnimplemented(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
@@ -20,12 +20,12 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 // NewVolumeSnapshotterPlugin constructs a VolumeSnapshotterPlugin.
@@ -58,7 +58,7 @@ func (c *VolumeSnapshotterGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+	if _, err := c.grpcClient.Init(c.callContext(), req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -81,7 +81,7 @@ func (c *VolumeSnapshotterGRPCClient) CreateVolumeFromSnapshot(snapshotID, volum
 		req.Iops = *iops
 	}
 
-	res, err := c.grpcClient.CreateVolumeFromSnapshot(context.Background(), req)
+	res, err := c.grpcClient.CreateVolumeFromSnapshot(c.callContext(), req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -98,7 +98,7 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeInfo(volumeID, volumeAZ string) (
 		VolumeAZ: volumeAZ,
 	}
 
-	res, err := c.grpcClient.GetVolumeInfo(context.Background(), req)
+	res, err := c.grpcClient.GetVolumeInfo(c.callContext(), req)
 	if err != nil {
 		return "", nil, fromGRPCError(err)
 	}
@@ -121,7 +121,7 @@ func (c *VolumeSnapshotterGRPCClient) CreateSnapshot(volumeID, volumeAZ string,
 		Tags:     tags,
 	}
 
-	res, err := c.grpcClient.CreateSnapshot(context.Background(), req)
+	res, err := c.grpcClient.CreateSnapshot(c.callContext(), req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -136,13 +136,32 @@ func (c *VolumeSnapshotterGRPCClient) DeleteSnapshot(snapshotID string) error {
 		SnapshotID: snapshotID,
 	}
 
-	if _, err := c.grpcClient.DeleteSnapshot(context.Background(), req); err != nil {
+	if _, err := c.grpcClient.DeleteSnapshot(c.callContext(), req); err != nil {
 		return fromGRPCError(err)
 	}
 
 	return nil
 }
 
+// SnapshotProgress returns the progress of the specified volume snapshot. The snapshots of a
+// plugin built against a version of Velero without progress reporting are always completed.
+func (c *VolumeSnapshotterGRPCClient) SnapshotProgress(snapshotID string) (velero.OperationProgress, error) {
+	req := &proto.SnapshotProgressRequest{
+		Plugin:     c.plugin,
+		SnapshotID: snapshotID,
+	}
+
+	res, err := c.grpcClient.SnapshotProgress(c.callContext(), req)
+	if err != nil {
+		if isUnimplemented(err) {
+			return velero.OperationProgress{Phase: velero.OperationPhaseCompleted}, nil
+		}
+		return velero.OperationProgress{}, fromGRPCError(err)
+	}
+
+	return protoToOperationProgress(res), nil
+}
+
 func (c *VolumeSnapshotterGRPCClient) GetVolumeID(pv runtime.Unstructured) (string, error) {
 	encodedPV, err := json.Marshal(pv.UnstructuredContent())
 	if err != nil {
@@ -154,7 +173,7 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeID(pv runtime.Unstructured) (stri
 		PersistentVolume: encodedPV,
 	}
 
-	resp, err := c.grpcClient.GetVolumeID(context.Background(), req)
+	resp, err := c.grpcClient.GetVolumeID(c.callContext(), req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -174,7 +193,7 @@ func (c *VolumeSnapshotterGRPCClient) SetVolumeID(pv runtime.Unstructured, volum
 		VolumeID:         volumeID,
 	}
 
-	resp, err := c.grpcClient.SetVolumeID(context.Background(), req)
+	resp, err := c.grpcClient.SetVolumeID(c.callContext(), req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -172,6 +172,33 @@ func (s *VolumeSnapshotterGRPCServer) DeleteSnapshot(ctx context.Context, req *p
 	return &proto.Empty{}, nil
 }
 
+// SnapshotProgress returns the progress of the specified volume snapshot. The snapshots of
+// a VolumeSnapshotter that doesn't report their progress are always completed.
+func (s *VolumeSnapshotterGRPCServer) SnapshotProgress(ctx context.Context, req *proto.SnapshotProgressRequest) (response *proto.OperationProgress, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	reporter, ok := impl.(velero.VolumeSnapshotProgressReporter)
+	if !ok {
+		return operationProgressToProto(velero.OperationProgress{Phase: velero.OperationPhaseCompleted}), nil
+	}
+
+	progress, err := reporter.SnapshotProgress(req.SnapshotID)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return operationProgressToProto(progress), nil
+}
+
 func (s *VolumeSnapshotterGRPCServer) GetVolumeID(ctx context.Context, req *proto.GetVolumeIDRequest) (response *proto.GetVolumeIDResponse, err error) {
 	defer func() {
 		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
@@ -185,11 +185,46 @@ func (m *BackupItemActionAppliesToResponse) GetResourceSelector() *ResourceSelec
 	return nil
 }
 
+type BackupItemActionProgressRequest struct {
+	Plugin string              `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item   *ResourceIdentifier `protobuf:"bytes,2,opt,name=item" json:"item,omitempty"`
+	Backup []byte              `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupItemActionProgressRequest) Reset()         { *m = BackupItemActionProgressRequest{} }
+func (m *BackupItemActionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionProgressRequest) ProtoMessage()    {}
+func (*BackupItemActionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{4}
+}
+
+func (m *BackupItemActionProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionProgressRequest) GetItem() *ResourceIdentifier {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *BackupItemActionProgressRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ExecuteRequest)(nil), "generated.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "generated.ExecuteResponse")
 	proto.RegisterType((*BackupItemActionAppliesToRequest)(nil), "generated.BackupItemActionAppliesToRequest")
 	proto.RegisterType((*BackupItemActionAppliesToResponse)(nil), "generated.BackupItemActionAppliesToResponse")
+	proto.RegisterType((*BackupItemActionProgressRequest)(nil), "generated.BackupItemActionProgressRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -205,6 +240,7 @@ const _ = grpc.SupportPackageIsVersion4
 type BackupItemActionClient interface {
 	AppliesTo(ctx context.Context, in *BackupItemActionAppliesToRequest, opts ...grpc.CallOption) (*BackupItemActionAppliesToResponse, error)
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
+	Progress(ctx context.Context, in *BackupItemActionProgressRequest, opts ...grpc.CallOption) (*OperationProgress, error)
 }
 
 type backupItemActionClient struct {
@@ -233,11 +269,21 @@ func (c *backupItemActionClient) Execute(ctx context.Context, in *ExecuteRequest
 	return out, nil
 }
 
+func (c *backupItemActionClient) Progress(ctx context.Context, in *BackupItemActionProgressRequest, opts ...grpc.CallOption) (*OperationProgress, error) {
+	out := new(OperationProgress)
+	err := grpc.Invoke(ctx, "/generated.BackupItemAction/Progress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for BackupItemAction service
 
 type BackupItemActionServer interface {
 	AppliesTo(context.Context, *BackupItemActionAppliesToRequest) (*BackupItemActionAppliesToResponse, error)
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
+	Progress(context.Context, *BackupItemActionProgressRequest) (*OperationProgress, error)
 }
 
 func RegisterBackupItemActionServer(s *grpc.Server, srv BackupItemActionServer) {
@@ -280,6 +326,24 @@ func _BackupItemAction_Execute_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BackupItemAction_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionServer).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemAction/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionServer).Progress(ctx, req.(*BackupItemActionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BackupItemAction_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.BackupItemAction",
 	HandlerType: (*BackupItemActionServer)(nil),
@@ -292,6 +356,10 @@ var _BackupItemAction_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Execute",
 			Handler:    _BackupItemAction_Execute_Handler,
 		},
+		{
+			MethodName: "Progress",
+			Handler:    _BackupItemAction_Progress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "BackupItemAction.proto",
@@ -300,24 +368,27 @@ var _BackupItemAction_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("BackupItemAction.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0x49, 0x2b, 0x95, 0x4e, 0x8b, 0x2d, 0x7b, 0x28, 0x31, 0x22, 0xc4, 0x9c, 0x02, 0x4a,
-	0x0e, 0xf1, 0xe6, 0xc9, 0x0a, 0x52, 0x7a, 0xdd, 0xf6, 0x05, 0xd2, 0x64, 0x5a, 0x17, 0xd3, 0xdd,
-	0x75, 0x77, 0x03, 0x3e, 0x9c, 0x0f, 0x27, 0xd9, 0x6e, 0x43, 0x8c, 0xc5, 0x7a, 0xcb, 0x64, 0xe6,
-	0xff, 0xe7, 0xfb, 0xd9, 0x81, 0xd9, 0x4b, 0x96, 0xbf, 0x57, 0x72, 0x69, 0x70, 0x3f, 0xcf, 0x0d,
-	0x13, 0x3c, 0x91, 0x4a, 0x18, 0x41, 0x86, 0x3b, 0xe4, 0xa8, 0x32, 0x83, 0x45, 0x30, 0x5e, 0xbd,
-	0x65, 0x0a, 0x8b, 0x43, 0x23, 0x5a, 0xc3, 0xd5, 0xeb, 0x27, 0xe6, 0x95, 0x41, 0x8a, 0x1f, 0x15,
-	0x6a, 0x43, 0x66, 0x30, 0x90, 0x65, 0xb5, 0x63, 0xdc, 0xf7, 0x42, 0x2f, 0x1e, 0x52, 0x57, 0x11,
-	0x02, 0x17, 0xcc, 0xe0, 0xde, 0xef, 0x85, 0x5e, 0x3c, 0xa6, 0xf6, 0xbb, 0x9e, 0xdd, 0xd8, 0x85,
-	0x7e, 0xdf, 0xfe, 0x75, 0x55, 0xc4, 0x61, 0xd2, 0xb8, 0x6a, 0x29, 0xb8, 0xc6, 0x46, 0xee, 0xb5,
-	0xe4, 0x0b, 0x98, 0x64, 0x45, 0xc1, 0x6a, 0xce, 0xac, 0xac, 0x99, 0xb5, 0xdf, 0x0b, 0xfb, 0xf1,
-	0x28, 0xbd, 0x4d, 0x1a, 0xde, 0x84, 0xa2, 0x16, 0x95, 0xca, 0x71, 0x59, 0x20, 0x37, 0x6c, 0xcb,
-	0x50, 0xd1, 0xae, 0x2a, 0x7a, 0x82, 0xb0, 0x1b, 0x7c, 0x2e, 0x65, 0xc9, 0x50, 0xaf, 0xc5, 0x99,
-	0x5c, 0x51, 0x09, 0x77, 0x7f, 0x68, 0x1d, 0xfd, 0x02, 0xa6, 0x47, 0x8e, 0x15, 0x96, 0x98, 0x1b,
-	0xa1, 0xac, 0xcd, 0x28, 0xbd, 0x39, 0x81, 0x7a, 0x1c, 0xa1, 0xbf, 0x44, 0xe9, 0x97, 0x07, 0xd3,
-	0xee, 0x3a, 0xb2, 0x85, 0x61, 0xb3, 0x92, 0xdc, 0xb7, 0x0c, 0xcf, 0x85, 0x0a, 0x1e, 0xfe, 0x37,
-	0xec, 0x52, 0x3c, 0xc3, 0xa5, 0x7b, 0x16, 0x72, 0xdd, 0x12, 0xfe, 0x3c, 0x80, 0x20, 0x38, 0xd5,
-	0x3a, 0x38, 0x6c, 0x06, 0xf6, 0x6a, 0x1e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x45, 0xdb, 0x5d,
-	0x9f, 0x68, 0x02, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xd1, 0x4e, 0xfa, 0x30,
+	0x14, 0xc6, 0x33, 0xf8, 0x87, 0xbf, 0x1c, 0x08, 0x90, 0x5e, 0x90, 0x39, 0x35, 0xce, 0x5d, 0x11,
+	0x31, 0x5c, 0xe0, 0x9d, 0x57, 0x62, 0x62, 0x08, 0x57, 0x9a, 0xc1, 0x0b, 0x8c, 0xed, 0x80, 0x8d,
+	0xa3, 0xad, 0x6d, 0x97, 0xf8, 0x1c, 0x3e, 0xb1, 0xd9, 0xec, 0x96, 0x32, 0x09, 0x78, 0xd7, 0xae,
+	0xdf, 0x77, 0xce, 0xef, 0x7c, 0x3b, 0x30, 0x7c, 0x8a, 0xe2, 0xf7, 0x4c, 0x2c, 0x34, 0xee, 0x66,
+	0xb1, 0xa6, 0x9c, 0x4d, 0x84, 0xe4, 0x9a, 0x93, 0xf6, 0x16, 0x19, 0xca, 0x48, 0x63, 0xe2, 0x75,
+	0x97, 0x6f, 0x91, 0xc4, 0xe4, 0xe7, 0x21, 0x58, 0x41, 0xef, 0xf9, 0x13, 0xe3, 0x4c, 0x63, 0x88,
+	0x1f, 0x19, 0x2a, 0x4d, 0x86, 0xd0, 0x12, 0x69, 0xb6, 0xa5, 0xcc, 0x75, 0x7c, 0x67, 0xd4, 0x0e,
+	0xcd, 0x8d, 0x10, 0xf8, 0x47, 0x35, 0xee, 0xdc, 0x86, 0xef, 0x8c, 0xba, 0x61, 0x71, 0xce, 0xb5,
+	0xeb, 0xa2, 0xa1, 0xdb, 0x2c, 0xbe, 0x9a, 0x5b, 0xc0, 0xa0, 0x5f, 0x55, 0x55, 0x82, 0x33, 0x85,
+	0x95, 0xdd, 0xb1, 0xec, 0x73, 0xe8, 0x47, 0x49, 0x42, 0x73, 0xce, 0x28, 0xcd, 0x99, 0x95, 0xdb,
+	0xf0, 0x9b, 0xa3, 0xce, 0xf4, 0x6a, 0x52, 0xf1, 0x4e, 0x42, 0x54, 0x3c, 0x93, 0x31, 0x2e, 0x12,
+	0x64, 0x9a, 0x6e, 0x28, 0xca, 0xb0, 0xee, 0x0a, 0x1e, 0xc0, 0xaf, 0x0f, 0x3e, 0x13, 0x22, 0xa5,
+	0xa8, 0x56, 0xfc, 0xc4, 0x5c, 0x41, 0x0a, 0x37, 0x47, 0xbc, 0x86, 0x7e, 0x0e, 0x83, 0x92, 0x63,
+	0x89, 0x29, 0xc6, 0x9a, 0xcb, 0xa2, 0x4c, 0x67, 0x7a, 0x71, 0x00, 0xb5, 0x94, 0x84, 0xbf, 0x4c,
+	0x01, 0x83, 0xeb, 0x7a, 0xb7, 0x57, 0xc9, 0xb7, 0x12, 0x95, 0x2a, 0x41, 0x7b, 0xfb, 0xa0, 0x64,
+	0x6c, 0x05, 0x7f, 0x2a, 0x9a, 0xdc, 0x6c, 0xff, 0x91, 0xe9, 0x57, 0x03, 0x06, 0xf5, 0x86, 0x64,
+	0x03, 0xed, 0x6a, 0x44, 0x32, 0xb6, 0x0a, 0x9e, 0x0a, 0xd1, 0xbb, 0xfb, 0x9b, 0xd8, 0xa4, 0xf6,
+	0x08, 0xff, 0xcd, 0x1a, 0x90, 0x73, 0xcb, 0xb8, 0xbf, 0x70, 0x9e, 0x77, 0xe8, 0xc9, 0x54, 0x58,
+	0xc1, 0x59, 0x19, 0x0f, 0xb9, 0x3d, 0xd2, 0xbb, 0x96, 0xa1, 0x77, 0x69, 0x69, 0x5f, 0x44, 0x7e,
+	0xb0, 0x44, 0xeb, 0x56, 0xb1, 0xfb, 0xf7, 0xdf, 0x03, 0x00, 0xe6, 0x36, 0x6c, 0x27, 0x2e, 0x03,
+	0x00, 0x00,
 }
@@ -404,6 +404,7 @@ type ObjectStoreClient interface {
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
 	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*Empty, error)
 	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	CreateSignedUploadURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
 }
 
 type objectStoreClient struct {
@@ -534,6 +535,15 @@ func (c *objectStoreClient) CreateSignedURL(ctx context.Context, in *CreateSigne
 	return out, nil
 }
 
+func (c *objectStoreClient) CreateSignedUploadURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error) {
+	out := new(CreateSignedURLResponse)
+	err := grpc.Invoke(ctx, "/generated.ObjectStore/CreateSignedUploadURL", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ObjectStore service
 
 type ObjectStoreServer interface {
@@ -545,6 +555,7 @@ type ObjectStoreServer interface {
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
 	DeleteObject(context.Context, *DeleteObjectRequest) (*Empty, error)
 	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	CreateSignedUploadURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
 }
 
 func RegisterObjectStoreServer(s *grpc.Server, srv ObjectStoreServer) {
@@ -706,6 +717,24 @@ func _ObjectStore_CreateSignedURL_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectStore_CreateSignedUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSignedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).CreateSignedUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ObjectStore/CreateSignedUploadURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).CreateSignedUploadURL(ctx, req.(*CreateSignedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.ObjectStore",
 	HandlerType: (*ObjectStoreServer)(nil),
@@ -734,6 +763,10 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSignedURL",
 			Handler:    _ObjectStore_CreateSignedURL_Handler,
 		},
+		{
+			MethodName: "CreateSignedUploadURL",
+			Handler:    _ObjectStore_CreateSignedUploadURL_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -753,42 +786,42 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xc6, 0x69, 0x54, 0x4f, 0x22, 0x61, 0xb6, 0x55, 0x30, 0x2e, 0x94, 0xb0, 0x02, 0x29,
-	0x08, 0x11, 0xa1, 0x72, 0x29, 0xd0, 0x03, 0x22, 0x44, 0x08, 0x29, 0x52, 0x2b, 0x07, 0x04, 0x07,
-	0x2e, 0x4e, 0x3c, 0x4d, 0x4d, 0x1c, 0x3b, 0xd8, 0x6b, 0x54, 0x1f, 0x79, 0x25, 0x9e, 0x89, 0x07,
-	0x41, 0xbb, 0xde, 0x26, 0xde, 0xfc, 0x10, 0xa9, 0xca, 0x6d, 0x66, 0x76, 0xe7, 0x9b, 0x6f, 0x66,
-	0xfd, 0x8d, 0xe1, 0xee, 0xf9, 0xf0, 0x07, 0x8e, 0xf8, 0x80, 0xc7, 0x09, 0x76, 0x66, 0x49, 0xcc,
-	0x63, 0x6a, 0x8e, 0x31, 0xc2, 0xc4, 0xe3, 0xe8, 0x3b, 0x8d, 0xc1, 0x95, 0x97, 0xa0, 0x5f, 0x1c,
-	0xb0, 0x2b, 0xb0, 0x2e, 0x32, 0x5e, 0x24, 0xb8, 0xf8, 0x33, 0xc3, 0x94, 0xd3, 0x26, 0xd4, 0x66,
-	0x61, 0x36, 0x0e, 0x22, 0x9b, 0xb4, 0x48, 0xdb, 0x74, 0x95, 0x27, 0xe2, 0xc3, 0x6c, 0x34, 0x41,
-	0x6e, 0x57, 0x8a, 0x78, 0xe1, 0x51, 0x0b, 0x8c, 0x09, 0xe6, 0xb6, 0x21, 0x83, 0xc2, 0xa4, 0x14,
-	0xaa, 0xc3, 0xd8, 0xcf, 0xed, 0x6a, 0x8b, 0xb4, 0x1b, 0xae, 0xb4, 0xd9, 0x57, 0x38, 0x28, 0xca,
-	0xf4, 0xae, 0x83, 0x94, 0xa7, 0x3b, 0x2b, 0xc6, 0x3a, 0x70, 0xa8, 0x03, 0xa7, 0xb3, 0x38, 0x4a,
-	0x51, 0x20, 0xa0, 0x8c, 0x48, 0xe4, 0x7d, 0x57, 0x79, 0xec, 0x33, 0x58, 0x1f, 0x71, 0xd7, 0x2d,
-	0xb3, 0x23, 0xd8, 0x7b, 0x9f, 0x73, 0x4c, 0x45, 0xef, 0xbe, 0xc7, 0x3d, 0x09, 0xd4, 0x70, 0xa5,
-	0xcd, 0x7e, 0x13, 0xb8, 0xdf, 0x0f, 0x52, 0xde, 0x8d, 0xa7, 0xd3, 0x38, 0xba, 0x48, 0xf0, 0x32,
-	0xb8, 0xc6, 0x5b, 0x8f, 0xe0, 0x01, 0x98, 0x3e, 0x86, 0xc1, 0x34, 0xe0, 0x98, 0x28, 0x0a, 0x8b,
-	0x80, 0x44, 0x93, 0x05, 0xe4, 0xf4, 0x05, 0x9a, 0xf4, 0xd8, 0x29, 0x38, 0xeb, 0x28, 0xa8, 0x61,
-	0x39, 0xb0, 0x3f, 0x53, 0x31, 0x9b, 0xb4, 0x8c, 0xb6, 0xe9, 0xce, 0x7d, 0xf6, 0x1d, 0xa8, 0xc8,
-	0x2c, 0x26, 0x76, 0x6b, 0xd6, 0x0b, 0x5e, 0x86, 0xc6, 0xeb, 0x19, 0x1c, 0x68, 0xe8, 0x8a, 0x10,
-	0x85, 0xea, 0x04, 0xf3, 0x1b, 0x32, 0xd2, 0x16, 0x9f, 0xd0, 0x07, 0x0c, 0x91, 0xe3, 0xae, 0x1f,
-	0x2f, 0x84, 0x66, 0x37, 0x41, 0x8f, 0xe3, 0x20, 0x18, 0x47, 0xe8, 0x7f, 0x71, 0xfb, 0xbb, 0xd3,
-	0x82, 0x05, 0x06, 0xe7, 0xa1, 0x7c, 0x0c, 0xc3, 0x15, 0x26, 0x7b, 0x0e, 0xf7, 0x56, 0xaa, 0xa9,
-	0xae, 0x2d, 0x30, 0xb2, 0x24, 0x54, 0xb5, 0x84, 0xc9, 0xfe, 0x10, 0x68, 0x96, 0xf4, 0xfc, 0x29,
-	0x0a, 0xb6, 0xf6, 0xdd, 0x83, 0xda, 0x28, 0x8e, 0x2e, 0x83, 0xb1, 0x5d, 0x69, 0x19, 0xed, 0xfa,
-	0xc9, 0x8b, 0xce, 0x5c, 0xfd, 0x9d, 0xf5, 0x50, 0x9d, 0xae, 0xbc, 0xdf, 0x8b, 0x78, 0x92, 0xbb,
-	0x2a, 0xd9, 0x79, 0x0d, 0xf5, 0x52, 0xf8, 0xa6, 0x33, 0xb2, 0xe8, 0xec, 0x10, 0xf6, 0x7e, 0x79,
-	0x61, 0x86, 0x6a, 0x04, 0x85, 0xf3, 0xa6, 0x72, 0x4a, 0x4e, 0xfe, 0x56, 0xa1, 0x5e, 0xaa, 0x44,
-	0xdf, 0x42, 0x55, 0x54, 0xa3, 0x8f, 0xb7, 0x32, 0x71, 0xac, 0xd2, 0x95, 0xde, 0x74, 0xc6, 0x73,
-	0x7a, 0x06, 0xe6, 0x7c, 0x45, 0xd1, 0xa3, 0xd2, 0xf1, 0xf2, 0xe2, 0x5a, 0xcd, 0x6d, 0x13, 0x7a,
-	0x0e, 0x8d, 0xf2, 0x76, 0xa0, 0xc7, 0x2b, 0x14, 0xb4, 0x7d, 0xe4, 0x3c, 0xda, 0x78, 0xae, 0x9e,
-	0xe8, 0x0c, 0xcc, 0xf9, 0xfa, 0xd0, 0xe8, 0x2c, 0x2f, 0x15, 0x8d, 0x8e, 0xdc, 0x0d, 0x2f, 0x09,
-	0xf5, 0x0a, 0x2d, 0xe9, 0x2a, 0xa4, 0x4f, 0x4a, 0x37, 0x37, 0xee, 0x09, 0xe7, 0xe9, 0x96, 0x5b,
-	0x8a, 0x60, 0x1f, 0xea, 0x25, 0x41, 0xd1, 0x87, 0x4b, 0x59, 0xba, 0x8c, 0x9d, 0xe3, 0x4d, 0xc7,
-	0x0a, 0xed, 0x1d, 0x34, 0xca, 0x9a, 0xd3, 0xe6, 0xb7, 0x46, 0x8c, 0x6b, 0xde, 0xef, 0x1b, 0xdc,
-	0x59, 0xfa, 0xdc, 0xb5, 0xef, 0x60, 0xbd, 0xf0, 0x1c, 0xf6, 0xbf, 0x2b, 0x05, 0xb7, 0x61, 0x4d,
-	0xfe, 0xc3, 0x5e, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x4e, 0x76, 0xa3, 0xf1, 0x06, 0x00,
-	0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x5f, 0x6b, 0xd3, 0x50,
+	0x14, 0xe7, 0x36, 0x6d, 0x59, 0x4e, 0x0b, 0xc6, 0xbb, 0x59, 0x63, 0xa6, 0xb3, 0x5e, 0x14, 0x2a,
+	0x62, 0x91, 0xf9, 0x32, 0x75, 0x0f, 0x62, 0x2d, 0x45, 0x28, 0x6c, 0xa4, 0x8a, 0x3e, 0x88, 0x90,
+	0x36, 0x67, 0x5d, 0x6c, 0x9a, 0xc4, 0xe4, 0x46, 0x96, 0x47, 0xbf, 0x92, 0x9f, 0xc1, 0x0f, 0x26,
+	0xb9, 0xb9, 0x6b, 0x93, 0x36, 0x5d, 0x61, 0xf4, 0xed, 0xfc, 0xfd, 0x9d, 0xdf, 0x39, 0x37, 0xe7,
+	0x04, 0xee, 0x9e, 0x8d, 0x7f, 0xe2, 0x84, 0x8f, 0xb8, 0x1f, 0x62, 0x37, 0x08, 0x7d, 0xee, 0x53,
+	0x75, 0x8a, 0x1e, 0x86, 0x16, 0x47, 0xdb, 0x68, 0x8e, 0x2e, 0xad, 0x10, 0xed, 0xcc, 0xc1, 0x2e,
+	0x41, 0x3b, 0x8f, 0x79, 0x96, 0x60, 0xe2, 0xaf, 0x18, 0x23, 0x4e, 0x5b, 0x50, 0x0f, 0xdc, 0x78,
+	0xea, 0x78, 0x3a, 0x69, 0x93, 0x8e, 0x6a, 0x4a, 0x2d, 0xb5, 0x8f, 0xe3, 0xc9, 0x0c, 0xb9, 0x5e,
+	0xc9, 0xec, 0x99, 0x46, 0x35, 0x50, 0x66, 0x98, 0xe8, 0x8a, 0x30, 0xa6, 0x22, 0xa5, 0x50, 0x1d,
+	0xfb, 0x76, 0xa2, 0x57, 0xdb, 0xa4, 0xd3, 0x34, 0x85, 0xcc, 0xbe, 0xc2, 0x7e, 0x56, 0xa6, 0x7f,
+	0xe5, 0x44, 0x3c, 0xda, 0x59, 0x31, 0xd6, 0x85, 0x83, 0x22, 0x70, 0x14, 0xf8, 0x5e, 0x84, 0x29,
+	0x02, 0x0a, 0x8b, 0x40, 0xde, 0x33, 0xa5, 0xc6, 0x3e, 0x83, 0x36, 0xc0, 0x5d, 0xb7, 0xcc, 0x0e,
+	0xa1, 0xf6, 0x21, 0xe1, 0x18, 0xa5, 0xbd, 0xdb, 0x16, 0xb7, 0x04, 0x50, 0xd3, 0x14, 0x32, 0xfb,
+	0x43, 0xe0, 0xc1, 0xd0, 0x89, 0x78, 0xcf, 0x9f, 0xcf, 0x7d, 0xef, 0x3c, 0xc4, 0x0b, 0xe7, 0x0a,
+	0x6f, 0x3d, 0x82, 0x87, 0xa0, 0xda, 0xe8, 0x3a, 0x73, 0x87, 0x63, 0x28, 0x29, 0x2c, 0x0d, 0x02,
+	0x4d, 0x14, 0xd0, 0xab, 0x12, 0x4d, 0x68, 0xec, 0x04, 0x8c, 0x32, 0x0a, 0x72, 0x58, 0x06, 0xec,
+	0x05, 0xd2, 0xa6, 0x93, 0xb6, 0xd2, 0x51, 0xcd, 0x85, 0xce, 0xbe, 0x03, 0x4d, 0x33, 0xb3, 0x89,
+	0xdd, 0x9a, 0xf5, 0x92, 0x97, 0x52, 0xe0, 0xf5, 0x1c, 0xf6, 0x0b, 0xe8, 0x92, 0x10, 0x85, 0xea,
+	0x0c, 0x93, 0x6b, 0x32, 0x42, 0x4e, 0x3f, 0xa1, 0x8f, 0xe8, 0x22, 0xc7, 0x5d, 0x3f, 0x9e, 0x0b,
+	0xad, 0x5e, 0x88, 0x16, 0xc7, 0x91, 0x33, 0xf5, 0xd0, 0xfe, 0x62, 0x0e, 0x77, 0xb7, 0x0b, 0x1a,
+	0x28, 0x9c, 0xbb, 0xe2, 0x31, 0x14, 0x33, 0x15, 0xd9, 0x0b, 0xb8, 0xbf, 0x56, 0x4d, 0x76, 0xad,
+	0x81, 0x12, 0x87, 0xae, 0xac, 0x95, 0x8a, 0xec, 0x2f, 0x81, 0x56, 0x6e, 0x9f, 0x3f, 0x79, 0xce,
+	0xd6, 0xbe, 0xfb, 0x50, 0x9f, 0xf8, 0xde, 0x85, 0x33, 0xd5, 0x2b, 0x6d, 0xa5, 0xd3, 0x38, 0x7e,
+	0xd9, 0x5d, 0x6c, 0x7f, 0xb7, 0x1c, 0xaa, 0xdb, 0x13, 0xf1, 0x7d, 0x8f, 0x87, 0x89, 0x29, 0x93,
+	0x8d, 0x37, 0xd0, 0xc8, 0x99, 0xaf, 0x3b, 0x23, 0xcb, 0xce, 0x0e, 0xa0, 0xf6, 0xdb, 0x72, 0x63,
+	0x94, 0x23, 0xc8, 0x94, 0xb7, 0x95, 0x13, 0x72, 0xfc, 0xaf, 0x06, 0x8d, 0x5c, 0x25, 0xfa, 0x0e,
+	0xaa, 0x69, 0x35, 0xfa, 0x64, 0x2b, 0x13, 0x43, 0xcb, 0x85, 0xf4, 0xe7, 0x01, 0x4f, 0xe8, 0x29,
+	0xa8, 0x8b, 0x13, 0x45, 0x0f, 0x73, 0xee, 0xd5, 0xc3, 0xb5, 0x9e, 0xdb, 0x21, 0xf4, 0x0c, 0x9a,
+	0xf9, 0xeb, 0x40, 0x8f, 0xd6, 0x28, 0x14, 0xee, 0x91, 0xf1, 0x78, 0xa3, 0x5f, 0x3e, 0xd1, 0x29,
+	0xa8, 0x03, 0x2c, 0xa3, 0x33, 0xc0, 0x1b, 0xe8, 0x88, 0xdb, 0xf0, 0x8a, 0x50, 0x0b, 0xe8, 0xfa,
+	0x16, 0xd2, 0xa7, 0xb9, 0xc8, 0x8d, 0x77, 0xc2, 0x78, 0xb6, 0x25, 0x4a, 0x12, 0x1c, 0x42, 0x23,
+	0xb7, 0x50, 0xf4, 0xd1, 0x4a, 0x56, 0x71, 0x8d, 0x8d, 0xa3, 0x4d, 0x6e, 0x89, 0xf6, 0x1e, 0x9a,
+	0xf9, 0x9d, 0x2b, 0xcc, 0xaf, 0x64, 0x19, 0x4b, 0xde, 0xef, 0x1b, 0xdc, 0x59, 0xf9, 0xdc, 0x0b,
+	0xdf, 0x41, 0xf9, 0xe2, 0x19, 0xec, 0xa6, 0x10, 0xc9, 0xed, 0x07, 0xdc, 0x2b, 0xb8, 0x02, 0xd7,
+	0xb7, 0x76, 0x88, 0x3f, 0xae, 0x8b, 0x7f, 0xe4, 0xeb, 0xff, 0x03, 0x00, 0x7c, 0x39, 0x92, 0x9a,
+	0x51, 0x07, 0x00, 0x00,
 }
@@ -156,35 +156,62 @@ func (m *ResourceSelector) GetSelector() string {
 	return ""
 }
 
+type OperationProgress struct {
+	Phase string `protobuf:"bytes,1,opt,name=phase" json:"phase,omitempty"`
+	Err   string `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *OperationProgress) Reset()                    { *m = OperationProgress{} }
+func (m *OperationProgress) String() string            { return proto.CompactTextString(m) }
+func (*OperationProgress) ProtoMessage()               {}
+func (*OperationProgress) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
+
+func (m *OperationProgress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "generated.Empty")
 	proto.RegisterType((*Stack)(nil), "generated.Stack")
 	proto.RegisterType((*StackFrame)(nil), "generated.StackFrame")
 	proto.RegisterType((*ResourceIdentifier)(nil), "generated.ResourceIdentifier")
 	proto.RegisterType((*ResourceSelector)(nil), "generated.ResourceSelector")
+	proto.RegisterType((*OperationProgress)(nil), "generated.OperationProgress")
 }
 
 func init() { proto.RegisterFile("Shared.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x4e, 0xb5, 0x30,
-	0x10, 0x85, 0xc3, 0x05, 0xee, 0xff, 0x33, 0xba, 0xd0, 0x46, 0x93, 0xc6, 0xb8, 0x20, 0xac, 0x58,
-	0x28, 0x0b, 0x4d, 0x7c, 0x03, 0x4d, 0xdc, 0x18, 0x53, 0x9e, 0x00, 0xcb, 0x70, 0x6d, 0xe4, 0xb6,
-	0xa4, 0x94, 0xe4, 0xfa, 0xca, 0x3e, 0x85, 0x69, 0x4b, 0x61, 0x81, 0xbb, 0x39, 0x73, 0x3e, 0xce,
-	0x0c, 0x93, 0xc2, 0x79, 0xfd, 0xd9, 0x68, 0x6c, 0xab, 0x41, 0x2b, 0xa3, 0x48, 0x76, 0x40, 0x89,
-	0xba, 0x31, 0xd8, 0x16, 0xff, 0x20, 0x7d, 0x3e, 0x0e, 0xe6, 0xbb, 0x78, 0x82, 0xb4, 0x36, 0x0d,
-	0xff, 0x22, 0xf7, 0xb0, 0xef, 0x74, 0x73, 0xc4, 0x91, 0x46, 0x79, 0x5c, 0x9e, 0x3d, 0x5c, 0x57,
-	0x0b, 0x5d, 0x39, 0xe2, 0xc5, 0xba, 0x6c, 0x86, 0x8a, 0x77, 0x80, 0xb5, 0x4b, 0x08, 0x24, 0x9d,
-	0xe8, 0x91, 0x46, 0x79, 0x54, 0x66, 0xcc, 0xd5, 0xb6, 0xd7, 0x0b, 0x89, 0x74, 0x97, 0x47, 0x65,
-	0xca, 0x5c, 0x4d, 0x6e, 0xe0, 0x7f, 0x37, 0x49, 0x6e, 0x84, 0x92, 0x34, 0x76, 0xec, 0xa2, 0x8b,
-	0x13, 0x10, 0x86, 0xa3, 0x9a, 0x34, 0xc7, 0xd7, 0x16, 0xa5, 0x11, 0x9d, 0x40, 0x4d, 0xae, 0x20,
-	0x3d, 0x68, 0x35, 0x0d, 0x73, 0xb4, 0x17, 0x36, 0x47, 0xcf, 0xac, 0xcb, 0xcf, 0xd8, 0xa2, 0xc9,
-	0x2d, 0x64, 0xd2, 0xae, 0x38, 0x34, 0x1c, 0xe7, 0x21, 0x6b, 0xc3, 0x6e, 0x65, 0x05, 0x4d, 0xfc,
-	0xa6, 0xb6, 0x2e, 0x7e, 0x22, 0xb8, 0x08, 0xa3, 0x6b, 0xec, 0x91, 0x1b, 0xa5, 0x49, 0x05, 0x44,
-	0x48, 0xde, 0x4f, 0x2d, 0xb6, 0x6f, 0xe1, 0x6b, 0x7f, 0x9b, 0x8c, 0xfd, 0xe1, 0x58, 0x1e, 0x4f,
-	0x1b, 0x7e, 0xe7, 0xf9, 0xad, 0x43, 0xee, 0xe0, 0x32, 0xa4, 0x84, 0xd9, 0x23, 0x8d, 0x1d, 0xbe,
-	0x35, 0x2c, 0x1d, 0x32, 0x56, 0x3a, 0xf1, 0xf4, 0xc6, 0xb0, 0xe7, 0x19, 0xe7, 0xff, 0xa0, 0xa9,
-	0x3f, 0x4f, 0xd0, 0x1f, 0x7b, 0xf7, 0x16, 0x1e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x83,
-	0xa1, 0x97, 0x1b, 0x02, 0x00, 0x00,
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0x49, 0xd3, 0xf4, 0xfb, 0x32, 0x55, 0xb0, 0x83, 0xc2, 0x22, 0x1e, 0x4a, 0x4e, 0x3d,
+	0x68, 0x04, 0x05, 0xdf, 0x40, 0xc1, 0x8b, 0x96, 0xf4, 0x09, 0x62, 0x32, 0x69, 0x83, 0xe9, 0x6e,
+	0x98, 0xdd, 0x40, 0x7d, 0x65, 0x9f, 0x42, 0x76, 0x37, 0x69, 0xc1, 0x78, 0x9b, 0xff, 0xfc, 0x7f,
+	0x99, 0x99, 0xcc, 0x2c, 0x9c, 0x6d, 0x76, 0x39, 0x53, 0x99, 0xb6, 0xac, 0x8c, 0xc2, 0x78, 0x4b,
+	0x92, 0x38, 0x37, 0x54, 0x26, 0xff, 0x20, 0x7a, 0xde, 0xb7, 0xe6, 0x2b, 0x79, 0x82, 0x68, 0x63,
+	0xf2, 0xe2, 0x13, 0xef, 0x60, 0x56, 0x71, 0xbe, 0x27, 0x2d, 0x82, 0x65, 0xb8, 0x9a, 0x3f, 0x5c,
+	0xa5, 0x47, 0x3a, 0x75, 0xc4, 0x8b, 0x75, 0xb3, 0x1e, 0x4a, 0xd6, 0x00, 0xa7, 0x2c, 0x22, 0x4c,
+	0xab, 0xba, 0x21, 0x11, 0x2c, 0x83, 0x55, 0x9c, 0xb9, 0xd8, 0xe6, 0x9a, 0x5a, 0x92, 0x98, 0x2c,
+	0x83, 0x55, 0x94, 0xb9, 0x18, 0xaf, 0xe1, 0x7f, 0xd5, 0xc9, 0xc2, 0xd4, 0x4a, 0x8a, 0xd0, 0xb1,
+	0x47, 0x9d, 0x1c, 0x00, 0x33, 0xd2, 0xaa, 0xe3, 0x82, 0x5e, 0x4b, 0x92, 0xa6, 0xae, 0x6a, 0x62,
+	0xbc, 0x84, 0x68, 0xcb, 0xaa, 0x6b, 0xfb, 0xd2, 0x5e, 0xd8, 0x3a, 0xdc, 0xb3, 0xae, 0x7e, 0x9c,
+	0x1d, 0x35, 0xde, 0x40, 0x2c, 0xed, 0x88, 0x6d, 0x5e, 0x50, 0xdf, 0xe4, 0x94, 0xb0, 0x53, 0x59,
+	0x21, 0xa6, 0x7e, 0x52, 0x1b, 0x27, 0xdf, 0x01, 0x5c, 0x0c, 0xad, 0x37, 0xd4, 0x50, 0x61, 0x14,
+	0x63, 0x0a, 0x58, 0xcb, 0xa2, 0xe9, 0x4a, 0x2a, 0xdf, 0x86, 0xaf, 0xfd, 0x6e, 0xe2, 0xec, 0x0f,
+	0xc7, 0xf2, 0x74, 0x18, 0xf1, 0x13, 0xcf, 0x8f, 0x1d, 0xbc, 0x85, 0xc5, 0x50, 0x65, 0xe8, 0xad,
+	0x45, 0xe8, 0xf0, 0xb1, 0x61, 0x69, 0x3a, 0xfc, 0x4a, 0x8a, 0xa9, 0xa7, 0x47, 0x86, 0x5d, 0x8f,
+	0xee, 0xff, 0x43, 0x44, 0x7e, 0x3d, 0x83, 0x4e, 0xee, 0x61, 0xf1, 0xde, 0xda, 0xbb, 0xd6, 0x4a,
+	0xae, 0x59, 0x6d, 0x99, 0xb4, 0xc6, 0x73, 0x88, 0xda, 0x5d, 0xae, 0xfb, 0x03, 0xe2, 0x1c, 0x42,
+	0x62, 0xf6, 0x9b, 0xfd, 0x98, 0xb9, 0xc7, 0xf3, 0xf8, 0x33, 0x00, 0x9c, 0x25, 0x30, 0xfe, 0x4c,
+	0x02, 0x00, 0x00,
 }
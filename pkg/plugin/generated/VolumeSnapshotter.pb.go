@@ -329,6 +329,30 @@ func (m *VolumeSnapshotterInitRequest) GetConfig() map[string]string {
 	return nil
 }
 
+type SnapshotProgressRequest struct {
+	Plugin     string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	SnapshotID string `protobuf:"bytes,2,opt,name=snapshotID" json:"snapshotID,omitempty"`
+}
+
+func (m *SnapshotProgressRequest) Reset()                    { *m = SnapshotProgressRequest{} }
+func (m *SnapshotProgressRequest) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotProgressRequest) ProtoMessage()               {}
+func (*SnapshotProgressRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{12} }
+
+func (m *SnapshotProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *SnapshotProgressRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CreateVolumeRequest)(nil), "generated.CreateVolumeRequest")
 	proto.RegisterType((*CreateVolumeResponse)(nil), "generated.CreateVolumeResponse")
@@ -342,6 +366,7 @@ func init() {
 	proto.RegisterType((*SetVolumeIDRequest)(nil), "generated.SetVolumeIDRequest")
 	proto.RegisterType((*SetVolumeIDResponse)(nil), "generated.SetVolumeIDResponse")
 	proto.RegisterType((*VolumeSnapshotterInitRequest)(nil), "generated.VolumeSnapshotterInitRequest")
+	proto.RegisterType((*SnapshotProgressRequest)(nil), "generated.SnapshotProgressRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -362,6 +387,7 @@ type VolumeSnapshotterClient interface {
 	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetVolumeID(ctx context.Context, in *GetVolumeIDRequest, opts ...grpc.CallOption) (*GetVolumeIDResponse, error)
 	SetVolumeID(ctx context.Context, in *SetVolumeIDRequest, opts ...grpc.CallOption) (*SetVolumeIDResponse, error)
+	SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*OperationProgress, error)
 }
 
 type volumeSnapshotterClient struct {
@@ -435,6 +461,15 @@ func (c *volumeSnapshotterClient) SetVolumeID(ctx context.Context, in *SetVolume
 	return out, nil
 }
 
+func (c *volumeSnapshotterClient) SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*OperationProgress, error) {
+	out := new(OperationProgress)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/SnapshotProgress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for VolumeSnapshotter service
 
 type VolumeSnapshotterServer interface {
@@ -445,6 +480,7 @@ type VolumeSnapshotterServer interface {
 	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*Empty, error)
 	GetVolumeID(context.Context, *GetVolumeIDRequest) (*GetVolumeIDResponse, error)
 	SetVolumeID(context.Context, *SetVolumeIDRequest) (*SetVolumeIDResponse, error)
+	SnapshotProgress(context.Context, *SnapshotProgressRequest) (*OperationProgress, error)
 }
 
 func RegisterVolumeSnapshotterServer(s *grpc.Server, srv VolumeSnapshotterServer) {
@@ -577,6 +613,24 @@ func _VolumeSnapshotter_SetVolumeID_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeSnapshotter_SnapshotProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).SnapshotProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/SnapshotProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).SnapshotProgress(ctx, req.(*SnapshotProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.VolumeSnapshotter",
 	HandlerType: (*VolumeSnapshotterServer)(nil),
@@ -609,6 +663,10 @@ var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetVolumeID",
 			Handler:    _VolumeSnapshotter_SetVolumeID_Handler,
 		},
+		{
+			MethodName: "SnapshotProgress",
+			Handler:    _VolumeSnapshotter_SnapshotProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "VolumeSnapshotter.proto",
@@ -617,41 +675,43 @@ var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("VolumeSnapshotter.proto", fileDescriptor7) }
 
 var fileDescriptor7 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xda, 0x6e, 0x44, 0x26, 0xa5, 0x0a, 0x9b, 0xa4, 0x58, 0x16, 0x04, 0xe3, 0x0b, 0x51,
-	0x0f, 0x96, 0x48, 0x0f, 0x14, 0x0e, 0x48, 0x51, 0x5d, 0x50, 0xd4, 0x4a, 0x48, 0x76, 0x41, 0x08,
-	0x4e, 0x86, 0x6e, 0x5c, 0x8b, 0xc4, 0x36, 0xde, 0x4d, 0xa5, 0x7c, 0x0c, 0xbf, 0x82, 0xf8, 0x14,
-	0x3e, 0x05, 0xc5, 0xde, 0x24, 0xbb, 0xb1, 0x1d, 0x87, 0x43, 0x6f, 0xde, 0x99, 0x9d, 0x37, 0x6f,
-	0x66, 0xdf, 0x8c, 0xe1, 0xf1, 0xa7, 0x78, 0x3a, 0x9f, 0x11, 0x2f, 0xf2, 0x13, 0x7a, 0x1b, 0x33,
-	0x46, 0x52, 0x3b, 0x49, 0x63, 0x16, 0xe3, 0x66, 0x40, 0x22, 0x92, 0xfa, 0x8c, 0xdc, 0x18, 0x87,
-	0xde, 0xad, 0x9f, 0x92, 0x9b, 0xdc, 0x61, 0xfd, 0x42, 0xd0, 0x39, 0x4f, 0x89, 0xcf, 0x48, 0x1e,
-	0xea, 0x92, 0x9f, 0x73, 0x42, 0x19, 0x3e, 0x86, 0x46, 0x32, 0x9d, 0x07, 0x61, 0xa4, 0x23, 0x13,
-	0x0d, 0x9a, 0x2e, 0x3f, 0xe1, 0x3e, 0x00, 0xe5, 0xe8, 0x63, 0x47, 0x57, 0x32, 0x9f, 0x60, 0x59,
-	0xfa, 0xef, 0x32, 0xa0, 0xeb, 0x45, 0x42, 0x74, 0x35, 0xf7, 0x6f, 0x2c, 0xd8, 0x80, 0x07, 0xf9,
-	0x69, 0xf4, 0x45, 0xd7, 0x32, 0xef, 0xfa, 0x8c, 0x31, 0x68, 0x61, 0x9c, 0x50, 0xfd, 0xc0, 0x44,
-	0x03, 0xd5, 0xcd, 0xbe, 0xad, 0x21, 0x74, 0x65, 0x7a, 0x34, 0x89, 0x23, 0x2a, 0xe0, 0x8c, 0x1d,
-	0xce, 0x70, 0x7d, 0xb6, 0x26, 0xd0, 0x7d, 0x4f, 0x58, 0x1e, 0x30, 0x8e, 0x26, 0x71, 0x5d, 0x4d,
-	0x22, 0x96, 0x22, 0x63, 0x49, 0x7c, 0x55, 0x99, 0xaf, 0x75, 0x09, 0xbd, 0xad, 0x3c, 0x9c, 0x9c,
-	0xdc, 0x04, 0x54, 0x68, 0xc2, 0xaa, 0x50, 0x45, 0x28, 0xf4, 0x2f, 0x82, 0x5e, 0x5e, 0xe9, 0xea,
-	0xf5, 0xee, 0x89, 0x36, 0x7e, 0x0b, 0x1a, 0xf3, 0x03, 0xaa, 0x6b, 0xa6, 0x3a, 0x68, 0x0d, 0x4f,
-	0xec, 0xb5, 0x34, 0xec, 0xd2, 0xfc, 0xf6, 0xb5, 0x1f, 0xd0, 0x8b, 0x88, 0xa5, 0x0b, 0x37, 0x8b,
-	0x33, 0x5e, 0x41, 0x73, 0x6d, 0xc2, 0x6d, 0x50, 0x7f, 0x90, 0x05, 0x67, 0xb6, 0xfc, 0xc4, 0x5d,
-	0x38, 0xb8, 0xf3, 0xa7, 0x73, 0xc2, 0x39, 0xe5, 0x87, 0x37, 0xca, 0x19, 0xb2, 0xce, 0xe0, 0x78,
-	0x3b, 0xc3, 0xa6, 0x61, 0x82, 0xaa, 0xd0, 0xb6, 0xaa, 0xac, 0x0f, 0xd0, 0x73, 0xc8, 0x94, 0xec,
-	0xdf, 0x9b, 0x1a, 0x99, 0x5a, 0x9f, 0x01, 0x6f, 0x9e, 0xce, 0xa9, 0x43, 0x3b, 0x81, 0x76, 0x42,
-	0x52, 0x1a, 0x52, 0x46, 0x22, 0x1e, 0x94, 0x61, 0x1e, 0xba, 0x05, 0xbb, 0xf5, 0x12, 0x3a, 0x12,
-	0xf2, 0x1e, 0x7a, 0x65, 0x80, 0xbd, 0x7b, 0x21, 0x23, 0x65, 0x55, 0xb7, 0xb2, 0x8e, 0xa0, 0xe3,
-	0x95, 0x10, 0x2d, 0x83, 0x47, 0x15, 0xb5, 0xfe, 0x46, 0xf0, 0xa4, 0xb0, 0x71, 0xc6, 0x51, 0x58,
-	0xfb, 0x3c, 0x97, 0xd0, 0xf8, 0x1e, 0x47, 0x93, 0x30, 0xd0, 0x95, 0x4c, 0x84, 0xa7, 0x82, 0x08,
-	0x77, 0x01, 0xda, 0xe7, 0x59, 0x54, 0xae, 0x46, 0x0e, 0x61, 0xbc, 0x86, 0x96, 0x60, 0xfe, 0x1f,
-	0x45, 0x0e, 0xff, 0x68, 0xf0, 0xa8, 0x90, 0x0f, 0x8f, 0x40, 0x5b, 0xe6, 0xc4, 0x2f, 0xf6, 0x64,
-	0x65, 0xb4, 0x85, 0x8b, 0x17, 0xb3, 0x84, 0x2d, 0xf0, 0x57, 0xd0, 0xc5, 0xb5, 0xf5, 0x2e, 0x8d,
-	0x67, 0xab, 0x58, 0xdc, 0x2f, 0x4c, 0x9c, 0xb4, 0x7a, 0x8d, 0x67, 0x95, 0x7e, 0xfe, 0x44, 0x2e,
-	0x3c, 0x94, 0xf6, 0x0e, 0x16, 0x23, 0xca, 0x36, 0x9f, 0x61, 0x56, 0x5f, 0xe0, 0x98, 0x1f, 0xe1,
-	0x48, 0x9e, 0x4d, 0x6c, 0xd6, 0x2d, 0x06, 0xe3, 0xf9, 0x8e, 0x1b, 0x1c, 0xd6, 0x81, 0x23, 0x79,
-	0x70, 0x25, 0xd8, 0xd2, 0x99, 0x2e, 0xe9, 0xe6, 0x15, 0xb4, 0x84, 0x99, 0xc2, 0x4f, 0x4b, 0xab,
-	0x59, 0x0d, 0x8e, 0xd1, 0xaf, 0x72, 0x73, 0x4e, 0x57, 0xd0, 0xf2, 0x2a, 0xd0, 0xbc, 0xdd, 0x68,
-	0x25, 0xf3, 0xf2, 0xad, 0x91, 0xfd, 0x47, 0x4f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xf2,
-	0xb8, 0x2f, 0x7b, 0x07, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x96, 0xe3, 0x34, 0xfa, 0x65, 0xd2, 0x5f, 0x14, 0x26, 0x49, 0x6b, 0x59, 0x25, 0x04, 0x5f,
+	0x88, 0x7a, 0x88, 0x44, 0x7a, 0xa0, 0x20, 0x81, 0x14, 0x35, 0x05, 0x45, 0xad, 0x54, 0x64, 0x07,
+	0x84, 0xe0, 0x64, 0xe8, 0xc6, 0xb5, 0x48, 0xbc, 0xc6, 0xbb, 0xa9, 0x94, 0x17, 0xe1, 0xc6, 0xab,
+	0xf0, 0x2c, 0x3c, 0x0a, 0x8a, 0xbd, 0x4e, 0x76, 0x63, 0xe7, 0x0f, 0x87, 0xde, 0xbc, 0x3b, 0x33,
+	0xdf, 0x7c, 0x33, 0xfb, 0xcd, 0x18, 0x8e, 0x3f, 0xd2, 0xc9, 0x6c, 0x4a, 0x9c, 0xc0, 0x0d, 0xd9,
+	0x1d, 0xe5, 0x9c, 0x44, 0xdd, 0x30, 0xa2, 0x9c, 0x62, 0xd9, 0x23, 0x01, 0x89, 0x5c, 0x4e, 0x6e,
+	0xcd, 0x43, 0xe7, 0xce, 0x8d, 0xc8, 0x6d, 0x62, 0xb0, 0x7e, 0x69, 0x50, 0xbf, 0x88, 0x88, 0xcb,
+	0x49, 0x12, 0x6a, 0x93, 0x1f, 0x33, 0xc2, 0x38, 0x1e, 0x41, 0x29, 0x9c, 0xcc, 0x3c, 0x3f, 0x30,
+	0xb4, 0xb6, 0xd6, 0x29, 0xdb, 0xe2, 0x84, 0x2d, 0x00, 0x26, 0xd0, 0x87, 0x03, 0xa3, 0x10, 0xdb,
+	0xa4, 0x9b, 0x85, 0xfd, 0x3e, 0x06, 0x1a, 0xcd, 0x43, 0x62, 0xe8, 0x89, 0x7d, 0x75, 0x83, 0x26,
+	0xfc, 0x97, 0x9c, 0xfa, 0x9f, 0x8d, 0x62, 0x6c, 0x5d, 0x9e, 0x11, 0xa1, 0xe8, 0xd3, 0x90, 0x19,
+	0x07, 0x6d, 0xad, 0xa3, 0xdb, 0xf1, 0xb7, 0xd5, 0x83, 0x86, 0x4a, 0x8f, 0x85, 0x34, 0x60, 0x12,
+	0xce, 0x70, 0x20, 0x18, 0x2e, 0xcf, 0xd6, 0x18, 0x1a, 0xef, 0x08, 0x4f, 0x02, 0x86, 0xc1, 0x98,
+	0xee, 0xaa, 0x49, 0xc6, 0x2a, 0xa8, 0x58, 0x0a, 0x5f, 0x5d, 0xe5, 0x6b, 0x5d, 0x41, 0x73, 0x2d,
+	0x8f, 0x20, 0xa7, 0x36, 0x41, 0xcb, 0x34, 0x21, 0x2d, 0xb4, 0x20, 0x15, 0xfa, 0x47, 0x83, 0x66,
+	0x52, 0x69, 0xfa, 0x7a, 0x0f, 0x44, 0x1b, 0xdf, 0x40, 0x91, 0xbb, 0x1e, 0x33, 0x8a, 0x6d, 0xbd,
+	0x53, 0xe9, 0x9d, 0x76, 0x97, 0xd2, 0xe8, 0xe6, 0xe6, 0xef, 0x8e, 0x5c, 0x8f, 0x5d, 0x06, 0x3c,
+	0x9a, 0xdb, 0x71, 0x9c, 0xf9, 0x02, 0xca, 0xcb, 0x2b, 0xac, 0x81, 0xfe, 0x9d, 0xcc, 0x05, 0xb3,
+	0xc5, 0x27, 0x36, 0xe0, 0xe0, 0xde, 0x9d, 0xcc, 0x88, 0xe0, 0x94, 0x1c, 0x5e, 0x15, 0xce, 0x35,
+	0xeb, 0x1c, 0x8e, 0xd6, 0x33, 0xac, 0x1a, 0x26, 0xa9, 0x4a, 0x5b, 0x57, 0x95, 0x75, 0x03, 0xcd,
+	0x01, 0x99, 0x90, 0xfd, 0x7b, 0xb3, 0x43, 0xa6, 0xd6, 0x27, 0xc0, 0xd5, 0xd3, 0x0d, 0x76, 0xa1,
+	0x9d, 0x42, 0x2d, 0x24, 0x11, 0xf3, 0x19, 0x27, 0x81, 0x08, 0x8a, 0x31, 0x0f, 0xed, 0xcc, 0xbd,
+	0xf5, 0x1c, 0xea, 0x0a, 0xf2, 0x1e, 0x7a, 0xe5, 0x80, 0xce, 0x83, 0x90, 0x51, 0xb2, 0xea, 0x6b,
+	0x59, 0xfb, 0x50, 0x77, 0x72, 0x88, 0xe6, 0xc1, 0x6b, 0x1b, 0x6a, 0xfd, 0xad, 0xc1, 0x49, 0x66,
+	0xe3, 0x0c, 0x03, 0x7f, 0xe7, 0xf3, 0x5c, 0x41, 0xe9, 0x1b, 0x0d, 0xc6, 0xbe, 0x67, 0x14, 0x62,
+	0x11, 0x9e, 0x49, 0x22, 0xdc, 0x06, 0xd8, 0xbd, 0x88, 0xa3, 0x12, 0x35, 0x0a, 0x08, 0xf3, 0x25,
+	0x54, 0xa4, 0xeb, 0x7f, 0x52, 0xe4, 0x6b, 0x38, 0x4e, 0x13, 0xbd, 0x8f, 0xa8, 0x17, 0x11, 0xc6,
+	0x52, 0xea, 0x55, 0x95, 0x3a, 0x62, 0x56, 0x51, 0xbd, 0x9f, 0x07, 0xf0, 0x28, 0x43, 0x17, 0xfb,
+	0x50, 0x5c, 0x50, 0xc6, 0x67, 0x7b, 0x16, 0x65, 0xd6, 0x24, 0xc7, 0xcb, 0x69, 0xc8, 0xe7, 0xf8,
+	0x05, 0x0c, 0x79, 0xeb, 0xbd, 0x8d, 0xe8, 0x34, 0x8d, 0xc5, 0x56, 0x66, 0x60, 0x95, 0xcd, 0x6d,
+	0x3e, 0xd9, 0x68, 0x17, 0x2f, 0x6c, 0xc3, 0xff, 0xca, 0xda, 0x42, 0x39, 0x22, 0x6f, 0x71, 0x9a,
+	0xed, 0xcd, 0x0e, 0x02, 0xf3, 0x03, 0x54, 0xd5, 0xd1, 0xc6, 0xf6, 0xae, 0xbd, 0x62, 0x3e, 0xdd,
+	0xe2, 0x21, 0x60, 0x07, 0x50, 0x55, 0xe7, 0x5e, 0x81, 0xcd, 0x5d, 0x09, 0x39, 0xdd, 0xbc, 0x86,
+	0x8a, 0x34, 0x92, 0xf8, 0x38, 0xb7, 0x9a, 0x74, 0xee, 0xcc, 0xd6, 0x26, 0xb3, 0xe0, 0x74, 0x0d,
+	0x15, 0x67, 0x03, 0x9a, 0xb3, 0x1d, 0x2d, 0x6f, 0xdc, 0x46, 0x50, 0x5b, 0x57, 0x20, 0x5a, 0x72,
+	0x4c, 0xbe, 0x3c, 0xcd, 0x13, 0xc9, 0xe7, 0x26, 0x5c, 0x7c, 0xf8, 0x34, 0x48, 0x9d, 0xbe, 0x96,
+	0xe2, 0x9f, 0xfb, 0xd9, 0xdf, 0x01, 0x00, 0x27, 0x6e, 0x35, 0xe8, 0x10, 0x08, 0x00, 0x00,
 }
@@ -43,3 +43,17 @@ type ResourceIdentifier struct {
 	Namespace string
 	Name      string
 }
+
+// BackupItemActionProgressReporter is an optional interface of BackupItemActions
+// whose Execute starts work for an item that goes on in the background after it
+// returns, e.g. uploading the item's data. A backup isn't completed until that
+// work is done for all the items. The work of a BackupItemAction that doesn't
+// implement it is done once Execute returns.
+type BackupItemActionProgressReporter interface {
+	// Progress returns the progress of the work Execute started for the item
+	// being backed up by the backup. It's called right after Execute and then
+	// until the work is done, and must return OperationPhaseCompleted if
+	// Execute didn't start any. An error means the progress couldn't be
+	// determined and is checked again later.
+	Progress(item ResourceIdentifier, backup *api.Backup) (OperationProgress, error)
+}
@@ -32,6 +32,27 @@ func (_m *ObjectStore) CreateSignedURL(bucket string, key string, ttl time.Durat
 	return r0, r1
 }
 
+// CreateSignedUploadURL provides a mock function with given fields: bucket, key, ttl
+func (_m *ObjectStore) CreateSignedUploadURL(bucket string, key string, ttl time.Duration) (string, error) {
+	ret := _m.Called(bucket, key, ttl)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) string); ok {
+		r0 = rf(bucket, key, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) error); ok {
+		r1 = rf(bucket, key, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteObject provides a mock function with given fields: bucket, key
 func (_m *ObjectStore) DeleteObject(bucket string, key string) error {
 	ret := _m.Called(bucket, key)
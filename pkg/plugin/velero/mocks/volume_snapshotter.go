@@ -4,6 +4,7 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import runtime "k8s.io/apimachinery/pkg/runtime"
+import velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 // VolumeSnapshotter is an autogenerated mock type for the VolumeSnapshotter type
 type VolumeSnapshotter struct {
@@ -66,6 +67,27 @@ func (_m *VolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
 	return r0
 }
 
+// SnapshotProgress provides a mock function with given fields: snapshotID
+func (_m *VolumeSnapshotter) SnapshotProgress(snapshotID string) (velero.OperationProgress, error) {
+	ret := _m.Called(snapshotID)
+
+	var r0 velero.OperationProgress
+	if rf, ok := ret.Get(0).(func(string) velero.OperationProgress); ok {
+		r0 = rf(snapshotID)
+	} else {
+		r0 = ret.Get(0).(velero.OperationProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(snapshotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolumeID provides a mock function with given fields: pv
 func (_m *VolumeSnapshotter) GetVolumeID(pv runtime.Unstructured) (string, error) {
 	ret := _m.Called(pv)
@@ -19,6 +19,8 @@ package velero
 import (
 	"io"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ObjectStore exposes basic object-storage operations required
@@ -63,3 +65,17 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// SignedUploadURLCreator is an optional interface of ObjectStores that can
+// create pre-signed URLs to upload objects with, such as the backup tarballs
+// that are imported from a local file.
+type SignedUploadURLCreator interface {
+	// CreateSignedUploadURL creates a pre-signed URL that the object with the
+	// given key can be uploaded to in the bucket with an HTTP PUT request, and
+	// that expires after ttl.
+	CreateSignedUploadURL(bucket, key string, ttl time.Duration) (string, error)
+}
+
+// ErrSignedUploadURLNotSupported is returned when creating a pre-signed upload
+// URL with an ObjectStore that doesn't implement SignedUploadURLCreator.
+var ErrSignedUploadURLNotSupported = errors.New("the object store plugin doesn't support creating signed upload URLs")
@@ -54,3 +54,24 @@ type Applicable interface {
 	// AppliesTo returns information about which resources this Responder should be invoked for.
 	AppliesTo() (ResourceSelector, error)
 }
+
+// OperationPhase is the phase of work a plugin keeps doing in the background
+// after the call that started it has returned, e.g. copying the data of a
+// volume snapshot to durable storage.
+type OperationPhase string
+
+const (
+	// OperationPhaseInProgress means the work isn't done yet.
+	OperationPhaseInProgress OperationPhase = "InProgress"
+	// OperationPhaseCompleted means the work is done.
+	OperationPhaseCompleted OperationPhase = "Completed"
+	// OperationPhaseFailed means the work failed and won't be retried.
+	OperationPhaseFailed OperationPhase = "Failed"
+)
+
+// OperationProgress is the progress of work a plugin does in the background.
+type OperationProgress struct {
+	Phase OperationPhase
+	// Err is the reason the work failed, if its phase is OperationPhaseFailed.
+	Err string
+}
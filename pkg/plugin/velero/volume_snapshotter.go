@@ -51,3 +51,15 @@ type VolumeSnapshotter interface {
 	// DeleteSnapshot deletes the specified volume snapshot.
 	DeleteSnapshot(snapshotID string) error
 }
+
+// VolumeSnapshotProgressReporter is an optional interface of VolumeSnapshotters
+// whose snapshots aren't usable as soon as CreateSnapshot returns, e.g. because
+// the provider keeps copying the volume's data in the background. A backup
+// isn't completed until all the snapshots it took are. The snapshots of a
+// VolumeSnapshotter that doesn't implement it are done once they're created.
+type VolumeSnapshotProgressReporter interface {
+	// SnapshotProgress returns the progress of the specified volume snapshot.
+	// An error means the progress couldn't be determined and is checked again
+	// later; a failed snapshot is reported with OperationPhaseFailed.
+	SnapshotProgress(snapshotID string) (OperationProgress, error)
+}
@@ -28,6 +28,7 @@ import (
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -41,6 +42,52 @@ type PodCommandExecutor interface {
 	ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error
 }
 
+// Result is the outcome of a command executed in a container.
+type Result struct {
+	// Container is the container the command was executed in.
+	Container string
+	// ExitCode is the exit code of the command, or nil if it's unknown, for
+	// example when the command timed out.
+	ExitCode *int
+	Stdout   string
+	Stderr   string
+	Duration time.Duration
+}
+
+// ResultPodCommandExecutor is a PodCommandExecutor that also returns the outcome of the
+// commands it executes.
+type ResultPodCommandExecutor interface {
+	PodCommandExecutor
+	// ExecutePodCommandWithResult is like ExecutePodCommand, and also returns the outcome of
+	// the command.
+	ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*Result, error)
+}
+
+// ExecutePodCommandWithResult executes a command in a container in a pod with the executor,
+// and returns the outcome of the command. If the executor doesn't return the outcome of the
+// commands it executes, only the container, the duration and, if the command succeeded, its
+// exit code are known.
+func ExecutePodCommandWithResult(e PodCommandExecutor, log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*Result, error) {
+	if re, ok := e.(ResultPodCommandExecutor); ok {
+		return re.ExecutePodCommandWithResult(log, item, namespace, name, hookName, hook)
+	}
+
+	start := time.Now()
+	err := e.ExecutePodCommand(log, item, namespace, name, hookName, hook)
+	res := &Result{Duration: time.Since(start)}
+	if hook != nil {
+		res.Container = hook.Container
+		pod := new(corev1api.Pod)
+		if res.Container == "" && runtime.DefaultUnstructuredConverter.FromUnstructured(item, pod) == nil && len(pod.Spec.Containers) > 0 {
+			res.Container = pod.Spec.Containers[0].Name
+		}
+	}
+	if err == nil {
+		res.ExitCode = new(int)
+	}
+	return res, err
+}
+
 type poster interface {
 	Post() *rest.Request
 }
@@ -67,39 +114,47 @@ func NewPodCommandExecutor(restClientConfig *rest.Config, restClient poster) Pod
 // possible to ensure the command is terminated when the timeout occurs, so it may continue to run
 // in the background).
 func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
+	_, err := e.ExecutePodCommandWithResult(log, item, namespace, name, hookName, hook)
+	return err
+}
+
+// ExecutePodCommandWithResult is like ExecutePodCommand, and also returns the outcome of the
+// command. The outcome is returned even if the command failed, unless it couldn't be executed
+// at all.
+func (e *defaultPodCommandExecutor) ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*Result, error) {
 	if item == nil {
-		return errors.New("item is required")
+		return nil, errors.New("item is required")
 	}
 	if namespace == "" {
-		return errors.New("namespace is required")
+		return nil, errors.New("namespace is required")
 	}
 	if name == "" {
-		return errors.New("name is required")
+		return nil, errors.New("name is required")
 	}
 	if hookName == "" {
-		return errors.New("hookName is required")
+		return nil, errors.New("hookName is required")
 	}
 	if hook == nil {
-		return errors.New("hook is required")
+		return nil, errors.New("hook is required")
 	}
 
 	localHook := *hook
 
 	pod := new(corev1api.Pod)
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item, pod); err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	if localHook.Container == "" {
 		if err := setDefaultHookContainer(pod, &localHook); err != nil {
-			return err
+			return nil, err
 		}
 	} else if err := ensureContainerExists(pod, localHook.Container); err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(localHook.Command) == 0 {
-		return errors.New("command is required")
+		return nil, errors.New("command is required")
 	}
 
 	switch localHook.OnError {
@@ -140,7 +195,7 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 
 	executor, err := e.streamExecutorFactory.NewSPDYExecutor(e.restClientConfig, "POST", req.URL())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var stdout, stderr bytes.Buffer
@@ -152,6 +207,7 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 
 	errCh := make(chan error)
 
+	start := time.Now()
 	go func() {
 		err = executor.Stream(streamOptions)
 		errCh <- err
@@ -164,16 +220,31 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 		timeoutCh = timer.C
 	}
 
+	res := &Result{Container: localHook.Container}
+
 	select {
 	case err = <-errCh:
 	case <-timeoutCh:
-		return errors.Errorf("timed out after %v", localHook.Timeout.Duration)
+		// the output is still being written by the command, so it's not returned
+		res.Duration = time.Since(start)
+		return res, errors.Errorf("timed out after %v", localHook.Timeout.Duration)
 	}
+	res.Duration = time.Since(start)
 
 	hookLog.Infof("stdout: %s", stdout.String())
 	hookLog.Infof("stderr: %s", stderr.String())
 
-	return err
+	res.Stdout, res.Stderr = stdout.String(), stderr.String()
+	var exitErr utilexec.ExitError
+	switch {
+	case err == nil:
+		res.ExitCode = new(int)
+	case errors.As(err, &exitErr) && exitErr.Exited():
+		code := exitErr.ExitStatus()
+		res.ExitCode = &code
+	}
+
+	return res, err
 }
 
 func ensureContainerExists(pod *corev1api.Pod, container string) error {
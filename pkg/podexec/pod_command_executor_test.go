@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -148,6 +149,7 @@ func TestExecutePodCommand(t *testing.T) {
 		expectedTimeout       time.Duration
 		hookError             error
 		expectedError         string
+		expectedExitCode      *int
 	}{
 		{
 			name:                  "validate defaults",
@@ -175,6 +177,16 @@ func TestExecutePodCommand(t *testing.T) {
 			hookError:             errors.New("hook error"),
 			expectedError:         "hook error",
 		},
+		{
+			name:                  "hook exit code",
+			command:               []string{"some", "command"},
+			expectedContainerName: "foo",
+			expectedErrorMode:     v1.HookErrorModeFail,
+			expectedTimeout:       30 * time.Second,
+			hookError:             utilexec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2},
+			expectedError:         "command terminated with exit code 2",
+			expectedExitCode:      intPtr(2),
+		},
 	}
 
 	for _, test := range tests {
@@ -233,17 +245,58 @@ func TestExecutePodCommand(t *testing.T) {
 			}
 			streamExecutor.On("Stream", expectedStreamOptions).Return(test.hookError)
 
-			err = podCommandExecutor.ExecutePodCommand(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &hook)
+			res, err := podCommandExecutor.ExecutePodCommandWithResult(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &hook)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expectedContainerName, res.Container)
 			if test.expectedError != "" {
 				assert.EqualError(t, err, test.expectedError)
+				assert.Equal(t, test.expectedExitCode, res.ExitCode)
 				return
 			}
 
 			require.NoError(t, err)
+			assert.Equal(t, intPtr(0), res.ExitCode)
 		})
 	}
 }
 
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestExecutePodCommandWithResultWithoutResult(t *testing.T) {
+	pod, err := velerotest.GetAsMap(`
+{
+	"metadata": {
+		"namespace": "namespace",
+		"name": "name"
+	},
+	"spec": {
+		"containers": [
+			{"name": "foo"}
+		]
+	}
+}`)
+	require.NoError(t, err)
+	hook := &v1.ExecHook{Command: []string{"some", "command"}}
+
+	executor := &velerotest.MockPodCommandExecutor{}
+	defer executor.AssertExpectations(t)
+	executor.On("ExecutePodCommand", mock.Anything, pod, "namespace", "name", "hookName", hook).Return(nil).Once()
+	executor.On("ExecutePodCommand", mock.Anything, pod, "namespace", "name", "hookName", hook).Return(errors.New("hook error")).Once()
+
+	// executors that don't return the outcome of the commands only report the container and the exit code of successful commands
+	res, err := ExecutePodCommandWithResult(executor, velerotest.NewLogger(), pod, "namespace", "name", "hookName", hook)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", res.Container)
+	assert.Equal(t, intPtr(0), res.ExitCode)
+
+	res, err = ExecutePodCommandWithResult(executor, velerotest.NewLogger(), pod, "namespace", "name", "hookName", hook)
+	assert.EqualError(t, err, "hook error")
+	assert.Equal(t, "foo", res.Container)
+	assert.Nil(t, res.ExitCode)
+}
+
 func TestEnsureContainerExists(t *testing.T) {
 	pod := &corev1api.Pod{
 		Spec: corev1api.PodSpec{
@@ -28,9 +28,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
@@ -102,15 +105,39 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, nil
 	}
 
-	repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation)
-	if err != nil {
-		return nil, []error{err}
-	}
+	var (
+		err            error
+		repoIdentifier string
+		uploaderType   = uploader.GetUploaderType(backup.Spec.UploaderType)
+	)
+	switch uploaderType {
+	case velerov1api.UploaderTypeRestic:
+		repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation)
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		// get a single non-exclusive lock since we'll wait for all individual
+		// backups to be complete before releasing it.
+		b.repoManager.repoLocker.Lock(repo.Name)
+		defer b.repoManager.repoLocker.Unlock(repo.Name)
+
+		repoIdentifier = repo.Spec.ResticIdentifier
+	case velerov1api.UploaderTypeKopia:
+		// kopia repositories are initialized by the uploader on first use and
+		// handle concurrent access themselves.
+		location := &velerov1api.BackupStorageLocation{}
+		if err := b.repoManager.kbClient.Get(b.ctx, kbclient.ObjectKey{
+			Namespace: backup.Namespace,
+			Name:      backup.Spec.StorageLocation,
+		}, location); err != nil {
+			return nil, []error{errors.Wrap(err, "error getting backup storage location")}
+		}
 
-	// get a single non-exclusive lock since we'll wait for all individual
-	// backups to be complete before releasing it.
-	b.repoManager.repoLocker.Lock(repo.Name)
-	defer b.repoManager.repoLocker.Unlock(repo.Name)
+		repoIdentifier = kopia.GetRepoIdentifier(location, pod.Namespace)
+	default:
+		return nil, []error{errors.Errorf("unsupported uploader type %q", uploaderType)}
+	}
 
 	resultsChan := make(chan *velerov1api.PodVolumeBackup)
 
@@ -123,8 +150,30 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		podVolumeBackups  []*velerov1api.PodVolumeBackup
 		podVolumes        = make(map[string]corev1api.Volume)
 		mountedPodVolumes = sets.String{}
+		// pending are the names of the pod volume backups waited for.
+		pending = sets.String{}
+		// previous are the pod volume backups of the pod's volumes created by
+		// the previous attempts of the backup. They're listed after the results
+		// channel is registered so that none of their results is missed.
+		previous map[string]*velerov1api.PodVolumeBackup
+		// retryable are the volumes of the pending pod volume backups of a
+		// previous attempt, by pod volume backup name, which are backed up
+		// again if the pod volume backup fails, e.g. because the restic
+		// server running it was restarted.
+		retryable = make(map[string]retryableVolume)
 	)
 
+	if backup.Status.Attempts > 1 {
+		list, err := b.repoManager.veleroClient.VeleroV1().PodVolumeBackups(backup.Namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", velerov1api.BackupUIDLabel, backup.UID),
+		})
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "error listing pod volume backups of previous attempts"))
+		} else {
+			previous = podVolumeBackupsByVolume(list.Items, pod)
+		}
+	}
+
 	// put the pod's volumes in a map for efficient lookup below
 	for _, podVolume := range pod.Spec.Volumes {
 		podVolumes[podVolume.Name] = podVolume
@@ -136,7 +185,6 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 	}
 
-	var numVolumeSnapshots int
 	for _, volumeName := range volumesToBackup {
 		volume, ok := podVolumes[volumeName]
 		if !ok {
@@ -177,25 +225,62 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			continue
 		}
 
-		volumeBackup := newPodVolumeBackup(backup, pod, volume, repo.Spec.ResticIdentifier, pvc)
+		// the pod volume backups of a previous attempt that completed are reused,
+		// and the ones still running are waited for. Failed ones are retried.
+		if pvb, ok := previous[volumeName]; ok {
+			switch pvb.Status.Phase {
+			case velerov1api.PodVolumeBackupPhaseCompleted:
+				log.Warnf("Reusing completed pod volume backup %s of a previous attempt for volume %s in pod %s/%s, so the volume's data is older than the resources backed up by this attempt", pvb.Name, volumeName, pod.Namespace, pod.Name)
+				podVolumeBackups = append(podVolumeBackups, pvb)
+				continue
+			case "", velerov1api.PodVolumeBackupPhaseNew, velerov1api.PodVolumeBackupPhaseInProgress:
+				log.Infof("Waiting for pod volume backup %s of a previous attempt for volume %s in pod %s/%s", pvb.Name, volumeName, pod.Namespace, pod.Name)
+				pending.Insert(pvb.Name)
+				retryable[pvb.Name] = retryableVolume{volume: volume, pvc: pvc}
+				continue
+			}
+		}
+
+		volumeBackup := newPodVolumeBackup(backup, pod, volume, repoIdentifier, uploaderType, pvc)
 		if volumeBackup, err = b.repoManager.veleroClient.VeleroV1().PodVolumeBackups(volumeBackup.Namespace).Create(context.TODO(), volumeBackup, metav1.CreateOptions{}); err != nil {
 			errs = append(errs, err)
 			continue
 		}
-		numVolumeSnapshots++
+		pending.Insert(volumeBackup.Name)
 	}
 
 ForEachVolume:
-	for i, count := 0, numVolumeSnapshots; i < count; i++ {
+	for pending.Len() > 0 {
 		select {
 		case <-b.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			if b.ctx.Err() == context.Canceled {
+				errs = append(errs, errors.New("canceled waiting for all PodVolumeBackups to complete"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
+			// results of the pod's other pod volume backups, e.g. the
+			// completed ones of a previous attempt, are ignored.
+			if !pending.Has(res.Name) {
+				continue
+			}
+			pending.Delete(res.Name)
+
 			switch res.Status.Phase {
 			case velerov1api.PodVolumeBackupPhaseCompleted:
 				podVolumeBackups = append(podVolumeBackups, res)
 			case velerov1api.PodVolumeBackupPhaseFailed:
+				if retry, ok := retryable[res.Name]; ok {
+					log.Infof("Pod volume backup %s of a previous attempt failed (%s), backing up volume %s in pod %s/%s again", res.Name, res.Status.Message, retry.volume.Name, pod.Namespace, pod.Name)
+					volumeBackup := newPodVolumeBackup(backup, pod, retry.volume, repoIdentifier, uploaderType, retry.pvc)
+					if volumeBackup, err = b.repoManager.veleroClient.VeleroV1().PodVolumeBackups(volumeBackup.Namespace).Create(context.TODO(), volumeBackup, metav1.CreateOptions{}); err != nil {
+						errs = append(errs, err)
+						continue
+					}
+					pending.Insert(volumeBackup.Name)
+					continue
+				}
 				errs = append(errs, errors.Errorf("pod volume backup failed: %s", res.Status.Message))
 				podVolumeBackups = append(podVolumeBackups, res)
 			}
@@ -209,6 +294,31 @@ ForEachVolume:
 	return podVolumeBackups, errs
 }
 
+// retryableVolume is a pod volume to back up again if the pod volume backup of
+// a previous attempt waited for fails.
+type retryableVolume struct {
+	volume corev1api.Volume
+	pvc    *corev1api.PersistentVolumeClaim
+}
+
+// podVolumeBackupsByVolume returns the latest of the pod volume backups of the
+// pod, by volume name.
+func podVolumeBackupsByVolume(pvbs []velerov1api.PodVolumeBackup, pod *corev1api.Pod) map[string]*velerov1api.PodVolumeBackup {
+	res := make(map[string]*velerov1api.PodVolumeBackup)
+	for i := range pvbs {
+		pvb := &pvbs[i]
+		if pvb.Spec.Pod.UID != pod.UID {
+			continue
+		}
+		if existing, ok := res[pvb.Spec.Volume]; ok && pvb.CreationTimestamp.Before(&existing.CreationTimestamp) {
+			continue
+		}
+		res[pvb.Spec.Volume] = pvb
+	}
+
+	return res
+}
+
 type pvcGetter interface {
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1api.PersistentVolumeClaim, error)
 }
@@ -236,7 +346,7 @@ func isHostPathVolume(volume *corev1api.Volume, pvc *corev1api.PersistentVolumeC
 	return pv.Spec.HostPath != nil, nil
 }
 
-func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
+func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier string, uploaderType velerov1api.UploaderType, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
 	pvb := &velerov1api.PodVolumeBackup{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    backup.Namespace,
@@ -274,6 +384,7 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 			},
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			RepoIdentifier:        repoIdentifier,
+			UploaderType:          uploaderType,
 		},
 	}
 
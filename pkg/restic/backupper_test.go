@@ -19,11 +19,15 @@ package restic
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
 func TestIsHostPathVolume(t *testing.T) {
@@ -139,3 +143,21 @@ func (g *fakePVGetter) Get(ctx context.Context, name string, opts metav1.GetOpti
 
 	return nil, errors.New("item not found")
 }
+
+func TestPodVolumeBackupsByVolume(t *testing.T) {
+	now := time.Now()
+	pod := builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("pod-uid")).Result()
+
+	pvbs := []velerov1api.PodVolumeBackup{
+		*builder.ForPodVolumeBackup("velero", "pvb-1").ObjectMeta(builder.WithCreationTimestamp(now)).PodUID("pod-uid").Volume("vol-1").Phase(velerov1api.PodVolumeBackupPhaseFailed).Result(),
+		*builder.ForPodVolumeBackup("velero", "pvb-2").ObjectMeta(builder.WithCreationTimestamp(now.Add(time.Minute))).PodUID("pod-uid").Volume("vol-1").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+		*builder.ForPodVolumeBackup("velero", "pvb-3").ObjectMeta(builder.WithCreationTimestamp(now)).PodUID("pod-uid").Volume("vol-2").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		*builder.ForPodVolumeBackup("velero", "pvb-4").ObjectMeta(builder.WithCreationTimestamp(now)).PodUID("other-pod-uid").Volume("vol-3").Result(),
+	}
+
+	res := podVolumeBackupsByVolume(pvbs, pod)
+
+	assert.Len(t, res, 2)
+	assert.Equal(t, "pvb-2", res["vol-1"].Name)
+	assert.Equal(t, "pvb-3", res["vol-2"].Name)
+}
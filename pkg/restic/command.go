@@ -17,6 +17,7 @@ limitations under the License.
 package restic
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -34,6 +35,8 @@ type Command struct {
 	Args           []string
 	ExtraFlags     []string
 	Env            []string
+	// Context, if not nil, kills the command's process when it's done.
+	Context context.Context
 }
 
 func (c *Command) RepoName() string {
@@ -77,7 +80,13 @@ func (c *Command) String() string {
 // Cmd returns an exec.Cmd for the command.
 func (c *Command) Cmd() *exec.Cmd {
 	parts := c.StringSlice()
-	cmd := exec.Command(parts[0], parts[1:]...)
+
+	var cmd *exec.Cmd
+	if c.Context != nil {
+		cmd = exec.CommandContext(c.Context, parts[0], parts[1:]...)
+	} else {
+		cmd = exec.Command(parts[0], parts[1:]...)
+	}
 	cmd.Dir = c.Dir
 
 	if len(c.Env) > 0 {
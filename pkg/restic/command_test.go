@@ -17,6 +17,7 @@ limitations under the License.
 package restic
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -104,3 +105,16 @@ func TestCmd(t *testing.T) {
 	assert.Equal(t, c.StringSlice(), execCmd.Args)
 	assert.Equal(t, c.Dir, execCmd.Dir)
 }
+
+func TestCmdWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := &Command{
+		Command: "cmd",
+		Context: ctx,
+	}
+
+	// a command whose context is done is killed, or not even started
+	require.Error(t, c.Cmd().Run())
+}
@@ -31,6 +31,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -147,6 +148,27 @@ func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod
 	return getPodSnapshotAnnotations(pod)
 }
 
+// getUploaderTypeForPod returns the type of the uploader that backed up the volumes
+// of the provided pod, along with the identifier of the repository holding their data.
+// The repository identifier is only returned for uploaders other than restic, whose
+// repositories are looked up from the restic repositories instead. Pod volumes only
+// known from the pod's annotations were always backed up by restic.
+func getUploaderTypeForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod *corev1api.Pod, sourcePodNs string) (velerov1api.UploaderType, string) {
+	for _, pvb := range podVolumeBackups {
+		if !isPVBMatchPod(pvb, pod.GetName(), sourcePodNs) || pvb.Status.SnapshotID == "" {
+			continue
+		}
+
+		uploaderType := uploader.GetUploaderType(pvb.Spec.UploaderType)
+		if uploaderType == velerov1api.UploaderTypeRestic {
+			return uploaderType, ""
+		}
+		return uploaderType, pvb.Spec.RepoIdentifier
+	}
+
+	return velerov1api.UploaderTypeRestic, ""
+}
+
 // GetVolumesToBackup returns a list of volume names to backup for
 // the provided pod.
 // Deprecated: Use GetPodVolumesUsingRestic instead.
@@ -238,6 +260,10 @@ type SnapshotIdentifier struct {
 
 	// SnapshotID is the short ID of the restic snapshot.
 	SnapshotID string
+
+	// UploaderType is the type of the uploader that took the snapshot.
+	// If empty, the snapshot was taken by restic.
+	UploaderType velerov1api.UploaderType
 }
 
 // GetSnapshotsInBackup returns a list of all restic snapshot ids associated with
@@ -261,6 +287,7 @@ func GetSnapshotsInBackup(backup *velerov1api.Backup, podVolumeBackupLister vele
 			VolumeNamespace:       item.Spec.Pod.Namespace,
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			SnapshotID:            item.Status.SnapshotID,
+			UploaderType:          item.Spec.UploaderType,
 		})
 	}
 
@@ -191,6 +191,47 @@ func TestGetVolumeBackupsForPod(t *testing.T) {
 	}
 }
 
+func TestGetUploaderTypeForPod(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Result()
+
+	tests := []struct {
+		name                   string
+		podVolumeBackups       []*velerov1api.PodVolumeBackup
+		expectedUploaderType   velerov1api.UploaderType
+		expectedRepoIdentifier string
+	}{
+		{
+			name:                 "pod without PVBs was backed up by restic",
+			expectedUploaderType: velerov1api.UploaderTypeRestic,
+		},
+		{
+			name: "PVB without uploader type was backed up by restic",
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-1").PodName("pod-1").PodNamespace("ns-1").SnapshotID("snap-1").RepoIdentifier("s3:bucket/restic/ns-1").Result(),
+			},
+			expectedUploaderType: velerov1api.UploaderTypeRestic,
+		},
+		{
+			name: "PVB with kopia uploader type returns its repository identifier",
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-1").PodName("pod-2").PodNamespace("ns-1").SnapshotID("snap-1").Result(),
+				builder.ForPodVolumeBackup("velero", "pvb-2").PodName("pod-1").PodNamespace("ns-1").SnapshotID("").UploaderType(velerov1api.UploaderTypeRestic).Result(),
+				builder.ForPodVolumeBackup("velero", "pvb-3").PodName("pod-1").PodNamespace("ns-1").SnapshotID("snap-3").UploaderType(velerov1api.UploaderTypeKopia).RepoIdentifier("kopia:bucket/kopia/ns-1").Result(),
+			},
+			expectedUploaderType:   velerov1api.UploaderTypeKopia,
+			expectedRepoIdentifier: "kopia:bucket/kopia/ns-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			uploaderType, repoIdentifier := getUploaderTypeForPod(tc.podVolumeBackups, pod, "ns-1")
+			assert.Equal(t, tc.expectedUploaderType, uploaderType)
+			assert.Equal(t, tc.expectedRepoIdentifier, repoIdentifier)
+		})
+	}
+}
+
 func TestGetVolumesToBackup(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -18,6 +18,9 @@ package restic
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
@@ -25,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
@@ -73,3 +77,21 @@ func RepoKeySelector() *corev1api.SecretKeySelector {
 	// for that repo.
 	return builder.ForSecretKeySelector(credentialsSecretName, credentialsKey).Result()
 }
+
+// GetRepoPassword returns the repository key, for uploaders that take the
+// password itself rather than a file holding it.
+func GetRepoPassword(credentialsFileStore credentials.FileStore) (string, error) {
+	file, err := credentialsFileStore.Path(RepoKeySelector())
+	if err != nil {
+		return "", errors.Wrap(err, "error getting repository key")
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(file)
+
+	password, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", errors.Wrap(err, "error reading repository key")
+	}
+
+	return strings.TrimSpace(string(password)), nil
+}
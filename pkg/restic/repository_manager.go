@@ -36,6 +36,7 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
 	veleroexec "github.com/vmware-tanzu/velero/pkg/util/exec"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
@@ -221,6 +222,10 @@ func (rm *repositoryManager) UnlockRepo(repo *velerov1api.ResticRepository) erro
 }
 
 func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdentifier) error {
+	if snapshot.UploaderType == velerov1api.UploaderTypeKopia {
+		return rm.forgetKopiaSnapshot(ctx, snapshot)
+	}
+
 	// We can't wait for this in the constructor, because this informer is coming
 	// from the shared informer factory, which isn't started until *after* the repo
 	// manager is instantiated & passed to the controller constructors. We'd get a
@@ -241,6 +246,36 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 	return rm.exec(ForgetCommand(repo.Spec.ResticIdentifier, snapshot.SnapshotID), repo.Spec.BackupStorageLocation)
 }
 
+// forgetKopiaSnapshot deletes a snapshot from the kopia repository holding it.
+// Kopia repositories handle concurrent access themselves, so no lock is taken.
+func (rm *repositoryManager) forgetKopiaSnapshot(ctx context.Context, snapshot SnapshotIdentifier) error {
+	loc := &velerov1api.BackupStorageLocation{}
+	if err := rm.kbClient.Get(ctx, kbclient.ObjectKey{
+		Namespace: rm.namespace,
+		Name:      snapshot.BackupStorageLocation,
+	}, loc); err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	password, err := GetRepoPassword(rm.credentialsFileStore)
+	if err != nil {
+		return err
+	}
+
+	env, err := CmdEnv(loc, rm.credentialsFileStore)
+	if err != nil {
+		return err
+	}
+
+	repo, err := kopia.Open(ctx, loc, snapshot.VolumeNamespace, password, env, rm.log)
+	if err != nil {
+		return err
+	}
+	defer repo.Close(ctx)
+
+	return repo.DeleteSnapshot(ctx, snapshot.SnapshotID)
+}
+
 func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 	file, err := rm.credentialsFileStore.Path(RepoKeySelector())
 	if err != nil {
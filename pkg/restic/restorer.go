@@ -101,15 +101,20 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 		return nil
 	}
 
-	repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation)
-	if err != nil {
-		return []error{err}
-	}
+	uploaderType, repoIdentifier := getUploaderTypeForPod(data.PodVolumeBackups, data.Pod, data.SourceNamespace)
+	if uploaderType == velerov1api.UploaderTypeRestic {
+		repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation)
+		if err != nil {
+			return []error{err}
+		}
 
-	// get a single non-exclusive lock since we'll wait for all individual
-	// restores to be complete before releasing it.
-	r.repoManager.repoLocker.Lock(repo.Name)
-	defer r.repoManager.repoLocker.Unlock(repo.Name)
+		// get a single non-exclusive lock since we'll wait for all individual
+		// restores to be complete before releasing it.
+		r.repoManager.repoLocker.Lock(repo.Name)
+		defer r.repoManager.repoLocker.Unlock(repo.Name)
+
+		repoIdentifier = repo.Spec.ResticIdentifier
+	}
 
 	resultsChan := make(chan *velerov1api.PodVolumeRestore)
 
@@ -118,6 +123,7 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 	r.resultsLock.Unlock()
 
 	var (
+		err         error
 		errs        []error
 		numRestores int
 		podVolumes  = make(map[string]corev1api.Volume)
@@ -140,7 +146,7 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 			}
 		}
 
-		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, snapshot, repo.Spec.ResticIdentifier, pvc)
+		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, snapshot, repoIdentifier, uploaderType, pvc)
 
 		if err := errorOnly(r.repoManager.veleroClient.VeleroV1().PodVolumeRestores(volumeRestore.Namespace).Create(context.TODO(), volumeRestore, metav1.CreateOptions{})); err != nil {
 			errs = append(errs, errors.WithStack(err))
@@ -153,7 +159,11 @@ ForEachVolume:
 	for i := 0; i < numRestores; i++ {
 		select {
 		case <-r.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			if r.ctx.Err() == context.Canceled {
+				errs = append(errs, errors.New("canceled waiting for all PodVolumeRestores to complete"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
 			if res.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed {
@@ -169,7 +179,7 @@ ForEachVolume:
 	return errs
 }
 
-func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeRestore {
+func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string, uploaderType velerov1api.UploaderType, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeRestore {
 	pvr := &velerov1api.PodVolumeRestore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    restore.Namespace,
@@ -200,6 +210,7 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 			SnapshotID:            snapshot,
 			BackupStorageLocation: backupLocation,
 			RepoIdentifier:        repoIdentifier,
+			UploaderType:          uploaderType,
 		},
 	}
 	if pvc != nil {
@@ -0,0 +1,193 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ItemAction is what a restore did with an item of the backup.
+type ItemAction string
+
+const (
+	// ItemActionCreated means the item was created in the cluster.
+	ItemActionCreated ItemAction = "created"
+
+	// ItemActionUpdated means the item already existed in the cluster and
+	// was updated.
+	ItemActionUpdated ItemAction = "updated"
+
+	// ItemActionSkippedExists means the item already existed in the cluster
+	// and was left as is.
+	ItemActionSkippedExists ItemAction = "skipped-exists"
+
+	// ItemActionSkippedFiltered means the item wasn't restored because it
+	// was excluded, either by the restore's spec or by Velero or a plugin.
+	ItemActionSkippedFiltered ItemAction = "skipped-filtered"
+
+	// ItemActionFailed means the item couldn't be restored.
+	ItemActionFailed ItemAction = "failed"
+
+	// ItemActionWouldCreate means a dry-run restore found that the item
+	// doesn't exist in the cluster and would create it.
+	ItemActionWouldCreate ItemAction = "would-create"
+
+	// ItemActionExistsIdentical means a dry-run restore found that the item
+	// already exists in the cluster and is the same as the backed-up version.
+	ItemActionExistsIdentical ItemAction = "exists-identical"
+
+	// ItemActionExistsDifferent means a dry-run restore found that the item
+	// already exists in the cluster and differs from the backed-up version.
+	ItemActionExistsDifferent ItemAction = "exists-different"
+
+	// ItemActionWouldSkip means a dry-run restore wouldn't restore the item
+	// because it's excluded or its namespace already exists.
+	ItemActionWouldSkip ItemAction = "would-skip"
+)
+
+// ItemStatus is the outcome of restoring an item.
+type ItemStatus struct {
+	Action ItemAction
+	Reason string
+
+	// Diff is the JSON merge patch from the in-cluster version of an item
+	// to its backed-up version, set by dry-run restores for items that
+	// exist in the cluster and differ from the backed-up version.
+	Diff []byte
+}
+
+// RestoredItem is the entry of an item in a restore's item report.
+type RestoredItem struct {
+	GroupResource string     `json:"groupResource"`
+	Namespace     string     `json:"namespace,omitempty"`
+	Name          string     `json:"name"`
+	Action        ItemAction `json:"action"`
+	Reason        string     `json:"reason,omitempty"`
+
+	// Diff is the JSON merge patch from the in-cluster version of the item
+	// to its backed-up version, only set by dry-run restores.
+	Diff json.RawMessage `json:"diff,omitempty"`
+}
+
+// RestoredResourceList returns the item report of the restore, sorted by
+// group resource, namespace and name.
+func (r *Request) RestoredResourceList() []RestoredItem {
+	items := make([]RestoredItem, 0, len(r.RestoredItems))
+	for id, status := range r.RestoredItems {
+		items = append(items, RestoredItem{
+			GroupResource: id.GroupResource.String(),
+			Namespace:     id.Namespace,
+			Name:          id.Name,
+			Action:        status.Action,
+			Reason:        status.Reason,
+			Diff:          status.Diff,
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].GroupResource != items[j].GroupResource {
+			return items[i].GroupResource < items[j].GroupResource
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	return items
+}
+
+// recordItem records the outcome of restoring an item in the restore's
+// item report, replacing any previous record of the item.
+func (ctx *restoreContext) recordItem(id velero.ResourceIdentifier, action ItemAction, reason string) {
+	ctx.recordItemStatus(id, ItemStatus{Action: action, Reason: reason})
+}
+
+// recordItemStatus records the status of an item in the restore's item report,
+// replacing any previous record of the item. A dry-run restore reports items
+// that it would create or skip as such, since it doesn't create or skip them.
+func (ctx *restoreContext) recordItemStatus(id velero.ResourceIdentifier, status ItemStatus) {
+	if ctx.restore.Spec.DryRun {
+		switch status.Action {
+		case ItemActionCreated:
+			status.Action = ItemActionWouldCreate
+		case ItemActionSkippedExists, ItemActionSkippedFiltered:
+			status.Action = ItemActionWouldSkip
+		}
+	}
+	ctx.itemReport[id] = status
+}
+
+// recordNamespace records a namespace that items are restored into as created
+// if it was created by the restore, or else as existing unless it's already
+// been recorded.
+func (ctx *restoreContext) recordNamespace(name string, created bool) {
+	id := velero.ResourceIdentifier{
+		GroupResource: kuberesource.Namespaces,
+		Name:          name,
+	}
+
+	if created {
+		ctx.recordItem(id, ItemActionCreated, "")
+		return
+	}
+	if _, ok := ctx.itemReport[id]; !ok {
+		ctx.recordItem(id, ItemActionSkippedExists, "namespace already exists")
+	}
+}
+
+// recordFilteredItems records the items of the backup in the given namespaces,
+// keyed by their namespace in the backup, as skipped-filtered.
+func (ctx *restoreContext) recordFilteredItems(groupResource schema.GroupResource, itemsByNamespace map[string][]string, reason string) {
+	for namespace, items := range itemsByNamespace {
+		targetNamespace := namespace
+		if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			targetNamespace = target
+		}
+
+		for _, name := range items {
+			ctx.recordItem(velero.ResourceIdentifier{
+				GroupResource: groupResource,
+				Namespace:     targetNamespace,
+				Name:          name,
+			}, ItemActionSkippedFiltered, reason)
+		}
+	}
+}
+
+// lastError returns the last error recorded in the result for the given
+// namespace, or cluster-scoped resources if the namespace is empty, falling
+// back to the last Velero error.
+func (r *Result) lastError(ns string) string {
+	msgs := r.Cluster
+	if ns != "" {
+		msgs = r.Namespaces[ns]
+	}
+	if len(msgs) == 0 {
+		msgs = r.Velero
+	}
+	if len(msgs) == 0 {
+		return ""
+	}
+	return msgs[len(msgs)-1]
+}
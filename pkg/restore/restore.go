@@ -47,6 +47,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -58,6 +59,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
@@ -67,6 +69,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
+// maxRestoreHookResults is the maximum number of hook results recorded in the
+// status of a restore, so that pre-restore hooks executed in many pods don't
+// make the restore too large to store.
+const maxRestoreHookResults = 100
+
 type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
 }
@@ -74,11 +81,25 @@ type VolumeSnapshotterGetter interface {
 type Request struct {
 	*velerov1api.Restore
 
-	Log              logrus.FieldLogger
-	Backup           *velerov1api.Backup
-	PodVolumeBackups []*velerov1api.PodVolumeBackup
-	VolumeSnapshots  []*volume.Snapshot
-	BackupReader     io.Reader
+	Log               logrus.FieldLogger
+	Backup            *velerov1api.Backup
+	PodVolumeBackups  []*velerov1api.PodVolumeBackup
+	VolumeSnapshots   []*volume.Snapshot
+	ItemSnapshots     []*volume.ItemSnapshot
+	BackupReader      io.Reader
+	ResourceModifiers *resourcemodifiers.ResourceModifiers
+
+	// RestoredItems, if not nil, is filled in by the restorer with the outcome
+	// of restoring each item of the backup, keyed by the item's identifier in
+	// the cluster.
+	RestoredItems map[velero.ResourceIdentifier]ItemStatus
+
+	// HookExecutions, if not nil, records the exec hooks executed by the restore.
+	HookExecutions *hook.ExecutionRecorder
+
+	// Context, if not nil, is canceled when the restore's cancellation is
+	// requested, in which case the remaining items aren't restored.
+	Context go_context.Context
 }
 
 // Restorer knows how to restore a backup.
@@ -223,11 +244,16 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	runCtx := req.Context
+	if runCtx == nil {
+		runCtx = go_context.Background()
+	}
+
+	ctx, cancelFunc := go_context.WithTimeout(runCtx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticRestorer restic.Restorer
-	if kr.resticRestorerFactory != nil {
+	if kr.resticRestorerFactory != nil && !req.Restore.Spec.DryRun {
 		resticRestorer, err = kr.resticRestorerFactory.NewRestorer(ctx, req.Restore)
 		if err != nil {
 			return Result{}, Result{Velero: []string{err.Error()}}
@@ -238,12 +264,28 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
-	hooksCtx, hooksCancelFunc := go_context.WithCancel(go_context.Background())
+	var restoreScopedHooks []restoreScopedHook
+	for _, rh := range resourceRestoreHooks {
+		if rh.Scope != velerov1api.RestoreHookScopeRestore {
+			continue
+		}
+		// hooks with the Restore scope apply to any resource, resolve the ones they select
+		rh.Selector.Resources = collections.GetResourceIncludesExcludes(kr.discoveryHelper, rh.Selector.Resources.GetIncludes(), rh.Selector.Resources.GetExcludes())
+		restoreScopedHooks = append(restoreScopedHooks, restoreScopedHook{ResourceRestoreHook: rh})
+	}
+	hooksCtx, hooksCancelFunc := go_context.WithCancel(runCtx)
 	waitExecHookHandler := &hook.DefaultWaitExecHookHandler{
 		PodCommandExecutor: kr.podCommandExecutor,
 		ListWatchFactory: &hook.DefaultListWatchFactory{
 			PodsGetter: kr.podGetter,
 		},
+		ExecutionRecorder: req.HookExecutions,
+	}
+	restoreHookRunner := &hook.RestoreHookRunner{
+		ListWatchFactory: &hook.DefaultListWatchFactory{
+			PodsGetter: kr.podGetter,
+		},
+		WaitExecHookHandler: waitExecHookHandler,
 	}
 
 	pvRestorer := &pvRestorer{
@@ -256,6 +298,17 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		snapshotLocationLister:  snapshotLocationLister,
 	}
 
+	itemReport := req.RestoredItems
+	if itemReport == nil {
+		itemReport = make(map[velero.ResourceIdentifier]ItemStatus)
+	}
+
+	// item snapshots are keyed by the identifier of their item in the backup
+	itemSnapshots := make(map[string]*volume.ItemSnapshot)
+	for _, itemSnapshot := range req.ItemSnapshots {
+		itemSnapshots[itemSnapshot.Spec.ResourceIdentifier] = itemSnapshot
+	}
+
 	restoreCtx := &restoreContext{
 		backup:                     req.Backup,
 		backupReader:               req.BackupReader,
@@ -276,10 +329,12 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		pvsToProvision:             sets.NewString(),
 		pvRestorer:                 pvRestorer,
 		volumeSnapshots:            req.VolumeSnapshots,
+		itemSnapshots:              itemSnapshots,
 		podVolumeBackups:           req.PodVolumeBackups,
 		resourceTerminatingTimeout: kr.resourceTerminatingTimeout,
 		resourceClients:            make(map[resourceClientKey]client.Dynamic),
 		restoredItems:              make(map[velero.ResourceIdentifier]struct{}),
+		itemReport:                 itemReport,
 		renamedPVs:                 make(map[string]string),
 		pvRenamer:                  kr.pvRenamer,
 		discoveryHelper:            kr.discoveryHelper,
@@ -287,9 +342,16 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		resourceRestoreHooks:       resourceRestoreHooks,
 		hooksErrs:                  make(chan error),
 		waitExecHookHandler:        waitExecHookHandler,
+		hookExecutions:             req.HookExecutions,
 		hooksContext:               hooksCtx,
 		hooksCancelFunc:            hooksCancelFunc,
+		restoreHookRunner:          restoreHookRunner,
+		restoreScopedHooks:         restoreScopedHooks,
+		preHookNamespaces:          sets.NewString(),
+		failedPreHookNamespaces:    sets.NewString(),
 		restoreClient:              kr.restoreClient,
+		resourceModifiers:          req.ResourceModifiers,
+		runContext:                 runCtx,
 	}
 
 	return restoreCtx.execute()
@@ -318,10 +380,12 @@ type restoreContext struct {
 	pvsToProvision             sets.String
 	pvRestorer                 PVRestorer
 	volumeSnapshots            []*volume.Snapshot
+	itemSnapshots              map[string]*volume.ItemSnapshot
 	podVolumeBackups           []*velerov1api.PodVolumeBackup
 	resourceTerminatingTimeout time.Duration
 	resourceClients            map[resourceClientKey]client.Dynamic
 	restoredItems              map[velero.ResourceIdentifier]struct{}
+	itemReport                 map[velero.ResourceIdentifier]ItemStatus
 	renamedPVs                 map[string]string
 	pvRenamer                  func(string) (string, error)
 	discoveryHelper            discovery.Helper
@@ -330,8 +394,29 @@ type restoreContext struct {
 	hooksErrs                  chan error
 	resourceRestoreHooks       []hook.ResourceRestoreHook
 	waitExecHookHandler        hook.WaitExecHookHandler
+	hookExecutions             *hook.ExecutionRecorder
 	hooksContext               go_context.Context
 	hooksCancelFunc            go_context.CancelFunc
+	restoreHookRunner          *hook.RestoreHookRunner
+	restoreScopedHooks         []restoreScopedHook
+	preHookNamespaces          sets.String
+	failedPreHookNamespaces    sets.String
+	resourceModifiers          *resourcemodifiers.ResourceModifiers
+	runContext                 go_context.Context
+}
+
+// restoreScopedHook is a restore hook with the Restore scope, and whether it was triggered
+// by an item it applies to.
+type restoreScopedHook struct {
+	hook.ResourceRestoreHook
+
+	// triggered is whether an item the hook applies to was about to be restored, in which
+	// case its pre hooks were executed.
+	triggered bool
+
+	// failed is whether one of its pre hooks with the Fail error mode failed, in which case
+	// the items it applies to aren't restored and its post hooks aren't executed.
+	failed bool
 }
 
 type resourceClientKey struct {
@@ -451,6 +536,9 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 
 	for _, selectedResource := range crdResourceCollection {
+		if ctx.canceled() {
+			break
+		}
 		var w, e Result
 		// Restore this resource
 		processedItems, w, e = ctx.processSelectedResource(
@@ -483,6 +571,9 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 
 	for _, selectedResource := range selectedResourceCollection {
+		if ctx.canceled() {
+			break
+		}
 		var w, e Result
 		// Restore this resource
 		processedItems, w, e = ctx.processSelectedResource(
@@ -552,9 +643,24 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	ctx.log.Info("Done waiting for all post-restore exec hooks to complete")
 
+	if ctx.canceled() {
+		ctx.log.Info("Restore was canceled, not restoring the remaining items")
+		errs.AddVeleroError(errors.New("restore canceled"))
+	} else {
+		e = ctx.runRestoreScopedPostHooks()
+		errs.Merge(&e)
+	}
+
+	ctx.restore.Status.HooksAttempted, ctx.restore.Status.HooksFailed = ctx.hookExecutions.Counts()
+
 	return warnings, errs
 }
 
+// canceled returns whether the restore's cancellation was requested.
+func (ctx *restoreContext) canceled() bool {
+	return ctx.runContext != nil && ctx.runContext.Err() != nil
+}
+
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
 // in the expected total restore count.
@@ -570,6 +676,10 @@ func (ctx *restoreContext) processSelectedResource(
 
 	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
 		for _, selectedItem := range selectedItems {
+			if ctx.canceled() {
+				return processedItems, warnings, errs
+			}
+
 			// If we don't know whether this namespace exists yet, attempt to create
 			// it in order to ensure it exists. Try to get it from the backup tarball
 			// (in order to get any backed-up metadata), but if we don't find it there,
@@ -582,11 +692,7 @@ func (ctx *restoreContext) processSelectedResource(
 					archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
 					selectedItem.targetNamespace,
 				)
-				_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
-					ns,
-					ctx.namespaceClient,
-					ctx.resourceTerminatingTimeout,
-				)
+				nsCreated, err := ctx.ensureNamespace(ns)
 				if err != nil {
 					errs.AddVeleroError(err)
 					continue
@@ -601,6 +707,7 @@ func (ctx *restoreContext) processSelectedResource(
 					}
 					ctx.restoredItems[itemKey] = struct{}{}
 				}
+				ctx.recordNamespace(ns.Name, nsCreated)
 
 				// Keep track of namespaces that we know exist so we don't
 				// have to try to create them multiple times.
@@ -656,6 +763,25 @@ func (ctx *restoreContext) processSelectedResource(
 	return processedItems, warnings, errs
 }
 
+// ensureNamespace ensures that the namespace exists and is ready, creating it if
+// needed, and returns whether it was created. A dry-run restore only checks whether
+// the namespace exists, and returns whether it would be created.
+func (ctx *restoreContext) ensureNamespace(ns *v1.Namespace) (bool, error) {
+	if !ctx.restore.Spec.DryRun {
+		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+		return nsCreated, err
+	}
+
+	_, err := ctx.namespaceClient.Get(go_context.TODO(), ns.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	}
+	return false, nil
+}
+
 // getNamespace returns a namespace API object that we should attempt to
 // create before restoring anything into it. It will come from the backup
 // tarball if it exists, else will be a new one. If from the tarball, it
@@ -702,6 +828,67 @@ func (ctx *restoreContext) getApplicableActions(groupResource schema.GroupResour
 	return actions
 }
 
+// createItemFromSnapshot calls the ItemSnapshotter that took the item snapshot to create
+// the item from it.
+func (ctx *restoreContext) createItemFromSnapshot(obj, itemFromBackup *unstructured.Unstructured, itemSnapshot *volume.ItemSnapshot) (*isv1.CreateItemOutput, error) {
+	for _, snapshotter := range ctx.itemSnapshotterActions {
+		if snapshotter.Name != itemSnapshot.Spec.ItemSnapshotter {
+			continue
+		}
+
+		ctx.log.Infof("Creating item from snapshot %s with item snapshotter %s", itemSnapshot.Status.ProviderSnapshotID, snapshotter.Name)
+		return snapshotter.CreateItemFromSnapshot(go_context.Background(), &isv1.CreateItemInput{
+			SnapshottedItem:  obj,
+			SnapshotID:       itemSnapshot.Status.ProviderSnapshotID,
+			ItemFromBackup:   itemFromBackup,
+			SnapshotMetadata: itemSnapshot.Status.Metadata,
+			Restore:          ctx.restore,
+		})
+	}
+
+	return nil, errors.Errorf("item snapshotter %s not found", itemSnapshot.Spec.ItemSnapshotter)
+}
+
+// restoreAdditionalItems restores the items returned by a plugin as related to an item
+// being restored into the given namespace.
+func (ctx *restoreContext) restoreAdditionalItems(additionalItems []velero.ResourceIdentifier, namespace string) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	for _, additionalItem := range additionalItems {
+		itemPath := archive.GetItemFilePath(ctx.restoreDir, additionalItem.GroupResource.String(), additionalItem.Namespace, additionalItem.Name)
+
+		if _, err := ctx.fileSystem.Stat(itemPath); err != nil {
+			ctx.log.WithError(err).WithFields(logrus.Fields{
+				"additionalResource":          additionalItem.GroupResource.String(),
+				"additionalResourceNamespace": additionalItem.Namespace,
+				"additionalResourceName":      additionalItem.Name,
+			}).Warn("unable to restore additional item")
+			warnings.Add(additionalItem.Namespace, err)
+
+			continue
+		}
+
+		additionalResourceID := getResourceID(additionalItem.GroupResource, additionalItem.Namespace, additionalItem.Name)
+		additionalObj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+		if err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error restoring additional item %s", additionalResourceID))
+		}
+
+		additionalItemNamespace := additionalItem.Namespace
+		if additionalItemNamespace != "" {
+			if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
+				additionalItemNamespace = remapped
+			}
+		}
+
+		w, e := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
+
+	return warnings, errs
+}
+
 func (ctx *restoreContext) getApplicableItemSnapshotters(groupResource schema.GroupResource, namespace string) []framework.ItemSnapshotterResolvedAction {
 	var actions []framework.ItemSnapshotterResolvedAction
 	for _, action := range ctx.itemSnapshotterActions {
@@ -877,7 +1064,41 @@ func getResourceID(groupResource schema.GroupResource, namespace, name string) s
 	return fmt.Sprintf("%s/%s/%s", groupResource.String(), namespace, name)
 }
 
+// restoreItem restores the item and records the outcome in the restore's item report.
 func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (Result, Result) {
+	itemKey := velero.ResourceIdentifier{
+		GroupResource: groupResource,
+		Namespace:     namespace,
+		Name:          obj.GetName(),
+	}
+
+	// items that a failed pre-restore hook applies to aren't restored
+	if _, restored := ctx.restoredItems[itemKey]; !restored {
+		if errs, skip := ctx.runPreRestoreHooks(obj, groupResource, namespace); skip {
+			ctx.recordItemStatus(itemKey, ItemStatus{Action: ItemActionFailed, Reason: "a pre-restore hook failed"})
+			return Result{}, errs
+		}
+	}
+
+	status := new(ItemStatus)
+	warnings, errs := ctx.doRestoreItem(obj, groupResource, namespace, status)
+
+	// items that failed to be restored don't set their status explicitly,
+	// the error they returned with is the last one of their namespace
+	if status.Action == "" {
+		if reason := errs.lastError(namespace); reason != "" {
+			status.Action, status.Reason = ItemActionFailed, reason
+		}
+	}
+	// an item without status has already been processed
+	if status.Action != "" {
+		ctx.recordItemStatus(itemKey, *status)
+	}
+
+	return warnings, errs
+}
+
+func (ctx *restoreContext) doRestoreItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, status *ItemStatus) (Result, Result) {
 	warnings, errs := Result{}, Result{}
 	resourceID := getResourceID(groupResource, namespace, obj.GetName())
 
@@ -890,6 +1111,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			"name":          obj.GetName(),
 			"groupResource": groupResource.String(),
 		}).Info("Not restoring item because resource is excluded")
+		status.Action, status.Reason = ItemActionSkippedFiltered, "resource is excluded"
 		return warnings, errs
 	}
 
@@ -906,6 +1128,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				"name":          obj.GetName(),
 				"groupResource": groupResource.String(),
 			}).Info("Not restoring item because namespace is excluded")
+			status.Action, status.Reason = ItemActionSkippedFiltered, "namespace is excluded"
 			return warnings, errs
 		}
 
@@ -913,7 +1136,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// namespace into which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(ctx.log, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		if _, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
+		if nsCreated, err := ctx.ensureNamespace(nsToEnsure); err != nil {
 			errs.AddVeleroError(err)
 			return warnings, errs
 		} else {
@@ -926,6 +1149,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				}
 				ctx.restoredItems[itemKey] = struct{}{}
 			}
+			ctx.recordNamespace(nsToEnsure.Name, nsCreated)
 		}
 	} else {
 		if boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
@@ -934,6 +1158,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				"name":          obj.GetName(),
 				"groupResource": groupResource.String(),
 			}).Info("Not restoring item because it's cluster-scoped")
+			status.Action, status.Reason = ItemActionSkippedFiltered, "cluster-scoped resources are excluded"
 			return warnings, errs
 		}
 	}
@@ -949,6 +1174,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 	if complete {
 		ctx.log.Infof("%s is complete - skipping", kube.NamespaceAndName(obj))
+		status.Action, status.Reason = ItemActionSkippedFiltered, "item is complete"
 		return warnings, errs
 	}
 
@@ -970,6 +1196,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// to the interface.
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		ctx.log.Infof("Not restoring pod because it's a mirror pod")
+		status.Action, status.Reason = ItemActionSkippedFiltered, "pod is a mirror pod"
 		return warnings, errs
 	}
 
@@ -1018,15 +1245,22 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				// associate it with the restored PVC.
 				obj = resetVolumeBindingInfo(obj)
 
-				// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
-				// uses the original name to look up metadata about the snapshot.
-				ctx.log.Infof("Restoring persistent volume from snapshot.")
-				updatedObj, err := ctx.pvRestorer.executePVAction(obj)
-				if err != nil {
-					errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
-					return warnings, errs
+				if ctx.restore.Spec.DryRun {
+					// A dry run doesn't create volumes from snapshots, the PV is
+					// planned as it is in the backup.
+					ctx.log.Infof("Dry run, not restoring persistent volume from snapshot.")
+					status.Reason = "persistent volume would be restored from a snapshot"
+				} else {
+					// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
+					// uses the original name to look up metadata about the snapshot.
+					ctx.log.Infof("Restoring persistent volume from snapshot.")
+					updatedObj, err := ctx.pvRestorer.executePVAction(obj)
+					if err != nil {
+						errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
+						return warnings, errs
+					}
+					obj = updatedObj
 				}
-				obj = updatedObj
 
 				// VolumeSnapshotter has modified the PV name, we should rename the PV.
 				if oldName != obj.GetName() {
@@ -1064,6 +1298,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
 			ctx.pvsToProvision.Insert(name)
+			status.Action, status.Reason = ItemActionSkippedFiltered, "persistent volume is dynamically re-provisioned"
 
 			// Return early because we don't want to restore the PV itself, we
 			// want to dynamically re-provision it.
@@ -1072,6 +1307,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasDeleteReclaimPolicy(obj.Object):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
 			ctx.pvsToProvision.Insert(name)
+			status.Action, status.Reason = ItemActionSkippedFiltered, "persistent volume is dynamically re-provisioned"
 
 			// Return early because we don't want to restore the PV itself, we
 			// want to dynamically re-provision it.
@@ -1106,6 +1342,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 	for _, action := range ctx.getApplicableActions(groupResource, namespace) {
 		if !action.Selector.Matches(labels.Set(obj.GetLabels())) {
+			status.Action, status.Reason = ItemActionSkippedFiltered, "item doesn't match the selector of a restore item action"
 			return warnings, errs
 		}
 
@@ -1123,6 +1360,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			status.Action, status.Reason = ItemActionSkippedFiltered, "a restore item action discarded it"
 			return warnings, errs
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -1133,34 +1371,46 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 		obj = unstructuredObj
 
-		for _, additionalItem := range executeOutput.AdditionalItems {
-			itemPath := archive.GetItemFilePath(ctx.restoreDir, additionalItem.GroupResource.String(), additionalItem.Namespace, additionalItem.Name)
-
-			if _, err := ctx.fileSystem.Stat(itemPath); err != nil {
-				ctx.log.WithError(err).WithFields(logrus.Fields{
-					"additionalResource":          additionalItem.GroupResource.String(),
-					"additionalResourceNamespace": additionalItem.Namespace,
-					"additionalResourceName":      additionalItem.Name,
-				}).Warn("unable to restore additional item")
-				warnings.Add(additionalItem.Namespace, err)
-
-				continue
-			}
+		w, e := ctx.restoreAdditionalItems(executeOutput.AdditionalItems, namespace)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
 
-			additionalResourceID := getResourceID(additionalItem.GroupResource, additionalItem.Namespace, additionalItem.Name)
-			additionalObj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+	// Items snapshotted by an ItemSnapshotter are created from their snapshot by the same
+	// ItemSnapshotter, after restore item actions have run.
+	itemSnapshotID := volume.ItemSnapshotResourceIdentifier(velero.ResourceIdentifier{
+		GroupResource: groupResource,
+		Namespace:     itemFromBackup.GetNamespace(),
+		Name:          itemFromBackup.GetName(),
+	})
+	if itemSnapshot, ok := ctx.itemSnapshots[itemSnapshotID]; ok {
+		if itemSnapshot.Status.Phase != isv1.SnapshotPhaseCompleted {
+			ctx.log.Warnf("Item snapshot %s of %s is %s, restoring the item from the backup", itemSnapshot.Status.ProviderSnapshotID, resourceID, itemSnapshot.Status.Phase)
+			warnings.Add(namespace, errors.Errorf("item snapshot %s of %s is %s, restored the item from the backup", itemSnapshot.Status.ProviderSnapshotID, resourceID, itemSnapshot.Status.Phase))
+		} else if ctx.restore.Spec.DryRun {
+			ctx.log.Infof("Dry run, not creating %s from item snapshot %s", resourceID, itemSnapshot.Status.ProviderSnapshotID)
+			status.Action, status.Reason = ItemActionWouldCreate, fmt.Sprintf("would be created from a snapshot by item snapshotter %s", itemSnapshot.Spec.ItemSnapshotter)
+			return warnings, errs
+		} else {
+			createOutput, err := ctx.createItemFromSnapshot(obj, itemFromBackup, itemSnapshot)
 			if err != nil {
-				errs.Add(namespace, errors.Wrapf(err, "error restoring additional item %s", additionalResourceID))
+				errs.Add(namespace, errors.Wrapf(err, "error creating %s from item snapshot %s", resourceID, itemSnapshot.Status.ProviderSnapshotID))
+				return warnings, errs
 			}
 
-			additionalItemNamespace := additionalItem.Namespace
-			if additionalItemNamespace != "" {
-				if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
-					additionalItemNamespace = remapped
-				}
+			if createOutput.SkipRestore {
+				ctx.log.Infof("Skipping restore of %s: %v because item snapshotter %s created it", obj.GroupVersionKind().Kind, name, itemSnapshot.Spec.ItemSnapshotter)
+				status.Action, status.Reason = ItemActionCreated, fmt.Sprintf("created from a snapshot by item snapshotter %s", itemSnapshot.Spec.ItemSnapshotter)
+				return warnings, errs
 			}
+			unstructuredObj, ok := createOutput.UpdatedItem.(*unstructured.Unstructured)
+			if !ok {
+				errs.Add(namespace, fmt.Errorf("%s: unexpected type %T", resourceID, createOutput.UpdatedItem))
+				return warnings, errs
+			}
+			obj = unstructuredObj
 
-			w, e := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
+			w, e := ctx.restoreAdditionalItems(createOutput.AdditionalItems, namespace)
 			warnings.Merge(&w)
 			errs.Merge(&e)
 		}
@@ -1209,13 +1459,31 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		obj.SetNamespace(namespace)
 	}
 
+	// Apply the user-provided resource modifier rules. This happens after the
+	// namespace has been remapped so that rules match the target namespace.
+	if ctx.resourceModifiers != nil {
+		if modifierErrs := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.log); len(modifierErrs) > 0 {
+			for _, err := range modifierErrs {
+				errs.Add(namespace, err)
+			}
+			return warnings, errs
+		}
+	}
+
 	// Label the resource with the restore's name and the restored backup's name
 	// for easy identification of all cluster resources created by this restore
 	// and which backup they came from.
 	addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
 
-	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
-	createdObj, restoreErr := resourceClient.Create(obj)
+	var createdObj *unstructured.Unstructured
+	var restoreErr error
+	if ctx.restore.Spec.DryRun {
+		ctx.log.Infof("Planning restore of %s: %v", obj.GroupVersionKind().Kind, name)
+		restoreErr = dryRunCreate(obj, groupResource, resourceClient)
+	} else {
+		ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
+		createdObj, restoreErr = resourceClient.Create(obj)
+	}
 	isAlreadyExistsError, err := isAlreadyExistsError(ctx, obj, restoreErr, resourceClient)
 	if err != nil {
 		errs.Add(namespace, err)
@@ -1226,6 +1494,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		if err != nil {
 			ctx.log.Infof("Error retrieving cluster version of %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
+			status.Action, status.Reason = ItemActionFailed, err.Error()
 			return warnings, errs
 		}
 		// Remove insubstantial metadata.
@@ -1233,21 +1502,39 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		if err != nil {
 			ctx.log.Infof("Error trying to reset metadata for %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
+			status.Action, status.Reason = ItemActionFailed, err.Error()
 			return warnings, errs
 		}
 
+		// Keep a copy of the in-cluster version, which is the base for any patch
+		// computed under the "update" existing resource policy.
+		inCluster := fromCluster.DeepCopy()
+
 		// We know the object from the cluster won't have the backup/restore name
 		// labels, so copy them from the object we attempted to restore.
 		labels := obj.GetLabels()
 		addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
 
 		if !equality.Semantic.DeepEqual(fromCluster, obj) {
+			if ctx.restore.Spec.DryRun {
+				diff, err := generatePatch(fromCluster, obj)
+				if err != nil {
+					ctx.log.Infof("error generating diff for %s: %v", kube.NamespaceAndName(obj), err)
+					warnings.Add(namespace, err)
+					status.Action, status.Reason = ItemActionFailed, err.Error()
+					return warnings, errs
+				}
+				status.Action, status.Reason, status.Diff = ItemActionExistsDifferent, ctx.existingItemPlan(groupResource), diff
+				return warnings, errs
+			}
+
 			switch groupResource {
 			case kuberesource.ServiceAccounts:
 				desired, err := mergeServiceAccounts(fromCluster, obj)
 				if err != nil {
 					ctx.log.Infof("error merging secrets for ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
 					warnings.Add(namespace, err)
+					status.Action, status.Reason = ItemActionFailed, err.Error()
 					return warnings, errs
 				}
 
@@ -1255,30 +1542,64 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if err != nil {
 					ctx.log.Infof("error generating patch for ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
 					warnings.Add(namespace, err)
+					status.Action, status.Reason = ItemActionFailed, err.Error()
 					return warnings, errs
 				}
 
 				if patchBytes == nil {
 					// In-cluster and desired state are the same, so move on to
 					// the next item.
+					status.Action, status.Reason = ItemActionSkippedExists, "item already exists and its secrets are up to date"
 					return warnings, errs
 				}
 
 				_, err = resourceClient.Patch(name, patchBytes)
 				if err != nil {
 					warnings.Add(namespace, err)
+					status.Action, status.Reason = ItemActionFailed, err.Error()
 				} else {
 					ctx.log.Infof("ServiceAccount %s successfully updated", kube.NamespaceAndName(obj))
+					status.Action, status.Reason = ItemActionUpdated, "secrets merged into the existing service account"
 				}
 			default:
+				if ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
+					w, e := ctx.updateExistingResource(inCluster, obj, namespace, resourceClient)
+					warnings.Merge(&w)
+					errs.Merge(&e)
+					switch {
+					case e.lastError(namespace) != "":
+						// the item failed, its status is set from the error
+					case w.lastError(namespace) != "":
+						status.Action, status.Reason = ItemActionUpdated, "only the backup and restore labels were updated: "+w.lastError(namespace)
+					default:
+						status.Action, status.Reason = ItemActionUpdated, "item differed from the backed-up version"
+					}
+					return warnings, errs
+				}
+
 				e := errors.Errorf("could not restore, %s %q already exists. Warning: the in-cluster version is different than the backed-up version.",
 					obj.GetKind(), obj.GetName())
 				warnings.Add(namespace, e)
+				status.Action, status.Reason = ItemActionSkippedExists, "item already exists and differs from the backed-up version"
 			}
 			return warnings, errs
 		}
 
 		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
+		status.Action, status.Reason = ItemActionSkippedExists, "item already exists and is the same as the backed-up version"
+		if ctx.restore.Spec.DryRun {
+			status.Action = ItemActionExistsIdentical
+			return warnings, errs
+		}
+		if ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
+			// Apply the latest backup/restore name labels to the unchanged in-cluster object.
+			w, e := ctx.updateRestoreLabels(inCluster, obj, namespace, resourceClient)
+			warnings.Merge(&w)
+			errs.Merge(&e)
+			if reason := e.lastError(namespace); reason != "" {
+				status.Action, status.Reason = ItemActionFailed, reason
+			}
+		}
 		return warnings, errs
 	}
 
@@ -1288,6 +1609,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		errs.Add(namespace, fmt.Errorf("error restoring %s: %v", resourceID, restoreErr))
 		return warnings, errs
 	}
+	status.Action = ItemActionCreated
+
+	// A dry run stops here, as nothing was created to restore pod volumes into,
+	// run hooks in or wait for.
+	if ctx.restore.Spec.DryRun {
+		return warnings, errs
+	}
 
 	if groupResource == kuberesource.Pods {
 		pod := new(v1.Pod)
@@ -1322,6 +1650,98 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	return warnings, errs
 }
 
+// updateExistingResource patches an in-cluster object that differs from its backed-up version
+// so that it matches the backed-up version, including the backup/restore name labels. If the
+// full patch is rejected (e.g. because it modifies immutable fields), only the backup/restore
+// name labels are patched onto the in-cluster object and a warning is recorded.
+func (ctx *restoreContext) updateExistingResource(inCluster, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	ctx.log.Infof("Attempting to update %s %s to match the backed-up version", obj.GetKind(), kube.NamespaceAndName(obj))
+	patchBytes, err := generatePatch(inCluster, obj)
+	if err != nil {
+		ctx.log.Errorf("error generating patch for %s %s: %v", obj.GetKind(), kube.NamespaceAndName(obj), err)
+		errs.Add(namespace, err)
+		return warnings, errs
+	}
+
+	if patchBytes == nil {
+		// In-cluster and desired state are the same, so move on to
+		// the next item.
+		return warnings, errs
+	}
+
+	if _, err := resourceClient.Patch(obj.GetName(), patchBytes); err != nil {
+		ctx.log.Warnf("error updating %s %s: %v", obj.GetKind(), kube.NamespaceAndName(obj), err)
+		warnings.Add(namespace, errors.Wrapf(err, "could not update %s %q to match the backed-up version", obj.GetKind(), obj.GetName()))
+
+		// Fall back to just applying the backup/restore name labels so the object can
+		// still be identified as belonging to this restore.
+		w, e := ctx.updateRestoreLabels(inCluster, obj, namespace, resourceClient)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+		return warnings, errs
+	}
+
+	ctx.log.Infof("%s %s successfully updated", obj.GetKind(), kube.NamespaceAndName(obj))
+	return warnings, errs
+}
+
+// updateRestoreLabels patches the backup/restore name labels of obj onto the in-cluster object.
+func (ctx *restoreContext) updateRestoreLabels(inCluster, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	labeled := inCluster.DeepCopy()
+	labels := obj.GetLabels()
+	addRestoreLabels(labeled, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+
+	patchBytes, err := generatePatch(inCluster, labeled)
+	if err != nil {
+		ctx.log.Errorf("error generating label patch for %s %s: %v", obj.GetKind(), kube.NamespaceAndName(obj), err)
+		errs.Add(namespace, err)
+		return warnings, errs
+	}
+
+	if patchBytes == nil {
+		return warnings, errs
+	}
+
+	if _, err := resourceClient.Patch(obj.GetName(), patchBytes); err != nil {
+		ctx.log.Errorf("error updating labels of %s %s: %v", obj.GetKind(), kube.NamespaceAndName(obj), err)
+		errs.Add(namespace, err)
+	}
+
+	return warnings, errs
+}
+
+// dryRunCreate stands in for creating obj in a dry-run restore: it returns an
+// AlreadyExists error if obj exists in the cluster, so that existing items are
+// compared to the backed-up version the same way as when restoring them.
+func dryRunCreate(obj *unstructured.Unstructured, groupResource schema.GroupResource, client client.Dynamic) error {
+	_, err := client.Get(obj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	default:
+		return apierrors.NewAlreadyExists(groupResource, obj.GetName())
+	}
+}
+
+// existingItemPlan describes what the restore would do with an item that exists
+// in the cluster and differs from the backed-up version.
+func (ctx *restoreContext) existingItemPlan(groupResource schema.GroupResource) string {
+	switch {
+	case groupResource == kuberesource.ServiceAccounts:
+		return "secrets would be merged into the existing service account"
+	case ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate:
+		return "item would be updated to match the backed-up version"
+	default:
+		return "item would be left as is"
+	}
+}
+
 func isAlreadyExistsError(ctx *restoreContext, obj *unstructured.Unstructured, err error, client client.Dynamic) (bool, error) {
 	if err == nil {
 		return false, nil
@@ -1509,6 +1929,91 @@ func (ctx *restoreContext) waitExec(createdObj *unstructured.Unstructured) {
 	}()
 }
 
+// runPreRestoreHooks executes the pre-restore hooks that must run before the item is restored:
+// the pre hooks of its namespace the first time an item of the namespace is restored, and the
+// pre hooks with the Restore scope the first time an item they apply to is restored. It returns
+// the errors of the hooks it executed, and whether the item must be skipped because a pre hook
+// with the Fail error mode that applies to it failed. A dry-run restore executes no hook.
+func (ctx *restoreContext) runPreRestoreHooks(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (Result, bool) {
+	errs := Result{}
+	if ctx.restore.Spec.DryRun || ctx.restoreHookRunner == nil {
+		return errs, false
+	}
+
+	skip := false
+	if namespace != "" {
+		if !ctx.preHookNamespaces.Has(namespace) {
+			ctx.preHookNamespaces.Insert(namespace)
+
+			results, hookErrs := ctx.restoreHookRunner.RunPreHooksInNamespace(ctx.log.WithField("namespace", namespace), namespace, ctx.resourceRestoreHooks)
+			ctx.addHookResults(results)
+			for _, err := range hookErrs {
+				errs.Add(namespace, errors.Wrap(err, "error executing pre-restore hook"))
+			}
+			if len(hookErrs) > 0 {
+				ctx.failedPreHookNamespaces.Insert(namespace)
+			}
+		}
+		skip = ctx.failedPreHookNamespaces.Has(namespace)
+	}
+
+	for i := range ctx.restoreScopedHooks {
+		rsh := &ctx.restoreScopedHooks[i]
+		if !rsh.AppliesTo(groupResource, namespace, obj.GetLabels()) {
+			continue
+		}
+		if !rsh.triggered {
+			rsh.triggered = true
+
+			results, hookErrs := ctx.restoreHookRunner.RunRestoreScopedHooks(ctx.log, rsh.ResourceRestoreHook, velerov1api.RestoreHookPhasePre)
+			ctx.addHookResults(results)
+			for _, err := range hookErrs {
+				errs.AddVeleroError(errors.Wrap(err, "error executing pre-restore hook"))
+			}
+			rsh.failed = len(hookErrs) > 0
+		}
+		skip = skip || rsh.failed
+	}
+
+	return errs, skip
+}
+
+// addHookResults records the hook results in the restore's status, up to
+// maxRestoreHookResults results. Every hook execution is logged regardless.
+func (ctx *restoreContext) addHookResults(results []velerov1api.RestoreHookResult) {
+	room := maxRestoreHookResults - len(ctx.restore.Status.HookResults)
+	if room <= 0 {
+		return
+	}
+	if len(results) > room {
+		ctx.log.Warnf("Only the first %d hook results are recorded in the restore's status", maxRestoreHookResults)
+		results = results[:room]
+	}
+	ctx.restore.Status.HookResults = append(ctx.restore.Status.HookResults, results...)
+}
+
+// runRestoreScopedPostHooks executes the post hooks with the Restore scope that were triggered
+// by a restored item, once all the items are restored.
+func (ctx *restoreContext) runRestoreScopedPostHooks() Result {
+	errs := Result{}
+	if ctx.restore.Spec.DryRun || ctx.restoreHookRunner == nil {
+		return errs
+	}
+
+	for _, rsh := range ctx.restoreScopedHooks {
+		if !rsh.triggered || rsh.failed {
+			continue
+		}
+		results, hookErrs := ctx.restoreHookRunner.RunRestoreScopedHooks(ctx.log, rsh.ResourceRestoreHook, velerov1api.RestoreHookPhasePost)
+		ctx.addHookResults(results)
+		for _, err := range hookErrs {
+			errs.AddVeleroError(errors.Wrap(err, "error executing post-restore hook"))
+		}
+	}
+
+	return errs
+}
+
 func hasSnapshot(pvName string, snapshots []*volume.Snapshot) bool {
 	for _, snapshot := range snapshots {
 		if snapshot.Spec.PersistentVolumeName == pvName {
@@ -1695,6 +2200,9 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 		gvr, _, err := ctx.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
 		if err != nil {
 			ctx.log.WithField("resource", resource).Infof("Skipping restore of resource because it cannot be resolved via discovery")
+			if resourceItems := backupResources[resource]; resourceItems != nil {
+				ctx.recordFilteredItems(schema.ParseGroupResource(resource), resourceItems.ItemsByNamespace, "resource cannot be resolved via discovery")
+			}
 			continue
 		}
 		groupResource := gvr.GroupResource()
@@ -1712,6 +2220,9 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 		// includes/excludes.
 		if !ctx.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
 			ctx.log.WithField("resource", groupResource.String()).Infof("Skipping restore of resource because the restore spec excludes it")
+			if resourceItems := backupResources[groupResource.String()]; resourceItems != nil {
+				ctx.recordFilteredItems(groupResource, resourceItems.ItemsByNamespace, "resource is excluded")
+			}
 			continue
 		}
 
@@ -1733,6 +2244,7 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 		for namespace, items := range resourceList.ItemsByNamespace {
 			if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
 				ctx.log.Infof("Skipping namespace %s", namespace)
+				ctx.recordFilteredItems(groupResource, map[string][]string{namespace: items}, "namespace is excluded")
 				continue
 			}
 
@@ -1745,11 +2257,13 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 
 			if targetNamespace == "" && boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
 				ctx.log.Infof("Skipping resource %s because it's cluster-scoped", resource)
+				ctx.recordFilteredItems(groupResource, map[string][]string{namespace: items}, "cluster-scoped resources are excluded")
 				continue
 			}
 
 			if targetNamespace == "" && !boolptr.IsSetToTrue(ctx.restore.Spec.IncludeClusterResources) && !ctx.namespaceIncludesExcludes.IncludeEverything() {
 				ctx.log.Infof("Skipping resource %s because it's cluster-scoped and only specific namespaces are included in the restore", resource)
+				ctx.recordFilteredItems(groupResource, map[string][]string{namespace: items}, "cluster-scoped resources are excluded when only specific namespaces are included")
 				continue
 			}
 
@@ -1775,6 +2289,7 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 	restorable := restoreableResource{
 		resource: resource,
 	}
+	groupResource := schema.ParseGroupResource(resource)
 
 	if restorable.selectedItemsByNamespace == nil {
 		restorable.selectedItemsByNamespace = make(map[string][]restoreableItem)
@@ -1817,6 +2332,11 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 		}
 
 		if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
+			ctx.recordItem(velero.ResourceIdentifier{
+				GroupResource: groupResource,
+				Namespace:     targetNamespace,
+				Name:          item,
+			}, ItemActionSkippedFiltered, "item doesn't match the label selector")
 			continue
 		}
 
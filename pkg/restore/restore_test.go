@@ -34,12 +34,17 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/dynamic"
 	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	fcache "k8s.io/client-go/tools/cache/testing"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -47,7 +52,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	resticmocks "github.com/vmware-tanzu/velero/pkg/restic/mocks"
 	"github.com/vmware-tanzu/velero/pkg/test"
@@ -1034,6 +1041,470 @@ func TestRestoreItems(t *testing.T) {
 	}
 }
 
+// TestRestoreExistingResourcePolicy runs restores of items that already exist in the
+// cluster and verifies that the in-cluster items are left alone or updated according
+// to the restore's existing resource policy.
+func TestRestoreExistingResourcePolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		backup       *velerov1api.Backup
+		apiResources []*test.APIResource
+		tarball      io.Reader
+		want         []*test.APIResource
+		wantWarnings bool
+	}{
+		{
+			name:    "changed in-cluster item is left alone with a warning when policy is not set",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "backup")).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "cluster")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "cluster")).Result()),
+			},
+			wantWarnings: true,
+		},
+		{
+			name:    "changed in-cluster item is left alone with a warning when policy is none",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeNone).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "backup")).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "cluster")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "cluster")).Result()),
+			},
+			wantWarnings: true,
+		},
+		{
+			name:    "changed in-cluster item is updated to the backed-up version when policy is update",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeUpdate).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(
+							builder.WithLabels("key-1", "backup"),
+							builder.WithAnnotations("key-2", "backup"),
+						).
+						Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "cluster", "key-3", "cluster")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(
+							builder.WithLabels("key-1", "backup", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+							builder.WithAnnotations("key-2", "backup"),
+						).
+						Result(),
+				),
+			},
+		},
+		{
+			name:    "unchanged in-cluster item gets backup and restore name labels when policy is update",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeUpdate).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "val-1")).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "val-1")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("key-1", "val-1", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       tc.backup,
+				BackupReader: tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, errs)
+			if tc.wantWarnings {
+				assertNonEmptyResults(t, "warning", warnings)
+			} else {
+				assertEmptyResults(t, warnings)
+			}
+			assertRestoredItems(t, h, tc.want)
+		})
+	}
+}
+
+// TestRestoreResourceModifiers runs restores with resource modifier rules and verifies
+// that the rules are applied to the matching items before they're created.
+func TestRestoreResourceModifiers(t *testing.T) {
+	modifiers := &resourcemodifiers.ResourceModifiers{
+		Version: "v1",
+		ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+			{
+				Conditions: resourcemodifiers.Conditions{
+					GroupResource:     "pods",
+					ResourceNameRegex: "^pod-1$",
+					Namespaces:        []string{"ns-2"},
+				},
+				Patches: []resourcemodifiers.JSONPatch{
+					{Operation: "add", Path: "/metadata/annotations", Value: map[string]interface{}{"modified": "true"}},
+				},
+			},
+		},
+	}
+	require.NoError(t, modifiers.Validate())
+
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().NamespaceMappings("ns-1", "ns-2").Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			).
+			Done(),
+		ResourceModifiers: modifiers,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assertRestoredItems(t, h, []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-2", "pod-1").
+				ObjectMeta(
+					builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+					builder.WithAnnotations("modified", "true"),
+				).
+				Result(),
+			builder.ForPod("ns-2", "pod-2").
+				ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).
+				Result(),
+		),
+	})
+}
+
+func TestRestoreItemReport(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods(builder.ForPod("ns-1", "pod-2").Result()))
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().ExcludedNamespaces("ns-2").Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+				builder.ForPod("ns-2", "pod-3").Result(),
+			).
+			Done(),
+		RestoredItems: make(map[velero.ResourceIdentifier]ItemStatus),
+	}
+	h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	want := []RestoredItem{
+		{GroupResource: "namespaces", Name: "ns-1", Action: ItemActionCreated},
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: ItemActionCreated},
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-2", Action: ItemActionSkippedExists, Reason: "item already exists and is the same as the backed-up version"},
+		{GroupResource: "pods", Namespace: "ns-2", Name: "pod-3", Action: ItemActionSkippedFiltered, Reason: "namespace is excluded"},
+	}
+	assert.Equal(t, want, data.RestoredResourceList())
+}
+
+// TestRestoreCanceled runs a restore whose context is canceled and verifies
+// that it doesn't restore any items and reports that it was canceled.
+func TestRestoreCanceled(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+			).
+			Done(),
+		Context: ctx,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assert.Empty(t, warnings.Velero)
+	assert.Equal(t, []string{"restore canceled"}, errs.Velero)
+	assertAPIContents(t, h, map[*test.APIResource][]string{test.Pods(): {}})
+}
+
+// TestRestoreDryRun runs a dry-run restore and verifies that it plans what it would
+// do with each item of the backup without creating anything in the cluster.
+func TestRestoreDryRun(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-2").Result(),
+		builder.ForPod("ns-1", "pod-3").ObjectMeta(builder.WithLabels("key-1", "cluster")).Result(),
+	))
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().ExcludedNamespaces("ns-2").DryRun(true).Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+				builder.ForPod("ns-1", "pod-3").ObjectMeta(builder.WithLabels("key-1", "backup")).Result(),
+				builder.ForPod("ns-2", "pod-4").Result(),
+			).
+			Done(),
+		RestoredItems: make(map[velero.ResourceIdentifier]ItemStatus),
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+
+	want := []RestoredItem{
+		{GroupResource: "namespaces", Name: "ns-1", Action: ItemActionWouldCreate},
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: ItemActionWouldCreate},
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-2", Action: ItemActionExistsIdentical, Reason: "item already exists and is the same as the backed-up version"},
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-3", Action: ItemActionExistsDifferent, Reason: "item would be left as is", Diff: json.RawMessage(`{"metadata":{"labels":{"key-1":"backup"}}}`)},
+		{GroupResource: "pods", Namespace: "ns-2", Name: "pod-4", Action: ItemActionWouldSkip, Reason: "namespace is excluded"},
+	}
+	assert.Equal(t, want, data.RestoredResourceList())
+
+	// nothing was created or changed in the cluster
+	_, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(context.TODO(), "pod-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = h.KubeClient.CoreV1().Namespaces().Get(context.TODO(), "ns-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	assertRestoredItems(t, h, []*test.APIResource{
+		test.Pods(builder.ForPod("ns-1", "pod-3").ObjectMeta(builder.WithLabels("key-1", "cluster")).Result()),
+	})
+}
+
+// fakeItemSnapshotter is a test fake for the isv1.ItemSnapshotter interface that
+// creates items from snapshots by annotating them with the snapshot ID.
+type fakeItemSnapshotter struct {
+	skipRestore bool
+	created     []string
+}
+
+func (s *fakeItemSnapshotter) Name() string {
+	return "velero.io/fake"
+}
+
+func (s *fakeItemSnapshotter) Init(config map[string]string) error {
+	return nil
+}
+
+func (s *fakeItemSnapshotter) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (s *fakeItemSnapshotter) AlsoHandles(input *isv1.AlsoHandlesInput) ([]velero.ResourceIdentifier, error) {
+	panic("AlsoHandles should not be used for restores")
+}
+
+func (s *fakeItemSnapshotter) SnapshotItem(ctx context.Context, input *isv1.SnapshotItemInput) (*isv1.SnapshotItemOutput, error) {
+	panic("SnapshotItem should not be used for restores")
+}
+
+func (s *fakeItemSnapshotter) Progress(input *isv1.ProgressInput) (*isv1.ProgressOutput, error) {
+	panic("Progress should not be used for restores")
+}
+
+func (s *fakeItemSnapshotter) DeleteSnapshot(ctx context.Context, input *isv1.DeleteSnapshotInput) error {
+	panic("DeleteSnapshot should not be used for restores")
+}
+
+func (s *fakeItemSnapshotter) CreateItemFromSnapshot(ctx context.Context, input *isv1.CreateItemInput) (*isv1.CreateItemOutput, error) {
+	s.created = append(s.created, input.SnapshotID)
+
+	obj := input.SnapshottedItem.(*unstructured.Unstructured).DeepCopy()
+	obj.SetAnnotations(map[string]string{"snapshot": input.SnapshotID})
+
+	return &isv1.CreateItemOutput{
+		UpdatedItem: obj,
+		SkipRestore: s.skipRestore,
+	}, nil
+}
+
+// TestRestoreWithItemSnapshotters runs restores of backups with item snapshots and
+// verifies that the items are created from completed snapshots by the item snapshotter
+// that took them.
+func TestRestoreWithItemSnapshotters(t *testing.T) {
+	itemSnapshot := func(item, snapshotID string, phase isv1.SnapshotPhase) *volume.ItemSnapshot {
+		return &volume.ItemSnapshot{
+			Spec: volume.ItemSnapshotSpec{
+				ItemSnapshotter:    "velero.io/fake",
+				BackupName:         "backup-1",
+				ResourceIdentifier: item,
+			},
+			Status: volume.ItemSnapshotStatus{
+				ProviderSnapshotID: snapshotID,
+				Phase:              phase,
+			},
+		}
+	}
+
+	restoredPod := func(ns, name string, annotations ...string) *corev1api.Pod {
+		opts := []builder.ObjectMetaOpt{builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")}
+		if len(annotations) > 0 {
+			opts = append(opts, builder.WithAnnotations(annotations...))
+		}
+		return builder.ForPod(ns, name).ObjectMeta(opts...).Result()
+	}
+
+	tests := []struct {
+		name          string
+		restore       *velerov1api.Restore
+		itemSnapshots []*volume.ItemSnapshot
+		skipRestore   bool
+		wantCreated   []string
+		wantWarnings  bool
+		want          []*test.APIResource
+	}{
+		{
+			name:          "items with a completed snapshot are created from it",
+			restore:       defaultRestore().Result(),
+			itemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pods/ns-1/pod-1", "snap-1", isv1.SnapshotPhaseCompleted)},
+			wantCreated:   []string{"snap-1"},
+			want: []*test.APIResource{
+				test.Pods(
+					restoredPod("ns-1", "pod-1", "snapshot", "snap-1"),
+					restoredPod("ns-1", "pod-2"),
+				),
+			},
+		},
+		{
+			name:          "item snapshots are found by the item's namespace in the backup",
+			restore:       defaultRestore().NamespaceMappings("ns-1", "ns-2").Result(),
+			itemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pods/ns-1/pod-2", "snap-2", isv1.SnapshotPhaseCompleted)},
+			wantCreated:   []string{"snap-2"},
+			want: []*test.APIResource{
+				test.Pods(
+					restoredPod("ns-2", "pod-1"),
+					restoredPod("ns-2", "pod-2", "snapshot", "snap-2"),
+				),
+			},
+		},
+		{
+			name:          "items created by the item snapshotter are not created by velero",
+			restore:       defaultRestore().Result(),
+			itemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pods/ns-1/pod-1", "snap-1", isv1.SnapshotPhaseCompleted)},
+			skipRestore:   true,
+			wantCreated:   []string{"snap-1"},
+			want: []*test.APIResource{
+				test.Pods(
+					restoredPod("ns-1", "pod-2"),
+				),
+			},
+		},
+		{
+			name:          "items with a failed snapshot are restored from the backup",
+			restore:       defaultRestore().Result(),
+			itemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pods/ns-1/pod-1", "snap-1", isv1.SnapshotPhaseFailed)},
+			wantWarnings:  true,
+			want: []*test.APIResource{
+				test.Pods(
+					restoredPod("ns-1", "pod-1"),
+					restoredPod("ns-1", "pod-2"),
+				),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods())
+
+			snapshotter := &fakeItemSnapshotter{skipRestore: tc.skipRestore}
+
+			data := Request{
+				Log:     h.log,
+				Restore: tc.restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("pods",
+						builder.ForPod("ns-1", "pod-1").Result(),
+						builder.ForPod("ns-1", "pod-2").Result(),
+					).
+					Done(),
+				ItemSnapshots: tc.itemSnapshots,
+			}
+			warnings, errs := h.restorer.RestoreWithResolvers(
+				data,
+				framework.NewRestoreItemActionResolver(nil),
+				framework.NewItemSnapshotterResolver([]isv1.ItemSnapshotter{snapshotter}),
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, errs)
+			if tc.wantWarnings {
+				assert.NotEmpty(t, warnings.Namespaces)
+			} else {
+				assertEmptyResults(t, warnings)
+			}
+			assert.Equal(t, tc.wantCreated, snapshotter.created)
+			assertRestoredItems(t, h, tc.want)
+		})
+	}
+}
+
 // recordResourcesAction is a restore item action that can be configured
 // to run for specific resources/namespaces and simply records the items
 // that it is executed for.
@@ -3179,3 +3650,142 @@ func TestIsAlreadyExistsError(t *testing.T) {
 		})
 	}
 }
+
+// fakeListWatchFactory lists the pods of a namespace from its fake source.
+type fakeListWatchFactory struct {
+	sources map[string]*fcache.FakeControllerSource
+}
+
+func (f *fakeListWatchFactory) NewListWatch(namespace string, selector fields.Selector) cache.ListerWatcher {
+	if _, ok := f.sources[namespace]; !ok {
+		f.sources[namespace] = fcache.NewFakeControllerSource()
+	}
+	return f.sources[namespace]
+}
+
+// failingWaitExecHookHandler fails the hooks executed in the given pods.
+type failingWaitExecHookHandler struct {
+	failingPods sets.String
+	executed    []string
+}
+
+func (h *failingWaitExecHookHandler) HandleHooks(_ context.Context, _ logrus.FieldLogger, pod *corev1api.Pod, byContainer map[string][]hook.PodExecRestoreHook) []error {
+	for _, hooks := range byContainer {
+		for _, hook := range hooks {
+			h.executed = append(h.executed, hook.HookName)
+		}
+	}
+	if h.failingPods.Has(pod.Name) {
+		return []error{errors.New("hook failed")}
+	}
+	return nil
+}
+
+// TestRunPreRestoreHooks verifies that pre-restore hooks are executed once per namespace or
+// once per restore, and that the items a failed pre hook applies to are skipped.
+func TestRunPreRestoreHooks(t *testing.T) {
+	restore := defaultRestore().Result()
+	restore.Spec.Hooks.Resources = []velerov1api.RestoreResourceHookSpec{
+		{
+			Name:          "freeze",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			PreHooks: []velerov1api.RestorePreHook{
+				{Exec: &velerov1api.ExecRestoreHook{Command: []string{"freeze"}, OnError: velerov1api.HookErrorModeFail}},
+			},
+		},
+		{
+			Name:               "lock",
+			Scope:              velerov1api.RestoreHookScopeRestore,
+			IncludedNamespaces: []string{"ns-2"},
+			Pod: &velerov1api.RestoreHookPod{
+				Namespace:     "ops",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "ops"}},
+			},
+			PreHooks: []velerov1api.RestorePreHook{
+				{Exec: &velerov1api.ExecRestoreHook{Command: []string{"lock"}, OnError: velerov1api.HookErrorModeContinue}},
+			},
+			PostHooks: []velerov1api.RestoreResourceHook{
+				{Exec: &velerov1api.ExecRestoreHook{Command: []string{"unlock"}, OnError: velerov1api.HookErrorModeContinue}},
+			},
+		},
+	}
+	resourceRestoreHooks, err := hook.GetRestoreHooksFromSpec(&restore.Spec.Hooks)
+	require.NoError(t, err)
+
+	listWatchFactory := &fakeListWatchFactory{sources: map[string]*fcache.FakeControllerSource{
+		"ns-1": fcache.NewFakeControllerSource(),
+		"ops":  fcache.NewFakeControllerSource(),
+	}}
+	listWatchFactory.sources["ns-1"].Add(builder.ForPod("ns-1", "db").ObjectMeta(builder.WithLabels("app", "db")).Containers(&corev1api.Container{Name: "db"}).Result())
+	listWatchFactory.sources["ops"].Add(builder.ForPod("ops", "ops").ObjectMeta(builder.WithLabels("app", "ops")).Containers(&corev1api.Container{Name: "ops"}).Result())
+	handler := &failingWaitExecHookHandler{failingPods: sets.NewString("db")}
+
+	ctx := &restoreContext{
+		restore:              restore,
+		log:                  logrus.StandardLogger(),
+		resourceRestoreHooks: resourceRestoreHooks,
+		restoreHookRunner: &hook.RestoreHookRunner{
+			ListWatchFactory:    listWatchFactory,
+			WaitExecHookHandler: handler,
+		},
+		restoreScopedHooks: []restoreScopedHook{
+			{ResourceRestoreHook: resourceRestoreHooks[1]},
+		},
+		preHookNamespaces:       sets.NewString(),
+		failedPreHookNamespaces: sets.NewString(),
+	}
+
+	item := func(namespace, name string) *unstructured.Unstructured {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.ForSecret(namespace, name).Result())
+		require.NoError(t, err)
+		return &unstructured.Unstructured{Object: obj}
+	}
+
+	// the pre hook of ns-1 fails in the db pod, the items of ns-1 are skipped
+	errs, skip := ctx.runPreRestoreHooks(item("ns-1", "secret-1"), kuberesource.Secrets, "ns-1")
+	assert.True(t, skip)
+	assert.Equal(t, []string{"error executing pre-restore hook: hook failed"}, errs.Namespaces["ns-1"])
+	errs, skip = ctx.runPreRestoreHooks(item("ns-1", "secret-2"), kuberesource.Secrets, "ns-1")
+	assert.True(t, skip)
+	assert.Empty(t, errs.Namespaces)
+
+	// the lock hook applies to the items of ns-2 and runs once in the ops pod
+	_, skip = ctx.runPreRestoreHooks(item("ns-2", "secret-1"), kuberesource.Secrets, "ns-2")
+	assert.False(t, skip)
+	_, skip = ctx.runPreRestoreHooks(item("ns-2", "secret-2"), kuberesource.Secrets, "ns-2")
+	assert.False(t, skip)
+	assert.Equal(t, []string{"freeze", "lock"}, handler.executed)
+
+	errs = ctx.runRestoreScopedPostHooks()
+	assert.Empty(t, errs.Velero)
+	assert.Equal(t, []string{"freeze", "lock", "lock"}, handler.executed)
+
+	assert.Equal(t, []velerov1api.RestoreHookResult{
+		{Name: "freeze", Phase: velerov1api.RestoreHookPhasePre, Pod: "ns-1/db", Container: "db", Message: "hook failed"},
+		{Name: "lock", Phase: velerov1api.RestoreHookPhasePre, Pod: "ops/ops", Container: "ops", Succeeded: true},
+		{Name: "lock", Phase: velerov1api.RestoreHookPhasePost, Pod: "ops/ops", Container: "ops", Succeeded: true},
+	}, restore.Status.HookResults)
+}
+
+func TestAddHookResults(t *testing.T) {
+	restore := defaultRestore().Result()
+	ctx := &restoreContext{
+		restore: restore,
+		log:     logrus.StandardLogger(),
+	}
+
+	result := velerov1api.RestoreHookResult{Name: "freeze", Phase: velerov1api.RestoreHookPhasePre, Succeeded: true}
+	results := make([]velerov1api.RestoreHookResult, maxRestoreHookResults-1)
+	for i := range results {
+		results[i] = result
+	}
+
+	ctx.addHookResults(results)
+	assert.Len(t, restore.Status.HookResults, maxRestoreHookResults-1)
+
+	ctx.addHookResults([]velerov1api.RestoreHookResult{result, result})
+	assert.Len(t, restore.Status.HookResults, maxRestoreHookResults)
+
+	ctx.addHookResults([]velerov1api.RestoreHookResult{result})
+	assert.Len(t, restore.Status.HookResults, maxRestoreHookResults)
+}
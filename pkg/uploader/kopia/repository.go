@@ -0,0 +1,387 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/repo/blob/azure"
+	"github.com/kopia/kopia/repo/blob/gcs"
+	"github.com/kopia/kopia/repo/blob/s3"
+	"github.com/kopia/kopia/repo/content"
+	"github.com/kopia/kopia/repo/maintenance"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+)
+
+const (
+	// repoUsername and repoHostname identify Velero as the client of kopia
+	// repositories. All Velero pods use the same identity, so that whichever
+	// of them deletes a snapshot is allowed to run the repository's maintenance.
+	repoUsername = "velero"
+	repoHostname = "velero"
+
+	// maximum sizes of the local caches of repository contents and metadata,
+	// the defaults of the kopia CLI. Kopia doesn't cache anything without them.
+	maxCacheSizeBytes         = 5000 << 20
+	maxMetadataCacheSizeBytes = 5000 << 20
+
+	// configuration keys of the backup storage location used to reach the
+	// object store.
+	regionKey                = "region"
+	s3URLKey                 = "s3Url"
+	insecureSkipTLSVerifyKey = "insecureSkipTLSVerify"
+	storageDomainKey         = "storageDomain"
+
+	// environment variables holding the object store credentials, as set up
+	// for the backup storage location's provider by restic.CmdEnv.
+	awsProfileEnvVar         = "AWS_PROFILE"
+	awsCredentialsFileEnvVar = "AWS_SHARED_CREDENTIALS_FILE"
+	azureAccountNameEnvVar   = "AZURE_ACCOUNT_NAME"
+	azureAccountKeyEnvVar    = "AZURE_ACCOUNT_KEY"
+	gcpCredentialsFileEnvVar = "GOOGLE_APPLICATION_CREDENTIALS"
+)
+
+// Repository is a connection to the kopia repository holding the data of the
+// pod volumes of a single namespace in a backup storage location.
+type Repository struct {
+	repo.DirectRepository
+
+	repoIdentifier string
+	conn           *connection
+	closed         bool
+	log            logrus.FieldLogger
+}
+
+// connection is an open connection to a kopia repository, shared by all the
+// Repositories opened for it until the last of them is closed.
+type connection struct {
+	repo     repo.DirectRepository
+	password string
+	err      error
+	// ready is closed once the connection attempt is over.
+	ready chan struct{}
+	users int
+}
+
+var (
+	connectionsLock sync.Mutex
+	// connections are the open connections to kopia repositories, keyed by
+	// repository identifier.
+	connections = map[string]*connection{}
+)
+
+// GetRepoIdentifier returns the identifier of the kopia repository holding the
+// data of the pod volumes of the given namespace. Kopia repositories are located
+// by their backup storage location and volume namespace, so the identifier is
+// the repository's path within the backup storage location's object storage.
+func GetRepoIdentifier(location *velerov1api.BackupStorageLocation, volumeNamespace string) string {
+	var bucket, prefix string
+	if location.Spec.ObjectStorage != nil {
+		bucket = location.Spec.ObjectStorage.Bucket
+		prefix = location.Spec.ObjectStorage.Prefix
+	}
+
+	return "kopia:" + path.Join(bucket, persistence.NewObjectStoreLayout(prefix).GetKopiaDir(), volumeNamespace)
+}
+
+// Open connects to the kopia repository holding the data of the pod volumes of
+// the given namespace in the backup storage location, initializing it if it
+// doesn't exist yet. env holds the environment variables, in the format var=val,
+// with the credentials of the backup storage location's provider. Repositories
+// opened at the same time share their connection, and the repository's local
+// config and cache are kept across connections. The repository must be closed
+// by the caller.
+func Open(ctx context.Context, location *velerov1api.BackupStorageLocation, volumeNamespace, password string, env []string, log logrus.FieldLogger) (*Repository, error) {
+	st, err := newStorage(ctx, location, volumeNamespace, envLookup(env))
+	if err != nil {
+		return nil, errors.Wrap(err, "error connecting to the backup storage location")
+	}
+
+	return openStorage(ctx, st, GetRepoIdentifier(location, volumeNamespace), password, log)
+}
+
+// openStorage returns a Repository for the kopia repository with the given
+// identifier in the storage, reusing the open connection to it if there's one.
+func openStorage(ctx context.Context, st blob.Storage, repoIdentifier, password string, log logrus.FieldLogger) (*Repository, error) {
+	connectionsLock.Lock()
+	conn, ok := connections[repoIdentifier]
+	if !ok {
+		conn = &connection{password: password, ready: make(chan struct{})}
+		connections[repoIdentifier] = conn
+	}
+	conn.users++
+	connectionsLock.Unlock()
+
+	if ok {
+		<-conn.ready
+	} else {
+		conn.repo, conn.err = connect(ctx, st, repoIdentifier, password, log)
+		if conn.err != nil {
+			connectionsLock.Lock()
+			delete(connections, repoIdentifier)
+			connectionsLock.Unlock()
+		}
+		close(conn.ready)
+	}
+
+	if conn.err != nil {
+		return nil, conn.err
+	}
+
+	rep := &Repository{
+		DirectRepository: conn.repo,
+		repoIdentifier:   repoIdentifier,
+		conn:             conn,
+		log:              log,
+	}
+
+	if conn.password != password {
+		rep.Close(ctx)
+		return nil, errors.New("error opening kopia repository: the password doesn't match the one of its open connection")
+	}
+
+	return rep, nil
+}
+
+// configDir returns the directory holding the local config file and cache of
+// the kopia repository with the given identifier. It's kept across connections
+// so that the repository's index blobs aren't fetched again by each of them.
+func configDir(repoIdentifier string) (string, error) {
+	// If VELERO_SCRATCH_DIR is defined, put the kopia config within it like the
+	// restic cache. If not, use the user's cache directory.
+	base := os.Getenv("VELERO_SCRATCH_DIR")
+	if base == "" {
+		var err error
+		if base, err = os.UserCacheDir(); err != nil {
+			base = os.TempDir()
+		}
+	}
+
+	hash := sha256.Sum256([]byte(repoIdentifier))
+	dir := filepath.Join(base, ".cache", "kopia", hex.EncodeToString(hash[:16]))
+
+	return dir, errors.WithStack(os.MkdirAll(dir, 0700))
+}
+
+func connect(ctx context.Context, st blob.Storage, repoIdentifier, password string, log logrus.FieldLogger) (repo.DirectRepository, error) {
+	initialized := false
+	if err := repo.Initialize(ctx, st, &repo.NewRepositoryOptions{}, password); err != nil {
+		if !errors.Is(err, repo.ErrAlreadyInitialized) {
+			return nil, errors.Wrap(err, "error initializing kopia repository")
+		}
+	} else {
+		initialized = true
+		log.Info("Initialized kopia repository")
+	}
+
+	configDir, err := configDir(repoIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	configFile := filepath.Join(configDir, "repository.config")
+
+	connectOpts := &repo.ConnectOptions{
+		ClientOptions: repo.ClientOptions{
+			Username: repoUsername,
+			Hostname: repoHostname,
+		},
+		CachingOptions: content.CachingOptions{
+			CacheDirectory:            filepath.Join(configDir, "cache"),
+			MaxCacheSizeBytes:         maxCacheSizeBytes,
+			MaxMetadataCacheSizeBytes: maxMetadataCacheSizeBytes,
+		},
+	}
+	// Connecting rewrites the config file, picking up changes of the storage's
+	// credentials, and keeps the cache.
+	if err := repo.Connect(ctx, configFile, st, password, connectOpts); err != nil {
+		return nil, errors.Wrap(err, "error connecting to kopia repository")
+	}
+
+	r, err := repo.Open(ctx, configFile, password, &repo.Options{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening kopia repository")
+	}
+
+	dr, ok := r.(repo.DirectRepository)
+	if !ok {
+		r.Close(ctx)
+		return nil, errors.Errorf("unexpected kopia repository type %T", r)
+	}
+
+	if initialized {
+		// Make Velero the owner of the repository's maintenance so that it can
+		// be run when snapshots are deleted.
+		if err := repo.DirectWriteSession(ctx, dr, repo.WriteSessionOptions{Purpose: "velero:init"}, func(ctx context.Context, w repo.DirectRepositoryWriter) error {
+			params := maintenance.DefaultParams()
+			params.Owner = w.ClientOptions().UsernameAtHost()
+			return maintenance.SetParams(ctx, w, &params)
+		}); err != nil {
+			dr.Close(ctx)
+			return nil, errors.Wrap(err, "error setting kopia repository maintenance parameters")
+		}
+	}
+
+	return dr, nil
+}
+
+// Close releases the repository, closing the connection to it once it's no
+// longer used. The repository's local config and cache are kept.
+func (r *Repository) Close(ctx context.Context) error {
+	connectionsLock.Lock()
+	if r.closed {
+		connectionsLock.Unlock()
+		return nil
+	}
+	r.closed = true
+
+	r.conn.users--
+	if r.conn.users > 0 {
+		connectionsLock.Unlock()
+		return nil
+	}
+	delete(connections, r.repoIdentifier)
+	connectionsLock.Unlock()
+
+	return errors.Wrap(r.conn.repo.Close(ctx), "error closing kopia repository")
+}
+
+// envLookup returns a func looking up variables in a list of environment
+// variables in the format var=val. Later values take precedence.
+func envLookup(env []string) func(string) string {
+	vars := map[string]string{}
+	for _, kv := range env {
+		if i := strings.Index(kv, "="); i > 0 {
+			vars[kv[:i]] = kv[i+1:]
+		}
+	}
+
+	return func(key string) string {
+		return vars[key]
+	}
+}
+
+func newStorage(ctx context.Context, location *velerov1api.BackupStorageLocation, volumeNamespace string, getEnv func(string) string) (blob.Storage, error) {
+	if location.Spec.ObjectStorage == nil {
+		return nil, errors.New("backup storage location has no object storage")
+	}
+
+	config := location.Spec.Config
+	if config == nil {
+		config = map[string]string{}
+	}
+
+	bucket := location.Spec.ObjectStorage.Bucket
+	prefix := path.Join(persistence.NewObjectStoreLayout(location.Spec.ObjectStorage.Prefix).GetKopiaDir(), volumeNamespace) + "/"
+
+	provider := location.Spec.Provider
+	if !strings.Contains(provider, "/") {
+		provider = "velero.io/" + provider
+	}
+
+	switch provider {
+	case "velero.io/aws":
+		opts := &s3.Options{
+			BucketName: bucket,
+			Prefix:     prefix,
+			Endpoint:   "s3.amazonaws.com",
+			Region:     config[regionKey],
+		}
+
+		if s3URL := config[s3URLKey]; s3URL != "" {
+			u, err := url.Parse(s3URL)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing %s %q", s3URLKey, s3URL)
+			}
+			opts.Endpoint = u.Host
+			opts.DoNotUseTLS = u.Scheme == "http"
+		}
+
+		if insecure, _ := strconv.ParseBool(config[insecureSkipTLSVerifyKey]); insecure {
+			opts.DoNotVerifyTLS = true
+		}
+
+		creds, err := getAWSCredentials(getEnv(awsCredentialsFileEnvVar), getEnv(awsProfileEnvVar))
+		if err != nil {
+			return nil, err
+		}
+		opts.AccessKeyID = creds.AccessKeyID
+		opts.SecretAccessKey = creds.SecretAccessKey
+		opts.SessionToken = creds.SessionToken
+
+		return s3.New(ctx, opts)
+	case "velero.io/azure":
+		return azure.New(ctx, &azure.Options{
+			Container:      bucket,
+			Prefix:         prefix,
+			StorageAccount: getEnv(azureAccountNameEnvVar),
+			StorageKey:     getEnv(azureAccountKeyEnvVar),
+			StorageDomain:  config[storageDomainKey],
+		})
+	case "velero.io/gcp":
+		return gcs.New(ctx, &gcs.Options{
+			BucketName:                    bucket,
+			Prefix:                        prefix,
+			ServiceAccountCredentialsFile: getEnv(gcpCredentialsFileEnvVar),
+		})
+	}
+
+	return nil, errors.Errorf("backup storage location provider %q is not supported by the kopia uploader", location.Spec.Provider)
+}
+
+// getAWSCredentials returns the credentials from the given shared credentials
+// file, or the credentials of the default AWS credential chain if no file is given.
+func getAWSCredentials(credentialsFile, profile string) (awscredentials.Value, error) {
+	if credentialsFile != "" {
+		creds, err := awscredentials.NewSharedCredentials(credentialsFile, profile).Get()
+		return creds, errors.Wrap(err, "error getting AWS credentials from credentials file")
+	}
+
+	sess, err := session.NewSession()
+	if err != nil {
+		return awscredentials.Value{}, errors.WithStack(err)
+	}
+
+	creds, err := sess.Config.Credentials.Get()
+	return creds, errors.Wrap(err, "error getting AWS credentials")
+}
+
+// GetVolumeNamespace returns the namespace of the pod volumes whose data is held
+// by the kopia repository with the given identifier.
+func GetVolumeNamespace(repoIdentifier string) (string, error) {
+	if !strings.HasPrefix(repoIdentifier, "kopia:") || path.Base(repoIdentifier) == "" {
+		return "", errors.Errorf("invalid kopia repository identifier %q", repoIdentifier)
+	}
+
+	return path.Base(repoIdentifier), nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kopia/kopia/fs/localfs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/maintenance"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/kopia/kopia/snapshot/policy"
+	"github.com/kopia/kopia/snapshot/restore"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/kopia/kopia/snapshot/snapshotmaintenance"
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// progressInterval is the minimum interval between two progress updates.
+const progressInterval = 10 * time.Second
+
+// ProgressFunc receives the progress of a pod volume backup or restore.
+type ProgressFunc func(velerov1api.PodVolumeOperationProgress)
+
+// Backup takes a snapshot of the directory at the given path, tagged with the
+// given tags, and returns its ID. If parentSnapshot is not empty, the snapshot
+// with that ID is used to skip unchanged files. If the directory holds no files,
+// no snapshot is taken and emptySnapshot is true.
+func (r *Repository) Backup(ctx context.Context, path string, tags map[string]string, parentSnapshot string, progress ProgressFunc) (snapshotID string, emptySnapshot bool, err error) {
+	source, err := localfs.Directory(path)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "error reading directory %s", path)
+	}
+
+	sourceInfo := snapshot.SourceInfo{
+		Host:     repoHostname,
+		UserName: repoUsername,
+		Path:     path,
+	}
+
+	var previous []*snapshot.Manifest
+	if parentSnapshot != "" {
+		parent, err := snapshot.LoadSnapshot(ctx, r, manifest.ID(parentSnapshot))
+		if err != nil {
+			r.log.WithError(err).Warnf("Unable to load parent snapshot %s, taking a full snapshot", parentSnapshot)
+		} else {
+			previous = append(previous, parent)
+		}
+	}
+
+	err = repo.WriteSession(ctx, r, repo.WriteSessionOptions{Purpose: "velero:backup"}, func(ctx context.Context, w repo.RepositoryWriter) error {
+		policyTree, err := policy.TreeForSource(ctx, w, sourceInfo)
+		if err != nil {
+			return errors.Wrap(err, "error getting snapshot policy")
+		}
+
+		uploadProgress := newUploadProgress(progress)
+		uploader := snapshotfs.NewUploader(w)
+		uploader.Progress = uploadProgress
+
+		man, err := uploader.Upload(ctx, source, policyTree, sourceInfo, previous...)
+		if err != nil {
+			return errors.Wrap(err, "error uploading snapshot")
+		}
+		if man.IncompleteReason != "" {
+			return errors.Errorf("snapshot is incomplete: %s", man.IncompleteReason)
+		}
+
+		uploadProgress.done()
+
+		if man.Stats.TotalFileCount == 0 {
+			emptySnapshot = true
+			return nil
+		}
+
+		man.Tags = tags
+		id, err := snapshot.SaveSnapshot(ctx, w, man)
+		if err != nil {
+			return errors.Wrap(err, "error saving snapshot")
+		}
+		snapshotID = string(id)
+
+		return nil
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	return snapshotID, emptySnapshot, nil
+}
+
+// Restore restores the snapshot with the given ID into the directory at the
+// given path, overwriting existing files.
+func (r *Repository) Restore(ctx context.Context, snapshotID, path string, progress ProgressFunc) error {
+	man, err := snapshot.LoadSnapshot(ctx, r, manifest.ID(snapshotID))
+	if err != nil {
+		return errors.Wrapf(err, "error loading snapshot %s", snapshotID)
+	}
+
+	root, err := snapshotfs.SnapshotRoot(r, man)
+	if err != nil {
+		return errors.Wrapf(err, "error getting root of snapshot %s", snapshotID)
+	}
+
+	output := &restore.FilesystemOutput{
+		TargetPath:             path,
+		OverwriteDirectories:   true,
+		OverwriteFiles:         true,
+		OverwriteSymlinks:      true,
+		IgnorePermissionErrors: true,
+	}
+
+	throttled := newThrottledProgress(progress)
+	total := man.Stats.TotalFileSize
+	if _, err := restore.Entry(ctx, r, output, root, restore.Options{
+		// restore full contents at any depth rather than placeholder entries
+		RestoreDirEntryAtDepth: math.MaxInt32,
+		ProgressCallback: func(ctx context.Context, stats restore.Stats) {
+			throttled.report(velerov1api.PodVolumeOperationProgress{
+				TotalBytes: total,
+				BytesDone:  stats.RestoredTotalFileSize,
+			}, false)
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "error restoring snapshot %s", snapshotID)
+	}
+
+	// update progress to 100%
+	throttled.report(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: total,
+		BytesDone:  total,
+	}, true)
+
+	return nil
+}
+
+// DeleteSnapshot deletes the snapshot with the given ID and runs the
+// repository's maintenance, when due, to reclaim the space of data that
+// is no longer referenced.
+func (r *Repository) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	return repo.DirectWriteSession(ctx, r, repo.WriteSessionOptions{Purpose: "velero:delete"}, func(ctx context.Context, w repo.DirectRepositoryWriter) error {
+		if err := w.DeleteManifest(ctx, manifest.ID(snapshotID)); err != nil {
+			return errors.Wrapf(err, "error deleting snapshot %s", snapshotID)
+		}
+
+		if err := snapshotmaintenance.Run(ctx, w, maintenance.ModeAuto, false, maintenance.SafetyFull); err != nil {
+			r.log.WithError(err).Warn("Error running kopia repository maintenance")
+		}
+
+		return nil
+	})
+}
+
+// throttledProgress passes progress updates on to a ProgressFunc at most once
+// per progressInterval.
+type throttledProgress struct {
+	progress ProgressFunc
+
+	lock       sync.Mutex
+	lastReport time.Time
+}
+
+func newThrottledProgress(progress ProgressFunc) *throttledProgress {
+	return &throttledProgress{progress: progress}
+}
+
+// report passes the update on if the last one is older than progressInterval,
+// or unconditionally if force is true.
+func (t *throttledProgress) report(update velerov1api.PodVolumeOperationProgress, force bool) {
+	if t.progress == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	if !force && now.Sub(t.lastReport) < progressInterval {
+		return
+	}
+	t.lastReport = now
+
+	t.progress(update)
+}
+
+// uploadProgress reports the progress of a kopia upload.
+type uploadProgress struct {
+	// totalBytes and bytesDone are accessed atomically and so must stay
+	// 64-bit aligned.
+	totalBytes int64
+	bytesDone  int64
+
+	snapshotfs.NullUploadProgress
+	throttled *throttledProgress
+}
+
+func newUploadProgress(progress ProgressFunc) *uploadProgress {
+	return &uploadProgress{throttled: newThrottledProgress(progress)}
+}
+
+// EstimatedDataSize records the total size of the data to upload.
+func (p *uploadProgress) EstimatedDataSize(fileCount int, totalBytes int64) {
+	atomic.StoreInt64(&p.totalBytes, totalBytes)
+	p.report(false)
+}
+
+// HashedBytes records data that was read and processed, whether or not it
+// had to be uploaded.
+func (p *uploadProgress) HashedBytes(numBytes int64) {
+	atomic.AddInt64(&p.bytesDone, numBytes)
+	p.report(false)
+}
+
+// CachedFile records files that are unchanged since the parent snapshot and
+// so are not read again.
+func (p *uploadProgress) CachedFile(path string, size int64) {
+	atomic.AddInt64(&p.bytesDone, size)
+	p.report(false)
+}
+
+// done updates the progress to 100%.
+func (p *uploadProgress) done() {
+	atomic.StoreInt64(&p.bytesDone, atomic.LoadInt64(&p.totalBytes))
+	p.report(true)
+}
+
+func (p *uploadProgress) report(force bool) {
+	p.throttled.report(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: atomic.LoadInt64(&p.totalBytes),
+		BytesDone:  atomic.LoadInt64(&p.bytesDone),
+	}, force)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kopia/kopia/repo/blob/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestRepository(t *testing.T, storageDir, password string) *Repository {
+	t.Helper()
+
+	ctx := context.Background()
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: storageDir}, true)
+	require.NoError(t, err)
+
+	r, err := openStorage(ctx, st, "kopia:"+storageDir, password, velerotest.NewLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close(ctx) })
+
+	return r
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	t.Setenv("VELERO_SCRATCH_DIR", t.TempDir())
+	ctx := context.Background()
+	storageDir := t.TempDir()
+	r := newTestRepository(t, storageDir, "passw0rd")
+
+	source := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "dir"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(source, "file-1"), []byte("contents of file-1"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(source, "dir", "file-2"), []byte("contents of file-2"), 0644))
+
+	var lastProgress velerov1api.PodVolumeOperationProgress
+	snapshotID, empty, err := r.Backup(ctx, source, map[string]string{"backup": "backup-1"}, "", func(p velerov1api.PodVolumeOperationProgress) {
+		lastProgress = p
+	})
+	require.NoError(t, err)
+	assert.False(t, empty)
+	assert.NotEmpty(t, snapshotID)
+	assert.Equal(t, lastProgress.TotalBytes, lastProgress.BytesDone)
+
+	// an incremental backup based on the first one sees the changed file
+	require.NoError(t, ioutil.WriteFile(filepath.Join(source, "file-1"), []byte("new contents of file-1"), 0644))
+	secondSnapshotID, _, err := r.Backup(ctx, source, nil, snapshotID, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, snapshotID, secondSnapshotID)
+
+	// the repository can be reopened by another client with the same password
+	require.NoError(t, r.Close(ctx))
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: storageDir}, false)
+	require.NoError(t, err)
+	other, err := openStorage(ctx, st, "kopia:other", "passw0rd", velerotest.NewLogger())
+	require.NoError(t, err)
+	defer other.Close(ctx)
+
+	target := t.TempDir()
+	require.NoError(t, other.Restore(ctx, snapshotID, target, nil))
+
+	data, err := ioutil.ReadFile(filepath.Join(target, "file-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "contents of file-1", string(data))
+	data, err = ioutil.ReadFile(filepath.Join(target, "dir", "file-2"))
+	require.NoError(t, err)
+	assert.Equal(t, "contents of file-2", string(data))
+
+	require.NoError(t, other.DeleteSnapshot(ctx, snapshotID))
+	assert.Error(t, other.Restore(ctx, snapshotID, t.TempDir(), nil))
+}
+
+func TestBackupEmptyDirectory(t *testing.T) {
+	t.Setenv("VELERO_SCRATCH_DIR", t.TempDir())
+	r := newTestRepository(t, t.TempDir(), "passw0rd")
+
+	snapshotID, empty, err := r.Backup(context.Background(), t.TempDir(), nil, "", nil)
+	require.NoError(t, err)
+	assert.True(t, empty)
+	assert.Empty(t, snapshotID)
+}
+
+func TestOpenWithWrongPassword(t *testing.T) {
+	t.Setenv("VELERO_SCRATCH_DIR", t.TempDir())
+	ctx := context.Background()
+	storageDir := t.TempDir()
+	r := newTestRepository(t, storageDir, "passw0rd")
+
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: storageDir}, false)
+	require.NoError(t, err)
+
+	// while the repository's connection is open
+	_, err = openStorage(ctx, st, "kopia:"+storageDir, "wrong", velerotest.NewLogger())
+	assert.Error(t, err)
+
+	// after it's closed
+	require.NoError(t, r.Close(ctx))
+	_, err = openStorage(ctx, st, "kopia:"+storageDir, "wrong", velerotest.NewLogger())
+	assert.Error(t, err)
+	assert.Empty(t, connections)
+}
+
+func TestOpenSharesConnection(t *testing.T) {
+	scratchDir := t.TempDir()
+	t.Setenv("VELERO_SCRATCH_DIR", scratchDir)
+	ctx := context.Background()
+	storageDir := t.TempDir()
+
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: storageDir}, true)
+	require.NoError(t, err)
+
+	first, err := openStorage(ctx, st, "kopia:"+storageDir, "passw0rd", velerotest.NewLogger())
+	require.NoError(t, err)
+	second, err := openStorage(ctx, st, "kopia:"+storageDir, "passw0rd", velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.True(t, first.DirectRepository == second.DirectRepository)
+
+	// the connection stays open while it's used
+	require.NoError(t, first.Close(ctx))
+	_, _, err = second.Backup(ctx, t.TempDir(), nil, "", nil)
+	require.NoError(t, err)
+	require.NoError(t, second.Close(ctx))
+	assert.Empty(t, connections)
+
+	// the config and cache are kept for the next connection
+	dir, err := configDir("kopia:" + storageDir)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(dir, scratchDir))
+	_, err = os.Stat(filepath.Join(dir, "repository.config"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "cache"))
+	assert.NoError(t, err)
+
+	third, err := openStorage(ctx, st, "kopia:"+storageDir, "passw0rd", velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.NoError(t, third.Close(ctx))
+}
+
+func TestGetRepoIdentifier(t *testing.T) {
+	location := &velerov1api.BackupStorageLocation{
+		Spec: velerov1api.BackupStorageLocationSpec{
+			StorageType: velerov1api.StorageType{
+				ObjectStorage: &velerov1api.ObjectStorageLocation{Bucket: "bucket", Prefix: "prefix"},
+			},
+		},
+	}
+
+	repoIdentifier := GetRepoIdentifier(location, "ns-1")
+	assert.Equal(t, "kopia:bucket/prefix/kopia/ns-1", repoIdentifier)
+
+	volumeNamespace, err := GetVolumeNamespace(repoIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, "ns-1", volumeNamespace)
+
+	_, err = GetVolumeNamespace("s3:s3.amazonaws.com/bucket/restic/ns-1")
+	assert.Error(t, err)
+}
+
+func TestNewStorageUnsupportedProvider(t *testing.T) {
+	location := &velerov1api.BackupStorageLocation{
+		Spec: velerov1api.BackupStorageLocationSpec{
+			Provider: "example.com/custom",
+			StorageType: velerov1api.StorageType{
+				ObjectStorage: &velerov1api.ObjectStorageLocation{Bucket: "bucket"},
+			},
+		},
+	}
+
+	_, err := newStorage(context.Background(), location, "ns-1", envLookup(nil))
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
+)
+
+// kopiaProvider backs up and restores pod volumes in-process with kopia.
+type kopiaProvider struct {
+	repo *kopia.Repository
+}
+
+func newKopiaProvider(
+	ctx context.Context,
+	repoIdentifier string,
+	location *velerov1api.BackupStorageLocation,
+	credentialsFileStore credentials.FileStore,
+	log logrus.FieldLogger,
+) (Provider, error) {
+	volumeNamespace, err := kopia.GetVolumeNamespace(repoIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := restic.GetRepoPassword(credentialsFileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := restic.CmdEnv(location, credentialsFileStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the backup storage location's credentials")
+	}
+
+	repo, err := kopia.Open(ctx, location, volumeNamespace, password, env, log)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kopiaProvider{repo: repo}, nil
+}
+
+func (p *kopiaProvider) RunBackup(ctx context.Context, path string, tags map[string]string, parentSnapshot string, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, bool, error) {
+	return p.repo.Backup(ctx, path, tags, parentSnapshot, updateFunc)
+}
+
+func (p *kopiaProvider) RunRestore(ctx context.Context, snapshotID, volumePath string, updateFunc func(velerov1api.PodVolumeOperationProgress)) error {
+	return p.repo.Restore(ctx, snapshotID, volumePath, updateFunc)
+}
+
+func (p *kopiaProvider) Close(ctx context.Context) error {
+	return p.repo.Close(ctx)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// Provider moves the data of a pod volume between the file system and the
+// repository in a backup storage location.
+type Provider interface {
+	// RunBackup backs up the directory at the given path, tagging the snapshot with
+	// the given tags, and returns the ID of the snapshot. If parentSnapshot is not
+	// empty, it is used to find the files that are unchanged since that snapshot.
+	// If the directory holds no data, no snapshot is taken and emptySnapshot is true.
+	RunBackup(ctx context.Context, path string, tags map[string]string, parentSnapshot string, updateFunc func(velerov1api.PodVolumeOperationProgress)) (snapshotID string, emptySnapshot bool, err error)
+
+	// RunRestore restores the snapshot with the given ID into the directory at the
+	// given path.
+	RunRestore(ctx context.Context, snapshotID, volumePath string, updateFunc func(velerov1api.PodVolumeOperationProgress)) error
+
+	// Close releases the resources held by the provider.
+	Close(ctx context.Context) error
+}
+
+// ResticBackupExecuter runs restic backups.
+type ResticBackupExecuter interface {
+	RunBackup(*restic.Command, logrus.FieldLogger, func(velerov1api.PodVolumeOperationProgress)) (string, string, error)
+	GetSnapshotID(*restic.Command) (string, error)
+}
+
+// NewUploaderProvider returns the Provider for the given uploader type, moving
+// data to and from the repository with the given identifier in the backup
+// storage location. resticExec is only used by the restic uploader.
+func NewUploaderProvider(
+	ctx context.Context,
+	uploaderType velerov1api.UploaderType,
+	repoIdentifier string,
+	location *velerov1api.BackupStorageLocation,
+	credentialsFileStore credentials.FileStore,
+	fileSystem filesystem.Interface,
+	resticExec ResticBackupExecuter,
+	log logrus.FieldLogger,
+) (Provider, error) {
+	switch uploader.GetUploaderType(uploaderType) {
+	case velerov1api.UploaderTypeRestic:
+		return newResticProvider(repoIdentifier, location, credentialsFileStore, fileSystem, resticExec, log)
+	case velerov1api.UploaderTypeKopia:
+		return newKopiaProvider(ctx, repoIdentifier, location, credentialsFileStore, log)
+	default:
+		return nil, errors.Errorf("unsupported uploader type %q", uploaderType)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/exec"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// resticProvider runs the restic binary to back up and restore pod volumes.
+type resticProvider struct {
+	repoIdentifier  string
+	credentialsFile string
+	caCertFile      string
+	cmdEnv          []string
+	extraFlags      []string
+	exec            ResticBackupExecuter
+	log             logrus.FieldLogger
+}
+
+func newResticProvider(
+	repoIdentifier string,
+	location *velerov1api.BackupStorageLocation,
+	credentialsFileStore credentials.FileStore,
+	fileSystem filesystem.Interface,
+	exec ResticBackupExecuter,
+	log logrus.FieldLogger,
+) (Provider, error) {
+	credentialsFile, err := credentialsFileStore.Path(restic.RepoKeySelector())
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp restic credentials file")
+	}
+
+	provider := &resticProvider{
+		repoIdentifier:  repoIdentifier,
+		credentialsFile: credentialsFile,
+		exec:            exec,
+		log:             log,
+	}
+
+	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
+	if location.Spec.ObjectStorage != nil && location.Spec.ObjectStorage.CACert != nil {
+		provider.caCertFile, err = restic.TempCACertFile(location.Spec.ObjectStorage.CACert, location.Name, fileSystem)
+		if err != nil {
+			log.WithError(err).Error("Error creating temp cacert file")
+		}
+	}
+
+	provider.cmdEnv, err = restic.CmdEnv(location, credentialsFileStore)
+	if err != nil {
+		provider.Close(context.Background())
+		return nil, errors.Wrap(err, "error setting restic cmd env")
+	}
+
+	// #4820: restrieve insecureSkipTLSVerify from BSL configuration for
+	// AWS plugin. If nothing is return, that means insecureSkipTLSVerify
+	// is not enable for Restic command.
+	if skipTLSRet := restic.GetInsecureSkipTLSVerifyFromBSL(location, log); len(skipTLSRet) > 0 {
+		provider.extraFlags = append(provider.extraFlags, skipTLSRet)
+	}
+
+	return provider, nil
+}
+
+func (p *resticProvider) configure(cmd *restic.Command) {
+	cmd.CACertFile = p.caCertFile
+	cmd.Env = p.cmdEnv
+	cmd.ExtraFlags = append(cmd.ExtraFlags, p.extraFlags...)
+}
+
+func (p *resticProvider) RunBackup(ctx context.Context, path string, tags map[string]string, parentSnapshot string, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, bool, error) {
+	cmd := restic.BackupCommand(p.repoIdentifier, p.credentialsFile, path, tags)
+	p.configure(cmd)
+	if parentSnapshot != "" {
+		cmd.ExtraFlags = append(cmd.ExtraFlags, fmt.Sprintf("--parent=%s", parentSnapshot))
+	}
+	// the restic process is killed if the backup is canceled
+	cmd.Context = ctx
+
+	stdout, stderr, err := p.exec.RunBackup(cmd, p.log, updateFunc)
+	if ctx.Err() != nil {
+		p.unlock()
+		return "", false, errors.Wrap(ctx.Err(), "restic backup canceled")
+	}
+	if err != nil {
+		if strings.Contains(stderr, "snapshot is empty") {
+			return "", true, nil
+		}
+		return "", false, errors.Wrapf(err, "error running restic backup, stderr=%s", stderr)
+	}
+	p.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+
+	snapshotIDCmd := restic.GetSnapshotCommand(p.repoIdentifier, p.credentialsFile, tags)
+	p.configure(snapshotIDCmd)
+
+	snapshotID, err := p.exec.GetSnapshotID(snapshotIDCmd)
+	if err != nil {
+		return "", false, errors.Wrap(err, "error getting snapshot id")
+	}
+
+	return snapshotID, false, nil
+}
+
+// unlock removes the lock a killed restic process left in the repository. The data it uploaded
+// isn't referenced by any snapshot, so it's removed by the next prune of the repository.
+func (p *resticProvider) unlock() {
+	cmd := restic.UnlockCommand(p.repoIdentifier)
+	cmd.PasswordFile = p.credentialsFile
+	p.configure(cmd)
+
+	if _, stderr, err := exec.RunCommand(cmd.Cmd()); err != nil {
+		p.log.WithError(err).WithField("stderr", stderr).Warn("Error removing the lock of the canceled restic backup")
+	}
+}
+
+func (p *resticProvider) RunRestore(ctx context.Context, snapshotID, volumePath string, updateFunc func(velerov1api.PodVolumeOperationProgress)) error {
+	cmd := restic.RestoreCommand(p.repoIdentifier, p.credentialsFile, snapshotID, volumePath)
+	p.configure(cmd)
+
+	stdout, stderr, err := restic.RunRestore(cmd, p.log, updateFunc)
+	if err != nil {
+		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+	}
+	p.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+
+	return nil
+}
+
+func (p *resticProvider) Close(ctx context.Context) error {
+	// ignore errors since there's nothing we can do and these are temp files.
+	os.Remove(p.credentialsFile)
+	if p.caCertFile != "" {
+		os.Remove(p.caCertFile)
+	}
+
+	return nil
+}
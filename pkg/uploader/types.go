@@ -0,0 +1,43 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uploader
+
+import (
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidateUploaderType returns an error if the given uploader type is not supported.
+func ValidateUploaderType(t string) error {
+	switch velerov1api.UploaderType(t) {
+	case velerov1api.UploaderTypeRestic, velerov1api.UploaderTypeKopia:
+		return nil
+	default:
+		return errors.Errorf("invalid uploader type %q, valid uploader types are %q and %q", t, velerov1api.UploaderTypeRestic, velerov1api.UploaderTypeKopia)
+	}
+}
+
+// GetUploaderType returns the uploader type recorded on a pod volume backup or
+// restore. Pod volume backups and restores created before the uploader type was
+// recorded were always handled by restic.
+func GetUploaderType(t velerov1api.UploaderType) velerov1api.UploaderType {
+	if t == "" {
+		return velerov1api.UploaderTypeRestic
+	}
+	return t
+}
@@ -0,0 +1,55 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uploader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateUploaderType(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "restic is valid", input: "restic"},
+		{name: "kopia is valid", input: "kopia"},
+		{name: "empty is invalid", input: "", wantErr: true},
+		{name: "unknown type is invalid", input: "rsync", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateUploaderType(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetUploaderType(t *testing.T) {
+	assert.Equal(t, velerov1api.UploaderTypeRestic, GetUploaderType(""))
+	assert.Equal(t, velerov1api.UploaderTypeRestic, GetUploaderType(velerov1api.UploaderTypeRestic))
+	assert.Equal(t, velerov1api.UploaderTypeKopia, GetUploaderType(velerov1api.UploaderTypeKopia))
+}
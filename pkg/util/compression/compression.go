@@ -0,0 +1,157 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package compression compresses and decompresses backup tarballs with the
+// algorithms backups can be configured with.
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Validate returns an error if config isn't a valid compression configuration.
+func Validate(config velerov1api.CompressionConfig) error {
+	var maxLevel int
+	switch Algorithm(config) {
+	case velerov1api.CompressionAlgorithmGzip:
+		maxLevel = gzip.BestCompression
+	case velerov1api.CompressionAlgorithmZstd:
+		maxLevel = 22
+	case velerov1api.CompressionAlgorithmNone:
+		if config.Level != 0 {
+			return errors.New("a compression level can't be set when the compression algorithm is none")
+		}
+	default:
+		return errors.Errorf("unsupported compression algorithm %q, must be one of gzip, zstd or none", config.Algorithm)
+	}
+
+	if config.Level < 0 || config.Level > maxLevel {
+		return errors.Errorf("invalid %s compression level %d, must be between 0 and %d", Algorithm(config), config.Level, maxLevel)
+	}
+
+	return nil
+}
+
+// Algorithm returns the compression algorithm of config, defaulting to gzip.
+func Algorithm(config velerov1api.CompressionConfig) velerov1api.CompressionAlgorithm {
+	if config.Algorithm == "" {
+		return velerov1api.CompressionAlgorithmGzip
+	}
+	return config.Algorithm
+}
+
+// Extension returns the file extension of tarballs compressed with algorithm.
+func Extension(algorithm velerov1api.CompressionAlgorithm) string {
+	switch algorithm {
+	case velerov1api.CompressionAlgorithmZstd:
+		return ".tar.zst"
+	case velerov1api.CompressionAlgorithmNone:
+		return ".tar"
+	default:
+		return ".tar.gz"
+	}
+}
+
+// NewWriter returns a writer that compresses what's written to it as configured
+// by config and writes it to w. The writer must be closed to flush it.
+func NewWriter(w io.Writer, config velerov1api.CompressionConfig) (io.WriteCloser, error) {
+	if err := Validate(config); err != nil {
+		return nil, err
+	}
+
+	switch Algorithm(config) {
+	case velerov1api.CompressionAlgorithmZstd:
+		level := zstd.SpeedDefault
+		if config.Level > 0 {
+			level = zstd.EncoderLevelFromZstd(config.Level)
+		}
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(level))
+		return zw, errors.WithStack(err)
+	case velerov1api.CompressionAlgorithmNone:
+		return nopWriteCloser{w}, nil
+	default:
+		level := gzip.DefaultCompression
+		if config.Level > 0 {
+			level = config.Level
+		}
+		gzw, err := gzip.NewWriterLevel(w, level)
+		return gzw, errors.WithStack(err)
+	}
+}
+
+// NewReader returns a reader of the decompressed contents of r. The algorithm
+// r is compressed with is detected from its contents, so tarballs compressed
+// with any of the supported algorithms, or not compressed, can be read.
+func NewReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	algorithm, err := Detect(br)
+	if err != nil {
+		return nil, err
+	}
+
+	switch algorithm {
+	case velerov1api.CompressionAlgorithmGzip:
+		gzr, err := gzip.NewReader(br)
+		return gzr, errors.WithStack(err)
+	case velerov1api.CompressionAlgorithmZstd:
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return ioutil.NopCloser(br), nil
+	}
+}
+
+// Detect returns the algorithm the contents read from r are compressed with.
+// It only peeks at the contents, so r can still be read from the start.
+func Detect(r *bufio.Reader) (velerov1api.CompressionAlgorithm, error) {
+	start, err := r.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return "", errors.WithStack(err)
+	}
+
+	switch {
+	case bytes.HasPrefix(start, gzipMagic):
+		return velerov1api.CompressionAlgorithmGzip, nil
+	case bytes.HasPrefix(start, zstdMagic):
+		return velerov1api.CompressionAlgorithmZstd, nil
+	default:
+		return velerov1api.CompressionAlgorithmNone, nil
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
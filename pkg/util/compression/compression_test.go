@@ -0,0 +1,140 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestCompressDecompress(t *testing.T) {
+	contents := strings.Repeat("velero backup contents ", 1000)
+
+	tests := []struct {
+		name          string
+		config        velerov1api.CompressionConfig
+		wantAlgorithm velerov1api.CompressionAlgorithm
+	}{
+		{
+			name:          "default compression is gzip",
+			wantAlgorithm: velerov1api.CompressionAlgorithmGzip,
+		},
+		{
+			name:          "gzip with a level",
+			config:        velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 1},
+			wantAlgorithm: velerov1api.CompressionAlgorithmGzip,
+		},
+		{
+			name:          "zstd",
+			config:        velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd},
+			wantAlgorithm: velerov1api.CompressionAlgorithmZstd,
+		},
+		{
+			name:          "zstd with a level",
+			config:        velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 19},
+			wantAlgorithm: velerov1api.CompressionAlgorithmZstd,
+		},
+		{
+			name:          "no compression",
+			config:        velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmNone},
+			wantAlgorithm: velerov1api.CompressionAlgorithmNone,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			w, err := NewWriter(buf, tc.config)
+			require.NoError(t, err)
+			_, err = w.Write([]byte(contents))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			if tc.wantAlgorithm == velerov1api.CompressionAlgorithmNone {
+				assert.Equal(t, contents, buf.String())
+			} else {
+				assert.Less(t, buf.Len(), len(contents))
+			}
+
+			algorithm, err := Detect(bufio.NewReader(bytes.NewReader(buf.Bytes())))
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAlgorithm, algorithm)
+
+			r, err := NewReader(buf)
+			require.NoError(t, err)
+			defer r.Close()
+
+			res, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, contents, string(res))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  velerov1api.CompressionConfig
+		wantErr string
+	}{
+		{
+			name: "empty config is valid",
+		},
+		{
+			name:   "gzip with the best compression is valid",
+			config: velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 9},
+		},
+		{
+			name:   "zstd with the best compression is valid",
+			config: velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 22},
+		},
+		{
+			name:    "gzip level above 9 is invalid",
+			config:  velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 10},
+			wantErr: "invalid gzip compression level 10, must be between 0 and 9",
+		},
+		{
+			name:    "level without compression is invalid",
+			config:  velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmNone, Level: 1},
+			wantErr: "a compression level can't be set when the compression algorithm is none",
+		},
+		{
+			name:    "unknown algorithm is invalid",
+			config:  velerov1api.CompressionConfig{Algorithm: "lz4"},
+			wantErr: "unsupported compression algorithm \"lz4\", must be one of gzip, zstd or none",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.config)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
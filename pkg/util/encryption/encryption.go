@@ -0,0 +1,299 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements the client-side encryption of the objects
+// Velero stores in backup storage locations.
+//
+// Objects are encrypted with AES-256-GCM envelope encryption: every object is
+// encrypted with its own random data key, and the data key is encrypted with
+// the location's key and stored in the object's header, along with the ID of
+// the location's key. The contents are encrypted in chunks, so that objects of
+// any size can be encrypted and decrypted as they're streamed, and the last
+// chunk is marked so that truncated objects can't be decrypted.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// magic is the start of every encrypted object.
+	magic = "VLRENC01"
+
+	// KeySize is the size in bytes of the AES-256 keys objects are encrypted with.
+	KeySize = 32
+
+	keyIDSize    = 8
+	prefixSize   = 7
+	chunkSize    = 64 * 1024
+	tagSize      = 16
+	nonceSize    = 12
+	wrappedSize  = KeySize + tagSize
+	sealedChunk  = chunkSize + tagSize
+	headerSize   = len(magic) + keyIDSize + nonceSize + wrappedSize + prefixSize
+	lastChunk    = 1
+	notLastChunk = 0
+)
+
+// KeyID returns the ID of key that's recorded in the objects encrypted with it.
+func KeyID(key []byte) string {
+	return hex.EncodeToString(keyID(key))
+}
+
+func keyID(key []byte) []byte {
+	sum := sha256.Sum256(key)
+	return sum[:keyIDSize]
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, errors.Errorf("encryption key must be %d bytes long, not %d", KeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	return aead, errors.WithStack(err)
+}
+
+// chunkNonce returns the nonce of the counter'th chunk of an object.
+func chunkNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[prefixSize:], counter)
+	if last {
+		nonce[nonceSize-1] = lastChunk
+	} else {
+		nonce[nonceSize-1] = notLastChunk
+	}
+	return nonce
+}
+
+// isLast reports whether src has been read to the end.
+func isLast(src *bufio.Reader) (bool, error) {
+	_, err := src.Peek(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	return false, err
+}
+
+type encryptingReader struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	plain   []byte
+	sealed  []byte
+	pending []byte
+	done    bool
+}
+
+// NewEncryptingReader returns a reader of the contents of src encrypted with key.
+func NewEncryptingReader(src io.Reader, key []byte) (io.Reader, error) {
+	kek, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, KeySize)
+	wrapNonce := make([]byte, nonceSize)
+	prefix := make([]byte, prefixSize)
+	for _, b := range [][]byte{dataKey, wrapNonce, prefix} {
+		if _, err := io.ReadFull(rand.Reader, b); err != nil {
+			return nil, errors.Wrap(err, "error generating data key")
+		}
+	}
+
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// the magic and the key ID are authenticated along with the data key
+	authenticated := append(append([]byte{}, magic...), keyID(key)...)
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, authenticated...)
+	header = append(header, wrapNonce...)
+	header = kek.Seal(header, wrapNonce, dataKey, authenticated)
+	header = append(header, prefix...)
+
+	return &encryptingReader{
+		src:     bufio.NewReaderSize(src, chunkSize),
+		aead:    aead,
+		prefix:  prefix,
+		plain:   make([]byte, chunkSize),
+		sealed:  make([]byte, 0, sealedChunk),
+		pending: header,
+	}, nil
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.sealChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *encryptingReader) sealChunk() error {
+	n, err := io.ReadFull(r.src, r.plain)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	last := err != nil
+	if !last {
+		if last, err = isLast(r.src); err != nil {
+			return err
+		}
+	}
+	if !last && r.counter == math.MaxUint32 {
+		return errors.New("object is too large to be encrypted")
+	}
+
+	r.pending = r.aead.Seal(r.sealed[:0], chunkNonce(r.prefix, r.counter, last), r.plain[:n], nil)
+	r.counter++
+	r.done = last
+
+	return nil
+}
+
+type decryptingReader struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	sealed  []byte
+	plain   []byte
+	pending []byte
+	done    bool
+}
+
+// NewDecryptingReader returns a reader of the contents of src decrypted with key.
+// Objects that aren't encrypted are rejected, unless allowUnencrypted is true,
+// in which case they're read as they are.
+func NewDecryptingReader(src io.Reader, key []byte, allowUnencrypted bool) (io.Reader, error) {
+	br := bufio.NewReaderSize(src, sealedChunk)
+
+	start, err := br.Peek(len(magic))
+	if err != nil && err != io.EOF {
+		return nil, errors.WithStack(err)
+	}
+	if string(start) != magic {
+		if allowUnencrypted {
+			return br, nil
+		}
+		return nil, errors.New("object isn't encrypted")
+	}
+
+	kek, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Wrap(err, "error reading encryption header")
+	}
+
+	id := header[len(magic) : len(magic)+keyIDSize]
+	if !bytes.Equal(id, keyID(key)) {
+		return nil, errors.Errorf("object is encrypted with key %s, not with key %s", hex.EncodeToString(id), KeyID(key))
+	}
+
+	wrapNonce := header[len(magic)+keyIDSize : len(magic)+keyIDSize+nonceSize]
+	wrapped := header[len(magic)+keyIDSize+nonceSize : headerSize-prefixSize]
+	dataKey, err := kek.Open(nil, wrapNonce, wrapped, header[:len(magic)+keyIDSize])
+	if err != nil {
+		return nil, errors.New("error decrypting the object's data key, the object may have been tampered with")
+	}
+
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReader{
+		src:    br,
+		aead:   aead,
+		prefix: header[headerSize-prefixSize:],
+		sealed: make([]byte, sealedChunk),
+		plain:  make([]byte, 0, chunkSize),
+	}, nil
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.openChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *decryptingReader) openChunk() error {
+	n, err := io.ReadFull(r.src, r.sealed)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	last := err != nil
+	if !last {
+		if last, err = isLast(r.src); err != nil {
+			return err
+		}
+	}
+
+	plain, err := r.aead.Open(r.plain[:0], chunkNonce(r.prefix, r.counter, last), r.sealed[:n], nil)
+	if err != nil {
+		return errors.New("error decrypting object, it may have been tampered with or truncated")
+	}
+
+	r.pending = plain
+	r.counter++
+	r.done = last
+
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	key      = bytes.Repeat([]byte{1}, KeySize)
+	otherKey = bytes.Repeat([]byte{2}, KeySize)
+)
+
+func encrypt(t *testing.T, plaintext []byte) []byte {
+	t.Helper()
+
+	r, err := NewEncryptingReader(bytes.NewReader(plaintext), key)
+	require.NoError(t, err)
+
+	ciphertext, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+
+	return ciphertext
+}
+
+func decrypt(ciphertext, key []byte) ([]byte, error) {
+	r, err := NewDecryptingReader(bytes.NewReader(ciphertext), key, false)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty object", size: 0},
+		{name: "object smaller than a chunk", size: 100},
+		{name: "object of exactly one chunk", size: chunkSize},
+		{name: "object of several chunks", size: 3*chunkSize + 100},
+		{name: "object of exactly several chunks", size: 2 * chunkSize},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plaintext := make([]byte, tc.size)
+			for i := range plaintext {
+				plaintext[i] = byte(i % 251)
+			}
+
+			ciphertext := encrypt(t, plaintext)
+			if tc.size > 0 {
+				assert.NotContains(t, string(ciphertext), string(plaintext))
+			}
+
+			res, err := decrypt(ciphertext, key)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, res)
+		})
+	}
+}
+
+func TestDecryptUnencryptedObject(t *testing.T) {
+	for _, plaintext := range []string{"", "{}", `{"kind":"Backup"}`} {
+		_, err := decrypt([]byte(plaintext), key)
+		assert.EqualError(t, err, "object isn't encrypted")
+
+		r, err := NewDecryptingReader(bytes.NewReader([]byte(plaintext)), key, true)
+		require.NoError(t, err)
+		res, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, string(res))
+	}
+}
+
+func TestDecryptWithWrongKey(t *testing.T) {
+	ciphertext := encrypt(t, []byte("secret"))
+
+	_, err := decrypt(ciphertext, otherKey)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "object is encrypted with key "+KeyID(key)+", not with key "+KeyID(otherKey))
+}
+
+func TestDecryptTamperedObject(t *testing.T) {
+	plaintext := make([]byte, 2*chunkSize+10)
+
+	tests := []struct {
+		name   string
+		tamper func([]byte) []byte
+	}{
+		{
+			name: "changed contents",
+			tamper: func(ciphertext []byte) []byte {
+				ciphertext[headerSize+10] ^= 1
+				return ciphertext
+			},
+		},
+		{
+			name: "changed data key",
+			tamper: func(ciphertext []byte) []byte {
+				ciphertext[len(magic)+keyIDSize+nonceSize] ^= 1
+				return ciphertext
+			},
+		},
+		{
+			name: "truncated after a chunk",
+			tamper: func(ciphertext []byte) []byte {
+				return ciphertext[:headerSize+sealedChunk]
+			},
+		},
+		{
+			name: "truncated in a chunk",
+			tamper: func(ciphertext []byte) []byte {
+				return ciphertext[:len(ciphertext)-5]
+			},
+		},
+		{
+			name: "truncated after the header",
+			tamper: func(ciphertext []byte) []byte {
+				return ciphertext[:headerSize]
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decrypt(tc.tamper(encrypt(t, plaintext)), key)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestInvalidKey(t *testing.T) {
+	_, err := NewEncryptingReader(bytes.NewReader(nil), []byte("too short"))
+	assert.EqualError(t, err, "encryption key must be 32 bytes long, not 9")
+}
@@ -16,7 +16,16 @@ limitations under the License.
 
 package volume
 
-import isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
+)
 
 // ItemSnapshot stores information about an item snapshot (includes volumes and other Astrolabe objects) taken as
 // part of a Velero backup.
@@ -42,7 +51,7 @@ type ItemSnapshotSpec struct {
 	Location string `json:"location"`
 
 	// Kubernetes resource identifier for the item
-	ResourceIdentifier string "json:resourceIdentifier"
+	ResourceIdentifier string `json:"resourceIdentifier"`
 }
 
 type ItemSnapshotStatus struct {
@@ -55,3 +64,22 @@ type ItemSnapshotStatus struct {
 	// Phase is the current state of the ItemSnapshot.
 	Phase isv1.SnapshotPhase `json:"phase,omitempty"`
 }
+
+// ItemSnapshotResourceIdentifier returns the identifier of an item, as recorded in the
+// ResourceIdentifier of the snapshots taken of it.
+func ItemSnapshotResourceIdentifier(id velero.ResourceIdentifier) string {
+	return path.Join(id.GroupResource.String(), id.Namespace, id.Name)
+}
+
+// ParseItemSnapshotResourceIdentifier parses the ResourceIdentifier of an item snapshot.
+func ParseItemSnapshotResourceIdentifier(id string) (velero.ResourceIdentifier, error) {
+	parts := strings.Split(id, "/")
+	switch len(parts) {
+	case 2:
+		return velero.ResourceIdentifier{GroupResource: schema.ParseGroupResource(parts[0]), Name: parts[1]}, nil
+	case 3:
+		return velero.ResourceIdentifier{GroupResource: schema.ParseGroupResource(parts[0]), Namespace: parts[1], Name: parts[2]}, nil
+	}
+
+	return velero.ResourceIdentifier{}, errors.Errorf("invalid item snapshot resource identifier %q", id)
+}
@@ -75,6 +75,10 @@ const (
 	// SnapshotPhaseCompleted means the volume snapshot was successfully created and can be restored from..
 	SnapshotPhaseCompleted SnapshotPhase = "Completed"
 
+	// SnapshotPhaseUploading means the volume snapshot was taken, but the volume
+	// snapshotter is still copying its data, so it can't be restored from yet.
+	SnapshotPhaseUploading SnapshotPhase = "Uploading"
+
 	// SnapshotPhaseFailed means the volume snapshot was unable to execute.
 	SnapshotPhaseFailed SnapshotPhase = "Failed"
 )